@@ -15,6 +15,7 @@ import (
 	"github.com/openkcm/common-sdk/pkg/otlp"
 	"github.com/samber/oops"
 
+	"github.com/openkcm/cmk/internal/auditor"
 	"github.com/openkcm/cmk/internal/config"
 	"github.com/openkcm/cmk/internal/constants"
 	"github.com/openkcm/cmk/internal/daemon"
@@ -104,6 +105,8 @@ func run(ctx context.Context, cfg *config.Config) error {
 		return oops.In("main").Wrapf(err, "closing server")
 	}
 
+	auditor.CloseExporters(ctx, cfg)
+
 	return nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/openkcm/common-sdk/pkg/otlp"
 	"github.com/samber/oops"
 
+	"github.com/openkcm/cmk/internal/auditor"
 	authz_loader "github.com/openkcm/cmk/internal/authz/loader"
 	authz_repo "github.com/openkcm/cmk/internal/authz/repo"
 	"github.com/openkcm/cmk/internal/clients"
@@ -107,6 +108,7 @@ func run(ctx context.Context, cfg *config.Config) error {
 
 	<-ctx.Done()
 	reconciler.CloseAmqpClients(ctx)
+	auditor.CloseExporters(ctx, cfg)
 
 	return nil
 }
@@ -26,7 +26,8 @@ func NewInvokeCmd(ctx context.Context, asyncClient async.Client) *cobra.Command
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			switch taskName {
 			case config.TypeCertificateTask, config.TypeSystemsTask, config.TypeHYOKSync,
-				config.TypeWorkflowExpire, config.TypeWorkflowCleanup, config.TypeKeystorePool:
+				config.TypeWorkflowExpire, config.TypeWorkflowCleanup, config.TypeKeystorePool,
+				config.TypeRoleElevationExpire:
 				var payload []byte
 				if len(tenants) > 0 {
 					p := asyncUtils.NewTenantListPayload(tenants)
@@ -15,6 +15,7 @@ import (
 	"github.com/openkcm/cmk/internal/async/tasks"
 	tenantTask "github.com/openkcm/cmk/internal/async/tasks/tenant"
 	"github.com/openkcm/cmk/internal/auditor"
+	audit_store "github.com/openkcm/cmk/internal/auditor/store"
 	authz_loader "github.com/openkcm/cmk/internal/authz/loader"
 	authz_repo "github.com/openkcm/cmk/internal/authz/repo"
 	"github.com/openkcm/cmk/internal/clients"
@@ -105,6 +106,8 @@ func run(ctx context.Context, cfg *config.Config) error {
 		return oops.In("main").Wrapf(err, "%s", async.ErrClientShutdown.Error())
 	}
 
+	auditor.CloseExporters(ctx, cfg)
+
 	log.Info(ctx, "shutting down worker")
 
 	return nil
@@ -154,11 +157,11 @@ func registerTasks(
 		return ErrRegistryEnabled
 	}
 
-	cmkAuditor := auditor.New(ctx, cfg)
+	cmkAuditor := auditor.New(ctx, cfg, auditor.WithEventStore(audit_store.New(authzRepo)))
 	userManager := manager.NewUserManager(authzRepo, cmkAuditor)
-	certManager := manager.NewCertificateManager(ctx, authzRepo, svcRegistry, cfg)
-	tenantConfigManager := manager.NewTenantConfigManager(authzRepo, svcRegistry, cfg, certManager)
-	tagManager := manager.NewTagManager(authzRepo)
+	certManager := manager.NewCertificateManager(ctx, authzRepo, svcRegistry, cfg, cmkAuditor)
+	tenantConfigManager := manager.NewTenantConfigManager(authzRepo, svcRegistry, cfg, certManager, cmkAuditor)
+	tagManager := manager.NewTagManager(authzRepo, cmkAuditor)
 	keyConfigManager := manager.NewKeyConfigManager(
 		authzRepo,
 		certManager,
@@ -173,9 +176,9 @@ func registerTasks(
 		userManager, certManager, eventFactory, cmkAuditor, cron.Client())
 	systemManager := manager.NewSystemManager(ctx, authzRepo, authzRepoLoader, nil, eventFactory,
 		svcRegistry, cfg, keyConfigManager, userManager)
-	groupManager := manager.NewGroupManager(authzRepo, svcRegistry, userManager)
+	groupManager := manager.NewGroupManager(authzRepo, svcRegistry, userManager, cmkAuditor)
 	workflowManager := manager.NewWorkflowManager(authzRepo, svcRegistry, keyManager, keyConfigManager, systemManager,
-		groupManager, userManager, cron.Client(), tenantConfigManager, cfg)
+		groupManager, userManager, cron.Client(), tenantConfigManager, cfg, cmkAuditor)
 
 	taskHandlers := []async.TaskHandler{
 		tenantTask.NewSystemsRefresher(sis, authzRepo),
@@ -185,6 +188,9 @@ func registerTasks(
 		tasks.NewNotificationSender(notifierClient),
 		tenantTask.NewWorkflowExpiryProcessor(workflowManager, authzRepo),
 		tenantTask.NewWorkflowCleaner(workflowManager, authzRepo),
+		tenantTask.NewRoleElevationExpiryProcessor(manager.NewRoleElevationManager(authzRepo, cmkAuditor), authzRepo),
+		tenantTask.NewSystemRetryProcessor(systemManager, authzRepo),
+		tenantTask.NewSystemKeyDriftChecker(systemManager, authzRepo),
 		tenantTask.NewTenantNameRefresher(authzRepo, f.Registry()),
 		tenantTask.NewHYOKSync(keyManager, authzRepo),
 		tasks.NewPendingStateSync(keyManager, authzRepo),
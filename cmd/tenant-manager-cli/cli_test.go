@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
 	"testing"
 
@@ -45,6 +46,8 @@ type CLISuite struct {
 	getTenantCmd    *cobra.Command
 	updateTenantCmd *cobra.Command
 	listTenantsCmd  *cobra.Command
+	authzMatrixCmd  *cobra.Command
+	authzTestCmd    *cobra.Command
 
 	gm *manager.GroupManager
 	tm *manager.TenantManager
@@ -77,9 +80,9 @@ func (s *CLISuite) SetupSuite() {
 	eventFactory, err := eventprocessor.NewEventFactory(ctx, cfg, authzRepo)
 	s.NoError(err)
 
-	cm := manager.NewCertificateManager(ctx, authzRepo, svcRegistry, cfg)
+	cm := manager.NewCertificateManager(ctx, authzRepo, svcRegistry, cfg, cmkAuditor)
 	um := manager.NewUserManager(authzRepo, cmkAuditor)
-	tagm := manager.NewTagManager(authzRepo)
+	tagm := manager.NewTagManager(authzRepo, cmkAuditor)
 	kcm := manager.NewKeyConfigManager(authzRepo, cm, um, tagm, cmkAuditor, eventFactory, cfg)
 
 	sys := manager.NewSystemManager(
@@ -97,7 +100,7 @@ func (s *CLISuite) SetupSuite() {
 	km := manager.NewKeyManager(
 		authzRepo,
 		svcRegistry,
-		manager.NewTenantConfigManager(authzRepo, svcRegistry, nil, nil),
+		manager.NewTenantConfigManager(authzRepo, svcRegistry, nil, nil, cmkAuditor),
 		kcm,
 		um,
 		cm,
@@ -109,7 +112,7 @@ func (s *CLISuite) SetupSuite() {
 	migrator, err := db.NewMigrator(r, cfg)
 	s.NoError(err)
 
-	s.gm = manager.NewGroupManager(authzRepo, svcRegistry, um)
+	s.gm = manager.NewGroupManager(authzRepo, svcRegistry, um, cmkAuditor)
 	s.tm = manager.NewTenantManager(authzRepo, sys, km, um, cmkAuditor, migrator)
 
 	factory, err := commands.NewCommandFactory(ctx, cfg, s.db, svcRegistry)
@@ -135,6 +138,12 @@ func (s *CLISuite) SetupSuite() {
 
 	s.updateTenantCmd = factory.NewUpdateTenantCmd(cmdCtx)
 	s.rootCmd.AddCommand(s.updateTenantCmd)
+
+	s.authzMatrixCmd = factory.NewAuthzMatrixCmd(cmdCtx)
+	s.rootCmd.AddCommand(s.authzMatrixCmd)
+
+	s.authzTestCmd = factory.NewAuthzTestCmd(cmdCtx)
+	s.rootCmd.AddCommand(s.authzTestCmd)
 }
 
 func (s *CLISuite) TearDownSuite() {
@@ -326,6 +335,75 @@ func (s *CLISuite) TestDeleteTenant() {
 	s.Require().NoError(err)
 }
 
+func (s *CLISuite) TestAuthzMatrixCmd() {
+	s.rootCmd.SetArgs([]string{"authz-matrix", "--format", "csv"})
+
+	out := new(bytes.Buffer)
+	s.rootCmd.SetOut(out)
+
+	err := s.rootCmd.Execute()
+	s.Require().NoError(err)
+
+	result := out.String()
+	s.Contains(result, "ENDPOINT,KEY_ADMINISTRATOR,TENANT_ADMINISTRATOR,TENANT_AUDITOR")
+	s.Contains(result, "GET /userInfo,ALLOW,ALLOW,ALLOW")
+
+	s.rootCmd.SetArgs([]string{"authz-matrix", "--layer", "unknown"})
+	err = s.rootCmd.Execute()
+	s.ErrorIs(err, commands.ErrUnknownLayer)
+}
+
+func (s *CLISuite) TestAuthzTestCmd() {
+	ctx := s.T().Context()
+	tenant, err := s.createTestTenant(ctx)
+	s.Require().NoError(err)
+
+	group := testutils.NewGroup(func(g *model.Group) {
+		g.Name = "key-admins"
+		g.Role = constants.KeyAdminRole
+	})
+	testutils.CreateTestEntities(cmkcontext.CreateTenantContext(ctx, tenant.ID), s.T(),
+		sql.NewRepository(s.db), group)
+
+	matrixFile := s.T().TempDir() + "/matrix.yaml"
+
+	run := func(cases string) (string, error) {
+		err := os.WriteFile(matrixFile, []byte("cases:\n"+cases), 0o600)
+		s.Require().NoError(err)
+
+		s.rootCmd.SetArgs([]string{"authz-test", "--matrix", matrixFile, "--tenant", tenant.ID})
+
+		out := new(bytes.Buffer)
+		s.rootCmd.SetOut(out)
+
+		err = s.rootCmd.Execute()
+
+		return out.String(), err
+	}
+
+	result, err := run(`
+  - group: key-admins
+    method: DELETE
+    path: /keys/123
+    expected: ALLOW
+  - role: TENANT_AUDITOR
+    method: DELETE
+    path: /keys/123
+    expected: DENY
+`)
+	s.Require().NoError(err)
+	s.Contains(result, "2 passed, 0 failed")
+
+	result, err = run(`
+  - group: key-admins
+    method: DELETE
+    path: /keys/123
+    expected: DENY
+`)
+	s.ErrorIs(err, commands.ErrPolicyTestFailed)
+	s.Contains(result, "0 passed, 1 failed")
+}
+
 func (s *CLISuite) createTenant() (*model.Tenant, error) {
 	id := uuid.NewString()
 
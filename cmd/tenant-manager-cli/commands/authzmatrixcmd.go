@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openkcm/cmk/internal/authz"
+)
+
+const (
+	layerAPI    = "api"
+	layerRepo   = "repo"
+	formatTable = "table"
+	formatCSV   = "csv"
+)
+
+// NewAuthzMatrixCmd creates a Cobra command that prints the effective permissions of the business roles.
+func (f *CommandFactory) NewAuthzMatrixCmd(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authz-matrix",
+		Short: "Print the access matrix of the business roles. Usage: tm authz-matrix [-t tenant id]",
+		Long: "Print the decision of every business role on every API endpoint, or on every " +
+			"repository resource and action with --layer repo. With a tenant id the custom roles " +
+			"of the tenant are included. Usage: tm authz-matrix --tenant [tenant id] --format [table|csv]",
+		Args: cobra.ExactArgs(0),
+
+		//nolint:contextcheck
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			tenantID, _ := cmd.Flags().GetString("tenant")
+			layer, _ := cmd.Flags().GetString("layer")
+			format, _ := cmd.Flags().GetString("format")
+
+			policies, err := f.loadEffectivePolicies(cmd, tenantID)
+			if err != nil {
+				cmd.PrintErrf("Failed to load policies: %v\n", err)
+				return err
+			}
+
+			var matrix authz.AccessMatrix
+
+			switch layer {
+			case layerAPI:
+				matrix = policies.APIMatrix()
+			case layerRepo:
+				matrix = policies.RepoMatrix()
+			default:
+				return ErrUnknownLayer
+			}
+
+			switch format {
+			case formatTable:
+				return matrix.WriteTable(cmd.OutOrStdout())
+			case formatCSV:
+				return matrix.WriteCSV(cmd.OutOrStdout())
+			default:
+				return ErrUnknownFormat
+			}
+		},
+	}
+
+	cmd.Flags().StringP("tenant", "t", "", "Tenant id to include the custom roles of")
+	cmd.Flags().StringP("layer", "l", layerAPI, "Authorization layer: api or repo")
+	cmd.Flags().StringP("format", "f", formatTable, "Output format: table or csv")
+
+	cmd.SetContext(ctx)
+
+	return cmd
+}
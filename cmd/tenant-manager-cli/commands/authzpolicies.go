@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+// loadEffectivePolicies returns the policies of the built-in roles and, if a
+// tenant id is given, the custom roles and groups of the tenant
+func (f *CommandFactory) loadEffectivePolicies(
+	cmd *cobra.Command,
+	tenantID string,
+) (authz.EffectivePolicies, error) {
+	policies := authz.BuiltInPolicies()
+	if tenantID == "" {
+		return policies, nil
+	}
+
+	ctx := cmd.Context()
+
+	tenant, err := f.tm.GetTenantByID(ctx, tenantID)
+	if err != nil {
+		return policies, err
+	}
+
+	if tenant == nil {
+		return policies, ErrTenantNotFound
+	}
+
+	ctx = cmkcontext.CreateTenantContext(ctx, tenant.ID)
+
+	var customRoles []model.CustomRole
+
+	err = f.r.List(ctx, &model.CustomRole{}, &customRoles, *repo.NewQuery())
+	if err != nil {
+		return policies, err
+	}
+
+	for _, customRole := range customRoles {
+		role, err := customRole.ToAuthz()
+		if err == nil {
+			err = role.Validate()
+		}
+
+		// Invalid custom roles grant nothing on the API
+		if err != nil {
+			cmd.PrintErrf("Skipping invalid custom role %s: %v\n", customRole.Name, err)
+			continue
+		}
+
+		policies.AddCustomRole(role)
+	}
+
+	var groups []model.Group
+
+	err = f.r.List(ctx, &model.Group{}, &groups, *repo.NewQuery())
+	if err != nil {
+		return policies, err
+	}
+
+	for _, group := range groups {
+		policies.Groups[group.Name] = group.Role
+	}
+
+	return policies, nil
+}
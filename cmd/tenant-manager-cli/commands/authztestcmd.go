@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openkcm/cmk/internal/authz"
+)
+
+// NewAuthzTestCmd creates a Cobra command that evaluates a matrix of expected authorization decisions.
+func (f *CommandFactory) NewAuthzTestCmd(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authz-test",
+		Short: "Evaluate expected API authorization decisions. Usage: tm authz-test -m [matrix file]",
+		Long: "Evaluate a YAML matrix of cases (role or group, method, path, expected ALLOW or DENY) " +
+			"against the API policies. Groups are the groups of the tenant given with --tenant. " +
+			"Usage: tm authz-test --matrix [matrix file] --tenant [tenant id]",
+		Args: cobra.ExactArgs(0),
+
+		//nolint:contextcheck
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, _ := cmd.Flags().GetString("matrix")
+			tenantID, _ := cmd.Flags().GetString("tenant")
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				cmd.PrintErrf("Failed to read matrix %s: %v\n", path, err)
+				return err
+			}
+
+			matrix, err := authz.ParsePolicyTestMatrix(data)
+			if err != nil {
+				cmd.PrintErrf("%v\n", err)
+				return err
+			}
+
+			policies, err := f.loadEffectivePolicies(cmd, tenantID)
+			if err != nil {
+				cmd.PrintErrf("Failed to load policies: %v\n", err)
+				return err
+			}
+
+			results := policies.RunPolicyTests(matrix)
+
+			failed, err := writePolicyTestResults(cmd, results)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("%d passed, %d failed\n", len(results)-failed, failed)
+
+			if failed > 0 {
+				return ErrPolicyTestFailed
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("matrix", "m", "", "Path of the YAML matrix file")
+	cmd.Flags().StringP("tenant", "t", "", "Tenant id to resolve groups and custom roles in")
+
+	err := cmd.MarkFlagRequired("matrix")
+	if err != nil {
+		cmd.PrintErrf("failed to mark flag 'matrix' as required: %v\n", err)
+	}
+
+	cmd.SetContext(ctx)
+
+	return cmd
+}
+
+func writePolicyTestResults(cmd *cobra.Command, results []authz.PolicyTestResult) (int, error) {
+	tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+
+	_, err := fmt.Fprintln(tw, "RESULT\tSUBJECT\tREQUEST\tENDPOINT\tEXPECTED\tACTUAL")
+	if err != nil {
+		return 0, err
+	}
+
+	failed := 0
+
+	for _, result := range results {
+		status := "PASS"
+		if !result.Passed() {
+			status = "FAIL"
+			failed++
+		}
+
+		subject := "role:" + string(result.Role)
+		if result.Group != "" {
+			subject = "group:" + result.Group
+		}
+
+		actual := string(result.Actual)
+		if result.Err != nil {
+			actual = result.Err.Error()
+		}
+
+		_, err = fmt.Fprintln(tw, strings.Join([]string{
+			status, subject, strings.ToUpper(result.Method) + " " + result.Path,
+			result.Endpoint, string(result.Expected), actual,
+		}, "\t"))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return failed, tw.Flush()
+}
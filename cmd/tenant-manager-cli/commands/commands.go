@@ -55,9 +55,9 @@ func NewCommandFactory(
 		return nil, err
 	}
 
-	cm := manager.NewCertificateManager(ctx, authzRepo, svcRegistry, cfg)
+	cm := manager.NewCertificateManager(ctx, authzRepo, svcRegistry, cfg, cmkAuditor)
 	um := manager.NewUserManager(authzRepo, cmkAuditor)
-	tagm := manager.NewTagManager(authzRepo)
+	tagm := manager.NewTagManager(authzRepo, cmkAuditor)
 	kcm := manager.NewKeyConfigManager(authzRepo, cm, um, tagm, cmkAuditor, eventFactory, cfg)
 
 	sys := manager.NewSystemManager(
@@ -75,7 +75,7 @@ func NewCommandFactory(
 	km := manager.NewKeyManager(
 		authzRepo,
 		svcRegistry,
-		manager.NewTenantConfigManager(authzRepo, svcRegistry, cfg, cm),
+		manager.NewTenantConfigManager(authzRepo, svcRegistry, cfg, cm, cmkAuditor),
 		kcm,
 		um,
 		cm,
@@ -92,7 +92,7 @@ func NewCommandFactory(
 	return &CommandFactory{
 		dbCon: dbCon,
 		r:     authzRepo,
-		gm:    manager.NewGroupManager(authzRepo, svcRegistry, um),
+		gm:    manager.NewGroupManager(authzRepo, svcRegistry, um, cmkAuditor),
 		tm:    manager.NewTenantManager(authzRepo, sys, km, um, cmkAuditor, migrator),
 	}, nil
 }
@@ -3,7 +3,12 @@ package commands
 import "errors"
 
 var (
-	ErrTenantIDRequired = errors.New("tenant id is required")
-	ErrDeleteTenant     = errors.New("failed to delete tenant")
-	ErrTenantNotFound   = errors.New("tenant not found")
+	ErrTenantIDRequired  = errors.New("tenant id is required")
+	ErrDeleteTenant      = errors.New("failed to delete tenant")
+	ErrTenantNotFound    = errors.New("tenant not found")
+	ErrVerifyAuditChain  = errors.New("failed to verify audit hash chain")
+	ErrAuditChainInvalid = errors.New("audit hash chain has issues")
+	ErrUnknownLayer      = errors.New("unknown authorization layer, use api or repo")
+	ErrUnknownFormat     = errors.New("unknown output format, use table or csv")
+	ErrPolicyTestFailed  = errors.New("authorization policy test cases failed")
 )
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	audit_store "github.com/openkcm/cmk/internal/auditor/store"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+// NewVerifyAuditChainCmd creates a Cobra command that replays the audit hash chain of a tenant.
+func (f *CommandFactory) NewVerifyAuditChainCmd(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-audit-chain",
+		Short: "Verify the audit event hash chain of a tenant. Usage: tm verify-audit-chain -i [tenant id]",
+		Long: "Replay the audit event hash chain of a tenant from the local audit store " +
+			"and report gaps and modified events. Usage: tm verify-audit-chain --id [tenant id]",
+		Args: cobra.ExactArgs(0),
+
+		//nolint:contextcheck
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			id, _ := cmd.Flags().GetString("id")
+			if id == "" {
+				cmd.Println("Tenant id is required")
+				return ErrTenantIDRequired
+			}
+
+			ctx := cmd.Context()
+
+			tenant, err := f.tm.GetTenantByID(ctx, id)
+			if err != nil {
+				cmd.PrintErrf("Failed to get tenant by ID %s: %v", id, err)
+
+				return err
+			}
+
+			if tenant == nil {
+				cmd.Printf("Tenant with id %s not found\n", id)
+
+				return ErrTenantNotFound
+			}
+
+			ctx = cmkcontext.CreateTenantContext(ctx, tenant.ID)
+
+			report, err := audit_store.New(f.r).VerifyChain(ctx)
+			if err != nil {
+				cmd.PrintErrf("%v %v\n", ErrVerifyAuditChain, err)
+				return err
+			}
+
+			out, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(out))
+
+			if !report.Valid() {
+				return ErrAuditChainInvalid
+			}
+
+			return nil
+		},
+	}
+
+	var id string
+	cmd.Flags().StringVarP(&id, "id", "i", "", "Tenant id")
+
+	err := cmd.MarkFlagRequired("id")
+	if err != nil {
+		cmd.PrintErrf("failed to mark flag 'id' as required: %v\n", err)
+	}
+
+	cmd.SetContext(ctx)
+
+	return cmd
+}
@@ -126,6 +126,15 @@ func setupCommands(
 	updateTenantCmd := factory.NewUpdateTenantCmd(ctx)
 	rootCmd.AddCommand(updateTenantCmd)
 
+	verifyAuditChainCmd := factory.NewVerifyAuditChainCmd(ctx)
+	rootCmd.AddCommand(verifyAuditChainCmd)
+
+	authzMatrixCmd := factory.NewAuthzMatrixCmd(ctx)
+	rootCmd.AddCommand(authzMatrixCmd)
+
+	authzTestCmd := factory.NewAuthzTestCmd(ctx)
+	rootCmd.AddCommand(authzTestCmd)
+
 	return rootCmd, nil
 }
 
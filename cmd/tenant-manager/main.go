@@ -115,8 +115,9 @@ func run(ctx context.Context, cfg *config.Config) error {
 		return err
 	}
 
+	cmkAuditor := auditor.New(ctx, cfg)
 	groupManager := manager.NewGroupManager(authzRepo, svcRegistry,
-		manager.NewUserManager(authzRepo, auditor.New(ctx, cfg)))
+		manager.NewUserManager(authzRepo, cmkAuditor), cmkAuditor)
 
 	operator, err := operator.NewTenantOperator(dbConn, cfg, target,
 		clients, tenantManager, groupManager, authzRepo)
@@ -125,6 +126,8 @@ func run(ctx context.Context, cfg *config.Config) error {
 			Wrapf(err, errMsgRunningOperator)
 	}
 
+	defer auditor.CloseExporters(ctx, cfg)
+
 	return operator.RunOperator(ctx)
 }
 
@@ -143,10 +146,10 @@ func createTenantManager(
 		return nil, err
 	}
 
-	cm := manager.NewCertificateManager(ctx, r, svcRegistry, cfg)
+	cm := manager.NewCertificateManager(ctx, r, svcRegistry, cfg, cmkAuditor)
 	um := manager.NewUserManager(r, cmkAuditor)
 
-	tagm := manager.NewTagManager(r)
+	tagm := manager.NewTagManager(r, cmkAuditor)
 	kcm := manager.NewKeyConfigManager(r, cm, um, tagm, cmkAuditor, eventFactory, cfg)
 
 	sys := manager.NewSystemManager(
@@ -164,7 +167,7 @@ func createTenantManager(
 	km := manager.NewKeyManager(
 		r,
 		svcRegistry,
-		manager.NewTenantConfigManager(r, svcRegistry, cfg, cm),
+		manager.NewTenantConfigManager(r, svcRegistry, cfg, cm, cmkAuditor),
 		kcm,
 		um,
 		cm,
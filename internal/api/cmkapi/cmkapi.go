@@ -26,21 +26,69 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// Defines values for GroupRole.
+// Defines values for APITokenStatus.
 const (
-	GroupRoleKEYADMINISTRATOR    GroupRole = "KEY_ADMINISTRATOR"
-	GroupRoleTENANTADMINISTRATOR GroupRole = "TENANT_ADMINISTRATOR"
-	GroupRoleTENANTAUDITOR       GroupRole = "TENANT_AUDITOR"
+	APITokenStatusACTIVE  APITokenStatus = "ACTIVE"
+	APITokenStatusEXPIRED APITokenStatus = "EXPIRED"
+	APITokenStatusREVOKED APITokenStatus = "REVOKED"
 )
 
-// Valid indicates whether the value is a known member of the GroupRole enum.
-func (e GroupRole) Valid() bool {
+// Valid indicates whether the value is a known member of the APITokenStatus enum.
+func (e APITokenStatus) Valid() bool {
 	switch e {
-	case GroupRoleKEYADMINISTRATOR:
+	case APITokenStatusACTIVE:
 		return true
-	case GroupRoleTENANTADMINISTRATOR:
+	case APITokenStatusEXPIRED:
 		return true
-	case GroupRoleTENANTAUDITOR:
+	case APITokenStatusREVOKED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AuthzExplainRequestMethod.
+const (
+	AuthzExplainRequestMethodDELETE AuthzExplainRequestMethod = "DELETE"
+	AuthzExplainRequestMethodGET    AuthzExplainRequestMethod = "GET"
+	AuthzExplainRequestMethodPATCH  AuthzExplainRequestMethod = "PATCH"
+	AuthzExplainRequestMethodPOST   AuthzExplainRequestMethod = "POST"
+	AuthzExplainRequestMethodPUT    AuthzExplainRequestMethod = "PUT"
+)
+
+// Valid indicates whether the value is a known member of the AuthzExplainRequestMethod enum.
+func (e AuthzExplainRequestMethod) Valid() bool {
+	switch e {
+	case AuthzExplainRequestMethodDELETE:
+		return true
+	case AuthzExplainRequestMethodGET:
+		return true
+	case AuthzExplainRequestMethodPATCH:
+		return true
+	case AuthzExplainRequestMethodPOST:
+		return true
+	case AuthzExplainRequestMethodPUT:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CustomRoleBaseRole.
+const (
+	CustomRoleBaseRoleKEYADMINISTRATOR    CustomRoleBaseRole = "KEY_ADMINISTRATOR"
+	CustomRoleBaseRoleTENANTADMINISTRATOR CustomRoleBaseRole = "TENANT_ADMINISTRATOR"
+	CustomRoleBaseRoleTENANTAUDITOR       CustomRoleBaseRole = "TENANT_AUDITOR"
+)
+
+// Valid indicates whether the value is a known member of the CustomRoleBaseRole enum.
+func (e CustomRoleBaseRole) Valid() bool {
+	switch e {
+	case CustomRoleBaseRoleKEYADMINISTRATOR:
+		return true
+	case CustomRoleBaseRoleTENANTADMINISTRATOR:
+		return true
+	case CustomRoleBaseRoleTENANTAUDITOR:
 		return true
 	default:
 		return false
@@ -125,10 +173,47 @@ func (e KeyType) Valid() bool {
 	}
 }
 
+// Defines values for ResourceGrantAccess.
+const (
+	ResourceGrantAccessOPERATE ResourceGrantAccess = "OPERATE"
+	ResourceGrantAccessREAD    ResourceGrantAccess = "READ"
+)
+
+// Valid indicates whether the value is a known member of the ResourceGrantAccess enum.
+func (e ResourceGrantAccess) Valid() bool {
+	switch e {
+	case ResourceGrantAccessOPERATE:
+		return true
+	case ResourceGrantAccessREAD:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ResourceGrantType.
+const (
+	ResourceGrantTypeKEYCONFIGURATION ResourceGrantType = "KEY_CONFIGURATION"
+	ResourceGrantTypeSYSTEM           ResourceGrantType = "SYSTEM"
+)
+
+// Valid indicates whether the value is a known member of the ResourceGrantType enum.
+func (e ResourceGrantType) Valid() bool {
+	switch e {
+	case ResourceGrantTypeKEYCONFIGURATION:
+		return true
+	case ResourceGrantTypeSYSTEM:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for SystemStatus.
 const (
 	SystemStatusCONNECTED    SystemStatus = "CONNECTED"
 	SystemStatusDISCONNECTED SystemStatus = "DISCONNECTED"
+	SystemStatusDRIFTED      SystemStatus = "DRIFTED"
 	SystemStatusFAILED       SystemStatus = "FAILED"
 	SystemStatusPROCESSING   SystemStatus = "PROCESSING"
 )
@@ -140,6 +225,8 @@ func (e SystemStatus) Valid() bool {
 		return true
 	case SystemStatusDISCONNECTED:
 		return true
+	case SystemStatusDRIFTED:
+		return true
 	case SystemStatusFAILED:
 		return true
 	case SystemStatusPROCESSING:
@@ -149,6 +236,27 @@ func (e SystemStatus) Valid() bool {
 	}
 }
 
+// Defines values for SystemGroupSystemResultResult.
+const (
+	SystemGroupSystemResultResultFAILED    SystemGroupSystemResultResult = "FAILED"
+	SystemGroupSystemResultResultSKIPPED   SystemGroupSystemResultResult = "SKIPPED"
+	SystemGroupSystemResultResultTRIGGERED SystemGroupSystemResultResult = "TRIGGERED"
+)
+
+// Valid indicates whether the value is a known member of the SystemGroupSystemResultResult enum.
+func (e SystemGroupSystemResultResult) Valid() bool {
+	switch e {
+	case SystemGroupSystemResultResultFAILED:
+		return true
+	case SystemGroupSystemResultResultSKIPPED:
+		return true
+	case SystemGroupSystemResultResultTRIGGERED:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for SystemRecoveryActionBodyAction.
 const (
 	SystemRecoveryActionBodyActionCANCEL SystemRecoveryActionBodyAction = "CANCEL"
@@ -194,6 +302,7 @@ func (e TenantRole) Valid() bool {
 // Defines values for WorkflowActionTypeEnum.
 const (
 	WorkflowActionTypeEnumDELETE        WorkflowActionTypeEnum = "DELETE"
+	WorkflowActionTypeEnumELEVATE       WorkflowActionTypeEnum = "ELEVATE"
 	WorkflowActionTypeEnumLINK          WorkflowActionTypeEnum = "LINK"
 	WorkflowActionTypeEnumSWITCH        WorkflowActionTypeEnum = "SWITCH"
 	WorkflowActionTypeEnumUNLINK        WorkflowActionTypeEnum = "UNLINK"
@@ -206,6 +315,8 @@ func (e WorkflowActionTypeEnum) Valid() bool {
 	switch e {
 	case WorkflowActionTypeEnumDELETE:
 		return true
+	case WorkflowActionTypeEnumELEVATE:
+		return true
 	case WorkflowActionTypeEnumLINK:
 		return true
 	case WorkflowActionTypeEnumSWITCH:
@@ -419,6 +530,181 @@ func (e WrappingAlgorithmName) Valid() bool {
 	}
 }
 
+// APIToken defines model for APIToken.
+type APIToken struct {
+	// CreatedAt Creation time of the API token
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// CreatedBy The identifier of the user that created the token
+	CreatedBy *string `json:"createdBy,omitempty"`
+
+	// ExpiresAt Expiry of the API token. Defaults to 90 days after creation and
+	// must be at most 365 days after creation.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// GroupID The ID of the group whose role the token acts with
+	GroupID openapi_types.UUID `json:"groupID"`
+
+	// Id The ID of the API token
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// LastUsedAt Time of the last request with the API token
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	// Name Name of the API token
+	Name string `json:"name"`
+
+	// Prefix The first characters of the token, to identify it
+	Prefix *string `json:"prefix,omitempty"`
+
+	// RevokedAt Revocation time of the API token
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+
+	// Status The status of the API token
+	Status *APITokenStatus `json:"status,omitempty"`
+
+	// Token The secret of the API token. Only returned on creation.
+	Token *string `json:"token,omitempty"`
+}
+
+// APITokenList defines model for APITokenList.
+type APITokenList struct {
+	// Count The total number of API tokens
+	Count *int       `json:"count,omitempty"`
+	Value []APIToken `json:"value"`
+}
+
+// APITokenStatus The status of the API token
+type APITokenStatus string
+
+// AuditEvent An audit event recorded for the Tenant
+type AuditEvent struct {
+	// Actor ID of the user or system that triggered the event
+	Actor *string `json:"actor,omitempty"`
+
+	// Attributes Additional attributes of the event
+	Attributes *map[string]string `json:"attributes,omitempty"`
+
+	// CorrelationID Correlation ID of the request that triggered the event
+	CorrelationID *string `json:"correlationID,omitempty"`
+
+	// CreatedAt Time the event was recorded
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// EventType Type of the audited event
+	EventType *string             `json:"eventType,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+
+	// Resource ID of the resource the event refers to
+	Resource *string `json:"resource,omitempty"`
+}
+
+// AuditEventList defines model for AuditEventList.
+type AuditEventList struct {
+	// Count The total number of Audit Events
+	Count *int         `json:"count,omitempty"`
+	Value []AuditEvent `json:"value"`
+}
+
+// AuthzExplainRequest defines model for AuthzExplainRequest.
+type AuthzExplainRequest struct {
+	// Groups IAM identifiers of the groups of the user
+	Groups []string `json:"groups"`
+
+	// Method HTTP method of the API request
+	Method AuthzExplainRequestMethod `json:"method"`
+
+	// Path Path of the API request relative to the tenant base path
+	Path string `json:"path"`
+
+	// ResourceID ID of the key configuration, key or system the request operates on.
+	// The resource type is the one of the endpoint restriction.
+	ResourceID *openapi_types.UUID `json:"resourceID,omitempty"`
+
+	// UserIdentifier Identifier of the user to explain. The role elevations of the user are
+	// evaluated as well. Without it only the groups are evaluated.
+	UserIdentifier *string `json:"userIdentifier,omitempty"`
+}
+
+// AuthzExplainRequestMethod HTTP method of the API request
+type AuthzExplainRequestMethod string
+
+// AuthzExplainedGroup defines model for AuthzExplainedGroup.
+type AuthzExplainedGroup struct {
+	// BaseRole Built-in role the role of the group acts as
+	BaseRole *string `json:"baseRole,omitempty"`
+
+	// Found Flag indicating whether the group exists in the tenant
+	Found bool `json:"found"`
+
+	// IamIdentifier Reference of the Group in the customer's Identity & Access Management (IAM) provider
+	IamIdentifier *GroupIAMIdentifier `json:"iamIdentifier,omitempty"`
+
+	// Role Role of the group
+	Role *string `json:"role,omitempty"`
+}
+
+// AuthzExplainedPolicy defines model for AuthzExplainedPolicy.
+type AuthzExplainedPolicy struct {
+	// GrantsAccess Flag indicating whether the policy grants the endpoint restriction
+	GrantsAccess bool   `json:"grantsAccess"`
+	PolicyID     string `json:"policyID"`
+	Role         string `json:"role"`
+
+	// RoleElevationID The role elevation granting the role, unset for the roles of the groups
+	RoleElevationID *openapi_types.UUID `json:"roleElevationID,omitempty"`
+}
+
+// AuthzExplanation defines model for AuthzExplanation.
+type AuthzExplanation struct {
+	// AllowListed Flag indicating whether the endpoint skips authorization
+	AllowListed bool `json:"allowListed"`
+
+	// Allowed Flag indicating whether the endpoint is allowed for the user
+	Allowed bool `json:"allowed"`
+
+	// Endpoint The endpoint pattern matching the method and path
+	Endpoint *string `json:"endpoint,omitempty"`
+
+	// GroupFilter The decision restricting the visible resources to the ones managed by the
+	// groups of the user
+	GroupFilter *AuthzGroupFilterDecision `json:"groupFilter,omitempty"`
+
+	// Groups The groups of the user and their roles
+	Groups []AuthzExplainedGroup `json:"groups"`
+
+	// Policies The policies of the roles of the groups
+	Policies []AuthzExplainedPolicy `json:"policies"`
+
+	// Reason Reason of the decision
+	Reason string `json:"reason"`
+
+	// Restriction The authorization requirement of an endpoint
+	Restriction *AuthzRestriction `json:"restriction,omitempty"`
+}
+
+// AuthzGroupFilterDecision The decision restricting the visible resources to the ones managed by the
+// groups of the user
+type AuthzGroupFilterDecision struct {
+	// GroupFiltered Flag indicating whether the resources are filtered by the groups
+	GroupFiltered bool `json:"groupFiltered"`
+
+	// Reason Reason of a denied or failed decision
+	Reason *string `json:"reason,omitempty"`
+
+	// ResourceAllowed Flag indicating whether the access on the resource is allowed
+	ResourceAllowed *bool `json:"resourceAllowed,omitempty"`
+
+	// ResourceChecked Flag indicating whether the access on the resource was evaluated
+	ResourceChecked bool `json:"resourceChecked"`
+}
+
+// AuthzRestriction The authorization requirement of an endpoint
+type AuthzRestriction struct {
+	Action       string `json:"action"`
+	ResourceType string `json:"resourceType"`
+}
+
 // BYOKKeystore defines model for BYOKKeystore.
 type BYOKKeystore struct {
 	// Allow Keystore supports BYOK keys
@@ -480,6 +766,54 @@ type CreatorID = string
 // CreatorName The username of the User who created the object
 type CreatorName = string
 
+// CustomRole defines model for CustomRole.
+type CustomRole struct {
+	// BaseRole Built-in role the custom role is based on. The permissions of the custom role
+	// must be granted by its base role.
+	BaseRole CustomRoleBaseRole `json:"baseRole"`
+
+	// Description Description of the custom role
+	Description *string `json:"description,omitempty"`
+
+	// Id The ID of the custom role
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// Name Name of the custom role, referenced by the role of groups
+	Name string `json:"name"`
+
+	// Permissions Permissions granted by the custom role
+	Permissions []CustomRolePermission `json:"permissions"`
+}
+
+// CustomRoleBaseRole Built-in role the custom role is based on. The permissions of the custom role
+// must be granted by its base role.
+type CustomRoleBaseRole string
+
+// CustomRoleList defines model for CustomRoleList.
+type CustomRoleList struct {
+	// Count The total number of custom roles
+	Count *int         `json:"count,omitempty"`
+	Value []CustomRole `json:"value"`
+}
+
+// CustomRolePatch A patch for updating a custom role
+type CustomRolePatch struct {
+	// Description Description of the custom role
+	Description *string `json:"description,omitempty"`
+
+	// Permissions Permissions granted by the custom role
+	Permissions *[]CustomRolePermission `json:"permissions,omitempty"`
+}
+
+// CustomRolePermission defines model for CustomRolePermission.
+type CustomRolePermission struct {
+	// Actions The actions granted on the resource type
+	Actions []string `json:"actions"`
+
+	// ResourceType The API resource type the actions are granted on
+	ResourceType string `json:"resourceType"`
+}
+
 // DetailedError defines model for DetailedError.
 type DetailedError struct {
 	// Code Technical code of the api_errors, used to identify the api_errors condition. This value is stable and can be used to handle specific api_errors cases.
@@ -534,13 +868,11 @@ type Group struct {
 	// Name Name of the group
 	Name string `json:"name"`
 
-	// Role Role of the group
+	// Role Role of the group. Either one of the built-in roles TENANT_ADMINISTRATOR,
+	// KEY_ADMINISTRATOR and TENANT_AUDITOR or the name of a custom role
 	Role GroupRole `json:"role"`
 }
 
-// GroupRole Role of the group
-type GroupRole string
-
 // GroupIAMCheckRequest defines model for GroupIAMCheckRequest.
 type GroupIAMCheckRequest struct {
 	// IamIdentifiers List of IAM Identifiers to check existence in IAM provider
@@ -583,6 +915,10 @@ type GroupPatch struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// GroupRole Role of the group. Either one of the built-in roles TENANT_ADMINISTRATOR,
+// KEY_ADMINISTRATOR and TENANT_AUDITOR or the name of a custom role
+type GroupRole = string
+
 // HYOKKeystore defines model for HYOKKeystore.
 type HYOKKeystore struct {
 	// Allow HYOK is allowed
@@ -923,6 +1259,38 @@ type KeyVersionMetadata struct {
 	UpdatedAt *UpdatedAt `json:"updatedAt,omitempty"`
 }
 
+// KeystoreCapabilities defines model for KeystoreCapabilities.
+type KeystoreCapabilities struct {
+	// Algorithms The supported key algorithms, empty if not restricted
+	Algorithms []KeyAlgorithm `json:"algorithms"`
+
+	// Declared Indicates if capabilities are configured for the provider, otherwise everything is assumed
+	Declared bool `json:"declared"`
+
+	// Import Indicates if key material can be imported into BYOK keys
+	Import bool `json:"import"`
+
+	// KeyTypes The supported key types, empty if not restricted
+	KeyTypes []KeyType `json:"keyTypes"`
+
+	// Provider The name of the keystore provider
+	Provider string `json:"provider"`
+
+	// Regions The regions served by the provider, empty if not restricted
+	Regions []KeyRegion `json:"regions"`
+
+	// Rotation Indicates if the provider rotates keys
+	Rotation bool `json:"rotation"`
+
+	// Version The version of the plugin interface bound for the provider
+	Version int `json:"version"`
+}
+
+// KeystoreCapabilitiesList defines model for KeystoreCapabilitiesList.
+type KeystoreCapabilitiesList struct {
+	Value []KeystoreCapabilities `json:"value"`
+}
+
 // Label A Label as a key-value pair
 type Label struct {
 	// Key A name of a Label
@@ -941,6 +1309,36 @@ type LabelList struct {
 // LabelsPostOrPatch defines model for LabelsPostOrPatch.
 type LabelsPostOrPatch = []Label
 
+// ResourceGrant defines model for ResourceGrant.
+type ResourceGrant struct {
+	// Access The access given on the resource. READ allows reading the resource and
+	// OPERATE allows every action on it.
+	Access ResourceGrantAccess `json:"access"`
+
+	// Id The ID of the resource grant
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// ResourceID The ID of the key configuration or system
+	ResourceID openapi_types.UUID `json:"resourceID"`
+
+	// ResourceType The type of resource the grant is given on
+	ResourceType ResourceGrantType `json:"resourceType"`
+}
+
+// ResourceGrantAccess The access given on the resource. READ allows reading the resource and
+// OPERATE allows every action on it.
+type ResourceGrantAccess string
+
+// ResourceGrantList defines model for ResourceGrantList.
+type ResourceGrantList struct {
+	// Count The total number of resource grants
+	Count *int            `json:"count,omitempty"`
+	Value []ResourceGrant `json:"value"`
+}
+
+// ResourceGrantType The type of resource the grant is given on
+type ResourceGrantType string
+
 // RotatedAt The datetime of when the key version was rotated (became current version) (RFC3339 format)
 type RotatedAt = time.Time
 
@@ -974,7 +1372,8 @@ type System struct {
 	// Region The region of the System
 	Region string `json:"region"`
 
-	// Status The status of the System
+	// Status The status of the System. DRIFTED if the key state reported by the
+	// System region does not match the key configuration
 	Status SystemStatus `json:"status"`
 
 	// TargetKeyConfigurationID The ID of the Key Configuration
@@ -990,9 +1389,29 @@ type System struct {
 	UnderWorkflow *bool `json:"underWorkflow,omitempty"`
 }
 
-// SystemStatus The status of the System
+// SystemStatus The status of the System. DRIFTED if the key state reported by the
+// System region does not match the key configuration
 type SystemStatus string
 
+// SystemAutoLinkRule A rule matching systems on their type, region and properties.
+// Criteria that are not set match any system
+type SystemAutoLinkRule struct {
+	// KeyConfigurationID The ID of the Key Configuration
+	KeyConfigurationID KeyConfigurationID `json:"keyConfigurationID"`
+
+	// Name The name of the rule
+	Name *string `json:"name,omitempty"`
+
+	// Properties System properties that must all match
+	Properties *map[string]string `json:"properties,omitempty"`
+
+	// Region The region of the systems to match
+	Region *string `json:"region,omitempty"`
+
+	// Type The type of the systems to match
+	Type *string `json:"type,omitempty"`
+}
+
 // SystemFilters System Filters
 type SystemFilters struct {
 	// KeyConfigurationName List of existing system key configurations
@@ -1005,12 +1424,125 @@ type SystemFilters struct {
 	Type *[]string `json:"type,omitempty"`
 }
 
+// SystemGroup A group of Systems managed together
+type SystemGroup struct {
+	// Description The description of the System Group
+	Description *string `json:"description,omitempty"`
+
+	// Id The ID of the System Group
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// Name The name of the System Group
+	Name string `json:"name"`
+
+	// Selector Selects Systems on their type, region and properties.
+	// Criteria that are not set match any System
+	Selector *SystemSelector `json:"selector,omitempty"`
+
+	// Status Aggregated status of the Systems of a System Group
+	Status *SystemGroupStatus `json:"status,omitempty"`
+
+	// SystemIDs The IDs of the member Systems of an explicit group.
+	// Can't be set together with a selector
+	SystemIDs *[]openapi_types.UUID `json:"systemIDs,omitempty"`
+}
+
+// SystemGroupActionResult The results of an action applied to all Systems of a System Group
+type SystemGroupActionResult struct {
+	Value []SystemGroupSystemResult `json:"value"`
+}
+
+// SystemGroupList defines model for SystemGroupList.
+type SystemGroupList struct {
+	// Count The total number of System Groups
+	Count *int          `json:"count,omitempty"`
+	Value []SystemGroup `json:"value"`
+}
+
+// SystemGroupPatch A patch for updating a System Group
+type SystemGroupPatch struct {
+	// Description The description of the System Group
+	Description *string `json:"description,omitempty"`
+
+	// Name The name of the System Group
+	Name *string `json:"name,omitempty"`
+
+	// Selector Selects Systems on their type, region and properties.
+	// Criteria that are not set match any System
+	Selector *SystemSelector `json:"selector,omitempty"`
+
+	// SystemIDs Replaces the member Systems of an explicit group
+	SystemIDs *[]openapi_types.UUID `json:"systemIDs,omitempty"`
+}
+
+// SystemGroupStatus Aggregated status of the Systems of a System Group
+type SystemGroupStatus struct {
+	// Connected The number of connected Systems
+	Connected int `json:"connected"`
+
+	// Disconnected The number of disconnected Systems
+	Disconnected int `json:"disconnected"`
+
+	// Drifted The number of drifted Systems
+	Drifted int `json:"drifted"`
+
+	// Failed The number of failed Systems
+	Failed int `json:"failed"`
+
+	// Processing The number of processing Systems
+	Processing int `json:"processing"`
+
+	// Total The number of Systems in the group
+	Total int `json:"total"`
+}
+
+// SystemGroupSystemResult The result of an action on a single System of a System Group
+type SystemGroupSystemResult struct {
+	// ErrorCode The code of the error of a failed System
+	ErrorCode *string `json:"errorCode,omitempty"`
+
+	// ErrorMessage The message of the error of a failed System
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+
+	// Result TRIGGERED if the System event was sent, SKIPPED if the System
+	// was already in the requested state and FAILED otherwise
+	Result SystemGroupSystemResultResult `json:"result"`
+
+	// SystemID The ID of the System
+	SystemID openapi_types.UUID `json:"systemID"`
+}
+
+// SystemGroupSystemResultResult TRIGGERED if the System event was sent, SKIPPED if the System
+// was already in the requested state and FAILED otherwise
+type SystemGroupSystemResultResult string
+
 // ID The ID of System entity
 type ID = openapi_types.UUID
 
 // Identifier The identifier of the System entity
 type Identifier = string
 
+// SystemLinkValidation Result of a System link validation
+type SystemLinkValidation struct {
+	// Blocking Reasons that prevent the System from being linked
+	Blocking []SystemLinkValidationReason `json:"blocking"`
+
+	// Valid True if no blocking reason was found and the link can be submitted
+	Valid bool `json:"valid"`
+
+	// Warnings Reasons that do not prevent the link but affect how it is processed
+	Warnings []SystemLinkValidationReason `json:"warnings"`
+}
+
+// SystemLinkValidationReason A single System link validation reason
+type SystemLinkValidationReason struct {
+	// Code Machine readable reason code
+	Code string `json:"code"`
+
+	// Message Human readable description of the reason
+	Message string `json:"message"`
+}
+
 // SystemList defines model for SystemList.
 type SystemList struct {
 	// Count The total number of Systems
@@ -1055,6 +1587,19 @@ type SystemRecoveryActionBody struct {
 // SystemRecoveryActionBodyAction The system recovery action
 type SystemRecoveryActionBodyAction string
 
+// SystemSelector Selects Systems on their type, region and properties.
+// Criteria that are not set match any System
+type SystemSelector struct {
+	// Properties System properties that must all match
+	Properties *map[string]string `json:"properties,omitempty"`
+
+	// Region The region of the Systems to select
+	Region *string `json:"region,omitempty"`
+
+	// Type The type of the Systems to select
+	Type *string `json:"type,omitempty"`
+}
+
 // TagList A list of tags
 type TagList struct {
 	// Count The total number of tags
@@ -1096,6 +1641,13 @@ type TenantList struct {
 	Value []Tenant `json:"value"`
 }
 
+// TenantSystemAutoLinkConfiguration defines model for TenantSystemAutoLinkConfiguration.
+type TenantSystemAutoLinkConfiguration struct {
+	// Rules Ordered list of auto-link rules. Newly discovered systems are linked
+	// to the key configuration of the first matching rule
+	Rules []SystemAutoLinkRule `json:"rules"`
+}
+
 // TenantWorkflowConfiguration defines model for TenantWorkflowConfiguration.
 type TenantWorkflowConfiguration struct {
 	// DefaultExpiryPeriodDays The default number of days before a workflow expires
@@ -1346,12 +1898,18 @@ type WrappingAlgorithmName string
 // CountPath defines model for countPath.
 type CountPath = bool
 
+// FilterAuditEvents defines model for filterAuditEvents.
+type FilterAuditEvents = string
+
 // FilterSystems defines model for filterSystems.
 type FilterSystems = string
 
 // FilterWorkflows defines model for filterWorkflows.
 type FilterWorkflows = string
 
+// GrantIDPath defines model for grantIDPath.
+type GrantIDPath = openapi_types.UUID
+
 // GroupIDPath defines model for groupIDPath.
 type GroupIDPath = openapi_types.UUID
 
@@ -1361,12 +1919,21 @@ type KeyConfigurationIDPath = openapi_types.UUID
 // KeyIDPath defines model for keyIDPath.
 type KeyIDPath = openapi_types.UUID
 
+// RoleIDPath defines model for roleIDPath.
+type RoleIDPath = openapi_types.UUID
+
 // SkipPath defines model for skipPath.
 type SkipPath = int
 
+// SystemGroupIDPath defines model for systemGroupIDPath.
+type SystemGroupIDPath = openapi_types.UUID
+
 // SystemIDPath defines model for systemIDPath.
 type SystemIDPath = openapi_types.UUID
 
+// TokenIDPath defines model for tokenIDPath.
+type TokenIDPath = openapi_types.UUID
+
 // TopPath defines model for topPath.
 type TopPath = int
 
@@ -1388,8 +1955,8 @@ type N409 = ErrorMessage
 // N500 defines model for 500.
 type N500 = ErrorMessage
 
-// GetGroupsParams defines parameters for GetGroups.
-type GetGroupsParams struct {
+// GetAPITokensParams defines parameters for GetAPITokens.
+type GetAPITokensParams struct {
 	// Skip The number of results to skip (default is 0)
 	Skip *SkipPath `form:"$skip,omitempty" json:"$skip,omitempty"`
 
@@ -1402,24 +1969,8 @@ type GetGroupsParams struct {
 	Count *CountPath `form:"$count,omitempty" json:"$count,omitempty"`
 }
 
-// GetKeyLabelsParams defines parameters for GetKeyLabels.
-type GetKeyLabelsParams struct {
-	// Top The number of results to return (default is 20)
-	Top *TopPath `form:"$top,omitempty" json:"$top,omitempty"`
-
-	// Skip The number of results to skip (default is 0)
-	Skip *SkipPath `form:"$skip,omitempty" json:"$skip,omitempty"`
-
-	// Count Flag indicating whether to return the total number of results in the queried collection. Using pagination query
-	// parameters $skip and $top will not affect this, i.e. the number of returned elements might be smaller than the
-	// count value.
-	Count *CountPath `form:"$count,omitempty" json:"$count,omitempty"`
-}
-
-// GetKeyConfigurationsParams defines parameters for GetKeyConfigurations.
-type GetKeyConfigurationsParams struct {
-	ExpandGroup *bool `form:"expandGroup,omitempty" json:"expandGroup,omitempty"`
-
+// GetAuditEventsParams defines parameters for GetAuditEvents.
+type GetAuditEventsParams struct {
 	// Skip The number of results to skip (default is 0)
 	Skip *SkipPath `form:"$skip,omitempty" json:"$skip,omitempty"`
 
@@ -1430,7 +1981,78 @@ type GetKeyConfigurationsParams struct {
 	// parameters $skip and $top will not affect this, i.e. the number of returned elements might be smaller than the
 	// count value.
 	Count *CountPath `form:"$count,omitempty" json:"$count,omitempty"`
-}
+
+	// Filter Filter(s) to apply to the results.
+	//
+	// The following operators are supported:
+	//
+	// - Equal (eq), on the following attributes: actor, resource, eventType, createdAfter and createdBefore.
+	// - Logical AND (and).
+	//
+	// - `createdAfter` and `createdBefore` take an RFC 3339 timestamp and bound the creation time of the events
+	//
+	// Examples:
+	//   - actor eq 'user@example.com' and createdAfter eq '2025-01-01T00:00:00Z'
+	Filter *FilterAuditEvents `form:"$filter,omitempty" json:"$filter,omitempty"`
+}
+
+// GetGroupsParams defines parameters for GetGroups.
+type GetGroupsParams struct {
+	// Skip The number of results to skip (default is 0)
+	Skip *SkipPath `form:"$skip,omitempty" json:"$skip,omitempty"`
+
+	// Top The number of results to return (default is 20)
+	Top *TopPath `form:"$top,omitempty" json:"$top,omitempty"`
+
+	// Count Flag indicating whether to return the total number of results in the queried collection. Using pagination query
+	// parameters $skip and $top will not affect this, i.e. the number of returned elements might be smaller than the
+	// count value.
+	Count *CountPath `form:"$count,omitempty" json:"$count,omitempty"`
+}
+
+// GetGroupGrantsParams defines parameters for GetGroupGrants.
+type GetGroupGrantsParams struct {
+	// Skip The number of results to skip (default is 0)
+	Skip *SkipPath `form:"$skip,omitempty" json:"$skip,omitempty"`
+
+	// Top The number of results to return (default is 20)
+	Top *TopPath `form:"$top,omitempty" json:"$top,omitempty"`
+
+	// Count Flag indicating whether to return the total number of results in the queried collection. Using pagination query
+	// parameters $skip and $top will not affect this, i.e. the number of returned elements might be smaller than the
+	// count value.
+	Count *CountPath `form:"$count,omitempty" json:"$count,omitempty"`
+}
+
+// GetKeyLabelsParams defines parameters for GetKeyLabels.
+type GetKeyLabelsParams struct {
+	// Top The number of results to return (default is 20)
+	Top *TopPath `form:"$top,omitempty" json:"$top,omitempty"`
+
+	// Skip The number of results to skip (default is 0)
+	Skip *SkipPath `form:"$skip,omitempty" json:"$skip,omitempty"`
+
+	// Count Flag indicating whether to return the total number of results in the queried collection. Using pagination query
+	// parameters $skip and $top will not affect this, i.e. the number of returned elements might be smaller than the
+	// count value.
+	Count *CountPath `form:"$count,omitempty" json:"$count,omitempty"`
+}
+
+// GetKeyConfigurationsParams defines parameters for GetKeyConfigurations.
+type GetKeyConfigurationsParams struct {
+	ExpandGroup *bool `form:"expandGroup,omitempty" json:"expandGroup,omitempty"`
+
+	// Skip The number of results to skip (default is 0)
+	Skip *SkipPath `form:"$skip,omitempty" json:"$skip,omitempty"`
+
+	// Top The number of results to return (default is 20)
+	Top *TopPath `form:"$top,omitempty" json:"$top,omitempty"`
+
+	// Count Flag indicating whether to return the total number of results in the queried collection. Using pagination query
+	// parameters $skip and $top will not affect this, i.e. the number of returned elements might be smaller than the
+	// count value.
+	Count *CountPath `form:"$count,omitempty" json:"$count,omitempty"`
+}
 
 // GetTagsForKeyConfigurationParams defines parameters for GetTagsForKeyConfiguration.
 type GetTagsForKeyConfigurationParams struct {
@@ -1477,6 +2099,48 @@ type GetKeyVersionsParams struct {
 	Count *CountPath `form:"$count,omitempty" json:"$count,omitempty"`
 }
 
+// GetRolesParams defines parameters for GetRoles.
+type GetRolesParams struct {
+	// Skip The number of results to skip (default is 0)
+	Skip *SkipPath `form:"$skip,omitempty" json:"$skip,omitempty"`
+
+	// Top The number of results to return (default is 20)
+	Top *TopPath `form:"$top,omitempty" json:"$top,omitempty"`
+
+	// Count Flag indicating whether to return the total number of results in the queried collection. Using pagination query
+	// parameters $skip and $top will not affect this, i.e. the number of returned elements might be smaller than the
+	// count value.
+	Count *CountPath `form:"$count,omitempty" json:"$count,omitempty"`
+}
+
+// GetSystemGroupsParams defines parameters for GetSystemGroups.
+type GetSystemGroupsParams struct {
+	// Skip The number of results to skip (default is 0)
+	Skip *SkipPath `form:"$skip,omitempty" json:"$skip,omitempty"`
+
+	// Top The number of results to return (default is 20)
+	Top *TopPath `form:"$top,omitempty" json:"$top,omitempty"`
+
+	// Count Flag indicating whether to return the total number of results in the queried collection. Using pagination query
+	// parameters $skip and $top will not affect this, i.e. the number of returned elements might be smaller than the
+	// count value.
+	Count *CountPath `form:"$count,omitempty" json:"$count,omitempty"`
+}
+
+// GetSystemGroupSystemsParams defines parameters for GetSystemGroupSystems.
+type GetSystemGroupSystemsParams struct {
+	// Skip The number of results to skip (default is 0)
+	Skip *SkipPath `form:"$skip,omitempty" json:"$skip,omitempty"`
+
+	// Top The number of results to return (default is 20)
+	Top *TopPath `form:"$top,omitempty" json:"$top,omitempty"`
+
+	// Count Flag indicating whether to return the total number of results in the queried collection. Using pagination query
+	// parameters $skip and $top will not affect this, i.e. the number of returned elements might be smaller than the
+	// count value.
+	Count *CountPath `form:"$count,omitempty" json:"$count,omitempty"`
+}
+
 // GetAllSystemsParams defines parameters for GetAllSystems.
 type GetAllSystemsParams struct {
 	// Skip The number of results to skip (default is 0)
@@ -1545,6 +2209,12 @@ type GetWorkflowsParams struct {
 	Filter *FilterWorkflows `form:"$filter,omitempty" json:"$filter,omitempty"`
 }
 
+// CreateAPITokenJSONRequestBody defines body for CreateAPIToken for application/json ContentType.
+type CreateAPITokenJSONRequestBody = APIToken
+
+// ExplainAuthzJSONRequestBody defines body for ExplainAuthz for application/json ContentType.
+type ExplainAuthzJSONRequestBody = AuthzExplainRequest
+
 // CreateGroupJSONRequestBody defines body for CreateGroup for application/json ContentType.
 type CreateGroupJSONRequestBody = Group
 
@@ -1554,6 +2224,9 @@ type CheckGroupsIAMJSONRequestBody = GroupIAMCheckRequest
 // UpdateGroupApplicationMergePatchPlusJSONRequestBody defines body for UpdateGroup for application/merge-patch+json ContentType.
 type UpdateGroupApplicationMergePatchPlusJSONRequestBody = GroupPatch
 
+// CreateGroupGrantJSONRequestBody defines body for CreateGroupGrant for application/json ContentType.
+type CreateGroupGrantJSONRequestBody = ResourceGrant
+
 // CreateOrUpdateLabelsJSONRequestBody defines body for CreateOrUpdateLabels for application/json ContentType.
 type CreateOrUpdateLabelsJSONRequestBody = LabelsPostOrPatch
 
@@ -1575,12 +2248,33 @@ type UpdateKeyApplicationMergePatchPlusJSONRequestBody = KeyPatch
 // ImportKeyMaterialJSONRequestBody defines body for ImportKeyMaterial for application/json ContentType.
 type ImportKeyMaterialJSONRequestBody = KeyImport
 
+// CreateRoleJSONRequestBody defines body for CreateRole for application/json ContentType.
+type CreateRoleJSONRequestBody = CustomRole
+
+// UpdateRoleApplicationMergePatchPlusJSONRequestBody defines body for UpdateRole for application/merge-patch+json ContentType.
+type UpdateRoleApplicationMergePatchPlusJSONRequestBody = CustomRolePatch
+
+// CreateSystemGroupJSONRequestBody defines body for CreateSystemGroup for application/json ContentType.
+type CreateSystemGroupJSONRequestBody = SystemGroup
+
+// UpdateSystemGroupApplicationMergePatchPlusJSONRequestBody defines body for UpdateSystemGroup for application/merge-patch+json ContentType.
+type UpdateSystemGroupApplicationMergePatchPlusJSONRequestBody = SystemGroupPatch
+
+// LinkSystemGroupActionApplicationMergePatchPlusJSONRequestBody defines body for LinkSystemGroupAction for application/merge-patch+json ContentType.
+type LinkSystemGroupActionApplicationMergePatchPlusJSONRequestBody = SystemPatch
+
 // LinkSystemActionApplicationMergePatchPlusJSONRequestBody defines body for LinkSystemAction for application/merge-patch+json ContentType.
 type LinkSystemActionApplicationMergePatchPlusJSONRequestBody = SystemPatch
 
+// ValidateSystemLinkJSONRequestBody defines body for ValidateSystemLink for application/json ContentType.
+type ValidateSystemLinkJSONRequestBody = SystemPatch
+
 // SendRecoveryActionsJSONRequestBody defines body for SendRecoveryActions for application/json ContentType.
 type SendRecoveryActionsJSONRequestBody = SystemRecoveryActionBody
 
+// UpdateTenantSystemAutoLinkConfigurationJSONRequestBody defines body for UpdateTenantSystemAutoLinkConfiguration for application/json ContentType.
+type UpdateTenantSystemAutoLinkConfigurationJSONRequestBody = TenantSystemAutoLinkConfiguration
+
 // UpdateTenantWorkflowConfigurationApplicationMergePatchPlusJSONRequestBody defines body for UpdateTenantWorkflowConfiguration for application/merge-patch+json ContentType.
 type UpdateTenantWorkflowConfigurationApplicationMergePatchPlusJSONRequestBody = TenantWorkflowConfiguration
 
@@ -1678,6 +2372,27 @@ func (a KeyAccessDetailsRegion) MarshalJSON() ([]byte, error) {
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get API Tokens
+	// (GET /apiTokens)
+	GetAPITokens(w http.ResponseWriter, r *http.Request, params GetAPITokensParams)
+	// Create an API Token
+	// (POST /apiTokens)
+	CreateAPIToken(w http.ResponseWriter, r *http.Request)
+	// Revoke an API Token
+	// (DELETE /apiTokens/{tokenID})
+	RevokeAPIToken(w http.ResponseWriter, r *http.Request, tokenID TokenIDPath)
+	// Get an API Token
+	// (GET /apiTokens/{tokenID})
+	GetAPITokenByID(w http.ResponseWriter, r *http.Request, tokenID TokenIDPath)
+	// Retrieve all Audit Events
+	// (GET /auditEvents)
+	GetAuditEvents(w http.ResponseWriter, r *http.Request, params GetAuditEventsParams)
+	// Explain an authorization decision
+	// (POST /authz/explain)
+	ExplainAuthz(w http.ResponseWriter, r *http.Request)
+	// Get keystore capabilities
+	// (GET /capabilities)
+	GetCapabilities(w http.ResponseWriter, r *http.Request)
 	// Get Groups
 	// (GET /groups)
 	GetGroups(w http.ResponseWriter, r *http.Request, params GetGroupsParams)
@@ -1696,6 +2411,15 @@ type ServerInterface interface {
 	// Update group
 	// (PATCH /groups/{groupID})
 	UpdateGroup(w http.ResponseWriter, r *http.Request, groupID GroupIDPath)
+	// Get Resource Grants of a Group
+	// (GET /groups/{groupID}/grants)
+	GetGroupGrants(w http.ResponseWriter, r *http.Request, groupID GroupIDPath, params GetGroupGrantsParams)
+	// Create a Resource Grant for a Group
+	// (POST /groups/{groupID}/grants)
+	CreateGroupGrant(w http.ResponseWriter, r *http.Request, groupID GroupIDPath)
+	// Delete a Resource Grant of a Group
+	// (DELETE /groups/{groupID}/grants/{grantID})
+	DeleteGroupGrant(w http.ResponseWriter, r *http.Request, groupID GroupIDPath, grantID GrantIDPath)
 	// Delete a specified Label from a Key
 	// (DELETE /key/{keyID}/label/{labelName})
 	DeleteLabel(w http.ResponseWriter, r *http.Request, keyID KeyIDPath, labelName string)
@@ -1753,6 +2477,45 @@ type ServerInterface interface {
 	// Get metadata of all Key Versions by Key ID
 	// (GET /keys/{keyID}/versions)
 	GetKeyVersions(w http.ResponseWriter, r *http.Request, keyID KeyIDPath, params GetKeyVersionsParams)
+	// Get Custom Roles
+	// (GET /roles)
+	GetRoles(w http.ResponseWriter, r *http.Request, params GetRolesParams)
+	// Create a new Custom Role
+	// (POST /roles)
+	CreateRole(w http.ResponseWriter, r *http.Request)
+	// Delete a Custom Role by its ID
+	// (DELETE /roles/{roleID})
+	DeleteRoleByID(w http.ResponseWriter, r *http.Request, roleID RoleIDPath)
+	// Get Custom Role by ID
+	// (GET /roles/{roleID})
+	GetRoleByID(w http.ResponseWriter, r *http.Request, roleID RoleIDPath)
+	// Update Custom Role
+	// (PATCH /roles/{roleID})
+	UpdateRole(w http.ResponseWriter, r *http.Request, roleID RoleIDPath)
+	// Retrieve all System Groups
+	// (GET /systemGroups)
+	GetSystemGroups(w http.ResponseWriter, r *http.Request, params GetSystemGroupsParams)
+	// Create a System Group
+	// (POST /systemGroups)
+	CreateSystemGroup(w http.ResponseWriter, r *http.Request)
+	// Delete a System Group
+	// (DELETE /systemGroups/{systemGroupID})
+	DeleteSystemGroup(w http.ResponseWriter, r *http.Request, systemGroupID SystemGroupIDPath)
+	// Retrieve a System Group
+	// (GET /systemGroups/{systemGroupID})
+	GetSystemGroupByID(w http.ResponseWriter, r *http.Request, systemGroupID SystemGroupIDPath)
+	// Update a System Group
+	// (PATCH /systemGroups/{systemGroupID})
+	UpdateSystemGroup(w http.ResponseWriter, r *http.Request, systemGroupID SystemGroupIDPath)
+	// Delete the link of all Systems of a System Group
+	// (DELETE /systemGroups/{systemGroupID}/link)
+	UnlinkSystemGroupAction(w http.ResponseWriter, r *http.Request, systemGroupID SystemGroupIDPath)
+	// Update the link of all Systems of a System Group
+	// (PATCH /systemGroups/{systemGroupID}/link)
+	LinkSystemGroupAction(w http.ResponseWriter, r *http.Request, systemGroupID SystemGroupIDPath)
+	// Retrieve the Systems of a System Group
+	// (GET /systemGroups/{systemGroupID}/systems)
+	GetSystemGroupSystems(w http.ResponseWriter, r *http.Request, systemGroupID SystemGroupIDPath, params GetSystemGroupSystemsParams)
 	// Retrieve all Systems
 	// (GET /systems)
 	GetAllSystems(w http.ResponseWriter, r *http.Request, params GetAllSystemsParams)
@@ -1768,6 +2531,9 @@ type ServerInterface interface {
 	// Update a System link
 	// (PATCH /systems/{systemID}/link)
 	LinkSystemAction(w http.ResponseWriter, r *http.Request, systemID SystemIDPath)
+	// Validate a System link
+	// (POST /systems/{systemID}/link:validate)
+	ValidateSystemLink(w http.ResponseWriter, r *http.Request, systemID SystemIDPath)
 	// Possible recovery action
 	// (GET /systems/{systemID}/recoveryActions)
 	GetRecoveryActions(w http.ResponseWriter, r *http.Request, systemID SystemIDPath)
@@ -1777,6 +2543,12 @@ type ServerInterface interface {
 	// Get tenant keystores
 	// (GET /tenantConfigurations/keystores)
 	GetTenantKeystores(w http.ResponseWriter, r *http.Request)
+	// Get tenant system auto-link configuration
+	// (GET /tenantConfigurations/systemAutoLink)
+	GetTenantSystemAutoLinkConfiguration(w http.ResponseWriter, r *http.Request)
+	// Replace tenant system auto-link configuration
+	// (PUT /tenantConfigurations/systemAutoLink)
+	UpdateTenantSystemAutoLinkConfiguration(w http.ResponseWriter, r *http.Request)
 	// Get tenant workflow configuration
 	// (GET /tenantConfigurations/workflow)
 	GetTenantWorkflowConfiguration(w http.ResponseWriter, r *http.Request)
@@ -1818,17 +2590,17 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// GetGroups operation middleware
-func (siw *ServerInterfaceWrapper) GetGroups(w http.ResponseWriter, r *http.Request) {
+// GetAPITokens operation middleware
+func (siw *ServerInterfaceWrapper) GetAPITokens(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetGroupsParams
+	var params GetAPITokensParams
 
 	// ------------- Optional query parameter "$skip" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$skip", r.URL.Query(), &params.Skip, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	err = runtime.BindQueryParameter("form", true, false, "$skip", r.URL.Query(), &params.Skip)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
 		return
@@ -1836,7 +2608,7 @@ func (siw *ServerInterfaceWrapper) GetGroups(w http.ResponseWriter, r *http.Requ
 
 	// ------------- Optional query parameter "$top" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$top", r.URL.Query(), &params.Top, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	err = runtime.BindQueryParameter("form", true, false, "$top", r.URL.Query(), &params.Top)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$top", Err: err})
 		return
@@ -1844,14 +2616,14 @@ func (siw *ServerInterfaceWrapper) GetGroups(w http.ResponseWriter, r *http.Requ
 
 	// ------------- Optional query parameter "$count" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$count", r.URL.Query(), &params.Count, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	err = runtime.BindQueryParameter("form", true, false, "$count", r.URL.Query(), &params.Count)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$count", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGroups(w, r, params)
+		siw.Handler.GetAPITokens(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1861,11 +2633,11 @@ func (siw *ServerInterfaceWrapper) GetGroups(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// CreateGroup operation middleware
-func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Request) {
+// CreateAPIToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateAPIToken(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateGroup(w, r)
+		siw.Handler.CreateAPIToken(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1875,11 +2647,22 @@ func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// CheckGroupsIAM operation middleware
-func (siw *ServerInterfaceWrapper) CheckGroupsIAM(w http.ResponseWriter, r *http.Request) {
+// RevokeAPIToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAPIToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tokenID" -------------
+	var tokenID TokenIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tokenID", r.PathValue("tokenID"), &tokenID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tokenID", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CheckGroupsIAM(w, r)
+		siw.Handler.RevokeAPIToken(w, r, tokenID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1889,22 +2672,22 @@ func (siw *ServerInterfaceWrapper) CheckGroupsIAM(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteGroupByID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteGroupByID(w http.ResponseWriter, r *http.Request) {
+// GetAPITokenByID operation middleware
+func (siw *ServerInterfaceWrapper) GetAPITokenByID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "groupID" -------------
-	var groupID GroupIDPath
+	// ------------- Path parameter "tokenID" -------------
+	var tokenID TokenIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "groupID", r.PathValue("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "tokenID", r.PathValue("tokenID"), &tokenID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tokenID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteGroupByID(w, r, groupID)
+		siw.Handler.GetAPITokenByID(w, r, tokenID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1914,47 +2697,48 @@ func (siw *ServerInterfaceWrapper) DeleteGroupByID(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetGroupByID operation middleware
-func (siw *ServerInterfaceWrapper) GetGroupByID(w http.ResponseWriter, r *http.Request) {
+// GetAuditEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditEvents(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "groupID" -------------
-	var groupID GroupIDPath
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditEventsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "groupID", r.PathValue("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", r.URL.Query(), &params.Skip)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGroupByID(w, r, groupID)
-	}))
+	// ------------- Optional query parameter "$top" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "$top", r.URL.Query(), &params.Top)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$top", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// UpdateGroup operation middleware
-func (siw *ServerInterfaceWrapper) UpdateGroup(w http.ResponseWriter, r *http.Request) {
+	// ------------- Optional query parameter "$count" -------------
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "$count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$count", Err: err})
+		return
+	}
 
-	// ------------- Path parameter "groupID" -------------
-	var groupID GroupIDPath
+	// ------------- Optional query parameter "$filter" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "groupID", r.PathValue("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindQueryParameter("form", true, false, "$filter", r.URL.Query(), &params.Filter)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$filter", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateGroup(w, r, groupID)
+		siw.Handler.GetAuditEvents(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1964,31 +2748,25 @@ func (siw *ServerInterfaceWrapper) UpdateGroup(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteLabel operation middleware
-func (siw *ServerInterfaceWrapper) DeleteLabel(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// ExplainAuthz operation middleware
+func (siw *ServerInterfaceWrapper) ExplainAuthz(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Path parameter "keyID" -------------
-	var keyID KeyIDPath
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExplainAuthz(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Path parameter "labelName" -------------
-	var labelName string
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "labelName", r.PathValue("labelName"), &labelName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelName", Err: err})
-		return
-	}
+// GetCapabilities operation middleware
+func (siw *ServerInterfaceWrapper) GetCapabilities(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteLabel(w, r, keyID, labelName)
+		siw.Handler.GetCapabilities(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1998,23 +2776,22 @@ func (siw *ServerInterfaceWrapper) DeleteLabel(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetKeyLabels operation middleware
-func (siw *ServerInterfaceWrapper) GetKeyLabels(w http.ResponseWriter, r *http.Request) {
+// GetGroups operation middleware
+func (siw *ServerInterfaceWrapper) GetGroups(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "keyID" -------------
-	var keyID KeyIDPath
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGroupsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$skip", r.URL.Query(), &params.Skip, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetKeyLabelsParams
-
 	// ------------- Optional query parameter "$top" -------------
 
 	err = runtime.BindQueryParameterWithOptions("form", true, false, "$top", r.URL.Query(), &params.Top, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
@@ -2023,14 +2800,6 @@ func (siw *ServerInterfaceWrapper) GetKeyLabels(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// ------------- Optional query parameter "$skip" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$skip", r.URL.Query(), &params.Skip, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
-		return
-	}
-
 	// ------------- Optional query parameter "$count" -------------
 
 	err = runtime.BindQueryParameterWithOptions("form", true, false, "$count", r.URL.Query(), &params.Count, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
@@ -2040,7 +2809,7 @@ func (siw *ServerInterfaceWrapper) GetKeyLabels(w http.ResponseWriter, r *http.R
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetKeyLabels(w, r, keyID, params)
+		siw.Handler.GetGroups(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2050,22 +2819,25 @@ func (siw *ServerInterfaceWrapper) GetKeyLabels(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateOrUpdateLabels operation middleware
-func (siw *ServerInterfaceWrapper) CreateOrUpdateLabels(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// CreateGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Path parameter "keyID" -------------
-	var keyID KeyIDPath
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateGroup(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// CheckGroupsIAM operation middleware
+func (siw *ServerInterfaceWrapper) CheckGroupsIAM(w http.ResponseWriter, r *http.Request) {
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateOrUpdateLabels(w, r, keyID)
+		siw.Handler.CheckGroupsIAM(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2075,48 +2847,47 @@ func (siw *ServerInterfaceWrapper) CreateOrUpdateLabels(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetKeyConfigurations operation middleware
-func (siw *ServerInterfaceWrapper) GetKeyConfigurations(w http.ResponseWriter, r *http.Request) {
+// DeleteGroupByID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGroupByID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetKeyConfigurationsParams
-
-	// ------------- Optional query parameter "expandGroup" -------------
+	// ------------- Path parameter "groupID" -------------
+	var groupID GroupIDPath
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "expandGroup", r.URL.Query(), &params.ExpandGroup, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", r.PathValue("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expandGroup", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "$skip" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteGroupByID(w, r, groupID)
+	}))
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$skip", r.URL.Query(), &params.Skip, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "$top" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$top", r.URL.Query(), &params.Top, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$top", Err: err})
-		return
-	}
+// GetGroupByID operation middleware
+func (siw *ServerInterfaceWrapper) GetGroupByID(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "$count" -------------
+	var err error
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$count", r.URL.Query(), &params.Count, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	// ------------- Path parameter "groupID" -------------
+	var groupID GroupIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", r.PathValue("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$count", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetKeyConfigurations(w, r, params)
+		siw.Handler.GetGroupByID(w, r, groupID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2126,11 +2897,22 @@ func (siw *ServerInterfaceWrapper) GetKeyConfigurations(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostKeyConfigurations operation middleware
-func (siw *ServerInterfaceWrapper) PostKeyConfigurations(w http.ResponseWriter, r *http.Request) {
+// UpdateGroup operation middleware
+func (siw *ServerInterfaceWrapper) UpdateGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "groupID" -------------
+	var groupID GroupIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", r.PathValue("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupID", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostKeyConfigurations(w, r)
+		siw.Handler.UpdateGroup(w, r, groupID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2140,22 +2922,49 @@ func (siw *ServerInterfaceWrapper) PostKeyConfigurations(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteKeyConfigurationByID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteKeyConfigurationByID(w http.ResponseWriter, r *http.Request) {
+// GetGroupGrants operation middleware
+func (siw *ServerInterfaceWrapper) GetGroupGrants(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "keyConfigurationID" -------------
-	var keyConfigurationID KeyConfigurationIDPath
+	// ------------- Path parameter "groupID" -------------
+	var groupID GroupIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "keyConfigurationID", r.PathValue("keyConfigurationID"), &keyConfigurationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", r.PathValue("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyConfigurationID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupID", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGroupGrantsParams
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", r.URL.Query(), &params.Skip)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", r.URL.Query(), &params.Top)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$top", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$count", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteKeyConfigurationByID(w, r, keyConfigurationID)
+		siw.Handler.GetGroupGrants(w, r, groupID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2165,22 +2974,22 @@ func (siw *ServerInterfaceWrapper) DeleteKeyConfigurationByID(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// GetKeyConfigurationByID operation middleware
-func (siw *ServerInterfaceWrapper) GetKeyConfigurationByID(w http.ResponseWriter, r *http.Request) {
+// CreateGroupGrant operation middleware
+func (siw *ServerInterfaceWrapper) CreateGroupGrant(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "keyConfigurationID" -------------
-	var keyConfigurationID KeyConfigurationIDPath
+	// ------------- Path parameter "groupID" -------------
+	var groupID GroupIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "keyConfigurationID", r.PathValue("keyConfigurationID"), &keyConfigurationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", r.PathValue("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyConfigurationID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetKeyConfigurationByID(w, r, keyConfigurationID)
+		siw.Handler.CreateGroupGrant(w, r, groupID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2190,22 +2999,31 @@ func (siw *ServerInterfaceWrapper) GetKeyConfigurationByID(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateKeyConfigurationByID operation middleware
-func (siw *ServerInterfaceWrapper) UpdateKeyConfigurationByID(w http.ResponseWriter, r *http.Request) {
+// DeleteGroupGrant operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGroupGrant(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "keyConfigurationID" -------------
-	var keyConfigurationID KeyConfigurationIDPath
+	// ------------- Path parameter "groupID" -------------
+	var groupID GroupIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "keyConfigurationID", r.PathValue("keyConfigurationID"), &keyConfigurationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", r.PathValue("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyConfigurationID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "grantID" -------------
+	var grantID GrantIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "grantID", r.PathValue("grantID"), &grantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "grantID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateKeyConfigurationByID(w, r, keyConfigurationID)
+		siw.Handler.DeleteGroupGrant(w, r, groupID, grantID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2215,22 +3033,31 @@ func (siw *ServerInterfaceWrapper) UpdateKeyConfigurationByID(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// GetKeyConfigurationCertificates operation middleware
-func (siw *ServerInterfaceWrapper) GetKeyConfigurationCertificates(w http.ResponseWriter, r *http.Request) {
+// DeleteLabel operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLabel(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "keyConfigurationID" -------------
-	var keyConfigurationID KeyConfigurationIDPath
+	// ------------- Path parameter "keyID" -------------
+	var keyID KeyIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "keyConfigurationID", r.PathValue("keyConfigurationID"), &keyConfigurationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyConfigurationID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "labelName" -------------
+	var labelName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "labelName", r.PathValue("labelName"), &labelName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelName", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetKeyConfigurationCertificates(w, r, keyConfigurationID)
+		siw.Handler.DeleteLabel(w, r, keyID, labelName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2240,36 +3067,36 @@ func (siw *ServerInterfaceWrapper) GetKeyConfigurationCertificates(w http.Respon
 	handler.ServeHTTP(w, r)
 }
 
-// GetTagsForKeyConfiguration operation middleware
-func (siw *ServerInterfaceWrapper) GetTagsForKeyConfiguration(w http.ResponseWriter, r *http.Request) {
+// GetKeyLabels operation middleware
+func (siw *ServerInterfaceWrapper) GetKeyLabels(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "keyConfigurationID" -------------
-	var keyConfigurationID KeyConfigurationIDPath
+	// ------------- Path parameter "keyID" -------------
+	var keyID KeyIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "keyConfigurationID", r.PathValue("keyConfigurationID"), &keyConfigurationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyConfigurationID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
 		return
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTagsForKeyConfigurationParams
+	var params GetKeyLabelsParams
 
-	// ------------- Optional query parameter "$skip" -------------
+	// ------------- Optional query parameter "$top" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$skip", r.URL.Query(), &params.Skip, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$top", r.URL.Query(), &params.Top, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$top", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "$top" -------------
+	// ------------- Optional query parameter "$skip" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$top", r.URL.Query(), &params.Top, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$skip", r.URL.Query(), &params.Skip, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$top", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
 		return
 	}
 
@@ -2282,7 +3109,7 @@ func (siw *ServerInterfaceWrapper) GetTagsForKeyConfiguration(w http.ResponseWri
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTagsForKeyConfiguration(w, r, keyConfigurationID, params)
+		siw.Handler.GetKeyLabels(w, r, keyID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2292,22 +3119,22 @@ func (siw *ServerInterfaceWrapper) GetTagsForKeyConfiguration(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// AddTagsToKeyConfiguration operation middleware
-func (siw *ServerInterfaceWrapper) AddTagsToKeyConfiguration(w http.ResponseWriter, r *http.Request) {
+// CreateOrUpdateLabels operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrUpdateLabels(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "keyConfigurationID" -------------
-	var keyConfigurationID KeyConfigurationIDPath
+	// ------------- Path parameter "keyID" -------------
+	var keyID KeyIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "keyConfigurationID", r.PathValue("keyConfigurationID"), &keyConfigurationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyConfigurationID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddTagsToKeyConfiguration(w, r, keyConfigurationID)
+		siw.Handler.CreateOrUpdateLabels(w, r, keyID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2317,26 +3144,19 @@ func (siw *ServerInterfaceWrapper) AddTagsToKeyConfiguration(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetKeys operation middleware
-func (siw *ServerInterfaceWrapper) GetKeys(w http.ResponseWriter, r *http.Request) {
+// GetKeyConfigurations operation middleware
+func (siw *ServerInterfaceWrapper) GetKeyConfigurations(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetKeysParams
-
-	// ------------- Required query parameter "keyConfigurationID" -------------
-
-	if paramValue := r.URL.Query().Get("keyConfigurationID"); paramValue != "" {
+	var params GetKeyConfigurationsParams
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "keyConfigurationID"})
-		return
-	}
+	// ------------- Optional query parameter "expandGroup" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, true, "keyConfigurationID", r.URL.Query(), &params.KeyConfigurationID, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "expandGroup", r.URL.Query(), &params.ExpandGroup, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyConfigurationID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expandGroup", Err: err})
 		return
 	}
 
@@ -2365,7 +3185,7 @@ func (siw *ServerInterfaceWrapper) GetKeys(w http.ResponseWriter, r *http.Reques
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetKeys(w, r, params)
+		siw.Handler.GetKeyConfigurations(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2375,11 +3195,11 @@ func (siw *ServerInterfaceWrapper) GetKeys(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// PostKeys operation middleware
-func (siw *ServerInterfaceWrapper) PostKeys(w http.ResponseWriter, r *http.Request) {
+// PostKeyConfigurations operation middleware
+func (siw *ServerInterfaceWrapper) PostKeyConfigurations(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostKeys(w, r)
+		siw.Handler.PostKeyConfigurations(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2389,22 +3209,22 @@ func (siw *ServerInterfaceWrapper) PostKeys(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteKeysKeyID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteKeysKeyID(w http.ResponseWriter, r *http.Request) {
+// DeleteKeyConfigurationByID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteKeyConfigurationByID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "keyID" -------------
-	var keyID KeyIDPath
+	// ------------- Path parameter "keyConfigurationID" -------------
+	var keyConfigurationID KeyConfigurationIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "keyConfigurationID", r.PathValue("keyConfigurationID"), &keyConfigurationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyConfigurationID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteKeysKeyID(w, r, keyID)
+		siw.Handler.DeleteKeyConfigurationByID(w, r, keyConfigurationID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2414,22 +3234,22 @@ func (siw *ServerInterfaceWrapper) DeleteKeysKeyID(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetKeysKeyID operation middleware
-func (siw *ServerInterfaceWrapper) GetKeysKeyID(w http.ResponseWriter, r *http.Request) {
+// GetKeyConfigurationByID operation middleware
+func (siw *ServerInterfaceWrapper) GetKeyConfigurationByID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "keyID" -------------
-	var keyID KeyIDPath
+	// ------------- Path parameter "keyConfigurationID" -------------
+	var keyConfigurationID KeyConfigurationIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "keyConfigurationID", r.PathValue("keyConfigurationID"), &keyConfigurationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyConfigurationID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetKeysKeyID(w, r, keyID)
+		siw.Handler.GetKeyConfigurationByID(w, r, keyConfigurationID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2439,22 +3259,22 @@ func (siw *ServerInterfaceWrapper) GetKeysKeyID(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateKey operation middleware
-func (siw *ServerInterfaceWrapper) UpdateKey(w http.ResponseWriter, r *http.Request) {
+// UpdateKeyConfigurationByID operation middleware
+func (siw *ServerInterfaceWrapper) UpdateKeyConfigurationByID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "keyID" -------------
-	var keyID KeyIDPath
+	// ------------- Path parameter "keyConfigurationID" -------------
+	var keyConfigurationID KeyConfigurationIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "keyConfigurationID", r.PathValue("keyConfigurationID"), &keyConfigurationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyConfigurationID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateKey(w, r, keyID)
+		siw.Handler.UpdateKeyConfigurationByID(w, r, keyConfigurationID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2464,47 +3284,22 @@ func (siw *ServerInterfaceWrapper) UpdateKey(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// ImportKeyMaterial operation middleware
-func (siw *ServerInterfaceWrapper) ImportKeyMaterial(w http.ResponseWriter, r *http.Request) {
+// GetKeyConfigurationCertificates operation middleware
+func (siw *ServerInterfaceWrapper) GetKeyConfigurationCertificates(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "keyID" -------------
-	var keyID KeyIDPath
-
-	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
-		return
-	}
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ImportKeyMaterial(w, r, keyID)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// GetKeyImportParams operation middleware
-func (siw *ServerInterfaceWrapper) GetKeyImportParams(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "keyID" -------------
-	var keyID KeyIDPath
+	// ------------- Path parameter "keyConfigurationID" -------------
+	var keyConfigurationID KeyConfigurationIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "keyConfigurationID", r.PathValue("keyConfigurationID"), &keyConfigurationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyConfigurationID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetKeyImportParams(w, r, keyID)
+		siw.Handler.GetKeyConfigurationCertificates(w, r, keyConfigurationID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2514,22 +3309,22 @@ func (siw *ServerInterfaceWrapper) GetKeyImportParams(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetKeyVersions operation middleware
-func (siw *ServerInterfaceWrapper) GetKeyVersions(w http.ResponseWriter, r *http.Request) {
+// GetTagsForKeyConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) GetTagsForKeyConfiguration(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "keyID" -------------
-	var keyID KeyIDPath
+	// ------------- Path parameter "keyConfigurationID" -------------
+	var keyConfigurationID KeyConfigurationIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "keyConfigurationID", r.PathValue("keyConfigurationID"), &keyConfigurationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyConfigurationID", Err: err})
 		return
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetKeyVersionsParams
+	var params GetTagsForKeyConfigurationParams
 
 	// ------------- Optional query parameter "$skip" -------------
 
@@ -2556,7 +3351,7 @@ func (siw *ServerInterfaceWrapper) GetKeyVersions(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetKeyVersions(w, r, keyID, params)
+		siw.Handler.GetTagsForKeyConfiguration(w, r, keyConfigurationID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2566,13 +3361,53 @@ func (siw *ServerInterfaceWrapper) GetKeyVersions(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetAllSystems operation middleware
-func (siw *ServerInterfaceWrapper) GetAllSystems(w http.ResponseWriter, r *http.Request) {
+// AddTagsToKeyConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) AddTagsToKeyConfiguration(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "keyConfigurationID" -------------
+	var keyConfigurationID KeyConfigurationIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keyConfigurationID", r.PathValue("keyConfigurationID"), &keyConfigurationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyConfigurationID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTagsToKeyConfiguration(w, r, keyConfigurationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetKeys(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAllSystemsParams
+	var params GetKeysParams
+
+	// ------------- Required query parameter "keyConfigurationID" -------------
+
+	if paramValue := r.URL.Query().Get("keyConfigurationID"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "keyConfigurationID"})
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "keyConfigurationID", r.URL.Query(), &params.KeyConfigurationID, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyConfigurationID", Err: err})
+		return
+	}
 
 	// ------------- Optional query parameter "$skip" -------------
 
@@ -2598,16 +3433,8 @@ func (siw *ServerInterfaceWrapper) GetAllSystems(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// ------------- Optional query parameter "$filter" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$filter", r.URL.Query(), &params.Filter, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$filter", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllSystems(w, r, params)
+		siw.Handler.GetKeys(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2617,11 +3444,11 @@ func (siw *ServerInterfaceWrapper) GetAllSystems(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetFilters operation middleware
-func (siw *ServerInterfaceWrapper) GetFilters(w http.ResponseWriter, r *http.Request) {
+// PostKeys operation middleware
+func (siw *ServerInterfaceWrapper) PostKeys(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFilters(w, r)
+		siw.Handler.PostKeys(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2631,22 +3458,22 @@ func (siw *ServerInterfaceWrapper) GetFilters(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetSystemByID operation middleware
-func (siw *ServerInterfaceWrapper) GetSystemByID(w http.ResponseWriter, r *http.Request) {
+// DeleteKeysKeyID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteKeysKeyID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "systemID" -------------
-	var systemID SystemIDPath
+	// ------------- Path parameter "keyID" -------------
+	var keyID KeyIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "systemID", r.PathValue("systemID"), &systemID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSystemByID(w, r, systemID)
+		siw.Handler.DeleteKeysKeyID(w, r, keyID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2656,22 +3483,22 @@ func (siw *ServerInterfaceWrapper) GetSystemByID(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// UnlinkSystemAction operation middleware
-func (siw *ServerInterfaceWrapper) UnlinkSystemAction(w http.ResponseWriter, r *http.Request) {
+// GetKeysKeyID operation middleware
+func (siw *ServerInterfaceWrapper) GetKeysKeyID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "systemID" -------------
-	var systemID SystemIDPath
+	// ------------- Path parameter "keyID" -------------
+	var keyID KeyIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "systemID", r.PathValue("systemID"), &systemID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UnlinkSystemAction(w, r, systemID)
+		siw.Handler.GetKeysKeyID(w, r, keyID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2681,22 +3508,22 @@ func (siw *ServerInterfaceWrapper) UnlinkSystemAction(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// LinkSystemAction operation middleware
-func (siw *ServerInterfaceWrapper) LinkSystemAction(w http.ResponseWriter, r *http.Request) {
+// UpdateKey operation middleware
+func (siw *ServerInterfaceWrapper) UpdateKey(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "systemID" -------------
-	var systemID SystemIDPath
+	// ------------- Path parameter "keyID" -------------
+	var keyID KeyIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "systemID", r.PathValue("systemID"), &systemID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.LinkSystemAction(w, r, systemID)
+		siw.Handler.UpdateKey(w, r, keyID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2706,22 +3533,22 @@ func (siw *ServerInterfaceWrapper) LinkSystemAction(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetRecoveryActions operation middleware
-func (siw *ServerInterfaceWrapper) GetRecoveryActions(w http.ResponseWriter, r *http.Request) {
+// ImportKeyMaterial operation middleware
+func (siw *ServerInterfaceWrapper) ImportKeyMaterial(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "systemID" -------------
-	var systemID SystemIDPath
+	// ------------- Path parameter "keyID" -------------
+	var keyID KeyIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "systemID", r.PathValue("systemID"), &systemID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRecoveryActions(w, r, systemID)
+		siw.Handler.ImportKeyMaterial(w, r, keyID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2731,22 +3558,22 @@ func (siw *ServerInterfaceWrapper) GetRecoveryActions(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// SendRecoveryActions operation middleware
-func (siw *ServerInterfaceWrapper) SendRecoveryActions(w http.ResponseWriter, r *http.Request) {
+// GetKeyImportParams operation middleware
+func (siw *ServerInterfaceWrapper) GetKeyImportParams(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "systemID" -------------
-	var systemID SystemIDPath
+	// ------------- Path parameter "keyID" -------------
+	var keyID KeyIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "systemID", r.PathValue("systemID"), &systemID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SendRecoveryActions(w, r, systemID)
+		siw.Handler.GetKeyImportParams(w, r, keyID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2756,53 +3583,49 @@ func (siw *ServerInterfaceWrapper) SendRecoveryActions(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetTenantKeystores operation middleware
-func (siw *ServerInterfaceWrapper) GetTenantKeystores(w http.ResponseWriter, r *http.Request) {
+// GetKeyVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetKeyVersions(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTenantKeystores(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ------------- Path parameter "keyID" -------------
+	var keyID KeyIDPath
 
-	handler.ServeHTTP(w, r)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "keyID", r.PathValue("keyID"), &keyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
+		return
+	}
 
-// GetTenantWorkflowConfiguration operation middleware
-func (siw *ServerInterfaceWrapper) GetTenantWorkflowConfiguration(w http.ResponseWriter, r *http.Request) {
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetKeyVersionsParams
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTenantWorkflowConfiguration(w, r)
-	}))
+	// ------------- Optional query parameter "$skip" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$skip", r.URL.Query(), &params.Skip, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// UpdateTenantWorkflowConfiguration operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTenantWorkflowConfiguration(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTenantWorkflowConfiguration(w, r)
-	}))
+	// ------------- Optional query parameter "$top" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$top", r.URL.Query(), &params.Top, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$top", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "$count" -------------
 
-// GetTenantInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetTenantInfo(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$count", r.URL.Query(), &params.Count, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$count", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTenantInfo(w, r)
+		siw.Handler.GetKeyVersions(w, r, keyID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2812,17 +3635,17 @@ func (siw *ServerInterfaceWrapper) GetTenantInfo(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetTenants operation middleware
-func (siw *ServerInterfaceWrapper) GetTenants(w http.ResponseWriter, r *http.Request) {
+// GetRoles operation middleware
+func (siw *ServerInterfaceWrapper) GetRoles(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTenantsParams
+	var params GetRolesParams
 
 	// ------------- Optional query parameter "$skip" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$skip", r.URL.Query(), &params.Skip, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	err = runtime.BindQueryParameter("form", true, false, "$skip", r.URL.Query(), &params.Skip)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
 		return
@@ -2830,7 +3653,7 @@ func (siw *ServerInterfaceWrapper) GetTenants(w http.ResponseWriter, r *http.Req
 
 	// ------------- Optional query parameter "$top" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$top", r.URL.Query(), &params.Top, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	err = runtime.BindQueryParameter("form", true, false, "$top", r.URL.Query(), &params.Top)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$top", Err: err})
 		return
@@ -2838,14 +3661,14 @@ func (siw *ServerInterfaceWrapper) GetTenants(w http.ResponseWriter, r *http.Req
 
 	// ------------- Optional query parameter "$count" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$count", r.URL.Query(), &params.Count, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	err = runtime.BindQueryParameter("form", true, false, "$count", r.URL.Query(), &params.Count)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$count", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTenants(w, r, params)
+		siw.Handler.GetRoles(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2855,11 +3678,11 @@ func (siw *ServerInterfaceWrapper) GetTenants(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetUserInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetUserInfo(w http.ResponseWriter, r *http.Request) {
+// CreateRole operation middleware
+func (siw *ServerInterfaceWrapper) CreateRole(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserInfo(w, r)
+		siw.Handler.CreateRole(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2869,48 +3692,115 @@ func (siw *ServerInterfaceWrapper) GetUserInfo(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetWorkflows operation middleware
-func (siw *ServerInterfaceWrapper) GetWorkflows(w http.ResponseWriter, r *http.Request) {
+// DeleteRoleByID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRoleByID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetWorkflowsParams
+	// ------------- Path parameter "roleID" -------------
+	var roleID RoleIDPath
 
-	// ------------- Optional query parameter "$top" -------------
+	err = runtime.BindStyledParameterWithOptions("simple", "roleID", r.PathValue("roleID"), &roleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roleID", Err: err})
+		return
+	}
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$top", r.URL.Query(), &params.Top, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRoleByID(w, r, roleID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRoleByID operation middleware
+func (siw *ServerInterfaceWrapper) GetRoleByID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "roleID" -------------
+	var roleID RoleIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roleID", r.PathValue("roleID"), &roleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$top", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roleID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRoleByID(w, r, roleID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "roleID" -------------
+	var roleID RoleIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roleID", r.PathValue("roleID"), &roleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roleID", Err: err})
 		return
 	}
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRole(w, r, roleID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSystemGroups operation middleware
+func (siw *ServerInterfaceWrapper) GetSystemGroups(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSystemGroupsParams
+
 	// ------------- Optional query parameter "$skip" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$skip", r.URL.Query(), &params.Skip, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	err = runtime.BindQueryParameter("form", true, false, "$skip", r.URL.Query(), &params.Skip)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "$count" -------------
+	// ------------- Optional query parameter "$top" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$count", r.URL.Query(), &params.Count, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	err = runtime.BindQueryParameter("form", true, false, "$top", r.URL.Query(), &params.Top)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$count", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$top", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "$filter" -------------
+	// ------------- Optional query parameter "$count" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "$filter", r.URL.Query(), &params.Filter, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindQueryParameter("form", true, false, "$count", r.URL.Query(), &params.Count)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$filter", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$count", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWorkflows(w, r, params)
+		siw.Handler.GetSystemGroups(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2920,11 +3810,11 @@ func (siw *ServerInterfaceWrapper) GetWorkflows(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) CreateWorkflow(w http.ResponseWriter, r *http.Request) {
+// CreateSystemGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateSystemGroup(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateWorkflow(w, r)
+		siw.Handler.CreateSystemGroup(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2934,11 +3824,22 @@ func (siw *ServerInterfaceWrapper) CreateWorkflow(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// CheckWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) CheckWorkflow(w http.ResponseWriter, r *http.Request) {
+// DeleteSystemGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSystemGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "systemGroupID" -------------
+	var systemGroupID SystemGroupIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "systemGroupID", r.PathValue("systemGroupID"), &systemGroupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemGroupID", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CheckWorkflow(w, r)
+		siw.Handler.DeleteSystemGroup(w, r, systemGroupID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2948,22 +3849,22 @@ func (siw *ServerInterfaceWrapper) CheckWorkflow(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetWorkflowByID operation middleware
-func (siw *ServerInterfaceWrapper) GetWorkflowByID(w http.ResponseWriter, r *http.Request) {
+// GetSystemGroupByID operation middleware
+func (siw *ServerInterfaceWrapper) GetSystemGroupByID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowID" -------------
-	var workflowID WorkflowIDPath
+	// ------------- Path parameter "systemGroupID" -------------
+	var systemGroupID SystemGroupIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowID", r.PathValue("workflowID"), &workflowID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "systemGroupID", r.PathValue("systemGroupID"), &systemGroupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemGroupID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWorkflowByID(w, r, workflowID)
+		siw.Handler.GetSystemGroupByID(w, r, systemGroupID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2973,22 +3874,22 @@ func (siw *ServerInterfaceWrapper) GetWorkflowByID(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// TransitionWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) TransitionWorkflow(w http.ResponseWriter, r *http.Request) {
+// UpdateSystemGroup operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSystemGroup(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowID" -------------
-	var workflowID WorkflowIDPath
+	// ------------- Path parameter "systemGroupID" -------------
+	var systemGroupID SystemGroupIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowID", r.PathValue("workflowID"), &workflowID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "systemGroupID", r.PathValue("systemGroupID"), &systemGroupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemGroupID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.TransitionWorkflow(w, r, workflowID)
+		siw.Handler.UpdateSystemGroup(w, r, systemGroupID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2998,2802 +3899,4997 @@ func (siw *ServerInterfaceWrapper) TransitionWorkflow(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// UnlinkSystemGroupAction operation middleware
+func (siw *ServerInterfaceWrapper) UnlinkSystemGroupAction(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "systemGroupID" -------------
+	var systemGroupID SystemGroupIDPath
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "systemGroupID", r.PathValue("systemGroupID"), &systemGroupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemGroupID", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnlinkSystemGroupAction(w, r, systemGroupID)
+	}))
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type RequiredParamError struct {
-	ParamName string
+	handler.ServeHTTP(w, r)
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+// LinkSystemGroupAction operation middleware
+func (siw *ServerInterfaceWrapper) LinkSystemGroupAction(w http.ResponseWriter, r *http.Request) {
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	var err error
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	// ------------- Path parameter "systemGroupID" -------------
+	var systemGroupID SystemGroupIDPath
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "systemGroupID", r.PathValue("systemGroupID"), &systemGroupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemGroupID", Err: err})
+		return
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LinkSystemGroupAction(w, r, systemGroupID)
+	}))
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+// GetSystemGroupSystems operation middleware
+func (siw *ServerInterfaceWrapper) GetSystemGroupSystems(w http.ResponseWriter, r *http.Request) {
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	var err error
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
-}
+	// ------------- Path parameter "systemGroupID" -------------
+	var systemGroupID SystemGroupIDPath
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "systemGroupID", r.PathValue("systemGroupID"), &systemGroupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemGroupID", Err: err})
+		return
+	}
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSystemGroupSystemsParams
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
-}
+	// ------------- Optional query parameter "$skip" -------------
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
-}
+	err = runtime.BindQueryParameter("form", true, false, "$skip", r.URL.Query(), &params.Skip)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
+		return
+	}
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+	// ------------- Optional query parameter "$top" -------------
 
-	if m == nil {
-		m = http.NewServeMux()
+	err = runtime.BindQueryParameter("form", true, false, "$top", r.URL.Query(), &params.Top)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$top", Err: err})
+		return
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$count", Err: err})
+		return
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSystemGroupSystems(w, r, systemGroupID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	m.HandleFunc("GET "+options.BaseURL+"/groups", wrapper.GetGroups)
-	m.HandleFunc("POST "+options.BaseURL+"/groups", wrapper.CreateGroup)
-	m.HandleFunc("POST "+options.BaseURL+"/groups/iamCheck", wrapper.CheckGroupsIAM)
-	m.HandleFunc("DELETE "+options.BaseURL+"/groups/{groupID}", wrapper.DeleteGroupByID)
-	m.HandleFunc("GET "+options.BaseURL+"/groups/{groupID}", wrapper.GetGroupByID)
-	m.HandleFunc("PATCH "+options.BaseURL+"/groups/{groupID}", wrapper.UpdateGroup)
-	m.HandleFunc("DELETE "+options.BaseURL+"/key/{keyID}/label/{labelName}", wrapper.DeleteLabel)
-	m.HandleFunc("GET "+options.BaseURL+"/key/{keyID}/labels", wrapper.GetKeyLabels)
-	m.HandleFunc("POST "+options.BaseURL+"/key/{keyID}/labels", wrapper.CreateOrUpdateLabels)
-	m.HandleFunc("GET "+options.BaseURL+"/keyConfigurations", wrapper.GetKeyConfigurations)
-	m.HandleFunc("POST "+options.BaseURL+"/keyConfigurations", wrapper.PostKeyConfigurations)
-	m.HandleFunc("DELETE "+options.BaseURL+"/keyConfigurations/{keyConfigurationID}", wrapper.DeleteKeyConfigurationByID)
-	m.HandleFunc("GET "+options.BaseURL+"/keyConfigurations/{keyConfigurationID}", wrapper.GetKeyConfigurationByID)
-	m.HandleFunc("PATCH "+options.BaseURL+"/keyConfigurations/{keyConfigurationID}", wrapper.UpdateKeyConfigurationByID)
-	m.HandleFunc("GET "+options.BaseURL+"/keyConfigurations/{keyConfigurationID}/certificates", wrapper.GetKeyConfigurationCertificates)
-	m.HandleFunc("GET "+options.BaseURL+"/keyConfigurations/{keyConfigurationID}/tags", wrapper.GetTagsForKeyConfiguration)
-	m.HandleFunc("PUT "+options.BaseURL+"/keyConfigurations/{keyConfigurationID}/tags", wrapper.AddTagsToKeyConfiguration)
-	m.HandleFunc("GET "+options.BaseURL+"/keys", wrapper.GetKeys)
-	m.HandleFunc("POST "+options.BaseURL+"/keys", wrapper.PostKeys)
-	m.HandleFunc("DELETE "+options.BaseURL+"/keys/{keyID}", wrapper.DeleteKeysKeyID)
-	m.HandleFunc("GET "+options.BaseURL+"/keys/{keyID}", wrapper.GetKeysKeyID)
-	m.HandleFunc("PATCH "+options.BaseURL+"/keys/{keyID}", wrapper.UpdateKey)
-	m.HandleFunc("POST "+options.BaseURL+"/keys/{keyID}/importKeyMaterial", wrapper.ImportKeyMaterial)
-	m.HandleFunc("GET "+options.BaseURL+"/keys/{keyID}/importParams", wrapper.GetKeyImportParams)
-	m.HandleFunc("GET "+options.BaseURL+"/keys/{keyID}/versions", wrapper.GetKeyVersions)
-	m.HandleFunc("GET "+options.BaseURL+"/systems", wrapper.GetAllSystems)
-	m.HandleFunc("GET "+options.BaseURL+"/systems/filterOptions", wrapper.GetFilters)
-	m.HandleFunc("GET "+options.BaseURL+"/systems/{systemID}", wrapper.GetSystemByID)
-	m.HandleFunc("DELETE "+options.BaseURL+"/systems/{systemID}/link", wrapper.UnlinkSystemAction)
-	m.HandleFunc("PATCH "+options.BaseURL+"/systems/{systemID}/link", wrapper.LinkSystemAction)
-	m.HandleFunc("GET "+options.BaseURL+"/systems/{systemID}/recoveryActions", wrapper.GetRecoveryActions)
-	m.HandleFunc("POST "+options.BaseURL+"/systems/{systemID}/recoveryActions", wrapper.SendRecoveryActions)
-	m.HandleFunc("GET "+options.BaseURL+"/tenantConfigurations/keystores", wrapper.GetTenantKeystores)
-	m.HandleFunc("GET "+options.BaseURL+"/tenantConfigurations/workflow", wrapper.GetTenantWorkflowConfiguration)
-	m.HandleFunc("PATCH "+options.BaseURL+"/tenantConfigurations/workflow", wrapper.UpdateTenantWorkflowConfiguration)
-	m.HandleFunc("GET "+options.BaseURL+"/tenantInfo", wrapper.GetTenantInfo)
-	m.HandleFunc("GET "+options.BaseURL+"/tenants", wrapper.GetTenants)
-	m.HandleFunc("GET "+options.BaseURL+"/userInfo", wrapper.GetUserInfo)
-	m.HandleFunc("GET "+options.BaseURL+"/workflows", wrapper.GetWorkflows)
-	m.HandleFunc("POST "+options.BaseURL+"/workflows", wrapper.CreateWorkflow)
-	m.HandleFunc("POST "+options.BaseURL+"/workflows/check", wrapper.CheckWorkflow)
-	m.HandleFunc("GET "+options.BaseURL+"/workflows/{workflowID}", wrapper.GetWorkflowByID)
-	m.HandleFunc("POST "+options.BaseURL+"/workflows/{workflowID}/state", wrapper.TransitionWorkflow)
-
-	return m
+	handler.ServeHTTP(w, r)
 }
 
-type N400JSONResponse ErrorMessage
+// GetAllSystems operation middleware
+func (siw *ServerInterfaceWrapper) GetAllSystems(w http.ResponseWriter, r *http.Request) {
 
-type N403JSONResponse ErrorMessage
+	var err error
 
-type N404JSONResponse ErrorMessage
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllSystemsParams
 
-type N409JSONResponse ErrorMessage
+	// ------------- Optional query parameter "$skip" -------------
 
-type N429ResponseHeaders struct {
-	RetryAfter int
-}
-type N429Response struct {
-	Headers N429ResponseHeaders
-}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$skip", r.URL.Query(), &params.Skip, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
+		return
+	}
 
-type N500JSONResponse ErrorMessage
+	// ------------- Optional query parameter "$top" -------------
 
-type GetGroupsRequestObject struct {
-	Params GetGroupsParams
-}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$top", r.URL.Query(), &params.Top, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$top", Err: err})
+		return
+	}
 
-type GetGroupsResponseObject interface {
-	VisitGetGroupsResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "$count" -------------
 
-type GetGroups200JSONResponse GroupList
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$count", r.URL.Query(), &params.Count, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$count", Err: err})
+		return
+	}
 
-func (response GetGroups200JSONResponse) VisitGetGroupsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "$filter" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$filter", r.URL.Query(), &params.Filter, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$filter", Err: err})
+		return
+	}
 
-type GetGroups400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAllSystems(w, r, params)
+	}))
 
-func (response GetGroups400JSONResponse) VisitGetGroupsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetGroups403JSONResponse struct{ N403JSONResponse }
+// GetFilters operation middleware
+func (siw *ServerInterfaceWrapper) GetFilters(w http.ResponseWriter, r *http.Request) {
 
-func (response GetGroups403JSONResponse) VisitGetGroupsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFilters(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetGroups429Response = N429Response
+// GetSystemByID operation middleware
+func (siw *ServerInterfaceWrapper) GetSystemByID(w http.ResponseWriter, r *http.Request) {
 
-func (response GetGroups429Response) VisitGetGroupsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
-	w.WriteHeader(429)
-	return nil
-}
+	var err error
 
-type GetGroups500JSONResponse struct{ N500JSONResponse }
+	// ------------- Path parameter "systemID" -------------
+	var systemID SystemIDPath
 
-func (response GetGroups500JSONResponse) VisitGetGroupsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "systemID", r.PathValue("systemID"), &systemID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemID", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSystemByID(w, r, systemID)
+	}))
 
-type CreateGroupRequestObject struct {
-	Body *CreateGroupJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateGroupResponseObject interface {
-	VisitCreateGroupResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type CreateGroup201JSONResponse Group
+// UnlinkSystemAction operation middleware
+func (siw *ServerInterfaceWrapper) UnlinkSystemAction(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateGroup201JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "systemID" -------------
+	var systemID SystemIDPath
 
-type CreateGroup400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "systemID", r.PathValue("systemID"), &systemID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemID", Err: err})
+		return
+	}
 
-func (response CreateGroup400JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnlinkSystemAction(w, r, systemID)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateGroup403JSONResponse struct{ N403JSONResponse }
+// LinkSystemAction operation middleware
+func (siw *ServerInterfaceWrapper) LinkSystemAction(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateGroup403JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "systemID" -------------
+	var systemID SystemIDPath
 
-type CreateGroup409JSONResponse struct{ N409JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "systemID", r.PathValue("systemID"), &systemID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemID", Err: err})
+		return
+	}
 
-func (response CreateGroup409JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LinkSystemAction(w, r, systemID)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateGroup429Response = N429Response
+// ValidateSystemLink operation middleware
+func (siw *ServerInterfaceWrapper) ValidateSystemLink(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateGroup429Response) VisitCreateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
-	w.WriteHeader(429)
-	return nil
-}
+	var err error
 
-type CreateGroup500JSONResponse struct{ N500JSONResponse }
+	// ------------- Path parameter "systemID" -------------
+	var systemID SystemIDPath
 
-func (response CreateGroup500JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "systemID", r.PathValue("systemID"), &systemID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemID", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ValidateSystemLink(w, r, systemID)
+	}))
 
-type CheckGroupsIAMRequestObject struct {
-	Body *CheckGroupsIAMJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CheckGroupsIAMResponseObject interface {
-	VisitCheckGroupsIAMResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type CheckGroupsIAM200JSONResponse GroupIAMCheckResponse
+// GetRecoveryActions operation middleware
+func (siw *ServerInterfaceWrapper) GetRecoveryActions(w http.ResponseWriter, r *http.Request) {
 
-func (response CheckGroupsIAM200JSONResponse) VisitCheckGroupsIAMResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "systemID" -------------
+	var systemID SystemIDPath
 
-type CheckGroupsIAM400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "systemID", r.PathValue("systemID"), &systemID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemID", Err: err})
+		return
+	}
 
-func (response CheckGroupsIAM400JSONResponse) VisitCheckGroupsIAMResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRecoveryActions(w, r, systemID)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CheckGroupsIAM403JSONResponse struct{ N403JSONResponse }
+// SendRecoveryActions operation middleware
+func (siw *ServerInterfaceWrapper) SendRecoveryActions(w http.ResponseWriter, r *http.Request) {
 
-func (response CheckGroupsIAM403JSONResponse) VisitCheckGroupsIAMResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CheckGroupsIAM409JSONResponse struct{ N409JSONResponse }
+	// ------------- Path parameter "systemID" -------------
+	var systemID SystemIDPath
 
-func (response CheckGroupsIAM409JSONResponse) VisitCheckGroupsIAMResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	err = runtime.BindStyledParameterWithOptions("simple", "systemID", r.PathValue("systemID"), &systemID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "systemID", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SendRecoveryActions(w, r, systemID)
+	}))
 
-type CheckGroupsIAM429Response = N429Response
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response CheckGroupsIAM429Response) VisitCheckGroupsIAMResponse(w http.ResponseWriter) error {
-	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
-	w.WriteHeader(429)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type CheckGroupsIAM500JSONResponse struct{ N500JSONResponse }
-
-func (response CheckGroupsIAM500JSONResponse) VisitCheckGroupsIAMResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetTenantKeystores operation middleware
+func (siw *ServerInterfaceWrapper) GetTenantKeystores(w http.ResponseWriter, r *http.Request) {
 
-type DeleteGroupByIDRequestObject struct {
-	GroupID GroupIDPath `json:"groupID"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenantKeystores(w, r)
+	}))
 
-type DeleteGroupByIDResponseObject interface {
-	VisitDeleteGroupByIDResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteGroupByID204Response struct {
+	handler.ServeHTTP(w, r)
 }
 
-func (response DeleteGroupByID204Response) VisitDeleteGroupByIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// GetTenantSystemAutoLinkConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) GetTenantSystemAutoLinkConfiguration(w http.ResponseWriter, r *http.Request) {
 
-type DeleteGroupByID400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenantSystemAutoLinkConfiguration(w, r)
+	}))
 
-func (response DeleteGroupByID400JSONResponse) VisitDeleteGroupByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteGroupByID403JSONResponse struct{ N403JSONResponse }
+// UpdateTenantSystemAutoLinkConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTenantSystemAutoLinkConfiguration(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteGroupByID403JSONResponse) VisitDeleteGroupByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTenantSystemAutoLinkConfiguration(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteGroupByID404JSONResponse struct{ N404JSONResponse }
+	handler.ServeHTTP(w, r)
+}
 
-func (response DeleteGroupByID404JSONResponse) VisitDeleteGroupByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetTenantWorkflowConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) GetTenantWorkflowConfiguration(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenantWorkflowConfiguration(w, r)
+	}))
 
-type DeleteGroupByID429Response = N429Response
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response DeleteGroupByID429Response) VisitDeleteGroupByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
-	w.WriteHeader(429)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteGroupByID500JSONResponse struct{ N500JSONResponse }
+// UpdateTenantWorkflowConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTenantWorkflowConfiguration(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteGroupByID500JSONResponse) VisitDeleteGroupByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTenantWorkflowConfiguration(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetGroupByIDRequestObject struct {
-	GroupID GroupIDPath `json:"groupID"`
+	handler.ServeHTTP(w, r)
 }
 
-type GetGroupByIDResponseObject interface {
-	VisitGetGroupByIDResponse(w http.ResponseWriter) error
-}
+// GetTenantInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetTenantInfo(w http.ResponseWriter, r *http.Request) {
 
-type GetGroupByID200JSONResponse Group
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenantInfo(w, r)
+	}))
 
-func (response GetGroupByID200JSONResponse) VisitGetGroupByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetGroupByID400JSONResponse struct{ N400JSONResponse }
+// GetTenants operation middleware
+func (siw *ServerInterfaceWrapper) GetTenants(w http.ResponseWriter, r *http.Request) {
 
-func (response GetGroupByID400JSONResponse) VisitGetGroupByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenantsParams
 
-type GetGroupByID403JSONResponse struct{ N403JSONResponse }
+	// ------------- Optional query parameter "$skip" -------------
 
-func (response GetGroupByID403JSONResponse) VisitGetGroupByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$skip", r.URL.Query(), &params.Skip, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "$top" -------------
 
-type GetGroupByID429Response = N429Response
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$top", r.URL.Query(), &params.Top, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$top", Err: err})
+		return
+	}
 
-func (response GetGroupByID429Response) VisitGetGroupByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
-	w.WriteHeader(429)
-	return nil
-}
+	// ------------- Optional query parameter "$count" -------------
 
-type GetGroupByID500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$count", r.URL.Query(), &params.Count, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$count", Err: err})
+		return
+	}
 
-func (response GetGroupByID500JSONResponse) VisitGetGroupByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenants(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateGroupRequestObject struct {
-	GroupID GroupIDPath `json:"groupID"`
-	Body    *UpdateGroupApplicationMergePatchPlusJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateGroupResponseObject interface {
-	VisitUpdateGroupResponse(w http.ResponseWriter) error
-}
+// GetUserInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetUserInfo(w http.ResponseWriter, r *http.Request) {
 
-type UpdateGroup200JSONResponse Group
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserInfo(w, r)
+	}))
 
-func (response UpdateGroup200JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateGroup400JSONResponse struct{ N400JSONResponse }
-
-func (response UpdateGroup400JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetWorkflows operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflows(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type UpdateGroup403JSONResponse struct{ N403JSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWorkflowsParams
 
-func (response UpdateGroup403JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Optional query parameter "$top" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$top", r.URL.Query(), &params.Top, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$top", Err: err})
+		return
+	}
 
-type UpdateGroup404JSONResponse struct{ N404JSONResponse }
+	// ------------- Optional query parameter "$skip" -------------
 
-func (response UpdateGroup404JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$skip", r.URL.Query(), &params.Skip, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$skip", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "$count" -------------
 
-type UpdateGroup409JSONResponse struct{ N409JSONResponse }
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$count", r.URL.Query(), &params.Count, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$count", Err: err})
+		return
+	}
 
-func (response UpdateGroup409JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	// ------------- Optional query parameter "$filter" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "$filter", r.URL.Query(), &params.Filter, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "$filter", Err: err})
+		return
+	}
 
-type UpdateGroup429Response = N429Response
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkflows(w, r, params)
+	}))
 
-func (response UpdateGroup429Response) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
-	w.WriteHeader(429)
-	return nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateGroup500JSONResponse struct{ N500JSONResponse }
+// CreateWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) CreateWorkflow(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateGroup500JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWorkflow(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteLabelRequestObject struct {
-	KeyID     KeyIDPath `json:"keyID"`
-	LabelName string    `json:"labelName"`
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteLabelResponseObject interface {
-	VisitDeleteLabelResponse(w http.ResponseWriter) error
-}
+// CheckWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) CheckWorkflow(w http.ResponseWriter, r *http.Request) {
 
-type DeleteLabel204Response struct {
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckWorkflow(w, r)
+	}))
 
-func (response DeleteLabel204Response) VisitDeleteLabelResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteLabel400JSONResponse struct{ N400JSONResponse }
+// GetWorkflowByID operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflowByID(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteLabel400JSONResponse) VisitDeleteLabelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "workflowID" -------------
+	var workflowID WorkflowIDPath
 
-type DeleteLabel403JSONResponse struct{ N403JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowID", r.PathValue("workflowID"), &workflowID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowID", Err: err})
+		return
+	}
 
-func (response DeleteLabel403JSONResponse) VisitDeleteLabelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkflowByID(w, r, workflowID)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteLabel404JSONResponse struct{ N404JSONResponse }
+	handler.ServeHTTP(w, r)
+}
 
-func (response DeleteLabel404JSONResponse) VisitDeleteLabelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// TransitionWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) TransitionWorkflow(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type DeleteLabel429Response = N429Response
+	// ------------- Path parameter "workflowID" -------------
+	var workflowID WorkflowIDPath
 
-func (response DeleteLabel429Response) VisitDeleteLabelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
-	w.WriteHeader(429)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowID", r.PathValue("workflowID"), &workflowID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowID", Err: err})
+		return
+	}
 
-type DeleteLabel500JSONResponse struct{ N500JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TransitionWorkflow(w, r, workflowID)
+	}))
 
-func (response DeleteLabel500JSONResponse) VisitDeleteLabelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetKeyLabelsRequestObject struct {
-	KeyID  KeyIDPath `json:"keyID"`
-	Params GetKeyLabelsParams
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
 }
 
-type GetKeyLabelsResponseObject interface {
-	VisitGetKeyLabelsResponse(w http.ResponseWriter) error
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
 }
 
-type GetKeyLabels200JSONResponse LabelList
-
-func (response GetKeyLabels200JSONResponse) VisitGetKeyLabelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
 }
 
-type GetKeyLabels400JSONResponse struct{ N400JSONResponse }
-
-func (response GetKeyLabels400JSONResponse) VisitGetKeyLabelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
 }
 
-type GetKeyLabels403JSONResponse struct{ N403JSONResponse }
-
-func (response GetKeyLabels403JSONResponse) VisitGetKeyLabelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
 }
 
-type GetKeyLabels404JSONResponse struct{ N404JSONResponse }
-
-func (response GetKeyLabels404JSONResponse) VisitGetKeyLabelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
 }
 
-type GetKeyLabels429Response = N429Response
-
-func (response GetKeyLabels429Response) VisitGetKeyLabelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
-	w.WriteHeader(429)
-	return nil
+type RequiredParamError struct {
+	ParamName string
 }
 
-type GetKeyLabels500JSONResponse struct{ N500JSONResponse }
-
-func (response GetKeyLabels500JSONResponse) VisitGetKeyLabelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
 }
 
-type CreateOrUpdateLabelsRequestObject struct {
-	KeyID KeyIDPath `json:"keyID"`
-	Body  *CreateOrUpdateLabelsJSONRequestBody
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
 }
 
-type CreateOrUpdateLabelsResponseObject interface {
-	VisitCreateOrUpdateLabelsResponse(w http.ResponseWriter) error
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
 }
 
-type CreateOrUpdateLabels204Response struct {
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
 }
 
-func (response CreateOrUpdateLabels204Response) VisitCreateOrUpdateLabelsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
 }
 
-type CreateOrUpdateLabels400JSONResponse struct{ N400JSONResponse }
-
-func (response CreateOrUpdateLabels400JSONResponse) VisitCreateOrUpdateLabelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
 }
 
-type CreateOrUpdateLabels403JSONResponse struct{ N403JSONResponse }
-
-func (response CreateOrUpdateLabels403JSONResponse) VisitCreateOrUpdateLabelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
 }
 
-type CreateOrUpdateLabels404JSONResponse struct{ N404JSONResponse }
-
-func (response CreateOrUpdateLabels404JSONResponse) VisitCreateOrUpdateLabelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
 }
 
-type CreateOrUpdateLabels429Response = N429Response
-
-func (response CreateOrUpdateLabels429Response) VisitCreateOrUpdateLabelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
-	w.WriteHeader(429)
-	return nil
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
 }
 
-type CreateOrUpdateLabels500JSONResponse struct{ N500JSONResponse }
-
-func (response CreateOrUpdateLabels500JSONResponse) VisitCreateOrUpdateLabelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
 }
 
-type GetKeyConfigurationsRequestObject struct {
-	Params GetKeyConfigurationsParams
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
 }
 
-type GetKeyConfigurationsResponseObject interface {
-	VisitGetKeyConfigurationsResponse(w http.ResponseWriter) error
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type GetKeyConfigurations200JSONResponse KeyConfigurationList
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
 
-func (response GetKeyConfigurations200JSONResponse) VisitGetKeyConfigurationsResponse(w http.ResponseWriter) error {
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/apiTokens", wrapper.GetAPITokens)
+	m.HandleFunc("POST "+options.BaseURL+"/apiTokens", wrapper.CreateAPIToken)
+	m.HandleFunc("DELETE "+options.BaseURL+"/apiTokens/{tokenID}", wrapper.RevokeAPIToken)
+	m.HandleFunc("GET "+options.BaseURL+"/apiTokens/{tokenID}", wrapper.GetAPITokenByID)
+	m.HandleFunc("GET "+options.BaseURL+"/auditEvents", wrapper.GetAuditEvents)
+	m.HandleFunc("POST "+options.BaseURL+"/authz/explain", wrapper.ExplainAuthz)
+	m.HandleFunc("GET "+options.BaseURL+"/capabilities", wrapper.GetCapabilities)
+	m.HandleFunc("GET "+options.BaseURL+"/groups", wrapper.GetGroups)
+	m.HandleFunc("POST "+options.BaseURL+"/groups", wrapper.CreateGroup)
+	m.HandleFunc("POST "+options.BaseURL+"/groups/iamCheck", wrapper.CheckGroupsIAM)
+	m.HandleFunc("DELETE "+options.BaseURL+"/groups/{groupID}", wrapper.DeleteGroupByID)
+	m.HandleFunc("GET "+options.BaseURL+"/groups/{groupID}", wrapper.GetGroupByID)
+	m.HandleFunc("PATCH "+options.BaseURL+"/groups/{groupID}", wrapper.UpdateGroup)
+	m.HandleFunc("GET "+options.BaseURL+"/groups/{groupID}/grants", wrapper.GetGroupGrants)
+	m.HandleFunc("POST "+options.BaseURL+"/groups/{groupID}/grants", wrapper.CreateGroupGrant)
+	m.HandleFunc("DELETE "+options.BaseURL+"/groups/{groupID}/grants/{grantID}", wrapper.DeleteGroupGrant)
+	m.HandleFunc("DELETE "+options.BaseURL+"/key/{keyID}/label/{labelName}", wrapper.DeleteLabel)
+	m.HandleFunc("GET "+options.BaseURL+"/key/{keyID}/labels", wrapper.GetKeyLabels)
+	m.HandleFunc("POST "+options.BaseURL+"/key/{keyID}/labels", wrapper.CreateOrUpdateLabels)
+	m.HandleFunc("GET "+options.BaseURL+"/keyConfigurations", wrapper.GetKeyConfigurations)
+	m.HandleFunc("POST "+options.BaseURL+"/keyConfigurations", wrapper.PostKeyConfigurations)
+	m.HandleFunc("DELETE "+options.BaseURL+"/keyConfigurations/{keyConfigurationID}", wrapper.DeleteKeyConfigurationByID)
+	m.HandleFunc("GET "+options.BaseURL+"/keyConfigurations/{keyConfigurationID}", wrapper.GetKeyConfigurationByID)
+	m.HandleFunc("PATCH "+options.BaseURL+"/keyConfigurations/{keyConfigurationID}", wrapper.UpdateKeyConfigurationByID)
+	m.HandleFunc("GET "+options.BaseURL+"/keyConfigurations/{keyConfigurationID}/certificates", wrapper.GetKeyConfigurationCertificates)
+	m.HandleFunc("GET "+options.BaseURL+"/keyConfigurations/{keyConfigurationID}/tags", wrapper.GetTagsForKeyConfiguration)
+	m.HandleFunc("PUT "+options.BaseURL+"/keyConfigurations/{keyConfigurationID}/tags", wrapper.AddTagsToKeyConfiguration)
+	m.HandleFunc("GET "+options.BaseURL+"/keys", wrapper.GetKeys)
+	m.HandleFunc("POST "+options.BaseURL+"/keys", wrapper.PostKeys)
+	m.HandleFunc("DELETE "+options.BaseURL+"/keys/{keyID}", wrapper.DeleteKeysKeyID)
+	m.HandleFunc("GET "+options.BaseURL+"/keys/{keyID}", wrapper.GetKeysKeyID)
+	m.HandleFunc("PATCH "+options.BaseURL+"/keys/{keyID}", wrapper.UpdateKey)
+	m.HandleFunc("POST "+options.BaseURL+"/keys/{keyID}/importKeyMaterial", wrapper.ImportKeyMaterial)
+	m.HandleFunc("GET "+options.BaseURL+"/keys/{keyID}/importParams", wrapper.GetKeyImportParams)
+	m.HandleFunc("GET "+options.BaseURL+"/keys/{keyID}/versions", wrapper.GetKeyVersions)
+	m.HandleFunc("GET "+options.BaseURL+"/roles", wrapper.GetRoles)
+	m.HandleFunc("POST "+options.BaseURL+"/roles", wrapper.CreateRole)
+	m.HandleFunc("DELETE "+options.BaseURL+"/roles/{roleID}", wrapper.DeleteRoleByID)
+	m.HandleFunc("GET "+options.BaseURL+"/roles/{roleID}", wrapper.GetRoleByID)
+	m.HandleFunc("PATCH "+options.BaseURL+"/roles/{roleID}", wrapper.UpdateRole)
+	m.HandleFunc("GET "+options.BaseURL+"/systemGroups", wrapper.GetSystemGroups)
+	m.HandleFunc("POST "+options.BaseURL+"/systemGroups", wrapper.CreateSystemGroup)
+	m.HandleFunc("DELETE "+options.BaseURL+"/systemGroups/{systemGroupID}", wrapper.DeleteSystemGroup)
+	m.HandleFunc("GET "+options.BaseURL+"/systemGroups/{systemGroupID}", wrapper.GetSystemGroupByID)
+	m.HandleFunc("PATCH "+options.BaseURL+"/systemGroups/{systemGroupID}", wrapper.UpdateSystemGroup)
+	m.HandleFunc("DELETE "+options.BaseURL+"/systemGroups/{systemGroupID}/link", wrapper.UnlinkSystemGroupAction)
+	m.HandleFunc("PATCH "+options.BaseURL+"/systemGroups/{systemGroupID}/link", wrapper.LinkSystemGroupAction)
+	m.HandleFunc("GET "+options.BaseURL+"/systemGroups/{systemGroupID}/systems", wrapper.GetSystemGroupSystems)
+	m.HandleFunc("GET "+options.BaseURL+"/systems", wrapper.GetAllSystems)
+	m.HandleFunc("GET "+options.BaseURL+"/systems/filterOptions", wrapper.GetFilters)
+	m.HandleFunc("GET "+options.BaseURL+"/systems/{systemID}", wrapper.GetSystemByID)
+	m.HandleFunc("DELETE "+options.BaseURL+"/systems/{systemID}/link", wrapper.UnlinkSystemAction)
+	m.HandleFunc("PATCH "+options.BaseURL+"/systems/{systemID}/link", wrapper.LinkSystemAction)
+	m.HandleFunc("POST "+options.BaseURL+"/systems/{systemID}/link:validate", wrapper.ValidateSystemLink)
+	m.HandleFunc("GET "+options.BaseURL+"/systems/{systemID}/recoveryActions", wrapper.GetRecoveryActions)
+	m.HandleFunc("POST "+options.BaseURL+"/systems/{systemID}/recoveryActions", wrapper.SendRecoveryActions)
+	m.HandleFunc("GET "+options.BaseURL+"/tenantConfigurations/keystores", wrapper.GetTenantKeystores)
+	m.HandleFunc("GET "+options.BaseURL+"/tenantConfigurations/systemAutoLink", wrapper.GetTenantSystemAutoLinkConfiguration)
+	m.HandleFunc("PUT "+options.BaseURL+"/tenantConfigurations/systemAutoLink", wrapper.UpdateTenantSystemAutoLinkConfiguration)
+	m.HandleFunc("GET "+options.BaseURL+"/tenantConfigurations/workflow", wrapper.GetTenantWorkflowConfiguration)
+	m.HandleFunc("PATCH "+options.BaseURL+"/tenantConfigurations/workflow", wrapper.UpdateTenantWorkflowConfiguration)
+	m.HandleFunc("GET "+options.BaseURL+"/tenantInfo", wrapper.GetTenantInfo)
+	m.HandleFunc("GET "+options.BaseURL+"/tenants", wrapper.GetTenants)
+	m.HandleFunc("GET "+options.BaseURL+"/userInfo", wrapper.GetUserInfo)
+	m.HandleFunc("GET "+options.BaseURL+"/workflows", wrapper.GetWorkflows)
+	m.HandleFunc("POST "+options.BaseURL+"/workflows", wrapper.CreateWorkflow)
+	m.HandleFunc("POST "+options.BaseURL+"/workflows/check", wrapper.CheckWorkflow)
+	m.HandleFunc("GET "+options.BaseURL+"/workflows/{workflowID}", wrapper.GetWorkflowByID)
+	m.HandleFunc("POST "+options.BaseURL+"/workflows/{workflowID}/state", wrapper.TransitionWorkflow)
+
+	return m
+}
+
+type N400JSONResponse ErrorMessage
+
+type N403JSONResponse ErrorMessage
+
+type N404JSONResponse ErrorMessage
+
+type N409JSONResponse ErrorMessage
+
+type N429ResponseHeaders struct {
+	RetryAfter int
+}
+type N429Response struct {
+	Headers N429ResponseHeaders
+}
+
+type N500JSONResponse ErrorMessage
+
+type GetAPITokensRequestObject struct {
+	Params GetAPITokensParams
+}
+
+type GetAPITokensResponseObject interface {
+	VisitGetAPITokensResponse(w http.ResponseWriter) error
+}
+
+type GetAPITokens200JSONResponse APITokenList
+
+func (response GetAPITokens200JSONResponse) VisitGetAPITokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyConfigurations400JSONResponse struct{ N400JSONResponse }
+type GetAPITokens400JSONResponse struct{ N400JSONResponse }
 
-func (response GetKeyConfigurations400JSONResponse) VisitGetKeyConfigurationsResponse(w http.ResponseWriter) error {
+func (response GetAPITokens400JSONResponse) VisitGetAPITokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyConfigurations403JSONResponse struct{ N403JSONResponse }
+type GetAPITokens403JSONResponse struct{ N403JSONResponse }
 
-func (response GetKeyConfigurations403JSONResponse) VisitGetKeyConfigurationsResponse(w http.ResponseWriter) error {
+func (response GetAPITokens403JSONResponse) VisitGetAPITokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyConfigurations429Response = N429Response
+type GetAPITokens429Response = N429Response
 
-func (response GetKeyConfigurations429Response) VisitGetKeyConfigurationsResponse(w http.ResponseWriter) error {
+func (response GetAPITokens429Response) VisitGetAPITokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetKeyConfigurations500JSONResponse struct{ N500JSONResponse }
+type GetAPITokens500JSONResponse struct{ N500JSONResponse }
 
-func (response GetKeyConfigurations500JSONResponse) VisitGetKeyConfigurationsResponse(w http.ResponseWriter) error {
+func (response GetAPITokens500JSONResponse) VisitGetAPITokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostKeyConfigurationsRequestObject struct {
-	Body *PostKeyConfigurationsJSONRequestBody
+type CreateAPITokenRequestObject struct {
+	Body *CreateAPITokenJSONRequestBody
 }
 
-type PostKeyConfigurationsResponseObject interface {
-	VisitPostKeyConfigurationsResponse(w http.ResponseWriter) error
+type CreateAPITokenResponseObject interface {
+	VisitCreateAPITokenResponse(w http.ResponseWriter) error
 }
 
-type PostKeyConfigurations201JSONResponse KeyConfiguration
+type CreateAPIToken201JSONResponse APIToken
 
-func (response PostKeyConfigurations201JSONResponse) VisitPostKeyConfigurationsResponse(w http.ResponseWriter) error {
+func (response CreateAPIToken201JSONResponse) VisitCreateAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostKeyConfigurations400JSONResponse struct{ N400JSONResponse }
+type CreateAPIToken400JSONResponse struct{ N400JSONResponse }
 
-func (response PostKeyConfigurations400JSONResponse) VisitPostKeyConfigurationsResponse(w http.ResponseWriter) error {
+func (response CreateAPIToken400JSONResponse) VisitCreateAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostKeyConfigurations403JSONResponse struct{ N403JSONResponse }
+type CreateAPIToken403JSONResponse struct{ N403JSONResponse }
 
-func (response PostKeyConfigurations403JSONResponse) VisitPostKeyConfigurationsResponse(w http.ResponseWriter) error {
+func (response CreateAPIToken403JSONResponse) VisitCreateAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostKeyConfigurations409JSONResponse struct{ N409JSONResponse }
+type CreateAPIToken404JSONResponse struct{ N404JSONResponse }
 
-func (response PostKeyConfigurations409JSONResponse) VisitPostKeyConfigurationsResponse(w http.ResponseWriter) error {
+func (response CreateAPIToken404JSONResponse) VisitCreateAPITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIToken409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateAPIToken409JSONResponse) VisitCreateAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostKeyConfigurations429Response = N429Response
+type CreateAPIToken429Response = N429Response
 
-func (response PostKeyConfigurations429Response) VisitPostKeyConfigurationsResponse(w http.ResponseWriter) error {
+func (response CreateAPIToken429Response) VisitCreateAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type PostKeyConfigurations500JSONResponse struct{ N500JSONResponse }
+type CreateAPIToken500JSONResponse struct{ N500JSONResponse }
 
-func (response PostKeyConfigurations500JSONResponse) VisitPostKeyConfigurationsResponse(w http.ResponseWriter) error {
+func (response CreateAPIToken500JSONResponse) VisitCreateAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKeyConfigurationByIDRequestObject struct {
-	KeyConfigurationID KeyConfigurationIDPath `json:"keyConfigurationID"`
+type RevokeAPITokenRequestObject struct {
+	TokenID TokenIDPath `json:"tokenID"`
 }
 
-type DeleteKeyConfigurationByIDResponseObject interface {
-	VisitDeleteKeyConfigurationByIDResponse(w http.ResponseWriter) error
+type RevokeAPITokenResponseObject interface {
+	VisitRevokeAPITokenResponse(w http.ResponseWriter) error
 }
 
-type DeleteKeyConfigurationByID204Response struct {
+type RevokeAPIToken204Response struct {
 }
 
-func (response DeleteKeyConfigurationByID204Response) VisitDeleteKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response RevokeAPIToken204Response) VisitRevokeAPITokenResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type DeleteKeyConfigurationByID400JSONResponse struct{ N400JSONResponse }
+type RevokeAPIToken400JSONResponse struct{ N400JSONResponse }
 
-func (response DeleteKeyConfigurationByID400JSONResponse) VisitDeleteKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response RevokeAPIToken400JSONResponse) VisitRevokeAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKeyConfigurationByID403JSONResponse struct{ N403JSONResponse }
+type RevokeAPIToken403JSONResponse struct{ N403JSONResponse }
 
-func (response DeleteKeyConfigurationByID403JSONResponse) VisitDeleteKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response RevokeAPIToken403JSONResponse) VisitRevokeAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKeyConfigurationByID404JSONResponse struct{ N404JSONResponse }
+type RevokeAPIToken404JSONResponse struct{ N404JSONResponse }
 
-func (response DeleteKeyConfigurationByID404JSONResponse) VisitDeleteKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response RevokeAPIToken404JSONResponse) VisitRevokeAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKeyConfigurationByID429Response = N429Response
+type RevokeAPIToken429Response = N429Response
 
-func (response DeleteKeyConfigurationByID429Response) VisitDeleteKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response RevokeAPIToken429Response) VisitRevokeAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type DeleteKeyConfigurationByID500JSONResponse struct{ N500JSONResponse }
+type RevokeAPIToken500JSONResponse struct{ N500JSONResponse }
 
-func (response DeleteKeyConfigurationByID500JSONResponse) VisitDeleteKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response RevokeAPIToken500JSONResponse) VisitRevokeAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyConfigurationByIDRequestObject struct {
-	KeyConfigurationID KeyConfigurationIDPath `json:"keyConfigurationID"`
+type GetAPITokenByIDRequestObject struct {
+	TokenID TokenIDPath `json:"tokenID"`
 }
 
-type GetKeyConfigurationByIDResponseObject interface {
-	VisitGetKeyConfigurationByIDResponse(w http.ResponseWriter) error
+type GetAPITokenByIDResponseObject interface {
+	VisitGetAPITokenByIDResponse(w http.ResponseWriter) error
 }
 
-type GetKeyConfigurationByID200JSONResponse KeyConfiguration
+type GetAPITokenByID200JSONResponse APIToken
 
-func (response GetKeyConfigurationByID200JSONResponse) VisitGetKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response GetAPITokenByID200JSONResponse) VisitGetAPITokenByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyConfigurationByID400JSONResponse struct{ N400JSONResponse }
+type GetAPITokenByID400JSONResponse struct{ N400JSONResponse }
 
-func (response GetKeyConfigurationByID400JSONResponse) VisitGetKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response GetAPITokenByID400JSONResponse) VisitGetAPITokenByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyConfigurationByID403JSONResponse struct{ N403JSONResponse }
+type GetAPITokenByID403JSONResponse struct{ N403JSONResponse }
 
-func (response GetKeyConfigurationByID403JSONResponse) VisitGetKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response GetAPITokenByID403JSONResponse) VisitGetAPITokenByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyConfigurationByID404JSONResponse struct{ N404JSONResponse }
+type GetAPITokenByID404JSONResponse struct{ N404JSONResponse }
 
-func (response GetKeyConfigurationByID404JSONResponse) VisitGetKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response GetAPITokenByID404JSONResponse) VisitGetAPITokenByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyConfigurationByID429Response = N429Response
+type GetAPITokenByID429Response = N429Response
 
-func (response GetKeyConfigurationByID429Response) VisitGetKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response GetAPITokenByID429Response) VisitGetAPITokenByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetKeyConfigurationByID500JSONResponse struct{ N500JSONResponse }
+type GetAPITokenByID500JSONResponse struct{ N500JSONResponse }
 
-func (response GetKeyConfigurationByID500JSONResponse) VisitGetKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response GetAPITokenByID500JSONResponse) VisitGetAPITokenByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKeyConfigurationByIDRequestObject struct {
-	KeyConfigurationID KeyConfigurationIDPath `json:"keyConfigurationID"`
-	Body               *UpdateKeyConfigurationByIDApplicationMergePatchPlusJSONRequestBody
+type GetAuditEventsRequestObject struct {
+	Params GetAuditEventsParams
 }
 
-type UpdateKeyConfigurationByIDResponseObject interface {
-	VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error
+type GetAuditEventsResponseObject interface {
+	VisitGetAuditEventsResponse(w http.ResponseWriter) error
 }
 
-type UpdateKeyConfigurationByID200JSONResponse KeyConfiguration
+type GetAuditEvents200JSONResponse AuditEventList
 
-func (response UpdateKeyConfigurationByID200JSONResponse) VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response GetAuditEvents200JSONResponse) VisitGetAuditEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKeyConfigurationByID400JSONResponse struct{ N400JSONResponse }
+type GetAuditEvents400JSONResponse struct{ N400JSONResponse }
 
-func (response UpdateKeyConfigurationByID400JSONResponse) VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response GetAuditEvents400JSONResponse) VisitGetAuditEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKeyConfigurationByID403JSONResponse struct{ N403JSONResponse }
+type GetAuditEvents403JSONResponse struct{ N403JSONResponse }
 
-func (response UpdateKeyConfigurationByID403JSONResponse) VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response GetAuditEvents403JSONResponse) VisitGetAuditEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKeyConfigurationByID404JSONResponse struct{ N404JSONResponse }
-
-func (response UpdateKeyConfigurationByID404JSONResponse) VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type UpdateKeyConfigurationByID409JSONResponse struct{ N409JSONResponse }
-
-func (response UpdateKeyConfigurationByID409JSONResponse) VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type UpdateKeyConfigurationByID429Response = N429Response
+type GetAuditEvents429Response = N429Response
 
-func (response UpdateKeyConfigurationByID429Response) VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response GetAuditEvents429Response) VisitGetAuditEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type UpdateKeyConfigurationByID500JSONResponse struct{ N500JSONResponse }
+type GetAuditEvents500JSONResponse struct{ N500JSONResponse }
 
-func (response UpdateKeyConfigurationByID500JSONResponse) VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+func (response GetAuditEvents500JSONResponse) VisitGetAuditEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyConfigurationCertificatesRequestObject struct {
-	KeyConfigurationID KeyConfigurationIDPath `json:"keyConfigurationID"`
+type ExplainAuthzRequestObject struct {
+	Body *ExplainAuthzJSONRequestBody
 }
 
-type GetKeyConfigurationCertificatesResponseObject interface {
-	VisitGetKeyConfigurationCertificatesResponse(w http.ResponseWriter) error
+type ExplainAuthzResponseObject interface {
+	VisitExplainAuthzResponse(w http.ResponseWriter) error
 }
 
-type GetKeyConfigurationCertificates200JSONResponse ClientCertificates
+type ExplainAuthz200JSONResponse AuthzExplanation
 
-func (response GetKeyConfigurationCertificates200JSONResponse) VisitGetKeyConfigurationCertificatesResponse(w http.ResponseWriter) error {
+func (response ExplainAuthz200JSONResponse) VisitExplainAuthzResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyConfigurationCertificates400JSONResponse struct{ N400JSONResponse }
+type ExplainAuthz400JSONResponse struct{ N400JSONResponse }
 
-func (response GetKeyConfigurationCertificates400JSONResponse) VisitGetKeyConfigurationCertificatesResponse(w http.ResponseWriter) error {
+func (response ExplainAuthz400JSONResponse) VisitExplainAuthzResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyConfigurationCertificates403JSONResponse struct{ N403JSONResponse }
+type ExplainAuthz403JSONResponse struct{ N403JSONResponse }
 
-func (response GetKeyConfigurationCertificates403JSONResponse) VisitGetKeyConfigurationCertificatesResponse(w http.ResponseWriter) error {
+func (response ExplainAuthz403JSONResponse) VisitExplainAuthzResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyConfigurationCertificates429Response = N429Response
+type ExplainAuthz429Response = N429Response
 
-func (response GetKeyConfigurationCertificates429Response) VisitGetKeyConfigurationCertificatesResponse(w http.ResponseWriter) error {
+func (response ExplainAuthz429Response) VisitExplainAuthzResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetKeyConfigurationCertificates500JSONResponse struct{ N500JSONResponse }
+type ExplainAuthz500JSONResponse struct{ N500JSONResponse }
 
-func (response GetKeyConfigurationCertificates500JSONResponse) VisitGetKeyConfigurationCertificatesResponse(w http.ResponseWriter) error {
+func (response ExplainAuthz500JSONResponse) VisitExplainAuthzResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTagsForKeyConfigurationRequestObject struct {
-	KeyConfigurationID KeyConfigurationIDPath `json:"keyConfigurationID"`
-	Params             GetTagsForKeyConfigurationParams
+type GetCapabilitiesRequestObject struct {
 }
 
-type GetTagsForKeyConfigurationResponseObject interface {
-	VisitGetTagsForKeyConfigurationResponse(w http.ResponseWriter) error
+type GetCapabilitiesResponseObject interface {
+	VisitGetCapabilitiesResponse(w http.ResponseWriter) error
 }
 
-type GetTagsForKeyConfiguration200JSONResponse TagList
+type GetCapabilities200JSONResponse KeystoreCapabilitiesList
 
-func (response GetTagsForKeyConfiguration200JSONResponse) VisitGetTagsForKeyConfigurationResponse(w http.ResponseWriter) error {
+func (response GetCapabilities200JSONResponse) VisitGetCapabilitiesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTagsForKeyConfiguration400JSONResponse struct{ N400JSONResponse }
+type GetCapabilities400JSONResponse struct{ N400JSONResponse }
 
-func (response GetTagsForKeyConfiguration400JSONResponse) VisitGetTagsForKeyConfigurationResponse(w http.ResponseWriter) error {
+func (response GetCapabilities400JSONResponse) VisitGetCapabilitiesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTagsForKeyConfiguration403JSONResponse struct{ N403JSONResponse }
+type GetCapabilities403JSONResponse struct{ N403JSONResponse }
 
-func (response GetTagsForKeyConfiguration403JSONResponse) VisitGetTagsForKeyConfigurationResponse(w http.ResponseWriter) error {
+func (response GetCapabilities403JSONResponse) VisitGetCapabilitiesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTagsForKeyConfiguration404JSONResponse struct{ N404JSONResponse }
-
-func (response GetTagsForKeyConfiguration404JSONResponse) VisitGetTagsForKeyConfigurationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetTagsForKeyConfiguration429Response = N429Response
+type GetCapabilities429Response = N429Response
 
-func (response GetTagsForKeyConfiguration429Response) VisitGetTagsForKeyConfigurationResponse(w http.ResponseWriter) error {
+func (response GetCapabilities429Response) VisitGetCapabilitiesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetTagsForKeyConfiguration500JSONResponse struct{ N500JSONResponse }
+type GetCapabilities500JSONResponse struct{ N500JSONResponse }
 
-func (response GetTagsForKeyConfiguration500JSONResponse) VisitGetTagsForKeyConfigurationResponse(w http.ResponseWriter) error {
+func (response GetCapabilities500JSONResponse) VisitGetCapabilitiesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddTagsToKeyConfigurationRequestObject struct {
-	KeyConfigurationID KeyConfigurationIDPath `json:"keyConfigurationID"`
-	Body               *AddTagsToKeyConfigurationJSONRequestBody
+type GetGroupsRequestObject struct {
+	Params GetGroupsParams
 }
 
-type AddTagsToKeyConfigurationResponseObject interface {
-	VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error
+type GetGroupsResponseObject interface {
+	VisitGetGroupsResponse(w http.ResponseWriter) error
 }
 
-type AddTagsToKeyConfiguration204Response struct {
-}
+type GetGroups200JSONResponse GroupList
 
-func (response AddTagsToKeyConfiguration204Response) VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
-
-type AddTagsToKeyConfiguration400JSONResponse struct{ N400JSONResponse }
-
-func (response AddTagsToKeyConfiguration400JSONResponse) VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type AddTagsToKeyConfiguration403JSONResponse struct{ N403JSONResponse }
-
-func (response AddTagsToKeyConfiguration403JSONResponse) VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error {
+func (response GetGroups200JSONResponse) VisitGetGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddTagsToKeyConfiguration404JSONResponse struct{ N404JSONResponse }
+type GetGroups400JSONResponse struct{ N400JSONResponse }
 
-func (response AddTagsToKeyConfiguration404JSONResponse) VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error {
+func (response GetGroups400JSONResponse) VisitGetGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddTagsToKeyConfiguration409JSONResponse struct{ N409JSONResponse }
+type GetGroups403JSONResponse struct{ N403JSONResponse }
 
-func (response AddTagsToKeyConfiguration409JSONResponse) VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error {
+func (response GetGroups403JSONResponse) VisitGetGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddTagsToKeyConfiguration429Response = N429Response
+type GetGroups429Response = N429Response
 
-func (response AddTagsToKeyConfiguration429Response) VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error {
+func (response GetGroups429Response) VisitGetGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type AddTagsToKeyConfiguration500JSONResponse struct{ N500JSONResponse }
+type GetGroups500JSONResponse struct{ N500JSONResponse }
 
-func (response AddTagsToKeyConfiguration500JSONResponse) VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error {
+func (response GetGroups500JSONResponse) VisitGetGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeysRequestObject struct {
-	Params GetKeysParams
+type CreateGroupRequestObject struct {
+	Body *CreateGroupJSONRequestBody
 }
 
-type GetKeysResponseObject interface {
-	VisitGetKeysResponse(w http.ResponseWriter) error
+type CreateGroupResponseObject interface {
+	VisitCreateGroupResponse(w http.ResponseWriter) error
 }
 
-type GetKeys200JSONResponse KeyList
+type CreateGroup201JSONResponse Group
 
-func (response GetKeys200JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+func (response CreateGroup201JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeys400JSONResponse struct{ N400JSONResponse }
+type CreateGroup400JSONResponse struct{ N400JSONResponse }
 
-func (response GetKeys400JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+func (response CreateGroup400JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeys403JSONResponse struct{ N403JSONResponse }
+type CreateGroup403JSONResponse struct{ N403JSONResponse }
 
-func (response GetKeys403JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+func (response CreateGroup403JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeys429Response = N429Response
+type CreateGroup409JSONResponse struct{ N409JSONResponse }
 
-func (response GetKeys429Response) VisitGetKeysResponse(w http.ResponseWriter) error {
+func (response CreateGroup409JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup429Response = N429Response
+
+func (response CreateGroup429Response) VisitCreateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetKeys500JSONResponse struct{ N500JSONResponse }
+type CreateGroup500JSONResponse struct{ N500JSONResponse }
 
-func (response GetKeys500JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+func (response CreateGroup500JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostKeysRequestObject struct {
-	Body *PostKeysJSONRequestBody
+type CheckGroupsIAMRequestObject struct {
+	Body *CheckGroupsIAMJSONRequestBody
 }
 
-type PostKeysResponseObject interface {
-	VisitPostKeysResponse(w http.ResponseWriter) error
+type CheckGroupsIAMResponseObject interface {
+	VisitCheckGroupsIAMResponse(w http.ResponseWriter) error
 }
 
-type PostKeys201JSONResponse Key
+type CheckGroupsIAM200JSONResponse GroupIAMCheckResponse
 
-func (response PostKeys201JSONResponse) VisitPostKeysResponse(w http.ResponseWriter) error {
+func (response CheckGroupsIAM200JSONResponse) VisitCheckGroupsIAMResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostKeys400JSONResponse struct{ N400JSONResponse }
+type CheckGroupsIAM400JSONResponse struct{ N400JSONResponse }
 
-func (response PostKeys400JSONResponse) VisitPostKeysResponse(w http.ResponseWriter) error {
+func (response CheckGroupsIAM400JSONResponse) VisitCheckGroupsIAMResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostKeys403JSONResponse struct{ N403JSONResponse }
+type CheckGroupsIAM403JSONResponse struct{ N403JSONResponse }
 
-func (response PostKeys403JSONResponse) VisitPostKeysResponse(w http.ResponseWriter) error {
+func (response CheckGroupsIAM403JSONResponse) VisitCheckGroupsIAMResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostKeys409JSONResponse struct{ N409JSONResponse }
+type CheckGroupsIAM409JSONResponse struct{ N409JSONResponse }
 
-func (response PostKeys409JSONResponse) VisitPostKeysResponse(w http.ResponseWriter) error {
+func (response CheckGroupsIAM409JSONResponse) VisitCheckGroupsIAMResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostKeys429Response = N429Response
+type CheckGroupsIAM429Response = N429Response
 
-func (response PostKeys429Response) VisitPostKeysResponse(w http.ResponseWriter) error {
+func (response CheckGroupsIAM429Response) VisitCheckGroupsIAMResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type PostKeys500JSONResponse struct{ N500JSONResponse }
+type CheckGroupsIAM500JSONResponse struct{ N500JSONResponse }
 
-func (response PostKeys500JSONResponse) VisitPostKeysResponse(w http.ResponseWriter) error {
+func (response CheckGroupsIAM500JSONResponse) VisitCheckGroupsIAMResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKeysKeyIDRequestObject struct {
-	KeyID KeyIDPath `json:"keyID"`
+type DeleteGroupByIDRequestObject struct {
+	GroupID GroupIDPath `json:"groupID"`
 }
 
-type DeleteKeysKeyIDResponseObject interface {
-	VisitDeleteKeysKeyIDResponse(w http.ResponseWriter) error
+type DeleteGroupByIDResponseObject interface {
+	VisitDeleteGroupByIDResponse(w http.ResponseWriter) error
 }
 
-type DeleteKeysKeyID204Response struct {
+type DeleteGroupByID204Response struct {
 }
 
-func (response DeleteKeysKeyID204Response) VisitDeleteKeysKeyIDResponse(w http.ResponseWriter) error {
+func (response DeleteGroupByID204Response) VisitDeleteGroupByIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type DeleteKeysKeyID400JSONResponse struct{ N400JSONResponse }
+type DeleteGroupByID400JSONResponse struct{ N400JSONResponse }
 
-func (response DeleteKeysKeyID400JSONResponse) VisitDeleteKeysKeyIDResponse(w http.ResponseWriter) error {
+func (response DeleteGroupByID400JSONResponse) VisitDeleteGroupByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKeysKeyID403JSONResponse struct{ N403JSONResponse }
+type DeleteGroupByID403JSONResponse struct{ N403JSONResponse }
 
-func (response DeleteKeysKeyID403JSONResponse) VisitDeleteKeysKeyIDResponse(w http.ResponseWriter) error {
+func (response DeleteGroupByID403JSONResponse) VisitDeleteGroupByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKeysKeyID404JSONResponse struct{ N404JSONResponse }
+type DeleteGroupByID404JSONResponse struct{ N404JSONResponse }
 
-func (response DeleteKeysKeyID404JSONResponse) VisitDeleteKeysKeyIDResponse(w http.ResponseWriter) error {
+func (response DeleteGroupByID404JSONResponse) VisitDeleteGroupByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKeysKeyID429Response = N429Response
+type DeleteGroupByID429Response = N429Response
 
-func (response DeleteKeysKeyID429Response) VisitDeleteKeysKeyIDResponse(w http.ResponseWriter) error {
+func (response DeleteGroupByID429Response) VisitDeleteGroupByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type DeleteKeysKeyID500JSONResponse struct{ N500JSONResponse }
+type DeleteGroupByID500JSONResponse struct{ N500JSONResponse }
 
-func (response DeleteKeysKeyID500JSONResponse) VisitDeleteKeysKeyIDResponse(w http.ResponseWriter) error {
+func (response DeleteGroupByID500JSONResponse) VisitDeleteGroupByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeysKeyIDRequestObject struct {
-	KeyID KeyIDPath `json:"keyID"`
+type GetGroupByIDRequestObject struct {
+	GroupID GroupIDPath `json:"groupID"`
 }
 
-type GetKeysKeyIDResponseObject interface {
-	VisitGetKeysKeyIDResponse(w http.ResponseWriter) error
+type GetGroupByIDResponseObject interface {
+	VisitGetGroupByIDResponse(w http.ResponseWriter) error
 }
 
-type GetKeysKeyID200JSONResponse Key
+type GetGroupByID200JSONResponse Group
 
-func (response GetKeysKeyID200JSONResponse) VisitGetKeysKeyIDResponse(w http.ResponseWriter) error {
+func (response GetGroupByID200JSONResponse) VisitGetGroupByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeysKeyID400JSONResponse struct{ N400JSONResponse }
+type GetGroupByID400JSONResponse struct{ N400JSONResponse }
 
-func (response GetKeysKeyID400JSONResponse) VisitGetKeysKeyIDResponse(w http.ResponseWriter) error {
+func (response GetGroupByID400JSONResponse) VisitGetGroupByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeysKeyID403JSONResponse struct{ N403JSONResponse }
+type GetGroupByID403JSONResponse struct{ N403JSONResponse }
 
-func (response GetKeysKeyID403JSONResponse) VisitGetKeysKeyIDResponse(w http.ResponseWriter) error {
+func (response GetGroupByID403JSONResponse) VisitGetGroupByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeysKeyID404JSONResponse struct{ N404JSONResponse }
-
-func (response GetKeysKeyID404JSONResponse) VisitGetKeysKeyIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetKeysKeyID429Response = N429Response
+type GetGroupByID429Response = N429Response
 
-func (response GetKeysKeyID429Response) VisitGetKeysKeyIDResponse(w http.ResponseWriter) error {
+func (response GetGroupByID429Response) VisitGetGroupByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetKeysKeyID500JSONResponse struct{ N500JSONResponse }
+type GetGroupByID500JSONResponse struct{ N500JSONResponse }
 
-func (response GetKeysKeyID500JSONResponse) VisitGetKeysKeyIDResponse(w http.ResponseWriter) error {
+func (response GetGroupByID500JSONResponse) VisitGetGroupByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKeyRequestObject struct {
-	KeyID KeyIDPath `json:"keyID"`
-	Body  *UpdateKeyApplicationMergePatchPlusJSONRequestBody
+type UpdateGroupRequestObject struct {
+	GroupID GroupIDPath `json:"groupID"`
+	Body    *UpdateGroupApplicationMergePatchPlusJSONRequestBody
 }
 
-type UpdateKeyResponseObject interface {
-	VisitUpdateKeyResponse(w http.ResponseWriter) error
+type UpdateGroupResponseObject interface {
+	VisitUpdateGroupResponse(w http.ResponseWriter) error
 }
 
-type UpdateKey200JSONResponse Key
+type UpdateGroup200JSONResponse Group
 
-func (response UpdateKey200JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response UpdateGroup200JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKey400JSONResponse struct{ N400JSONResponse }
+type UpdateGroup400JSONResponse struct{ N400JSONResponse }
 
-func (response UpdateKey400JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response UpdateGroup400JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKey403JSONResponse struct{ N403JSONResponse }
+type UpdateGroup403JSONResponse struct{ N403JSONResponse }
 
-func (response UpdateKey403JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response UpdateGroup403JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKey404JSONResponse struct{ N404JSONResponse }
+type UpdateGroup404JSONResponse struct{ N404JSONResponse }
 
-func (response UpdateKey404JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response UpdateGroup404JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKey409JSONResponse struct{ N409JSONResponse }
+type UpdateGroup409JSONResponse struct{ N409JSONResponse }
 
-func (response UpdateKey409JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response UpdateGroup409JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKey429Response = N429Response
+type UpdateGroup429Response = N429Response
 
-func (response UpdateKey429Response) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response UpdateGroup429Response) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type UpdateKey500JSONResponse struct{ N500JSONResponse }
+type UpdateGroup500JSONResponse struct{ N500JSONResponse }
 
-func (response UpdateKey500JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response UpdateGroup500JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ImportKeyMaterialRequestObject struct {
-	KeyID KeyIDPath `json:"keyID"`
-	Body  *ImportKeyMaterialJSONRequestBody
+type GetGroupGrantsRequestObject struct {
+	GroupID GroupIDPath `json:"groupID"`
+	Params  GetGroupGrantsParams
 }
 
-type ImportKeyMaterialResponseObject interface {
-	VisitImportKeyMaterialResponse(w http.ResponseWriter) error
+type GetGroupGrantsResponseObject interface {
+	VisitGetGroupGrantsResponse(w http.ResponseWriter) error
 }
 
-type ImportKeyMaterial201JSONResponse Key
+type GetGroupGrants200JSONResponse ResourceGrantList
 
-func (response ImportKeyMaterial201JSONResponse) VisitImportKeyMaterialResponse(w http.ResponseWriter) error {
+func (response GetGroupGrants200JSONResponse) VisitGetGroupGrantsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ImportKeyMaterial400JSONResponse struct{ N400JSONResponse }
+type GetGroupGrants400JSONResponse struct{ N400JSONResponse }
 
-func (response ImportKeyMaterial400JSONResponse) VisitImportKeyMaterialResponse(w http.ResponseWriter) error {
+func (response GetGroupGrants400JSONResponse) VisitGetGroupGrantsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ImportKeyMaterial403JSONResponse struct{ N403JSONResponse }
+type GetGroupGrants403JSONResponse struct{ N403JSONResponse }
 
-func (response ImportKeyMaterial403JSONResponse) VisitImportKeyMaterialResponse(w http.ResponseWriter) error {
+func (response GetGroupGrants403JSONResponse) VisitGetGroupGrantsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ImportKeyMaterial404JSONResponse struct{ N404JSONResponse }
+type GetGroupGrants404JSONResponse struct{ N404JSONResponse }
 
-func (response ImportKeyMaterial404JSONResponse) VisitImportKeyMaterialResponse(w http.ResponseWriter) error {
+func (response GetGroupGrants404JSONResponse) VisitGetGroupGrantsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ImportKeyMaterial429Response = N429Response
+type GetGroupGrants429Response = N429Response
 
-func (response ImportKeyMaterial429Response) VisitImportKeyMaterialResponse(w http.ResponseWriter) error {
+func (response GetGroupGrants429Response) VisitGetGroupGrantsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type ImportKeyMaterial500JSONResponse struct{ N500JSONResponse }
+type GetGroupGrants500JSONResponse struct{ N500JSONResponse }
 
-func (response ImportKeyMaterial500JSONResponse) VisitImportKeyMaterialResponse(w http.ResponseWriter) error {
+func (response GetGroupGrants500JSONResponse) VisitGetGroupGrantsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyImportParamsRequestObject struct {
-	KeyID KeyIDPath `json:"keyID"`
+type CreateGroupGrantRequestObject struct {
+	GroupID GroupIDPath `json:"groupID"`
+	Body    *CreateGroupGrantJSONRequestBody
 }
 
-type GetKeyImportParamsResponseObject interface {
-	VisitGetKeyImportParamsResponse(w http.ResponseWriter) error
+type CreateGroupGrantResponseObject interface {
+	VisitCreateGroupGrantResponse(w http.ResponseWriter) error
 }
 
-type GetKeyImportParams200JSONResponse ImportParams
+type CreateGroupGrant201JSONResponse ResourceGrant
 
-func (response GetKeyImportParams200JSONResponse) VisitGetKeyImportParamsResponse(w http.ResponseWriter) error {
+func (response CreateGroupGrant201JSONResponse) VisitCreateGroupGrantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyImportParams400JSONResponse struct{ N400JSONResponse }
+type CreateGroupGrant400JSONResponse struct{ N400JSONResponse }
 
-func (response GetKeyImportParams400JSONResponse) VisitGetKeyImportParamsResponse(w http.ResponseWriter) error {
+func (response CreateGroupGrant400JSONResponse) VisitCreateGroupGrantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyImportParams403JSONResponse struct{ N403JSONResponse }
+type CreateGroupGrant403JSONResponse struct{ N403JSONResponse }
 
-func (response GetKeyImportParams403JSONResponse) VisitGetKeyImportParamsResponse(w http.ResponseWriter) error {
+func (response CreateGroupGrant403JSONResponse) VisitCreateGroupGrantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyImportParams404JSONResponse struct{ N404JSONResponse }
+type CreateGroupGrant404JSONResponse struct{ N404JSONResponse }
 
-func (response GetKeyImportParams404JSONResponse) VisitGetKeyImportParamsResponse(w http.ResponseWriter) error {
+func (response CreateGroupGrant404JSONResponse) VisitCreateGroupGrantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyImportParams429Response = N429Response
+type CreateGroupGrant409JSONResponse struct{ N409JSONResponse }
 
-func (response GetKeyImportParams429Response) VisitGetKeyImportParamsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+func (response CreateGroupGrant409JSONResponse) VisitCreateGroupGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroupGrant429Response = N429Response
+
+func (response CreateGroupGrant429Response) VisitCreateGroupGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetKeyImportParams500JSONResponse struct{ N500JSONResponse }
+type CreateGroupGrant500JSONResponse struct{ N500JSONResponse }
 
-func (response GetKeyImportParams500JSONResponse) VisitGetKeyImportParamsResponse(w http.ResponseWriter) error {
+func (response CreateGroupGrant500JSONResponse) VisitCreateGroupGrantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyVersionsRequestObject struct {
-	KeyID  KeyIDPath `json:"keyID"`
-	Params GetKeyVersionsParams
+type DeleteGroupGrantRequestObject struct {
+	GroupID GroupIDPath `json:"groupID"`
+	GrantID GrantIDPath `json:"grantID"`
 }
 
-type GetKeyVersionsResponseObject interface {
-	VisitGetKeyVersionsResponse(w http.ResponseWriter) error
+type DeleteGroupGrantResponseObject interface {
+	VisitDeleteGroupGrantResponse(w http.ResponseWriter) error
 }
 
-type GetKeyVersions200JSONResponse KeyVersionList
-
-func (response GetKeyVersions200JSONResponse) VisitGetKeyVersionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type DeleteGroupGrant204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response DeleteGroupGrant204Response) VisitDeleteGroupGrantResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type GetKeyVersions400JSONResponse struct{ N400JSONResponse }
+type DeleteGroupGrant400JSONResponse struct{ N400JSONResponse }
 
-func (response GetKeyVersions400JSONResponse) VisitGetKeyVersionsResponse(w http.ResponseWriter) error {
+func (response DeleteGroupGrant400JSONResponse) VisitDeleteGroupGrantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyVersions403JSONResponse struct{ N403JSONResponse }
+type DeleteGroupGrant403JSONResponse struct{ N403JSONResponse }
 
-func (response GetKeyVersions403JSONResponse) VisitGetKeyVersionsResponse(w http.ResponseWriter) error {
+func (response DeleteGroupGrant403JSONResponse) VisitDeleteGroupGrantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyVersions404JSONResponse struct{ N404JSONResponse }
+type DeleteGroupGrant404JSONResponse struct{ N404JSONResponse }
 
-func (response GetKeyVersions404JSONResponse) VisitGetKeyVersionsResponse(w http.ResponseWriter) error {
+func (response DeleteGroupGrant404JSONResponse) VisitDeleteGroupGrantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyVersions429Response = N429Response
+type DeleteGroupGrant429Response = N429Response
 
-func (response GetKeyVersions429Response) VisitGetKeyVersionsResponse(w http.ResponseWriter) error {
+func (response DeleteGroupGrant429Response) VisitDeleteGroupGrantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetKeyVersions500JSONResponse struct{ N500JSONResponse }
+type DeleteGroupGrant500JSONResponse struct{ N500JSONResponse }
 
-func (response GetKeyVersions500JSONResponse) VisitGetKeyVersionsResponse(w http.ResponseWriter) error {
+func (response DeleteGroupGrant500JSONResponse) VisitDeleteGroupGrantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllSystemsRequestObject struct {
-	Params GetAllSystemsParams
+type DeleteLabelRequestObject struct {
+	KeyID     KeyIDPath `json:"keyID"`
+	LabelName string    `json:"labelName"`
 }
 
-type GetAllSystemsResponseObject interface {
-	VisitGetAllSystemsResponse(w http.ResponseWriter) error
+type DeleteLabelResponseObject interface {
+	VisitDeleteLabelResponse(w http.ResponseWriter) error
 }
 
-type GetAllSystems200JSONResponse SystemList
+type DeleteLabel204Response struct {
+}
 
-func (response GetAllSystems200JSONResponse) VisitGetAllSystemsResponse(w http.ResponseWriter) error {
+func (response DeleteLabel204Response) VisitDeleteLabelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteLabel400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteLabel400JSONResponse) VisitDeleteLabelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllSystems400JSONResponse struct{ N400JSONResponse }
+type DeleteLabel403JSONResponse struct{ N403JSONResponse }
 
-func (response GetAllSystems400JSONResponse) VisitGetAllSystemsResponse(w http.ResponseWriter) error {
+func (response DeleteLabel403JSONResponse) VisitDeleteLabelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllSystems403JSONResponse struct{ N403JSONResponse }
+type DeleteLabel404JSONResponse struct{ N404JSONResponse }
 
-func (response GetAllSystems403JSONResponse) VisitGetAllSystemsResponse(w http.ResponseWriter) error {
+func (response DeleteLabel404JSONResponse) VisitDeleteLabelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllSystems429Response = N429Response
+type DeleteLabel429Response = N429Response
 
-func (response GetAllSystems429Response) VisitGetAllSystemsResponse(w http.ResponseWriter) error {
+func (response DeleteLabel429Response) VisitDeleteLabelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetAllSystems500JSONResponse struct{ N500JSONResponse }
+type DeleteLabel500JSONResponse struct{ N500JSONResponse }
 
-func (response GetAllSystems500JSONResponse) VisitGetAllSystemsResponse(w http.ResponseWriter) error {
+func (response DeleteLabel500JSONResponse) VisitDeleteLabelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetFiltersRequestObject struct {
+type GetKeyLabelsRequestObject struct {
+	KeyID  KeyIDPath `json:"keyID"`
+	Params GetKeyLabelsParams
 }
 
-type GetFiltersResponseObject interface {
-	VisitGetFiltersResponse(w http.ResponseWriter) error
+type GetKeyLabelsResponseObject interface {
+	VisitGetKeyLabelsResponse(w http.ResponseWriter) error
 }
 
-type GetFilters200JSONResponse SystemFilters
+type GetKeyLabels200JSONResponse LabelList
 
-func (response GetFilters200JSONResponse) VisitGetFiltersResponse(w http.ResponseWriter) error {
+func (response GetKeyLabels200JSONResponse) VisitGetKeyLabelsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetFilters400JSONResponse struct{ N400JSONResponse }
+type GetKeyLabels400JSONResponse struct{ N400JSONResponse }
 
-func (response GetFilters400JSONResponse) VisitGetFiltersResponse(w http.ResponseWriter) error {
+func (response GetKeyLabels400JSONResponse) VisitGetKeyLabelsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetFilters403JSONResponse struct{ N403JSONResponse }
+type GetKeyLabels403JSONResponse struct{ N403JSONResponse }
 
-func (response GetFilters403JSONResponse) VisitGetFiltersResponse(w http.ResponseWriter) error {
+func (response GetKeyLabels403JSONResponse) VisitGetKeyLabelsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetFilters404JSONResponse struct{ N404JSONResponse }
+type GetKeyLabels404JSONResponse struct{ N404JSONResponse }
 
-func (response GetFilters404JSONResponse) VisitGetFiltersResponse(w http.ResponseWriter) error {
+func (response GetKeyLabels404JSONResponse) VisitGetKeyLabelsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetFilters429Response = N429Response
+type GetKeyLabels429Response = N429Response
 
-func (response GetFilters429Response) VisitGetFiltersResponse(w http.ResponseWriter) error {
+func (response GetKeyLabels429Response) VisitGetKeyLabelsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetFilters500JSONResponse struct{ N500JSONResponse }
+type GetKeyLabels500JSONResponse struct{ N500JSONResponse }
 
-func (response GetFilters500JSONResponse) VisitGetFiltersResponse(w http.ResponseWriter) error {
+func (response GetKeyLabels500JSONResponse) VisitGetKeyLabelsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemByIDRequestObject struct {
-	SystemID SystemIDPath `json:"systemID"`
+type CreateOrUpdateLabelsRequestObject struct {
+	KeyID KeyIDPath `json:"keyID"`
+	Body  *CreateOrUpdateLabelsJSONRequestBody
 }
 
-type GetSystemByIDResponseObject interface {
-	VisitGetSystemByIDResponse(w http.ResponseWriter) error
+type CreateOrUpdateLabelsResponseObject interface {
+	VisitCreateOrUpdateLabelsResponse(w http.ResponseWriter) error
 }
 
-type GetSystemByID200JSONResponse System
-
-func (response GetSystemByID200JSONResponse) VisitGetSystemByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type CreateOrUpdateLabels204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response CreateOrUpdateLabels204Response) VisitCreateOrUpdateLabelsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type GetSystemByID400JSONResponse struct{ N400JSONResponse }
+type CreateOrUpdateLabels400JSONResponse struct{ N400JSONResponse }
 
-func (response GetSystemByID400JSONResponse) VisitGetSystemByIDResponse(w http.ResponseWriter) error {
+func (response CreateOrUpdateLabels400JSONResponse) VisitCreateOrUpdateLabelsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemByID403JSONResponse struct{ N403JSONResponse }
+type CreateOrUpdateLabels403JSONResponse struct{ N403JSONResponse }
 
-func (response GetSystemByID403JSONResponse) VisitGetSystemByIDResponse(w http.ResponseWriter) error {
+func (response CreateOrUpdateLabels403JSONResponse) VisitCreateOrUpdateLabelsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemByID404JSONResponse struct{ N404JSONResponse }
+type CreateOrUpdateLabels404JSONResponse struct{ N404JSONResponse }
 
-func (response GetSystemByID404JSONResponse) VisitGetSystemByIDResponse(w http.ResponseWriter) error {
+func (response CreateOrUpdateLabels404JSONResponse) VisitCreateOrUpdateLabelsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemByID429Response = N429Response
+type CreateOrUpdateLabels429Response = N429Response
 
-func (response GetSystemByID429Response) VisitGetSystemByIDResponse(w http.ResponseWriter) error {
+func (response CreateOrUpdateLabels429Response) VisitCreateOrUpdateLabelsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetSystemByID500JSONResponse struct{ N500JSONResponse }
+type CreateOrUpdateLabels500JSONResponse struct{ N500JSONResponse }
 
-func (response GetSystemByID500JSONResponse) VisitGetSystemByIDResponse(w http.ResponseWriter) error {
+func (response CreateOrUpdateLabels500JSONResponse) VisitCreateOrUpdateLabelsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UnlinkSystemActionRequestObject struct {
-	SystemID SystemIDPath `json:"systemID"`
-}
-
-type UnlinkSystemActionResponseObject interface {
-	VisitUnlinkSystemActionResponse(w http.ResponseWriter) error
-}
-
-type UnlinkSystemAction204Response struct {
+type GetKeyConfigurationsRequestObject struct {
+	Params GetKeyConfigurationsParams
 }
 
-func (response UnlinkSystemAction204Response) VisitUnlinkSystemActionResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type GetKeyConfigurationsResponseObject interface {
+	VisitGetKeyConfigurationsResponse(w http.ResponseWriter) error
 }
 
-type UnlinkSystemAction400JSONResponse struct{ N400JSONResponse }
+type GetKeyConfigurations200JSONResponse KeyConfigurationList
 
-func (response UnlinkSystemAction400JSONResponse) VisitUnlinkSystemActionResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurations200JSONResponse) VisitGetKeyConfigurationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UnlinkSystemAction403JSONResponse struct{ N403JSONResponse }
+type GetKeyConfigurations400JSONResponse struct{ N400JSONResponse }
 
-func (response UnlinkSystemAction403JSONResponse) VisitUnlinkSystemActionResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurations400JSONResponse) VisitGetKeyConfigurationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UnlinkSystemAction404JSONResponse struct{ N404JSONResponse }
+type GetKeyConfigurations403JSONResponse struct{ N403JSONResponse }
 
-func (response UnlinkSystemAction404JSONResponse) VisitUnlinkSystemActionResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurations403JSONResponse) VisitGetKeyConfigurationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UnlinkSystemAction429Response = N429Response
+type GetKeyConfigurations429Response = N429Response
 
-func (response UnlinkSystemAction429Response) VisitUnlinkSystemActionResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurations429Response) VisitGetKeyConfigurationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type UnlinkSystemAction500JSONResponse struct{ N500JSONResponse }
+type GetKeyConfigurations500JSONResponse struct{ N500JSONResponse }
 
-func (response UnlinkSystemAction500JSONResponse) VisitUnlinkSystemActionResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurations500JSONResponse) VisitGetKeyConfigurationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type LinkSystemActionRequestObject struct {
-	SystemID SystemIDPath `json:"systemID"`
-	Body     *LinkSystemActionApplicationMergePatchPlusJSONRequestBody
+type PostKeyConfigurationsRequestObject struct {
+	Body *PostKeyConfigurationsJSONRequestBody
 }
 
-type LinkSystemActionResponseObject interface {
-	VisitLinkSystemActionResponse(w http.ResponseWriter) error
+type PostKeyConfigurationsResponseObject interface {
+	VisitPostKeyConfigurationsResponse(w http.ResponseWriter) error
 }
 
-type LinkSystemAction200JSONResponse System
+type PostKeyConfigurations201JSONResponse KeyConfiguration
 
-func (response LinkSystemAction200JSONResponse) VisitLinkSystemActionResponse(w http.ResponseWriter) error {
+func (response PostKeyConfigurations201JSONResponse) VisitPostKeyConfigurationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type LinkSystemAction400JSONResponse struct{ N400JSONResponse }
+type PostKeyConfigurations400JSONResponse struct{ N400JSONResponse }
 
-func (response LinkSystemAction400JSONResponse) VisitLinkSystemActionResponse(w http.ResponseWriter) error {
+func (response PostKeyConfigurations400JSONResponse) VisitPostKeyConfigurationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type LinkSystemAction403JSONResponse struct{ N403JSONResponse }
+type PostKeyConfigurations403JSONResponse struct{ N403JSONResponse }
 
-func (response LinkSystemAction403JSONResponse) VisitLinkSystemActionResponse(w http.ResponseWriter) error {
+func (response PostKeyConfigurations403JSONResponse) VisitPostKeyConfigurationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type LinkSystemAction409JSONResponse struct{ N409JSONResponse }
+type PostKeyConfigurations409JSONResponse struct{ N409JSONResponse }
 
-func (response LinkSystemAction409JSONResponse) VisitLinkSystemActionResponse(w http.ResponseWriter) error {
+func (response PostKeyConfigurations409JSONResponse) VisitPostKeyConfigurationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type LinkSystemAction429Response = N429Response
+type PostKeyConfigurations429Response = N429Response
 
-func (response LinkSystemAction429Response) VisitLinkSystemActionResponse(w http.ResponseWriter) error {
+func (response PostKeyConfigurations429Response) VisitPostKeyConfigurationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type LinkSystemAction500JSONResponse struct{ N500JSONResponse }
+type PostKeyConfigurations500JSONResponse struct{ N500JSONResponse }
 
-func (response LinkSystemAction500JSONResponse) VisitLinkSystemActionResponse(w http.ResponseWriter) error {
+func (response PostKeyConfigurations500JSONResponse) VisitPostKeyConfigurationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRecoveryActionsRequestObject struct {
-	SystemID SystemIDPath `json:"systemID"`
+type DeleteKeyConfigurationByIDRequestObject struct {
+	KeyConfigurationID KeyConfigurationIDPath `json:"keyConfigurationID"`
 }
 
-type GetRecoveryActionsResponseObject interface {
-	VisitGetRecoveryActionsResponse(w http.ResponseWriter) error
+type DeleteKeyConfigurationByIDResponseObject interface {
+	VisitDeleteKeyConfigurationByIDResponse(w http.ResponseWriter) error
 }
 
-type GetRecoveryActions200JSONResponse SystemRecoveryAction
-
-func (response GetRecoveryActions200JSONResponse) VisitGetRecoveryActionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type DeleteKeyConfigurationByID204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response DeleteKeyConfigurationByID204Response) VisitDeleteKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type GetRecoveryActions400JSONResponse struct{ N400JSONResponse }
+type DeleteKeyConfigurationByID400JSONResponse struct{ N400JSONResponse }
 
-func (response GetRecoveryActions400JSONResponse) VisitGetRecoveryActionsResponse(w http.ResponseWriter) error {
+func (response DeleteKeyConfigurationByID400JSONResponse) VisitDeleteKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRecoveryActions403JSONResponse struct{ N403JSONResponse }
+type DeleteKeyConfigurationByID403JSONResponse struct{ N403JSONResponse }
 
-func (response GetRecoveryActions403JSONResponse) VisitGetRecoveryActionsResponse(w http.ResponseWriter) error {
+func (response DeleteKeyConfigurationByID403JSONResponse) VisitDeleteKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRecoveryActions409JSONResponse struct{ N409JSONResponse }
+type DeleteKeyConfigurationByID404JSONResponse struct{ N404JSONResponse }
 
-func (response GetRecoveryActions409JSONResponse) VisitGetRecoveryActionsResponse(w http.ResponseWriter) error {
+func (response DeleteKeyConfigurationByID404JSONResponse) VisitDeleteKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRecoveryActions429Response = N429Response
+type DeleteKeyConfigurationByID429Response = N429Response
 
-func (response GetRecoveryActions429Response) VisitGetRecoveryActionsResponse(w http.ResponseWriter) error {
+func (response DeleteKeyConfigurationByID429Response) VisitDeleteKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetRecoveryActions500JSONResponse struct{ N500JSONResponse }
+type DeleteKeyConfigurationByID500JSONResponse struct{ N500JSONResponse }
 
-func (response GetRecoveryActions500JSONResponse) VisitGetRecoveryActionsResponse(w http.ResponseWriter) error {
+func (response DeleteKeyConfigurationByID500JSONResponse) VisitDeleteKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SendRecoveryActionsRequestObject struct {
-	SystemID SystemIDPath `json:"systemID"`
-	Body     *SendRecoveryActionsJSONRequestBody
+type GetKeyConfigurationByIDRequestObject struct {
+	KeyConfigurationID KeyConfigurationIDPath `json:"keyConfigurationID"`
 }
 
-type SendRecoveryActionsResponseObject interface {
-	VisitSendRecoveryActionsResponse(w http.ResponseWriter) error
+type GetKeyConfigurationByIDResponseObject interface {
+	VisitGetKeyConfigurationByIDResponse(w http.ResponseWriter) error
 }
 
-type SendRecoveryActions200Response struct {
-}
+type GetKeyConfigurationByID200JSONResponse KeyConfiguration
 
-func (response SendRecoveryActions200Response) VisitSendRecoveryActionsResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurationByID200JSONResponse) VisitGetKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SendRecoveryActions400JSONResponse struct{ N400JSONResponse }
+type GetKeyConfigurationByID400JSONResponse struct{ N400JSONResponse }
 
-func (response SendRecoveryActions400JSONResponse) VisitSendRecoveryActionsResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurationByID400JSONResponse) VisitGetKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SendRecoveryActions403JSONResponse struct{ N403JSONResponse }
+type GetKeyConfigurationByID403JSONResponse struct{ N403JSONResponse }
 
-func (response SendRecoveryActions403JSONResponse) VisitSendRecoveryActionsResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurationByID403JSONResponse) VisitGetKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SendRecoveryActions409JSONResponse struct{ N409JSONResponse }
+type GetKeyConfigurationByID404JSONResponse struct{ N404JSONResponse }
 
-func (response SendRecoveryActions409JSONResponse) VisitSendRecoveryActionsResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurationByID404JSONResponse) VisitGetKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SendRecoveryActions429Response = N429Response
+type GetKeyConfigurationByID429Response = N429Response
 
-func (response SendRecoveryActions429Response) VisitSendRecoveryActionsResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurationByID429Response) VisitGetKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type SendRecoveryActions500JSONResponse struct{ N500JSONResponse }
+type GetKeyConfigurationByID500JSONResponse struct{ N500JSONResponse }
 
-func (response SendRecoveryActions500JSONResponse) VisitSendRecoveryActionsResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurationByID500JSONResponse) VisitGetKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenantKeystoresRequestObject struct {
+type UpdateKeyConfigurationByIDRequestObject struct {
+	KeyConfigurationID KeyConfigurationIDPath `json:"keyConfigurationID"`
+	Body               *UpdateKeyConfigurationByIDApplicationMergePatchPlusJSONRequestBody
 }
 
-type GetTenantKeystoresResponseObject interface {
-	VisitGetTenantKeystoresResponse(w http.ResponseWriter) error
+type UpdateKeyConfigurationByIDResponseObject interface {
+	VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error
 }
 
-type GetTenantKeystores200JSONResponse TenantKeystore
+type UpdateKeyConfigurationByID200JSONResponse KeyConfiguration
 
-func (response GetTenantKeystores200JSONResponse) VisitGetTenantKeystoresResponse(w http.ResponseWriter) error {
+func (response UpdateKeyConfigurationByID200JSONResponse) VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenantKeystores400JSONResponse struct{ N400JSONResponse }
+type UpdateKeyConfigurationByID400JSONResponse struct{ N400JSONResponse }
 
-func (response GetTenantKeystores400JSONResponse) VisitGetTenantKeystoresResponse(w http.ResponseWriter) error {
+func (response UpdateKeyConfigurationByID400JSONResponse) VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenantKeystores403JSONResponse struct{ N403JSONResponse }
+type UpdateKeyConfigurationByID403JSONResponse struct{ N403JSONResponse }
 
-func (response GetTenantKeystores403JSONResponse) VisitGetTenantKeystoresResponse(w http.ResponseWriter) error {
+func (response UpdateKeyConfigurationByID403JSONResponse) VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenantKeystores429Response = N429Response
+type UpdateKeyConfigurationByID404JSONResponse struct{ N404JSONResponse }
 
-func (response GetTenantKeystores429Response) VisitGetTenantKeystoresResponse(w http.ResponseWriter) error {
+func (response UpdateKeyConfigurationByID404JSONResponse) VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateKeyConfigurationByID409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdateKeyConfigurationByID409JSONResponse) VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateKeyConfigurationByID429Response = N429Response
+
+func (response UpdateKeyConfigurationByID429Response) VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetTenantKeystores500JSONResponse struct{ N500JSONResponse }
+type UpdateKeyConfigurationByID500JSONResponse struct{ N500JSONResponse }
 
-func (response GetTenantKeystores500JSONResponse) VisitGetTenantKeystoresResponse(w http.ResponseWriter) error {
+func (response UpdateKeyConfigurationByID500JSONResponse) VisitUpdateKeyConfigurationByIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenantWorkflowConfigurationRequestObject struct {
+type GetKeyConfigurationCertificatesRequestObject struct {
+	KeyConfigurationID KeyConfigurationIDPath `json:"keyConfigurationID"`
 }
 
-type GetTenantWorkflowConfigurationResponseObject interface {
-	VisitGetTenantWorkflowConfigurationResponse(w http.ResponseWriter) error
+type GetKeyConfigurationCertificatesResponseObject interface {
+	VisitGetKeyConfigurationCertificatesResponse(w http.ResponseWriter) error
 }
 
-type GetTenantWorkflowConfiguration200JSONResponse TenantWorkflowConfiguration
+type GetKeyConfigurationCertificates200JSONResponse ClientCertificates
 
-func (response GetTenantWorkflowConfiguration200JSONResponse) VisitGetTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurationCertificates200JSONResponse) VisitGetKeyConfigurationCertificatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenantWorkflowConfiguration400JSONResponse struct{ N400JSONResponse }
+type GetKeyConfigurationCertificates400JSONResponse struct{ N400JSONResponse }
 
-func (response GetTenantWorkflowConfiguration400JSONResponse) VisitGetTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurationCertificates400JSONResponse) VisitGetKeyConfigurationCertificatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenantWorkflowConfiguration403JSONResponse struct{ N403JSONResponse }
+type GetKeyConfigurationCertificates403JSONResponse struct{ N403JSONResponse }
 
-func (response GetTenantWorkflowConfiguration403JSONResponse) VisitGetTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurationCertificates403JSONResponse) VisitGetKeyConfigurationCertificatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenantWorkflowConfiguration429Response = N429Response
+type GetKeyConfigurationCertificates429Response = N429Response
 
-func (response GetTenantWorkflowConfiguration429Response) VisitGetTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurationCertificates429Response) VisitGetKeyConfigurationCertificatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetTenantWorkflowConfiguration500JSONResponse struct{ N500JSONResponse }
+type GetKeyConfigurationCertificates500JSONResponse struct{ N500JSONResponse }
 
-func (response GetTenantWorkflowConfiguration500JSONResponse) VisitGetTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+func (response GetKeyConfigurationCertificates500JSONResponse) VisitGetKeyConfigurationCertificatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateTenantWorkflowConfigurationRequestObject struct {
-	Body *UpdateTenantWorkflowConfigurationApplicationMergePatchPlusJSONRequestBody
+type GetTagsForKeyConfigurationRequestObject struct {
+	KeyConfigurationID KeyConfigurationIDPath `json:"keyConfigurationID"`
+	Params             GetTagsForKeyConfigurationParams
 }
 
-type UpdateTenantWorkflowConfigurationResponseObject interface {
-	VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error
+type GetTagsForKeyConfigurationResponseObject interface {
+	VisitGetTagsForKeyConfigurationResponse(w http.ResponseWriter) error
 }
 
-type UpdateTenantWorkflowConfiguration200JSONResponse TenantWorkflowConfiguration
+type GetTagsForKeyConfiguration200JSONResponse TagList
 
-func (response UpdateTenantWorkflowConfiguration200JSONResponse) VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+func (response GetTagsForKeyConfiguration200JSONResponse) VisitGetTagsForKeyConfigurationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateTenantWorkflowConfiguration400JSONResponse struct{ N400JSONResponse }
+type GetTagsForKeyConfiguration400JSONResponse struct{ N400JSONResponse }
 
-func (response UpdateTenantWorkflowConfiguration400JSONResponse) VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+func (response GetTagsForKeyConfiguration400JSONResponse) VisitGetTagsForKeyConfigurationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateTenantWorkflowConfiguration403JSONResponse struct{ N403JSONResponse }
+type GetTagsForKeyConfiguration403JSONResponse struct{ N403JSONResponse }
 
-func (response UpdateTenantWorkflowConfiguration403JSONResponse) VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+func (response GetTagsForKeyConfiguration403JSONResponse) VisitGetTagsForKeyConfigurationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateTenantWorkflowConfiguration404JSONResponse struct{ N404JSONResponse }
+type GetTagsForKeyConfiguration404JSONResponse struct{ N404JSONResponse }
 
-func (response UpdateTenantWorkflowConfiguration404JSONResponse) VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+func (response GetTagsForKeyConfiguration404JSONResponse) VisitGetTagsForKeyConfigurationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateTenantWorkflowConfiguration409JSONResponse struct{ N409JSONResponse }
-
-func (response UpdateTenantWorkflowConfiguration409JSONResponse) VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type UpdateTenantWorkflowConfiguration429Response = N429Response
+type GetTagsForKeyConfiguration429Response = N429Response
 
-func (response UpdateTenantWorkflowConfiguration429Response) VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+func (response GetTagsForKeyConfiguration429Response) VisitGetTagsForKeyConfigurationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type UpdateTenantWorkflowConfiguration500JSONResponse struct{ N500JSONResponse }
+type GetTagsForKeyConfiguration500JSONResponse struct{ N500JSONResponse }
 
-func (response UpdateTenantWorkflowConfiguration500JSONResponse) VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+func (response GetTagsForKeyConfiguration500JSONResponse) VisitGetTagsForKeyConfigurationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenantInfoRequestObject struct {
+type AddTagsToKeyConfigurationRequestObject struct {
+	KeyConfigurationID KeyConfigurationIDPath `json:"keyConfigurationID"`
+	Body               *AddTagsToKeyConfigurationJSONRequestBody
 }
 
-type GetTenantInfoResponseObject interface {
-	VisitGetTenantInfoResponse(w http.ResponseWriter) error
+type AddTagsToKeyConfigurationResponseObject interface {
+	VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error
 }
 
-type GetTenantInfo200JSONResponse Tenant
-
-func (response GetTenantInfo200JSONResponse) VisitGetTenantInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type AddTagsToKeyConfiguration204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response AddTagsToKeyConfiguration204Response) VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type GetTenantInfo400JSONResponse struct{ N400JSONResponse }
+type AddTagsToKeyConfiguration400JSONResponse struct{ N400JSONResponse }
 
-func (response GetTenantInfo400JSONResponse) VisitGetTenantInfoResponse(w http.ResponseWriter) error {
+func (response AddTagsToKeyConfiguration400JSONResponse) VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenantInfo403JSONResponse struct{ N403JSONResponse }
+type AddTagsToKeyConfiguration403JSONResponse struct{ N403JSONResponse }
 
-func (response GetTenantInfo403JSONResponse) VisitGetTenantInfoResponse(w http.ResponseWriter) error {
+func (response AddTagsToKeyConfiguration403JSONResponse) VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenantInfo404JSONResponse struct{ N404JSONResponse }
+type AddTagsToKeyConfiguration404JSONResponse struct{ N404JSONResponse }
 
-func (response GetTenantInfo404JSONResponse) VisitGetTenantInfoResponse(w http.ResponseWriter) error {
+func (response AddTagsToKeyConfiguration404JSONResponse) VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenantInfo429Response = N429Response
+type AddTagsToKeyConfiguration409JSONResponse struct{ N409JSONResponse }
 
-func (response GetTenantInfo429Response) VisitGetTenantInfoResponse(w http.ResponseWriter) error {
+func (response AddTagsToKeyConfiguration409JSONResponse) VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTagsToKeyConfiguration429Response = N429Response
+
+func (response AddTagsToKeyConfiguration429Response) VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetTenantInfo500JSONResponse struct{ N500JSONResponse }
+type AddTagsToKeyConfiguration500JSONResponse struct{ N500JSONResponse }
 
-func (response GetTenantInfo500JSONResponse) VisitGetTenantInfoResponse(w http.ResponseWriter) error {
+func (response AddTagsToKeyConfiguration500JSONResponse) VisitAddTagsToKeyConfigurationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenantsRequestObject struct {
-	Params GetTenantsParams
+type GetKeysRequestObject struct {
+	Params GetKeysParams
 }
 
-type GetTenantsResponseObject interface {
-	VisitGetTenantsResponse(w http.ResponseWriter) error
+type GetKeysResponseObject interface {
+	VisitGetKeysResponse(w http.ResponseWriter) error
 }
 
-type GetTenants200JSONResponse TenantList
+type GetKeys200JSONResponse KeyList
 
-func (response GetTenants200JSONResponse) VisitGetTenantsResponse(w http.ResponseWriter) error {
+func (response GetKeys200JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenants400JSONResponse struct{ N400JSONResponse }
+type GetKeys400JSONResponse struct{ N400JSONResponse }
 
-func (response GetTenants400JSONResponse) VisitGetTenantsResponse(w http.ResponseWriter) error {
+func (response GetKeys400JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenants403JSONResponse struct{ N403JSONResponse }
+type GetKeys403JSONResponse struct{ N403JSONResponse }
 
-func (response GetTenants403JSONResponse) VisitGetTenantsResponse(w http.ResponseWriter) error {
+func (response GetKeys403JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTenants429Response = N429Response
+type GetKeys429Response = N429Response
 
-func (response GetTenants429Response) VisitGetTenantsResponse(w http.ResponseWriter) error {
+func (response GetKeys429Response) VisitGetKeysResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetTenants500JSONResponse struct{ N500JSONResponse }
+type GetKeys500JSONResponse struct{ N500JSONResponse }
 
-func (response GetTenants500JSONResponse) VisitGetTenantsResponse(w http.ResponseWriter) error {
+func (response GetKeys500JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUserInfoRequestObject struct {
+type PostKeysRequestObject struct {
+	Body *PostKeysJSONRequestBody
 }
 
-type GetUserInfoResponseObject interface {
-	VisitGetUserInfoResponse(w http.ResponseWriter) error
+type PostKeysResponseObject interface {
+	VisitPostKeysResponse(w http.ResponseWriter) error
 }
 
-type GetUserInfo200JSONResponse UserInfo
+type PostKeys201JSONResponse Key
 
-func (response GetUserInfo200JSONResponse) VisitGetUserInfoResponse(w http.ResponseWriter) error {
+func (response PostKeys201JSONResponse) VisitPostKeysResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUserInfo404JSONResponse struct{ N404JSONResponse }
+type PostKeys400JSONResponse struct{ N400JSONResponse }
 
-func (response GetUserInfo404JSONResponse) VisitGetUserInfoResponse(w http.ResponseWriter) error {
+func (response PostKeys400JSONResponse) VisitPostKeysResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUserInfo429Response = N429Response
+type PostKeys403JSONResponse struct{ N403JSONResponse }
 
-func (response GetUserInfo429Response) VisitGetUserInfoResponse(w http.ResponseWriter) error {
+func (response PostKeys403JSONResponse) VisitPostKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostKeys409JSONResponse struct{ N409JSONResponse }
+
+func (response PostKeys409JSONResponse) VisitPostKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostKeys429Response = N429Response
+
+func (response PostKeys429Response) VisitPostKeysResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetUserInfo500JSONResponse struct{ N500JSONResponse }
+type PostKeys500JSONResponse struct{ N500JSONResponse }
 
-func (response GetUserInfo500JSONResponse) VisitGetUserInfoResponse(w http.ResponseWriter) error {
+func (response PostKeys500JSONResponse) VisitPostKeysResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowsRequestObject struct {
-	Params GetWorkflowsParams
+type DeleteKeysKeyIDRequestObject struct {
+	KeyID KeyIDPath `json:"keyID"`
 }
 
-type GetWorkflowsResponseObject interface {
-	VisitGetWorkflowsResponse(w http.ResponseWriter) error
+type DeleteKeysKeyIDResponseObject interface {
+	VisitDeleteKeysKeyIDResponse(w http.ResponseWriter) error
 }
 
-type GetWorkflows200JSONResponse WorkflowList
+type DeleteKeysKeyID204Response struct {
+}
 
-func (response GetWorkflows200JSONResponse) VisitGetWorkflowsResponse(w http.ResponseWriter) error {
+func (response DeleteKeysKeyID204Response) VisitDeleteKeysKeyIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteKeysKeyID400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteKeysKeyID400JSONResponse) VisitDeleteKeysKeyIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflows400JSONResponse struct{ N400JSONResponse }
+type DeleteKeysKeyID403JSONResponse struct{ N403JSONResponse }
 
-func (response GetWorkflows400JSONResponse) VisitGetWorkflowsResponse(w http.ResponseWriter) error {
+func (response DeleteKeysKeyID403JSONResponse) VisitDeleteKeysKeyIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflows403JSONResponse struct{ N403JSONResponse }
+type DeleteKeysKeyID404JSONResponse struct{ N404JSONResponse }
 
-func (response GetWorkflows403JSONResponse) VisitGetWorkflowsResponse(w http.ResponseWriter) error {
+func (response DeleteKeysKeyID404JSONResponse) VisitDeleteKeysKeyIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflows429Response = N429Response
+type DeleteKeysKeyID429Response = N429Response
 
-func (response GetWorkflows429Response) VisitGetWorkflowsResponse(w http.ResponseWriter) error {
+func (response DeleteKeysKeyID429Response) VisitDeleteKeysKeyIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetWorkflows500JSONResponse struct{ N500JSONResponse }
+type DeleteKeysKeyID500JSONResponse struct{ N500JSONResponse }
 
-func (response GetWorkflows500JSONResponse) VisitGetWorkflowsResponse(w http.ResponseWriter) error {
+func (response DeleteKeysKeyID500JSONResponse) VisitDeleteKeysKeyIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflowRequestObject struct {
-	Body *CreateWorkflowJSONRequestBody
+type GetKeysKeyIDRequestObject struct {
+	KeyID KeyIDPath `json:"keyID"`
 }
 
-type CreateWorkflowResponseObject interface {
-	VisitCreateWorkflowResponse(w http.ResponseWriter) error
+type GetKeysKeyIDResponseObject interface {
+	VisitGetKeysKeyIDResponse(w http.ResponseWriter) error
 }
 
-type CreateWorkflow201JSONResponse Workflow
+type GetKeysKeyID200JSONResponse Key
 
-func (response CreateWorkflow201JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+func (response GetKeysKeyID200JSONResponse) VisitGetKeysKeyIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflow400JSONResponse struct{ N400JSONResponse }
+type GetKeysKeyID400JSONResponse struct{ N400JSONResponse }
 
-func (response CreateWorkflow400JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+func (response GetKeysKeyID400JSONResponse) VisitGetKeysKeyIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflow403JSONResponse struct{ N403JSONResponse }
+type GetKeysKeyID403JSONResponse struct{ N403JSONResponse }
 
-func (response CreateWorkflow403JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+func (response GetKeysKeyID403JSONResponse) VisitGetKeysKeyIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflow404JSONResponse struct{ N404JSONResponse }
+type GetKeysKeyID404JSONResponse struct{ N404JSONResponse }
 
-func (response CreateWorkflow404JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+func (response GetKeysKeyID404JSONResponse) VisitGetKeysKeyIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflow409JSONResponse struct{ N409JSONResponse }
-
-func (response CreateWorkflow409JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CreateWorkflow429Response = N429Response
+type GetKeysKeyID429Response = N429Response
 
-func (response CreateWorkflow429Response) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+func (response GetKeysKeyID429Response) VisitGetKeysKeyIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type CreateWorkflow500JSONResponse struct{ N500JSONResponse }
+type GetKeysKeyID500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateWorkflow500JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+func (response GetKeysKeyID500JSONResponse) VisitGetKeysKeyIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CheckWorkflowRequestObject struct {
-	Body *CheckWorkflowJSONRequestBody
+type UpdateKeyRequestObject struct {
+	KeyID KeyIDPath `json:"keyID"`
+	Body  *UpdateKeyApplicationMergePatchPlusJSONRequestBody
 }
 
-type CheckWorkflowResponseObject interface {
-	VisitCheckWorkflowResponse(w http.ResponseWriter) error
+type UpdateKeyResponseObject interface {
+	VisitUpdateKeyResponse(w http.ResponseWriter) error
 }
 
-type CheckWorkflow200JSONResponse WorkflowCheck
+type UpdateKey200JSONResponse Key
 
-func (response CheckWorkflow200JSONResponse) VisitCheckWorkflowResponse(w http.ResponseWriter) error {
+func (response UpdateKey200JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CheckWorkflow400JSONResponse struct{ N400JSONResponse }
+type UpdateKey400JSONResponse struct{ N400JSONResponse }
 
-func (response CheckWorkflow400JSONResponse) VisitCheckWorkflowResponse(w http.ResponseWriter) error {
+func (response UpdateKey400JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CheckWorkflow403JSONResponse struct{ N403JSONResponse }
+type UpdateKey403JSONResponse struct{ N403JSONResponse }
 
-func (response CheckWorkflow403JSONResponse) VisitCheckWorkflowResponse(w http.ResponseWriter) error {
+func (response UpdateKey403JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CheckWorkflow404JSONResponse struct{ N404JSONResponse }
+type UpdateKey404JSONResponse struct{ N404JSONResponse }
 
-func (response CheckWorkflow404JSONResponse) VisitCheckWorkflowResponse(w http.ResponseWriter) error {
+func (response UpdateKey404JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CheckWorkflow409JSONResponse struct{ N409JSONResponse }
+type UpdateKey409JSONResponse struct{ N409JSONResponse }
 
-func (response CheckWorkflow409JSONResponse) VisitCheckWorkflowResponse(w http.ResponseWriter) error {
+func (response UpdateKey409JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CheckWorkflow429Response = N429Response
+type UpdateKey429Response = N429Response
 
-func (response CheckWorkflow429Response) VisitCheckWorkflowResponse(w http.ResponseWriter) error {
+func (response UpdateKey429Response) VisitUpdateKeyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type CheckWorkflow500JSONResponse struct{ N500JSONResponse }
+type UpdateKey500JSONResponse struct{ N500JSONResponse }
 
-func (response CheckWorkflow500JSONResponse) VisitCheckWorkflowResponse(w http.ResponseWriter) error {
+func (response UpdateKey500JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowByIDRequestObject struct {
-	WorkflowID WorkflowIDPath `json:"workflowID"`
+type ImportKeyMaterialRequestObject struct {
+	KeyID KeyIDPath `json:"keyID"`
+	Body  *ImportKeyMaterialJSONRequestBody
 }
 
-type GetWorkflowByIDResponseObject interface {
-	VisitGetWorkflowByIDResponse(w http.ResponseWriter) error
+type ImportKeyMaterialResponseObject interface {
+	VisitImportKeyMaterialResponse(w http.ResponseWriter) error
 }
 
-type GetWorkflowByID200JSONResponse Workflow
+type ImportKeyMaterial201JSONResponse Key
 
-func (response GetWorkflowByID200JSONResponse) VisitGetWorkflowByIDResponse(w http.ResponseWriter) error {
+func (response ImportKeyMaterial201JSONResponse) VisitImportKeyMaterialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowByID400JSONResponse struct{ N400JSONResponse }
+type ImportKeyMaterial400JSONResponse struct{ N400JSONResponse }
 
-func (response GetWorkflowByID400JSONResponse) VisitGetWorkflowByIDResponse(w http.ResponseWriter) error {
+func (response ImportKeyMaterial400JSONResponse) VisitImportKeyMaterialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowByID403JSONResponse struct{ N403JSONResponse }
+type ImportKeyMaterial403JSONResponse struct{ N403JSONResponse }
 
-func (response GetWorkflowByID403JSONResponse) VisitGetWorkflowByIDResponse(w http.ResponseWriter) error {
+func (response ImportKeyMaterial403JSONResponse) VisitImportKeyMaterialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowByID404JSONResponse struct{ N404JSONResponse }
+type ImportKeyMaterial404JSONResponse struct{ N404JSONResponse }
 
-func (response GetWorkflowByID404JSONResponse) VisitGetWorkflowByIDResponse(w http.ResponseWriter) error {
+func (response ImportKeyMaterial404JSONResponse) VisitImportKeyMaterialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowByID429Response = N429Response
+type ImportKeyMaterial429Response = N429Response
 
-func (response GetWorkflowByID429Response) VisitGetWorkflowByIDResponse(w http.ResponseWriter) error {
+func (response ImportKeyMaterial429Response) VisitImportKeyMaterialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type GetWorkflowByID500JSONResponse struct{ N500JSONResponse }
+type ImportKeyMaterial500JSONResponse struct{ N500JSONResponse }
 
-func (response GetWorkflowByID500JSONResponse) VisitGetWorkflowByIDResponse(w http.ResponseWriter) error {
+func (response ImportKeyMaterial500JSONResponse) VisitImportKeyMaterialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TransitionWorkflowRequestObject struct {
-	WorkflowID WorkflowIDPath `json:"workflowID"`
-	Body       *TransitionWorkflowJSONRequestBody
+type GetKeyImportParamsRequestObject struct {
+	KeyID KeyIDPath `json:"keyID"`
 }
 
-type TransitionWorkflowResponseObject interface {
-	VisitTransitionWorkflowResponse(w http.ResponseWriter) error
+type GetKeyImportParamsResponseObject interface {
+	VisitGetKeyImportParamsResponse(w http.ResponseWriter) error
 }
 
-type TransitionWorkflow200JSONResponse Workflow
+type GetKeyImportParams200JSONResponse ImportParams
 
-func (response TransitionWorkflow200JSONResponse) VisitTransitionWorkflowResponse(w http.ResponseWriter) error {
+func (response GetKeyImportParams200JSONResponse) VisitGetKeyImportParamsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TransitionWorkflow400JSONResponse struct{ N400JSONResponse }
+type GetKeyImportParams400JSONResponse struct{ N400JSONResponse }
 
-func (response TransitionWorkflow400JSONResponse) VisitTransitionWorkflowResponse(w http.ResponseWriter) error {
+func (response GetKeyImportParams400JSONResponse) VisitGetKeyImportParamsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TransitionWorkflow403JSONResponse struct{ N403JSONResponse }
+type GetKeyImportParams403JSONResponse struct{ N403JSONResponse }
 
-func (response TransitionWorkflow403JSONResponse) VisitTransitionWorkflowResponse(w http.ResponseWriter) error {
+func (response GetKeyImportParams403JSONResponse) VisitGetKeyImportParamsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TransitionWorkflow404JSONResponse struct{ N404JSONResponse }
+type GetKeyImportParams404JSONResponse struct{ N404JSONResponse }
 
-func (response TransitionWorkflow404JSONResponse) VisitTransitionWorkflowResponse(w http.ResponseWriter) error {
+func (response GetKeyImportParams404JSONResponse) VisitGetKeyImportParamsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TransitionWorkflow429Response = N429Response
+type GetKeyImportParams429Response = N429Response
 
-func (response TransitionWorkflow429Response) VisitTransitionWorkflowResponse(w http.ResponseWriter) error {
+func (response GetKeyImportParams429Response) VisitGetKeyImportParamsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
 	w.WriteHeader(429)
 	return nil
 }
 
-type TransitionWorkflow500JSONResponse struct{ N500JSONResponse }
+type GetKeyImportParams500JSONResponse struct{ N500JSONResponse }
 
-func (response TransitionWorkflow500JSONResponse) VisitTransitionWorkflowResponse(w http.ResponseWriter) error {
+func (response GetKeyImportParams500JSONResponse) VisitGetKeyImportParamsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get Groups
-	// (GET /groups)
-	GetGroups(ctx context.Context, request GetGroupsRequestObject) (GetGroupsResponseObject, error)
-	// Create a new Group
-	// (POST /groups)
-	CreateGroup(ctx context.Context, request CreateGroupRequestObject) (CreateGroupResponseObject, error)
-	// Check groups existence in IAM provider
-	// (POST /groups/iamCheck)
-	CheckGroupsIAM(ctx context.Context, request CheckGroupsIAMRequestObject) (CheckGroupsIAMResponseObject, error)
-	// Delete a Group by its ID
-	// (DELETE /groups/{groupID})
-	DeleteGroupByID(ctx context.Context, request DeleteGroupByIDRequestObject) (DeleteGroupByIDResponseObject, error)
-	// Get Group by ID
-	// (GET /groups/{groupID})
-	GetGroupByID(ctx context.Context, request GetGroupByIDRequestObject) (GetGroupByIDResponseObject, error)
-	// Update group
-	// (PATCH /groups/{groupID})
-	UpdateGroup(ctx context.Context, request UpdateGroupRequestObject) (UpdateGroupResponseObject, error)
-	// Delete a specified Label from a Key
-	// (DELETE /key/{keyID}/label/{labelName})
-	DeleteLabel(ctx context.Context, request DeleteLabelRequestObject) (DeleteLabelResponseObject, error)
-	// Get the Labels associated with the Key
-	// (GET /key/{keyID}/labels)
-	GetKeyLabels(ctx context.Context, request GetKeyLabelsRequestObject) (GetKeyLabelsResponseObject, error)
-	// Create and update Labels
-	// (POST /key/{keyID}/labels)
-	CreateOrUpdateLabels(ctx context.Context, request CreateOrUpdateLabelsRequestObject) (CreateOrUpdateLabelsResponseObject, error)
-	// Get all Key Configurations
-	// (GET /keyConfigurations)
-	GetKeyConfigurations(ctx context.Context, request GetKeyConfigurationsRequestObject) (GetKeyConfigurationsResponseObject, error)
-	// Create a new Key Configuration
-	// (POST /keyConfigurations)
+type GetKeyVersionsRequestObject struct {
+	KeyID  KeyIDPath `json:"keyID"`
+	Params GetKeyVersionsParams
+}
+
+type GetKeyVersionsResponseObject interface {
+	VisitGetKeyVersionsResponse(w http.ResponseWriter) error
+}
+
+type GetKeyVersions200JSONResponse KeyVersionList
+
+func (response GetKeyVersions200JSONResponse) VisitGetKeyVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyVersions400JSONResponse struct{ N400JSONResponse }
+
+func (response GetKeyVersions400JSONResponse) VisitGetKeyVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyVersions403JSONResponse struct{ N403JSONResponse }
+
+func (response GetKeyVersions403JSONResponse) VisitGetKeyVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyVersions404JSONResponse struct{ N404JSONResponse }
+
+func (response GetKeyVersions404JSONResponse) VisitGetKeyVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyVersions429Response = N429Response
+
+func (response GetKeyVersions429Response) VisitGetKeyVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetKeyVersions500JSONResponse struct{ N500JSONResponse }
+
+func (response GetKeyVersions500JSONResponse) VisitGetKeyVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRolesRequestObject struct {
+	Params GetRolesParams
+}
+
+type GetRolesResponseObject interface {
+	VisitGetRolesResponse(w http.ResponseWriter) error
+}
+
+type GetRoles200JSONResponse CustomRoleList
+
+func (response GetRoles200JSONResponse) VisitGetRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoles400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRoles400JSONResponse) VisitGetRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoles403JSONResponse struct{ N403JSONResponse }
+
+func (response GetRoles403JSONResponse) VisitGetRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoles429Response = N429Response
+
+func (response GetRoles429Response) VisitGetRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetRoles500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRoles500JSONResponse) VisitGetRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRoleRequestObject struct {
+	Body *CreateRoleJSONRequestBody
+}
+
+type CreateRoleResponseObject interface {
+	VisitCreateRoleResponse(w http.ResponseWriter) error
+}
+
+type CreateRole201JSONResponse CustomRole
+
+func (response CreateRole201JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRole400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateRole400JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRole403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateRole403JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRole409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateRole409JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRole429Response = N429Response
+
+func (response CreateRole429Response) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type CreateRole500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateRole500JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRoleByIDRequestObject struct {
+	RoleID RoleIDPath `json:"roleID"`
+}
+
+type DeleteRoleByIDResponseObject interface {
+	VisitDeleteRoleByIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteRoleByID204Response struct {
+}
+
+func (response DeleteRoleByID204Response) VisitDeleteRoleByIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteRoleByID400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteRoleByID400JSONResponse) VisitDeleteRoleByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRoleByID403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteRoleByID403JSONResponse) VisitDeleteRoleByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRoleByID404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteRoleByID404JSONResponse) VisitDeleteRoleByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRoleByID429Response = N429Response
+
+func (response DeleteRoleByID429Response) VisitDeleteRoleByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type DeleteRoleByID500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteRoleByID500JSONResponse) VisitDeleteRoleByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoleByIDRequestObject struct {
+	RoleID RoleIDPath `json:"roleID"`
+}
+
+type GetRoleByIDResponseObject interface {
+	VisitGetRoleByIDResponse(w http.ResponseWriter) error
+}
+
+type GetRoleByID200JSONResponse CustomRole
+
+func (response GetRoleByID200JSONResponse) VisitGetRoleByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoleByID400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRoleByID400JSONResponse) VisitGetRoleByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoleByID403JSONResponse struct{ N403JSONResponse }
+
+func (response GetRoleByID403JSONResponse) VisitGetRoleByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoleByID404JSONResponse struct{ N404JSONResponse }
+
+func (response GetRoleByID404JSONResponse) VisitGetRoleByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoleByID429Response = N429Response
+
+func (response GetRoleByID429Response) VisitGetRoleByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetRoleByID500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRoleByID500JSONResponse) VisitGetRoleByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRoleRequestObject struct {
+	RoleID RoleIDPath `json:"roleID"`
+	Body   *UpdateRoleApplicationMergePatchPlusJSONRequestBody
+}
+
+type UpdateRoleResponseObject interface {
+	VisitUpdateRoleResponse(w http.ResponseWriter) error
+}
+
+type UpdateRole200JSONResponse CustomRole
+
+func (response UpdateRole200JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRole400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateRole400JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRole403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateRole403JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRole404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateRole404JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRole429Response = N429Response
+
+func (response UpdateRole429Response) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type UpdateRole500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateRole500JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemGroupsRequestObject struct {
+	Params GetSystemGroupsParams
+}
+
+type GetSystemGroupsResponseObject interface {
+	VisitGetSystemGroupsResponse(w http.ResponseWriter) error
+}
+
+type GetSystemGroups200JSONResponse SystemGroupList
+
+func (response GetSystemGroups200JSONResponse) VisitGetSystemGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemGroups400JSONResponse struct{ N400JSONResponse }
+
+func (response GetSystemGroups400JSONResponse) VisitGetSystemGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemGroups403JSONResponse struct{ N403JSONResponse }
+
+func (response GetSystemGroups403JSONResponse) VisitGetSystemGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemGroups429Response = N429Response
+
+func (response GetSystemGroups429Response) VisitGetSystemGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetSystemGroups500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSystemGroups500JSONResponse) VisitGetSystemGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSystemGroupRequestObject struct {
+	Body *CreateSystemGroupJSONRequestBody
+}
+
+type CreateSystemGroupResponseObject interface {
+	VisitCreateSystemGroupResponse(w http.ResponseWriter) error
+}
+
+type CreateSystemGroup201JSONResponse SystemGroup
+
+func (response CreateSystemGroup201JSONResponse) VisitCreateSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSystemGroup400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateSystemGroup400JSONResponse) VisitCreateSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSystemGroup403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateSystemGroup403JSONResponse) VisitCreateSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSystemGroup409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateSystemGroup409JSONResponse) VisitCreateSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSystemGroup429Response = N429Response
+
+func (response CreateSystemGroup429Response) VisitCreateSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type CreateSystemGroup500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateSystemGroup500JSONResponse) VisitCreateSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSystemGroupRequestObject struct {
+	SystemGroupID SystemGroupIDPath `json:"systemGroupID"`
+}
+
+type DeleteSystemGroupResponseObject interface {
+	VisitDeleteSystemGroupResponse(w http.ResponseWriter) error
+}
+
+type DeleteSystemGroup204Response struct {
+}
+
+func (response DeleteSystemGroup204Response) VisitDeleteSystemGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSystemGroup400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteSystemGroup400JSONResponse) VisitDeleteSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSystemGroup403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteSystemGroup403JSONResponse) VisitDeleteSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSystemGroup404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteSystemGroup404JSONResponse) VisitDeleteSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSystemGroup429Response = N429Response
+
+func (response DeleteSystemGroup429Response) VisitDeleteSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type DeleteSystemGroup500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteSystemGroup500JSONResponse) VisitDeleteSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemGroupByIDRequestObject struct {
+	SystemGroupID SystemGroupIDPath `json:"systemGroupID"`
+}
+
+type GetSystemGroupByIDResponseObject interface {
+	VisitGetSystemGroupByIDResponse(w http.ResponseWriter) error
+}
+
+type GetSystemGroupByID200JSONResponse SystemGroup
+
+func (response GetSystemGroupByID200JSONResponse) VisitGetSystemGroupByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemGroupByID400JSONResponse struct{ N400JSONResponse }
+
+func (response GetSystemGroupByID400JSONResponse) VisitGetSystemGroupByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemGroupByID403JSONResponse struct{ N403JSONResponse }
+
+func (response GetSystemGroupByID403JSONResponse) VisitGetSystemGroupByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemGroupByID404JSONResponse struct{ N404JSONResponse }
+
+func (response GetSystemGroupByID404JSONResponse) VisitGetSystemGroupByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemGroupByID429Response = N429Response
+
+func (response GetSystemGroupByID429Response) VisitGetSystemGroupByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetSystemGroupByID500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSystemGroupByID500JSONResponse) VisitGetSystemGroupByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSystemGroupRequestObject struct {
+	SystemGroupID SystemGroupIDPath `json:"systemGroupID"`
+	Body          *UpdateSystemGroupApplicationMergePatchPlusJSONRequestBody
+}
+
+type UpdateSystemGroupResponseObject interface {
+	VisitUpdateSystemGroupResponse(w http.ResponseWriter) error
+}
+
+type UpdateSystemGroup200JSONResponse SystemGroup
+
+func (response UpdateSystemGroup200JSONResponse) VisitUpdateSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSystemGroup400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateSystemGroup400JSONResponse) VisitUpdateSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSystemGroup403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateSystemGroup403JSONResponse) VisitUpdateSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSystemGroup404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateSystemGroup404JSONResponse) VisitUpdateSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSystemGroup409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdateSystemGroup409JSONResponse) VisitUpdateSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSystemGroup429Response = N429Response
+
+func (response UpdateSystemGroup429Response) VisitUpdateSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type UpdateSystemGroup500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateSystemGroup500JSONResponse) VisitUpdateSystemGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlinkSystemGroupActionRequestObject struct {
+	SystemGroupID SystemGroupIDPath `json:"systemGroupID"`
+}
+
+type UnlinkSystemGroupActionResponseObject interface {
+	VisitUnlinkSystemGroupActionResponse(w http.ResponseWriter) error
+}
+
+type UnlinkSystemGroupAction200JSONResponse SystemGroupActionResult
+
+func (response UnlinkSystemGroupAction200JSONResponse) VisitUnlinkSystemGroupActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlinkSystemGroupAction400JSONResponse struct{ N400JSONResponse }
+
+func (response UnlinkSystemGroupAction400JSONResponse) VisitUnlinkSystemGroupActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlinkSystemGroupAction403JSONResponse struct{ N403JSONResponse }
+
+func (response UnlinkSystemGroupAction403JSONResponse) VisitUnlinkSystemGroupActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlinkSystemGroupAction404JSONResponse struct{ N404JSONResponse }
+
+func (response UnlinkSystemGroupAction404JSONResponse) VisitUnlinkSystemGroupActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlinkSystemGroupAction429Response = N429Response
+
+func (response UnlinkSystemGroupAction429Response) VisitUnlinkSystemGroupActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type UnlinkSystemGroupAction500JSONResponse struct{ N500JSONResponse }
+
+func (response UnlinkSystemGroupAction500JSONResponse) VisitUnlinkSystemGroupActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkSystemGroupActionRequestObject struct {
+	SystemGroupID SystemGroupIDPath `json:"systemGroupID"`
+	Body          *LinkSystemGroupActionApplicationMergePatchPlusJSONRequestBody
+}
+
+type LinkSystemGroupActionResponseObject interface {
+	VisitLinkSystemGroupActionResponse(w http.ResponseWriter) error
+}
+
+type LinkSystemGroupAction200JSONResponse SystemGroupActionResult
+
+func (response LinkSystemGroupAction200JSONResponse) VisitLinkSystemGroupActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkSystemGroupAction400JSONResponse struct{ N400JSONResponse }
+
+func (response LinkSystemGroupAction400JSONResponse) VisitLinkSystemGroupActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkSystemGroupAction403JSONResponse struct{ N403JSONResponse }
+
+func (response LinkSystemGroupAction403JSONResponse) VisitLinkSystemGroupActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkSystemGroupAction404JSONResponse struct{ N404JSONResponse }
+
+func (response LinkSystemGroupAction404JSONResponse) VisitLinkSystemGroupActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkSystemGroupAction429Response = N429Response
+
+func (response LinkSystemGroupAction429Response) VisitLinkSystemGroupActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type LinkSystemGroupAction500JSONResponse struct{ N500JSONResponse }
+
+func (response LinkSystemGroupAction500JSONResponse) VisitLinkSystemGroupActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemGroupSystemsRequestObject struct {
+	SystemGroupID SystemGroupIDPath `json:"systemGroupID"`
+	Params        GetSystemGroupSystemsParams
+}
+
+type GetSystemGroupSystemsResponseObject interface {
+	VisitGetSystemGroupSystemsResponse(w http.ResponseWriter) error
+}
+
+type GetSystemGroupSystems200JSONResponse SystemList
+
+func (response GetSystemGroupSystems200JSONResponse) VisitGetSystemGroupSystemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemGroupSystems400JSONResponse struct{ N400JSONResponse }
+
+func (response GetSystemGroupSystems400JSONResponse) VisitGetSystemGroupSystemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemGroupSystems403JSONResponse struct{ N403JSONResponse }
+
+func (response GetSystemGroupSystems403JSONResponse) VisitGetSystemGroupSystemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemGroupSystems404JSONResponse struct{ N404JSONResponse }
+
+func (response GetSystemGroupSystems404JSONResponse) VisitGetSystemGroupSystemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemGroupSystems429Response = N429Response
+
+func (response GetSystemGroupSystems429Response) VisitGetSystemGroupSystemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetSystemGroupSystems500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSystemGroupSystems500JSONResponse) VisitGetSystemGroupSystemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllSystemsRequestObject struct {
+	Params GetAllSystemsParams
+}
+
+type GetAllSystemsResponseObject interface {
+	VisitGetAllSystemsResponse(w http.ResponseWriter) error
+}
+
+type GetAllSystems200JSONResponse SystemList
+
+func (response GetAllSystems200JSONResponse) VisitGetAllSystemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllSystems400JSONResponse struct{ N400JSONResponse }
+
+func (response GetAllSystems400JSONResponse) VisitGetAllSystemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllSystems403JSONResponse struct{ N403JSONResponse }
+
+func (response GetAllSystems403JSONResponse) VisitGetAllSystemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllSystems429Response = N429Response
+
+func (response GetAllSystems429Response) VisitGetAllSystemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetAllSystems500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAllSystems500JSONResponse) VisitGetAllSystemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFiltersRequestObject struct {
+}
+
+type GetFiltersResponseObject interface {
+	VisitGetFiltersResponse(w http.ResponseWriter) error
+}
+
+type GetFilters200JSONResponse SystemFilters
+
+func (response GetFilters200JSONResponse) VisitGetFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFilters400JSONResponse struct{ N400JSONResponse }
+
+func (response GetFilters400JSONResponse) VisitGetFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFilters403JSONResponse struct{ N403JSONResponse }
+
+func (response GetFilters403JSONResponse) VisitGetFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFilters404JSONResponse struct{ N404JSONResponse }
+
+func (response GetFilters404JSONResponse) VisitGetFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFilters429Response = N429Response
+
+func (response GetFilters429Response) VisitGetFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetFilters500JSONResponse struct{ N500JSONResponse }
+
+func (response GetFilters500JSONResponse) VisitGetFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemByIDRequestObject struct {
+	SystemID SystemIDPath `json:"systemID"`
+}
+
+type GetSystemByIDResponseObject interface {
+	VisitGetSystemByIDResponse(w http.ResponseWriter) error
+}
+
+type GetSystemByID200JSONResponse System
+
+func (response GetSystemByID200JSONResponse) VisitGetSystemByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemByID400JSONResponse struct{ N400JSONResponse }
+
+func (response GetSystemByID400JSONResponse) VisitGetSystemByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemByID403JSONResponse struct{ N403JSONResponse }
+
+func (response GetSystemByID403JSONResponse) VisitGetSystemByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemByID404JSONResponse struct{ N404JSONResponse }
+
+func (response GetSystemByID404JSONResponse) VisitGetSystemByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemByID429Response = N429Response
+
+func (response GetSystemByID429Response) VisitGetSystemByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetSystemByID500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSystemByID500JSONResponse) VisitGetSystemByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlinkSystemActionRequestObject struct {
+	SystemID SystemIDPath `json:"systemID"`
+}
+
+type UnlinkSystemActionResponseObject interface {
+	VisitUnlinkSystemActionResponse(w http.ResponseWriter) error
+}
+
+type UnlinkSystemAction204Response struct {
+}
+
+func (response UnlinkSystemAction204Response) VisitUnlinkSystemActionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UnlinkSystemAction400JSONResponse struct{ N400JSONResponse }
+
+func (response UnlinkSystemAction400JSONResponse) VisitUnlinkSystemActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlinkSystemAction403JSONResponse struct{ N403JSONResponse }
+
+func (response UnlinkSystemAction403JSONResponse) VisitUnlinkSystemActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlinkSystemAction404JSONResponse struct{ N404JSONResponse }
+
+func (response UnlinkSystemAction404JSONResponse) VisitUnlinkSystemActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlinkSystemAction429Response = N429Response
+
+func (response UnlinkSystemAction429Response) VisitUnlinkSystemActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type UnlinkSystemAction500JSONResponse struct{ N500JSONResponse }
+
+func (response UnlinkSystemAction500JSONResponse) VisitUnlinkSystemActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkSystemActionRequestObject struct {
+	SystemID SystemIDPath `json:"systemID"`
+	Body     *LinkSystemActionApplicationMergePatchPlusJSONRequestBody
+}
+
+type LinkSystemActionResponseObject interface {
+	VisitLinkSystemActionResponse(w http.ResponseWriter) error
+}
+
+type LinkSystemAction200JSONResponse System
+
+func (response LinkSystemAction200JSONResponse) VisitLinkSystemActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkSystemAction400JSONResponse struct{ N400JSONResponse }
+
+func (response LinkSystemAction400JSONResponse) VisitLinkSystemActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkSystemAction403JSONResponse struct{ N403JSONResponse }
+
+func (response LinkSystemAction403JSONResponse) VisitLinkSystemActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkSystemAction409JSONResponse struct{ N409JSONResponse }
+
+func (response LinkSystemAction409JSONResponse) VisitLinkSystemActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkSystemAction429Response = N429Response
+
+func (response LinkSystemAction429Response) VisitLinkSystemActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type LinkSystemAction500JSONResponse struct{ N500JSONResponse }
+
+func (response LinkSystemAction500JSONResponse) VisitLinkSystemActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateSystemLinkRequestObject struct {
+	SystemID SystemIDPath `json:"systemID"`
+	Body     *ValidateSystemLinkJSONRequestBody
+}
+
+type ValidateSystemLinkResponseObject interface {
+	VisitValidateSystemLinkResponse(w http.ResponseWriter) error
+}
+
+type ValidateSystemLink200JSONResponse SystemLinkValidation
+
+func (response ValidateSystemLink200JSONResponse) VisitValidateSystemLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateSystemLink400JSONResponse struct{ N400JSONResponse }
+
+func (response ValidateSystemLink400JSONResponse) VisitValidateSystemLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateSystemLink403JSONResponse struct{ N403JSONResponse }
+
+func (response ValidateSystemLink403JSONResponse) VisitValidateSystemLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateSystemLink404JSONResponse struct{ N404JSONResponse }
+
+func (response ValidateSystemLink404JSONResponse) VisitValidateSystemLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateSystemLink429Response = N429Response
+
+func (response ValidateSystemLink429Response) VisitValidateSystemLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type ValidateSystemLink500JSONResponse struct{ N500JSONResponse }
+
+func (response ValidateSystemLink500JSONResponse) VisitValidateSystemLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecoveryActionsRequestObject struct {
+	SystemID SystemIDPath `json:"systemID"`
+}
+
+type GetRecoveryActionsResponseObject interface {
+	VisitGetRecoveryActionsResponse(w http.ResponseWriter) error
+}
+
+type GetRecoveryActions200JSONResponse SystemRecoveryAction
+
+func (response GetRecoveryActions200JSONResponse) VisitGetRecoveryActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecoveryActions400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRecoveryActions400JSONResponse) VisitGetRecoveryActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecoveryActions403JSONResponse struct{ N403JSONResponse }
+
+func (response GetRecoveryActions403JSONResponse) VisitGetRecoveryActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecoveryActions409JSONResponse struct{ N409JSONResponse }
+
+func (response GetRecoveryActions409JSONResponse) VisitGetRecoveryActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecoveryActions429Response = N429Response
+
+func (response GetRecoveryActions429Response) VisitGetRecoveryActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetRecoveryActions500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRecoveryActions500JSONResponse) VisitGetRecoveryActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendRecoveryActionsRequestObject struct {
+	SystemID SystemIDPath `json:"systemID"`
+	Body     *SendRecoveryActionsJSONRequestBody
+}
+
+type SendRecoveryActionsResponseObject interface {
+	VisitSendRecoveryActionsResponse(w http.ResponseWriter) error
+}
+
+type SendRecoveryActions200Response struct {
+}
+
+func (response SendRecoveryActions200Response) VisitSendRecoveryActionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type SendRecoveryActions400JSONResponse struct{ N400JSONResponse }
+
+func (response SendRecoveryActions400JSONResponse) VisitSendRecoveryActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendRecoveryActions403JSONResponse struct{ N403JSONResponse }
+
+func (response SendRecoveryActions403JSONResponse) VisitSendRecoveryActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendRecoveryActions409JSONResponse struct{ N409JSONResponse }
+
+func (response SendRecoveryActions409JSONResponse) VisitSendRecoveryActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendRecoveryActions429Response = N429Response
+
+func (response SendRecoveryActions429Response) VisitSendRecoveryActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type SendRecoveryActions500JSONResponse struct{ N500JSONResponse }
+
+func (response SendRecoveryActions500JSONResponse) VisitSendRecoveryActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantKeystoresRequestObject struct {
+}
+
+type GetTenantKeystoresResponseObject interface {
+	VisitGetTenantKeystoresResponse(w http.ResponseWriter) error
+}
+
+type GetTenantKeystores200JSONResponse TenantKeystore
+
+func (response GetTenantKeystores200JSONResponse) VisitGetTenantKeystoresResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantKeystores400JSONResponse struct{ N400JSONResponse }
+
+func (response GetTenantKeystores400JSONResponse) VisitGetTenantKeystoresResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantKeystores403JSONResponse struct{ N403JSONResponse }
+
+func (response GetTenantKeystores403JSONResponse) VisitGetTenantKeystoresResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantKeystores429Response = N429Response
+
+func (response GetTenantKeystores429Response) VisitGetTenantKeystoresResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetTenantKeystores500JSONResponse struct{ N500JSONResponse }
+
+func (response GetTenantKeystores500JSONResponse) VisitGetTenantKeystoresResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantSystemAutoLinkConfigurationRequestObject struct {
+}
+
+type GetTenantSystemAutoLinkConfigurationResponseObject interface {
+	VisitGetTenantSystemAutoLinkConfigurationResponse(w http.ResponseWriter) error
+}
+
+type GetTenantSystemAutoLinkConfiguration200JSONResponse TenantSystemAutoLinkConfiguration
+
+func (response GetTenantSystemAutoLinkConfiguration200JSONResponse) VisitGetTenantSystemAutoLinkConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantSystemAutoLinkConfiguration400JSONResponse struct{ N400JSONResponse }
+
+func (response GetTenantSystemAutoLinkConfiguration400JSONResponse) VisitGetTenantSystemAutoLinkConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantSystemAutoLinkConfiguration403JSONResponse struct{ N403JSONResponse }
+
+func (response GetTenantSystemAutoLinkConfiguration403JSONResponse) VisitGetTenantSystemAutoLinkConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantSystemAutoLinkConfiguration429Response = N429Response
+
+func (response GetTenantSystemAutoLinkConfiguration429Response) VisitGetTenantSystemAutoLinkConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetTenantSystemAutoLinkConfiguration500JSONResponse struct{ N500JSONResponse }
+
+func (response GetTenantSystemAutoLinkConfiguration500JSONResponse) VisitGetTenantSystemAutoLinkConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTenantSystemAutoLinkConfigurationRequestObject struct {
+	Body *UpdateTenantSystemAutoLinkConfigurationJSONRequestBody
+}
+
+type UpdateTenantSystemAutoLinkConfigurationResponseObject interface {
+	VisitUpdateTenantSystemAutoLinkConfigurationResponse(w http.ResponseWriter) error
+}
+
+type UpdateTenantSystemAutoLinkConfiguration200JSONResponse TenantSystemAutoLinkConfiguration
+
+func (response UpdateTenantSystemAutoLinkConfiguration200JSONResponse) VisitUpdateTenantSystemAutoLinkConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTenantSystemAutoLinkConfiguration400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateTenantSystemAutoLinkConfiguration400JSONResponse) VisitUpdateTenantSystemAutoLinkConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTenantSystemAutoLinkConfiguration403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateTenantSystemAutoLinkConfiguration403JSONResponse) VisitUpdateTenantSystemAutoLinkConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTenantSystemAutoLinkConfiguration404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateTenantSystemAutoLinkConfiguration404JSONResponse) VisitUpdateTenantSystemAutoLinkConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTenantSystemAutoLinkConfiguration429Response = N429Response
+
+func (response UpdateTenantSystemAutoLinkConfiguration429Response) VisitUpdateTenantSystemAutoLinkConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type UpdateTenantSystemAutoLinkConfiguration500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateTenantSystemAutoLinkConfiguration500JSONResponse) VisitUpdateTenantSystemAutoLinkConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantWorkflowConfigurationRequestObject struct {
+}
+
+type GetTenantWorkflowConfigurationResponseObject interface {
+	VisitGetTenantWorkflowConfigurationResponse(w http.ResponseWriter) error
+}
+
+type GetTenantWorkflowConfiguration200JSONResponse TenantWorkflowConfiguration
+
+func (response GetTenantWorkflowConfiguration200JSONResponse) VisitGetTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantWorkflowConfiguration400JSONResponse struct{ N400JSONResponse }
+
+func (response GetTenantWorkflowConfiguration400JSONResponse) VisitGetTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantWorkflowConfiguration403JSONResponse struct{ N403JSONResponse }
+
+func (response GetTenantWorkflowConfiguration403JSONResponse) VisitGetTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantWorkflowConfiguration429Response = N429Response
+
+func (response GetTenantWorkflowConfiguration429Response) VisitGetTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetTenantWorkflowConfiguration500JSONResponse struct{ N500JSONResponse }
+
+func (response GetTenantWorkflowConfiguration500JSONResponse) VisitGetTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTenantWorkflowConfigurationRequestObject struct {
+	Body *UpdateTenantWorkflowConfigurationApplicationMergePatchPlusJSONRequestBody
+}
+
+type UpdateTenantWorkflowConfigurationResponseObject interface {
+	VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error
+}
+
+type UpdateTenantWorkflowConfiguration200JSONResponse TenantWorkflowConfiguration
+
+func (response UpdateTenantWorkflowConfiguration200JSONResponse) VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTenantWorkflowConfiguration400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateTenantWorkflowConfiguration400JSONResponse) VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTenantWorkflowConfiguration403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateTenantWorkflowConfiguration403JSONResponse) VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTenantWorkflowConfiguration404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateTenantWorkflowConfiguration404JSONResponse) VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTenantWorkflowConfiguration409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdateTenantWorkflowConfiguration409JSONResponse) VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTenantWorkflowConfiguration429Response = N429Response
+
+func (response UpdateTenantWorkflowConfiguration429Response) VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type UpdateTenantWorkflowConfiguration500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateTenantWorkflowConfiguration500JSONResponse) VisitUpdateTenantWorkflowConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantInfoRequestObject struct {
+}
+
+type GetTenantInfoResponseObject interface {
+	VisitGetTenantInfoResponse(w http.ResponseWriter) error
+}
+
+type GetTenantInfo200JSONResponse Tenant
+
+func (response GetTenantInfo200JSONResponse) VisitGetTenantInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantInfo400JSONResponse struct{ N400JSONResponse }
+
+func (response GetTenantInfo400JSONResponse) VisitGetTenantInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantInfo403JSONResponse struct{ N403JSONResponse }
+
+func (response GetTenantInfo403JSONResponse) VisitGetTenantInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantInfo404JSONResponse struct{ N404JSONResponse }
+
+func (response GetTenantInfo404JSONResponse) VisitGetTenantInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantInfo429Response = N429Response
+
+func (response GetTenantInfo429Response) VisitGetTenantInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetTenantInfo500JSONResponse struct{ N500JSONResponse }
+
+func (response GetTenantInfo500JSONResponse) VisitGetTenantInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantsRequestObject struct {
+	Params GetTenantsParams
+}
+
+type GetTenantsResponseObject interface {
+	VisitGetTenantsResponse(w http.ResponseWriter) error
+}
+
+type GetTenants200JSONResponse TenantList
+
+func (response GetTenants200JSONResponse) VisitGetTenantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenants400JSONResponse struct{ N400JSONResponse }
+
+func (response GetTenants400JSONResponse) VisitGetTenantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenants403JSONResponse struct{ N403JSONResponse }
+
+func (response GetTenants403JSONResponse) VisitGetTenantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenants429Response = N429Response
+
+func (response GetTenants429Response) VisitGetTenantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetTenants500JSONResponse struct{ N500JSONResponse }
+
+func (response GetTenants500JSONResponse) VisitGetTenantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserInfoRequestObject struct {
+}
+
+type GetUserInfoResponseObject interface {
+	VisitGetUserInfoResponse(w http.ResponseWriter) error
+}
+
+type GetUserInfo200JSONResponse UserInfo
+
+func (response GetUserInfo200JSONResponse) VisitGetUserInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserInfo404JSONResponse struct{ N404JSONResponse }
+
+func (response GetUserInfo404JSONResponse) VisitGetUserInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserInfo429Response = N429Response
+
+func (response GetUserInfo429Response) VisitGetUserInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetUserInfo500JSONResponse struct{ N500JSONResponse }
+
+func (response GetUserInfo500JSONResponse) VisitGetUserInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowsRequestObject struct {
+	Params GetWorkflowsParams
+}
+
+type GetWorkflowsResponseObject interface {
+	VisitGetWorkflowsResponse(w http.ResponseWriter) error
+}
+
+type GetWorkflows200JSONResponse WorkflowList
+
+func (response GetWorkflows200JSONResponse) VisitGetWorkflowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflows400JSONResponse struct{ N400JSONResponse }
+
+func (response GetWorkflows400JSONResponse) VisitGetWorkflowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflows403JSONResponse struct{ N403JSONResponse }
+
+func (response GetWorkflows403JSONResponse) VisitGetWorkflowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflows429Response = N429Response
+
+func (response GetWorkflows429Response) VisitGetWorkflowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetWorkflows500JSONResponse struct{ N500JSONResponse }
+
+func (response GetWorkflows500JSONResponse) VisitGetWorkflowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowRequestObject struct {
+	Body *CreateWorkflowJSONRequestBody
+}
+
+type CreateWorkflowResponseObject interface {
+	VisitCreateWorkflowResponse(w http.ResponseWriter) error
+}
+
+type CreateWorkflow201JSONResponse Workflow
+
+func (response CreateWorkflow201JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflow400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateWorkflow400JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflow403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateWorkflow403JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflow404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateWorkflow404JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflow409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateWorkflow409JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflow429Response = N429Response
+
+func (response CreateWorkflow429Response) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type CreateWorkflow500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateWorkflow500JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckWorkflowRequestObject struct {
+	Body *CheckWorkflowJSONRequestBody
+}
+
+type CheckWorkflowResponseObject interface {
+	VisitCheckWorkflowResponse(w http.ResponseWriter) error
+}
+
+type CheckWorkflow200JSONResponse WorkflowCheck
+
+func (response CheckWorkflow200JSONResponse) VisitCheckWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckWorkflow400JSONResponse struct{ N400JSONResponse }
+
+func (response CheckWorkflow400JSONResponse) VisitCheckWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckWorkflow403JSONResponse struct{ N403JSONResponse }
+
+func (response CheckWorkflow403JSONResponse) VisitCheckWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckWorkflow404JSONResponse struct{ N404JSONResponse }
+
+func (response CheckWorkflow404JSONResponse) VisitCheckWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckWorkflow409JSONResponse struct{ N409JSONResponse }
+
+func (response CheckWorkflow409JSONResponse) VisitCheckWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckWorkflow429Response = N429Response
+
+func (response CheckWorkflow429Response) VisitCheckWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type CheckWorkflow500JSONResponse struct{ N500JSONResponse }
+
+func (response CheckWorkflow500JSONResponse) VisitCheckWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowByIDRequestObject struct {
+	WorkflowID WorkflowIDPath `json:"workflowID"`
+}
+
+type GetWorkflowByIDResponseObject interface {
+	VisitGetWorkflowByIDResponse(w http.ResponseWriter) error
+}
+
+type GetWorkflowByID200JSONResponse Workflow
+
+func (response GetWorkflowByID200JSONResponse) VisitGetWorkflowByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowByID400JSONResponse struct{ N400JSONResponse }
+
+func (response GetWorkflowByID400JSONResponse) VisitGetWorkflowByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowByID403JSONResponse struct{ N403JSONResponse }
+
+func (response GetWorkflowByID403JSONResponse) VisitGetWorkflowByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowByID404JSONResponse struct{ N404JSONResponse }
+
+func (response GetWorkflowByID404JSONResponse) VisitGetWorkflowByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowByID429Response = N429Response
+
+func (response GetWorkflowByID429Response) VisitGetWorkflowByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetWorkflowByID500JSONResponse struct{ N500JSONResponse }
+
+func (response GetWorkflowByID500JSONResponse) VisitGetWorkflowByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransitionWorkflowRequestObject struct {
+	WorkflowID WorkflowIDPath `json:"workflowID"`
+	Body       *TransitionWorkflowJSONRequestBody
+}
+
+type TransitionWorkflowResponseObject interface {
+	VisitTransitionWorkflowResponse(w http.ResponseWriter) error
+}
+
+type TransitionWorkflow200JSONResponse Workflow
+
+func (response TransitionWorkflow200JSONResponse) VisitTransitionWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransitionWorkflow400JSONResponse struct{ N400JSONResponse }
+
+func (response TransitionWorkflow400JSONResponse) VisitTransitionWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransitionWorkflow403JSONResponse struct{ N403JSONResponse }
+
+func (response TransitionWorkflow403JSONResponse) VisitTransitionWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransitionWorkflow404JSONResponse struct{ N404JSONResponse }
+
+func (response TransitionWorkflow404JSONResponse) VisitTransitionWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransitionWorkflow429Response = N429Response
+
+func (response TransitionWorkflow429Response) VisitTransitionWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+	return nil
+}
+
+type TransitionWorkflow500JSONResponse struct{ N500JSONResponse }
+
+func (response TransitionWorkflow500JSONResponse) VisitTransitionWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get API Tokens
+	// (GET /apiTokens)
+	GetAPITokens(ctx context.Context, request GetAPITokensRequestObject) (GetAPITokensResponseObject, error)
+	// Create an API Token
+	// (POST /apiTokens)
+	CreateAPIToken(ctx context.Context, request CreateAPITokenRequestObject) (CreateAPITokenResponseObject, error)
+	// Revoke an API Token
+	// (DELETE /apiTokens/{tokenID})
+	RevokeAPIToken(ctx context.Context, request RevokeAPITokenRequestObject) (RevokeAPITokenResponseObject, error)
+	// Get an API Token
+	// (GET /apiTokens/{tokenID})
+	GetAPITokenByID(ctx context.Context, request GetAPITokenByIDRequestObject) (GetAPITokenByIDResponseObject, error)
+	// Retrieve all Audit Events
+	// (GET /auditEvents)
+	GetAuditEvents(ctx context.Context, request GetAuditEventsRequestObject) (GetAuditEventsResponseObject, error)
+	// Explain an authorization decision
+	// (POST /authz/explain)
+	ExplainAuthz(ctx context.Context, request ExplainAuthzRequestObject) (ExplainAuthzResponseObject, error)
+	// Get keystore capabilities
+	// (GET /capabilities)
+	GetCapabilities(ctx context.Context, request GetCapabilitiesRequestObject) (GetCapabilitiesResponseObject, error)
+	// Get Groups
+	// (GET /groups)
+	GetGroups(ctx context.Context, request GetGroupsRequestObject) (GetGroupsResponseObject, error)
+	// Create a new Group
+	// (POST /groups)
+	CreateGroup(ctx context.Context, request CreateGroupRequestObject) (CreateGroupResponseObject, error)
+	// Check groups existence in IAM provider
+	// (POST /groups/iamCheck)
+	CheckGroupsIAM(ctx context.Context, request CheckGroupsIAMRequestObject) (CheckGroupsIAMResponseObject, error)
+	// Delete a Group by its ID
+	// (DELETE /groups/{groupID})
+	DeleteGroupByID(ctx context.Context, request DeleteGroupByIDRequestObject) (DeleteGroupByIDResponseObject, error)
+	// Get Group by ID
+	// (GET /groups/{groupID})
+	GetGroupByID(ctx context.Context, request GetGroupByIDRequestObject) (GetGroupByIDResponseObject, error)
+	// Update group
+	// (PATCH /groups/{groupID})
+	UpdateGroup(ctx context.Context, request UpdateGroupRequestObject) (UpdateGroupResponseObject, error)
+	// Get Resource Grants of a Group
+	// (GET /groups/{groupID}/grants)
+	GetGroupGrants(ctx context.Context, request GetGroupGrantsRequestObject) (GetGroupGrantsResponseObject, error)
+	// Create a Resource Grant for a Group
+	// (POST /groups/{groupID}/grants)
+	CreateGroupGrant(ctx context.Context, request CreateGroupGrantRequestObject) (CreateGroupGrantResponseObject, error)
+	// Delete a Resource Grant of a Group
+	// (DELETE /groups/{groupID}/grants/{grantID})
+	DeleteGroupGrant(ctx context.Context, request DeleteGroupGrantRequestObject) (DeleteGroupGrantResponseObject, error)
+	// Delete a specified Label from a Key
+	// (DELETE /key/{keyID}/label/{labelName})
+	DeleteLabel(ctx context.Context, request DeleteLabelRequestObject) (DeleteLabelResponseObject, error)
+	// Get the Labels associated with the Key
+	// (GET /key/{keyID}/labels)
+	GetKeyLabels(ctx context.Context, request GetKeyLabelsRequestObject) (GetKeyLabelsResponseObject, error)
+	// Create and update Labels
+	// (POST /key/{keyID}/labels)
+	CreateOrUpdateLabels(ctx context.Context, request CreateOrUpdateLabelsRequestObject) (CreateOrUpdateLabelsResponseObject, error)
+	// Get all Key Configurations
+	// (GET /keyConfigurations)
+	GetKeyConfigurations(ctx context.Context, request GetKeyConfigurationsRequestObject) (GetKeyConfigurationsResponseObject, error)
+	// Create a new Key Configuration
+	// (POST /keyConfigurations)
 	PostKeyConfigurations(ctx context.Context, request PostKeyConfigurationsRequestObject) (PostKeyConfigurationsResponseObject, error)
 	// Delete an Key Configuration by its ID
 	// (DELETE /keyConfigurations/{keyConfigurationID})
@@ -5837,6 +8933,45 @@ type StrictServerInterface interface {
 	// Get metadata of all Key Versions by Key ID
 	// (GET /keys/{keyID}/versions)
 	GetKeyVersions(ctx context.Context, request GetKeyVersionsRequestObject) (GetKeyVersionsResponseObject, error)
+	// Get Custom Roles
+	// (GET /roles)
+	GetRoles(ctx context.Context, request GetRolesRequestObject) (GetRolesResponseObject, error)
+	// Create a new Custom Role
+	// (POST /roles)
+	CreateRole(ctx context.Context, request CreateRoleRequestObject) (CreateRoleResponseObject, error)
+	// Delete a Custom Role by its ID
+	// (DELETE /roles/{roleID})
+	DeleteRoleByID(ctx context.Context, request DeleteRoleByIDRequestObject) (DeleteRoleByIDResponseObject, error)
+	// Get Custom Role by ID
+	// (GET /roles/{roleID})
+	GetRoleByID(ctx context.Context, request GetRoleByIDRequestObject) (GetRoleByIDResponseObject, error)
+	// Update Custom Role
+	// (PATCH /roles/{roleID})
+	UpdateRole(ctx context.Context, request UpdateRoleRequestObject) (UpdateRoleResponseObject, error)
+	// Retrieve all System Groups
+	// (GET /systemGroups)
+	GetSystemGroups(ctx context.Context, request GetSystemGroupsRequestObject) (GetSystemGroupsResponseObject, error)
+	// Create a System Group
+	// (POST /systemGroups)
+	CreateSystemGroup(ctx context.Context, request CreateSystemGroupRequestObject) (CreateSystemGroupResponseObject, error)
+	// Delete a System Group
+	// (DELETE /systemGroups/{systemGroupID})
+	DeleteSystemGroup(ctx context.Context, request DeleteSystemGroupRequestObject) (DeleteSystemGroupResponseObject, error)
+	// Retrieve a System Group
+	// (GET /systemGroups/{systemGroupID})
+	GetSystemGroupByID(ctx context.Context, request GetSystemGroupByIDRequestObject) (GetSystemGroupByIDResponseObject, error)
+	// Update a System Group
+	// (PATCH /systemGroups/{systemGroupID})
+	UpdateSystemGroup(ctx context.Context, request UpdateSystemGroupRequestObject) (UpdateSystemGroupResponseObject, error)
+	// Delete the link of all Systems of a System Group
+	// (DELETE /systemGroups/{systemGroupID}/link)
+	UnlinkSystemGroupAction(ctx context.Context, request UnlinkSystemGroupActionRequestObject) (UnlinkSystemGroupActionResponseObject, error)
+	// Update the link of all Systems of a System Group
+	// (PATCH /systemGroups/{systemGroupID}/link)
+	LinkSystemGroupAction(ctx context.Context, request LinkSystemGroupActionRequestObject) (LinkSystemGroupActionResponseObject, error)
+	// Retrieve the Systems of a System Group
+	// (GET /systemGroups/{systemGroupID}/systems)
+	GetSystemGroupSystems(ctx context.Context, request GetSystemGroupSystemsRequestObject) (GetSystemGroupSystemsResponseObject, error)
 	// Retrieve all Systems
 	// (GET /systems)
 	GetAllSystems(ctx context.Context, request GetAllSystemsRequestObject) (GetAllSystemsResponseObject, error)
@@ -5852,6 +8987,9 @@ type StrictServerInterface interface {
 	// Update a System link
 	// (PATCH /systems/{systemID}/link)
 	LinkSystemAction(ctx context.Context, request LinkSystemActionRequestObject) (LinkSystemActionResponseObject, error)
+	// Validate a System link
+	// (POST /systems/{systemID}/link:validate)
+	ValidateSystemLink(ctx context.Context, request ValidateSystemLinkRequestObject) (ValidateSystemLinkResponseObject, error)
 	// Possible recovery action
 	// (GET /systems/{systemID}/recoveryActions)
 	GetRecoveryActions(ctx context.Context, request GetRecoveryActionsRequestObject) (GetRecoveryActionsResponseObject, error)
@@ -5861,6 +8999,12 @@ type StrictServerInterface interface {
 	// Get tenant keystores
 	// (GET /tenantConfigurations/keystores)
 	GetTenantKeystores(ctx context.Context, request GetTenantKeystoresRequestObject) (GetTenantKeystoresResponseObject, error)
+	// Get tenant system auto-link configuration
+	// (GET /tenantConfigurations/systemAutoLink)
+	GetTenantSystemAutoLinkConfiguration(ctx context.Context, request GetTenantSystemAutoLinkConfigurationRequestObject) (GetTenantSystemAutoLinkConfigurationResponseObject, error)
+	// Replace tenant system auto-link configuration
+	// (PUT /tenantConfigurations/systemAutoLink)
+	UpdateTenantSystemAutoLinkConfiguration(ctx context.Context, request UpdateTenantSystemAutoLinkConfigurationRequestObject) (UpdateTenantSystemAutoLinkConfigurationResponseObject, error)
 	// Get tenant workflow configuration
 	// (GET /tenantConfigurations/workflow)
 	GetTenantWorkflowConfiguration(ctx context.Context, request GetTenantWorkflowConfigurationRequestObject) (GetTenantWorkflowConfigurationResponseObject, error)
@@ -5893,54 +9037,651 @@ type StrictServerInterface interface {
 	TransitionWorkflow(ctx context.Context, request TransitionWorkflowRequestObject) (TransitionWorkflowResponseObject, error)
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAPITokens operation middleware
+func (sh *strictHandler) GetAPITokens(w http.ResponseWriter, r *http.Request, params GetAPITokensParams) {
+	var request GetAPITokensRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPITokens(ctx, request.(GetAPITokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPITokens")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPITokensResponseObject); ok {
+		if err := validResponse.VisitGetAPITokensResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAPIToken operation middleware
+func (sh *strictHandler) CreateAPIToken(w http.ResponseWriter, r *http.Request) {
+	var request CreateAPITokenRequestObject
+
+	var body CreateAPITokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAPIToken(ctx, request.(CreateAPITokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAPIToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAPITokenResponseObject); ok {
+		if err := validResponse.VisitCreateAPITokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeAPIToken operation middleware
+func (sh *strictHandler) RevokeAPIToken(w http.ResponseWriter, r *http.Request, tokenID TokenIDPath) {
+	var request RevokeAPITokenRequestObject
+
+	request.TokenID = tokenID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeAPIToken(ctx, request.(RevokeAPITokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeAPIToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeAPITokenResponseObject); ok {
+		if err := validResponse.VisitRevokeAPITokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPITokenByID operation middleware
+func (sh *strictHandler) GetAPITokenByID(w http.ResponseWriter, r *http.Request, tokenID TokenIDPath) {
+	var request GetAPITokenByIDRequestObject
+
+	request.TokenID = tokenID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPITokenByID(ctx, request.(GetAPITokenByIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPITokenByID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPITokenByIDResponseObject); ok {
+		if err := validResponse.VisitGetAPITokenByIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuditEvents operation middleware
+func (sh *strictHandler) GetAuditEvents(w http.ResponseWriter, r *http.Request, params GetAuditEventsParams) {
+	var request GetAuditEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuditEvents(ctx, request.(GetAuditEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuditEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuditEventsResponseObject); ok {
+		if err := validResponse.VisitGetAuditEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExplainAuthz operation middleware
+func (sh *strictHandler) ExplainAuthz(w http.ResponseWriter, r *http.Request) {
+	var request ExplainAuthzRequestObject
+
+	var body ExplainAuthzJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExplainAuthz(ctx, request.(ExplainAuthzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExplainAuthz")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExplainAuthzResponseObject); ok {
+		if err := validResponse.VisitExplainAuthzResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCapabilities operation middleware
+func (sh *strictHandler) GetCapabilities(w http.ResponseWriter, r *http.Request) {
+	var request GetCapabilitiesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCapabilities(ctx, request.(GetCapabilitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCapabilities")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCapabilitiesResponseObject); ok {
+		if err := validResponse.VisitGetCapabilitiesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetGroups operation middleware
+func (sh *strictHandler) GetGroups(w http.ResponseWriter, r *http.Request, params GetGroupsParams) {
+	var request GetGroupsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGroups(ctx, request.(GetGroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGroups")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetGroupsResponseObject); ok {
+		if err := validResponse.VisitGetGroupsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateGroup operation middleware
+func (sh *strictHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	var request CreateGroupRequestObject
+
+	var body CreateGroupJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateGroup(ctx, request.(CreateGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateGroupResponseObject); ok {
+		if err := validResponse.VisitCreateGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CheckGroupsIAM operation middleware
+func (sh *strictHandler) CheckGroupsIAM(w http.ResponseWriter, r *http.Request) {
+	var request CheckGroupsIAMRequestObject
+
+	var body CheckGroupsIAMJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CheckGroupsIAM(ctx, request.(CheckGroupsIAMRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CheckGroupsIAM")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CheckGroupsIAMResponseObject); ok {
+		if err := validResponse.VisitCheckGroupsIAMResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteGroupByID operation middleware
+func (sh *strictHandler) DeleteGroupByID(w http.ResponseWriter, r *http.Request, groupID GroupIDPath) {
+	var request DeleteGroupByIDRequestObject
+
+	request.GroupID = groupID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteGroupByID(ctx, request.(DeleteGroupByIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteGroupByID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteGroupByIDResponseObject); ok {
+		if err := validResponse.VisitDeleteGroupByIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetGroupByID operation middleware
+func (sh *strictHandler) GetGroupByID(w http.ResponseWriter, r *http.Request, groupID GroupIDPath) {
+	var request GetGroupByIDRequestObject
+
+	request.GroupID = groupID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGroupByID(ctx, request.(GetGroupByIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGroupByID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetGroupByIDResponseObject); ok {
+		if err := validResponse.VisitGetGroupByIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateGroup operation middleware
+func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, groupID GroupIDPath) {
+	var request UpdateGroupRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	request.GroupID = groupID
+
+	var body UpdateGroupApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		if !errors.Is(err, io.EOF) {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+	} else {
+		request.Body = &body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateGroup(ctx, request.(UpdateGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateGroupResponseObject); ok {
+		if err := validResponse.VisitUpdateGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// GetGroupGrants operation middleware
+func (sh *strictHandler) GetGroupGrants(w http.ResponseWriter, r *http.Request, groupID GroupIDPath, params GetGroupGrantsParams) {
+	var request GetGroupGrantsRequestObject
+
+	request.GroupID = groupID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGroupGrants(ctx, request.(GetGroupGrantsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGroupGrants")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetGroupGrantsResponseObject); ok {
+		if err := validResponse.VisitGetGroupGrantsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// CreateGroupGrant operation middleware
+func (sh *strictHandler) CreateGroupGrant(w http.ResponseWriter, r *http.Request, groupID GroupIDPath) {
+	var request CreateGroupGrantRequestObject
+
+	request.GroupID = groupID
+
+	var body CreateGroupGrantJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateGroupGrant(ctx, request.(CreateGroupGrantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateGroupGrant")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateGroupGrantResponseObject); ok {
+		if err := validResponse.VisitCreateGroupGrantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// DeleteGroupGrant operation middleware
+func (sh *strictHandler) DeleteGroupGrant(w http.ResponseWriter, r *http.Request, groupID GroupIDPath, grantID GrantIDPath) {
+	var request DeleteGroupGrantRequestObject
+
+	request.GroupID = groupID
+	request.GrantID = grantID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteGroupGrant(ctx, request.(DeleteGroupGrantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteGroupGrant")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteGroupGrantResponseObject); ok {
+		if err := validResponse.VisitDeleteGroupGrantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteLabel operation middleware
+func (sh *strictHandler) DeleteLabel(w http.ResponseWriter, r *http.Request, keyID KeyIDPath, labelName string) {
+	var request DeleteLabelRequestObject
+
+	request.KeyID = keyID
+	request.LabelName = labelName
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteLabel(ctx, request.(DeleteLabelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteLabel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteLabelResponseObject); ok {
+		if err := validResponse.VisitDeleteLabelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetKeyLabels operation middleware
+func (sh *strictHandler) GetKeyLabels(w http.ResponseWriter, r *http.Request, keyID KeyIDPath, params GetKeyLabelsParams) {
+	var request GetKeyLabelsRequestObject
+
+	request.KeyID = keyID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetKeyLabels(ctx, request.(GetKeyLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetKeyLabels")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetKeyLabelsResponseObject); ok {
+		if err := validResponse.VisitGetKeyLabelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateOrUpdateLabels operation middleware
+func (sh *strictHandler) CreateOrUpdateLabels(w http.ResponseWriter, r *http.Request, keyID KeyIDPath) {
+	var request CreateOrUpdateLabelsRequestObject
+
+	request.KeyID = keyID
+
+	var body CreateOrUpdateLabelsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOrUpdateLabels(ctx, request.(CreateOrUpdateLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOrUpdateLabels")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateOrUpdateLabelsResponseObject); ok {
+		if err := validResponse.VisitCreateOrUpdateLabelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetKeyConfigurations operation middleware
+func (sh *strictHandler) GetKeyConfigurations(w http.ResponseWriter, r *http.Request, params GetKeyConfigurationsParams) {
+	var request GetKeyConfigurationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetKeyConfigurations(ctx, request.(GetKeyConfigurationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetKeyConfigurations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetKeyConfigurationsResponseObject); ok {
+		if err := validResponse.VisitGetKeyConfigurationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostKeyConfigurations operation middleware
+func (sh *strictHandler) PostKeyConfigurations(w http.ResponseWriter, r *http.Request) {
+	var request PostKeyConfigurationsRequestObject
+
+	var body PostKeyConfigurationsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostKeyConfigurations(ctx, request.(PostKeyConfigurationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostKeyConfigurations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostKeyConfigurationsResponseObject); ok {
+		if err := validResponse.VisitPostKeyConfigurationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetGroups operation middleware
-func (sh *strictHandler) GetGroups(w http.ResponseWriter, r *http.Request, params GetGroupsParams) {
-	var request GetGroupsRequestObject
+// DeleteKeyConfigurationByID operation middleware
+func (sh *strictHandler) DeleteKeyConfigurationByID(w http.ResponseWriter, r *http.Request, keyConfigurationID KeyConfigurationIDPath) {
+	var request DeleteKeyConfigurationByIDRequestObject
 
-	request.Params = params
+	request.KeyConfigurationID = keyConfigurationID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetGroups(ctx, request.(GetGroupsRequestObject))
+		return sh.ssi.DeleteKeyConfigurationByID(ctx, request.(DeleteKeyConfigurationByIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetGroups")
+		handler = middleware(handler, "DeleteKeyConfigurationByID")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetGroupsResponseObject); ok {
-		if err := validResponse.VisitGetGroupsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteKeyConfigurationByIDResponseObject); ok {
+		if err := validResponse.VisitDeleteKeyConfigurationByIDResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5948,30 +9689,25 @@ func (sh *strictHandler) GetGroups(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// CreateGroup operation middleware
-func (sh *strictHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
-	var request CreateGroupRequestObject
+// GetKeyConfigurationByID operation middleware
+func (sh *strictHandler) GetKeyConfigurationByID(w http.ResponseWriter, r *http.Request, keyConfigurationID KeyConfigurationIDPath) {
+	var request GetKeyConfigurationByIDRequestObject
 
-	var body CreateGroupJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.KeyConfigurationID = keyConfigurationID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateGroup(ctx, request.(CreateGroupRequestObject))
+		return sh.ssi.GetKeyConfigurationByID(ctx, request.(GetKeyConfigurationByIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateGroup")
+		handler = middleware(handler, "GetKeyConfigurationByID")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateGroupResponseObject); ok {
-		if err := validResponse.VisitCreateGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetKeyConfigurationByIDResponseObject); ok {
+		if err := validResponse.VisitGetKeyConfigurationByIDResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5979,30 +9715,35 @@ func (sh *strictHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CheckGroupsIAM operation middleware
-func (sh *strictHandler) CheckGroupsIAM(w http.ResponseWriter, r *http.Request) {
-	var request CheckGroupsIAMRequestObject
+// UpdateKeyConfigurationByID operation middleware
+func (sh *strictHandler) UpdateKeyConfigurationByID(w http.ResponseWriter, r *http.Request, keyConfigurationID KeyConfigurationIDPath) {
+	var request UpdateKeyConfigurationByIDRequestObject
 
-	var body CheckGroupsIAMJSONRequestBody
+	request.KeyConfigurationID = keyConfigurationID
+
+	var body UpdateKeyConfigurationByIDApplicationMergePatchPlusJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+		if !errors.Is(err, io.EOF) {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+	} else {
+		request.Body = &body
 	}
-	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CheckGroupsIAM(ctx, request.(CheckGroupsIAMRequestObject))
+		return sh.ssi.UpdateKeyConfigurationByID(ctx, request.(UpdateKeyConfigurationByIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CheckGroupsIAM")
+		handler = middleware(handler, "UpdateKeyConfigurationByID")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CheckGroupsIAMResponseObject); ok {
-		if err := validResponse.VisitCheckGroupsIAMResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateKeyConfigurationByIDResponseObject); ok {
+		if err := validResponse.VisitUpdateKeyConfigurationByIDResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6010,25 +9751,25 @@ func (sh *strictHandler) CheckGroupsIAM(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// DeleteGroupByID operation middleware
-func (sh *strictHandler) DeleteGroupByID(w http.ResponseWriter, r *http.Request, groupID GroupIDPath) {
-	var request DeleteGroupByIDRequestObject
+// GetKeyConfigurationCertificates operation middleware
+func (sh *strictHandler) GetKeyConfigurationCertificates(w http.ResponseWriter, r *http.Request, keyConfigurationID KeyConfigurationIDPath) {
+	var request GetKeyConfigurationCertificatesRequestObject
 
-	request.GroupID = groupID
+	request.KeyConfigurationID = keyConfigurationID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteGroupByID(ctx, request.(DeleteGroupByIDRequestObject))
+		return sh.ssi.GetKeyConfigurationCertificates(ctx, request.(GetKeyConfigurationCertificatesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteGroupByID")
+		handler = middleware(handler, "GetKeyConfigurationCertificates")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteGroupByIDResponseObject); ok {
-		if err := validResponse.VisitDeleteGroupByIDResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetKeyConfigurationCertificatesResponseObject); ok {
+		if err := validResponse.VisitGetKeyConfigurationCertificatesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6036,25 +9777,26 @@ func (sh *strictHandler) DeleteGroupByID(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetGroupByID operation middleware
-func (sh *strictHandler) GetGroupByID(w http.ResponseWriter, r *http.Request, groupID GroupIDPath) {
-	var request GetGroupByIDRequestObject
+// GetTagsForKeyConfiguration operation middleware
+func (sh *strictHandler) GetTagsForKeyConfiguration(w http.ResponseWriter, r *http.Request, keyConfigurationID KeyConfigurationIDPath, params GetTagsForKeyConfigurationParams) {
+	var request GetTagsForKeyConfigurationRequestObject
 
-	request.GroupID = groupID
+	request.KeyConfigurationID = keyConfigurationID
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetGroupByID(ctx, request.(GetGroupByIDRequestObject))
+		return sh.ssi.GetTagsForKeyConfiguration(ctx, request.(GetTagsForKeyConfigurationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetGroupByID")
+		handler = middleware(handler, "GetTagsForKeyConfiguration")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetGroupByIDResponseObject); ok {
-		if err := validResponse.VisitGetGroupByIDResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTagsForKeyConfigurationResponseObject); ok {
+		if err := validResponse.VisitGetTagsForKeyConfigurationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6062,13 +9804,13 @@ func (sh *strictHandler) GetGroupByID(w http.ResponseWriter, r *http.Request, gr
 	}
 }
 
-// UpdateGroup operation middleware
-func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, groupID GroupIDPath) {
-	var request UpdateGroupRequestObject
+// AddTagsToKeyConfiguration operation middleware
+func (sh *strictHandler) AddTagsToKeyConfiguration(w http.ResponseWriter, r *http.Request, keyConfigurationID KeyConfigurationIDPath) {
+	var request AddTagsToKeyConfigurationRequestObject
 
-	request.GroupID = groupID
+	request.KeyConfigurationID = keyConfigurationID
 
-	var body UpdateGroupApplicationMergePatchPlusJSONRequestBody
+	var body AddTagsToKeyConfigurationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		if !errors.Is(err, io.EOF) {
 			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
@@ -6079,18 +9821,18 @@ func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, gro
 	}
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateGroup(ctx, request.(UpdateGroupRequestObject))
+		return sh.ssi.AddTagsToKeyConfiguration(ctx, request.(AddTagsToKeyConfigurationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateGroup")
+		handler = middleware(handler, "AddTagsToKeyConfiguration")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateGroupResponseObject); ok {
-		if err := validResponse.VisitUpdateGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(AddTagsToKeyConfigurationResponseObject); ok {
+		if err := validResponse.VisitAddTagsToKeyConfigurationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6098,26 +9840,82 @@ func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, gro
 	}
 }
 
-// DeleteLabel operation middleware
-func (sh *strictHandler) DeleteLabel(w http.ResponseWriter, r *http.Request, keyID KeyIDPath, labelName string) {
-	var request DeleteLabelRequestObject
+// GetKeys operation middleware
+func (sh *strictHandler) GetKeys(w http.ResponseWriter, r *http.Request, params GetKeysParams) {
+	var request GetKeysRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetKeys(ctx, request.(GetKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetKeysResponseObject); ok {
+		if err := validResponse.VisitGetKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostKeys operation middleware
+func (sh *strictHandler) PostKeys(w http.ResponseWriter, r *http.Request) {
+	var request PostKeysRequestObject
+
+	var body PostKeysJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostKeys(ctx, request.(PostKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostKeysResponseObject); ok {
+		if err := validResponse.VisitPostKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteKeysKeyID operation middleware
+func (sh *strictHandler) DeleteKeysKeyID(w http.ResponseWriter, r *http.Request, keyID KeyIDPath) {
+	var request DeleteKeysKeyIDRequestObject
 
 	request.KeyID = keyID
-	request.LabelName = labelName
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteLabel(ctx, request.(DeleteLabelRequestObject))
+		return sh.ssi.DeleteKeysKeyID(ctx, request.(DeleteKeysKeyIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteLabel")
+		handler = middleware(handler, "DeleteKeysKeyID")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteLabelResponseObject); ok {
-		if err := validResponse.VisitDeleteLabelResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteKeysKeyIDResponseObject); ok {
+		if err := validResponse.VisitDeleteKeysKeyIDResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6125,26 +9923,25 @@ func (sh *strictHandler) DeleteLabel(w http.ResponseWriter, r *http.Request, key
 	}
 }
 
-// GetKeyLabels operation middleware
-func (sh *strictHandler) GetKeyLabels(w http.ResponseWriter, r *http.Request, keyID KeyIDPath, params GetKeyLabelsParams) {
-	var request GetKeyLabelsRequestObject
+// GetKeysKeyID operation middleware
+func (sh *strictHandler) GetKeysKeyID(w http.ResponseWriter, r *http.Request, keyID KeyIDPath) {
+	var request GetKeysKeyIDRequestObject
 
 	request.KeyID = keyID
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetKeyLabels(ctx, request.(GetKeyLabelsRequestObject))
+		return sh.ssi.GetKeysKeyID(ctx, request.(GetKeysKeyIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetKeyLabels")
+		handler = middleware(handler, "GetKeysKeyID")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetKeyLabelsResponseObject); ok {
-		if err := validResponse.VisitGetKeyLabelsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetKeysKeyIDResponseObject); ok {
+		if err := validResponse.VisitGetKeysKeyIDResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6152,32 +9949,35 @@ func (sh *strictHandler) GetKeyLabels(w http.ResponseWriter, r *http.Request, ke
 	}
 }
 
-// CreateOrUpdateLabels operation middleware
-func (sh *strictHandler) CreateOrUpdateLabels(w http.ResponseWriter, r *http.Request, keyID KeyIDPath) {
-	var request CreateOrUpdateLabelsRequestObject
+// UpdateKey operation middleware
+func (sh *strictHandler) UpdateKey(w http.ResponseWriter, r *http.Request, keyID KeyIDPath) {
+	var request UpdateKeyRequestObject
 
 	request.KeyID = keyID
 
-	var body CreateOrUpdateLabelsJSONRequestBody
+	var body UpdateKeyApplicationMergePatchPlusJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+		if !errors.Is(err, io.EOF) {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+	} else {
+		request.Body = &body
 	}
-	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateOrUpdateLabels(ctx, request.(CreateOrUpdateLabelsRequestObject))
+		return sh.ssi.UpdateKey(ctx, request.(UpdateKeyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateOrUpdateLabels")
+		handler = middleware(handler, "UpdateKey")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateOrUpdateLabelsResponseObject); ok {
-		if err := validResponse.VisitCreateOrUpdateLabelsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateKeyResponseObject); ok {
+		if err := validResponse.VisitUpdateKeyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6185,25 +9985,32 @@ func (sh *strictHandler) CreateOrUpdateLabels(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetKeyConfigurations operation middleware
-func (sh *strictHandler) GetKeyConfigurations(w http.ResponseWriter, r *http.Request, params GetKeyConfigurationsParams) {
-	var request GetKeyConfigurationsRequestObject
+// ImportKeyMaterial operation middleware
+func (sh *strictHandler) ImportKeyMaterial(w http.ResponseWriter, r *http.Request, keyID KeyIDPath) {
+	var request ImportKeyMaterialRequestObject
 
-	request.Params = params
+	request.KeyID = keyID
+
+	var body ImportKeyMaterialJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetKeyConfigurations(ctx, request.(GetKeyConfigurationsRequestObject))
+		return sh.ssi.ImportKeyMaterial(ctx, request.(ImportKeyMaterialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetKeyConfigurations")
+		handler = middleware(handler, "ImportKeyMaterial")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetKeyConfigurationsResponseObject); ok {
-		if err := validResponse.VisitGetKeyConfigurationsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportKeyMaterialResponseObject); ok {
+		if err := validResponse.VisitImportKeyMaterialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6211,30 +10018,25 @@ func (sh *strictHandler) GetKeyConfigurations(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// PostKeyConfigurations operation middleware
-func (sh *strictHandler) PostKeyConfigurations(w http.ResponseWriter, r *http.Request) {
-	var request PostKeyConfigurationsRequestObject
+// GetKeyImportParams operation middleware
+func (sh *strictHandler) GetKeyImportParams(w http.ResponseWriter, r *http.Request, keyID KeyIDPath) {
+	var request GetKeyImportParamsRequestObject
 
-	var body PostKeyConfigurationsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.KeyID = keyID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostKeyConfigurations(ctx, request.(PostKeyConfigurationsRequestObject))
+		return sh.ssi.GetKeyImportParams(ctx, request.(GetKeyImportParamsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostKeyConfigurations")
+		handler = middleware(handler, "GetKeyImportParams")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostKeyConfigurationsResponseObject); ok {
-		if err := validResponse.VisitPostKeyConfigurationsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetKeyImportParamsResponseObject); ok {
+		if err := validResponse.VisitGetKeyImportParamsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6242,25 +10044,26 @@ func (sh *strictHandler) PostKeyConfigurations(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// DeleteKeyConfigurationByID operation middleware
-func (sh *strictHandler) DeleteKeyConfigurationByID(w http.ResponseWriter, r *http.Request, keyConfigurationID KeyConfigurationIDPath) {
-	var request DeleteKeyConfigurationByIDRequestObject
+// GetKeyVersions operation middleware
+func (sh *strictHandler) GetKeyVersions(w http.ResponseWriter, r *http.Request, keyID KeyIDPath, params GetKeyVersionsParams) {
+	var request GetKeyVersionsRequestObject
 
-	request.KeyConfigurationID = keyConfigurationID
+	request.KeyID = keyID
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteKeyConfigurationByID(ctx, request.(DeleteKeyConfigurationByIDRequestObject))
+		return sh.ssi.GetKeyVersions(ctx, request.(GetKeyVersionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteKeyConfigurationByID")
+		handler = middleware(handler, "GetKeyVersions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteKeyConfigurationByIDResponseObject); ok {
-		if err := validResponse.VisitDeleteKeyConfigurationByIDResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetKeyVersionsResponseObject); ok {
+		if err := validResponse.VisitGetKeyVersionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6268,25 +10071,25 @@ func (sh *strictHandler) DeleteKeyConfigurationByID(w http.ResponseWriter, r *ht
 	}
 }
 
-// GetKeyConfigurationByID operation middleware
-func (sh *strictHandler) GetKeyConfigurationByID(w http.ResponseWriter, r *http.Request, keyConfigurationID KeyConfigurationIDPath) {
-	var request GetKeyConfigurationByIDRequestObject
+// GetRoles operation middleware
+func (sh *strictHandler) GetRoles(w http.ResponseWriter, r *http.Request, params GetRolesParams) {
+	var request GetRolesRequestObject
 
-	request.KeyConfigurationID = keyConfigurationID
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetKeyConfigurationByID(ctx, request.(GetKeyConfigurationByIDRequestObject))
+		return sh.ssi.GetRoles(ctx, request.(GetRolesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetKeyConfigurationByID")
+		handler = middleware(handler, "GetRoles")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetKeyConfigurationByIDResponseObject); ok {
-		if err := validResponse.VisitGetKeyConfigurationByIDResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRolesResponseObject); ok {
+		if err := validResponse.VisitGetRolesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6294,35 +10097,30 @@ func (sh *strictHandler) GetKeyConfigurationByID(w http.ResponseWriter, r *http.
 	}
 }
 
-// UpdateKeyConfigurationByID operation middleware
-func (sh *strictHandler) UpdateKeyConfigurationByID(w http.ResponseWriter, r *http.Request, keyConfigurationID KeyConfigurationIDPath) {
-	var request UpdateKeyConfigurationByIDRequestObject
-
-	request.KeyConfigurationID = keyConfigurationID
+// CreateRole operation middleware
+func (sh *strictHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
+	var request CreateRoleRequestObject
 
-	var body UpdateKeyConfigurationByIDApplicationMergePatchPlusJSONRequestBody
+	var body CreateRoleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		if !errors.Is(err, io.EOF) {
-			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-			return
-		}
-	} else {
-		request.Body = &body
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateKeyConfigurationByID(ctx, request.(UpdateKeyConfigurationByIDRequestObject))
+		return sh.ssi.CreateRole(ctx, request.(CreateRoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateKeyConfigurationByID")
+		handler = middleware(handler, "CreateRole")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateKeyConfigurationByIDResponseObject); ok {
-		if err := validResponse.VisitUpdateKeyConfigurationByIDResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateRoleResponseObject); ok {
+		if err := validResponse.VisitCreateRoleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6330,25 +10128,25 @@ func (sh *strictHandler) UpdateKeyConfigurationByID(w http.ResponseWriter, r *ht
 	}
 }
 
-// GetKeyConfigurationCertificates operation middleware
-func (sh *strictHandler) GetKeyConfigurationCertificates(w http.ResponseWriter, r *http.Request, keyConfigurationID KeyConfigurationIDPath) {
-	var request GetKeyConfigurationCertificatesRequestObject
+// DeleteRoleByID operation middleware
+func (sh *strictHandler) DeleteRoleByID(w http.ResponseWriter, r *http.Request, roleID RoleIDPath) {
+	var request DeleteRoleByIDRequestObject
 
-	request.KeyConfigurationID = keyConfigurationID
+	request.RoleID = roleID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetKeyConfigurationCertificates(ctx, request.(GetKeyConfigurationCertificatesRequestObject))
+		return sh.ssi.DeleteRoleByID(ctx, request.(DeleteRoleByIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetKeyConfigurationCertificates")
+		handler = middleware(handler, "DeleteRoleByID")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetKeyConfigurationCertificatesResponseObject); ok {
-		if err := validResponse.VisitGetKeyConfigurationCertificatesResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteRoleByIDResponseObject); ok {
+		if err := validResponse.VisitDeleteRoleByIDResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6356,26 +10154,25 @@ func (sh *strictHandler) GetKeyConfigurationCertificates(w http.ResponseWriter,
 	}
 }
 
-// GetTagsForKeyConfiguration operation middleware
-func (sh *strictHandler) GetTagsForKeyConfiguration(w http.ResponseWriter, r *http.Request, keyConfigurationID KeyConfigurationIDPath, params GetTagsForKeyConfigurationParams) {
-	var request GetTagsForKeyConfigurationRequestObject
+// GetRoleByID operation middleware
+func (sh *strictHandler) GetRoleByID(w http.ResponseWriter, r *http.Request, roleID RoleIDPath) {
+	var request GetRoleByIDRequestObject
 
-	request.KeyConfigurationID = keyConfigurationID
-	request.Params = params
+	request.RoleID = roleID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTagsForKeyConfiguration(ctx, request.(GetTagsForKeyConfigurationRequestObject))
+		return sh.ssi.GetRoleByID(ctx, request.(GetRoleByIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTagsForKeyConfiguration")
+		handler = middleware(handler, "GetRoleByID")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTagsForKeyConfigurationResponseObject); ok {
-		if err := validResponse.VisitGetTagsForKeyConfigurationResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRoleByIDResponseObject); ok {
+		if err := validResponse.VisitGetRoleByIDResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6383,35 +10180,32 @@ func (sh *strictHandler) GetTagsForKeyConfiguration(w http.ResponseWriter, r *ht
 	}
 }
 
-// AddTagsToKeyConfiguration operation middleware
-func (sh *strictHandler) AddTagsToKeyConfiguration(w http.ResponseWriter, r *http.Request, keyConfigurationID KeyConfigurationIDPath) {
-	var request AddTagsToKeyConfigurationRequestObject
+// UpdateRole operation middleware
+func (sh *strictHandler) UpdateRole(w http.ResponseWriter, r *http.Request, roleID RoleIDPath) {
+	var request UpdateRoleRequestObject
 
-	request.KeyConfigurationID = keyConfigurationID
+	request.RoleID = roleID
 
-	var body AddTagsToKeyConfigurationJSONRequestBody
+	var body UpdateRoleApplicationMergePatchPlusJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		if !errors.Is(err, io.EOF) {
-			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-			return
-		}
-	} else {
-		request.Body = &body
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AddTagsToKeyConfiguration(ctx, request.(AddTagsToKeyConfigurationRequestObject))
+		return sh.ssi.UpdateRole(ctx, request.(UpdateRoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddTagsToKeyConfiguration")
+		handler = middleware(handler, "UpdateRole")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AddTagsToKeyConfigurationResponseObject); ok {
-		if err := validResponse.VisitAddTagsToKeyConfigurationResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateRoleResponseObject); ok {
+		if err := validResponse.VisitUpdateRoleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6419,25 +10213,25 @@ func (sh *strictHandler) AddTagsToKeyConfiguration(w http.ResponseWriter, r *htt
 	}
 }
 
-// GetKeys operation middleware
-func (sh *strictHandler) GetKeys(w http.ResponseWriter, r *http.Request, params GetKeysParams) {
-	var request GetKeysRequestObject
+// GetSystemGroups operation middleware
+func (sh *strictHandler) GetSystemGroups(w http.ResponseWriter, r *http.Request, params GetSystemGroupsParams) {
+	var request GetSystemGroupsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetKeys(ctx, request.(GetKeysRequestObject))
+		return sh.ssi.GetSystemGroups(ctx, request.(GetSystemGroupsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetKeys")
+		handler = middleware(handler, "GetSystemGroups")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetKeysResponseObject); ok {
-		if err := validResponse.VisitGetKeysResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSystemGroupsResponseObject); ok {
+		if err := validResponse.VisitGetSystemGroupsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6445,11 +10239,11 @@ func (sh *strictHandler) GetKeys(w http.ResponseWriter, r *http.Request, params
 	}
 }
 
-// PostKeys operation middleware
-func (sh *strictHandler) PostKeys(w http.ResponseWriter, r *http.Request) {
-	var request PostKeysRequestObject
+// CreateSystemGroup operation middleware
+func (sh *strictHandler) CreateSystemGroup(w http.ResponseWriter, r *http.Request) {
+	var request CreateSystemGroupRequestObject
 
-	var body PostKeysJSONRequestBody
+	var body CreateSystemGroupJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6457,18 +10251,18 @@ func (sh *strictHandler) PostKeys(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostKeys(ctx, request.(PostKeysRequestObject))
+		return sh.ssi.CreateSystemGroup(ctx, request.(CreateSystemGroupRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostKeys")
+		handler = middleware(handler, "CreateSystemGroup")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostKeysResponseObject); ok {
-		if err := validResponse.VisitPostKeysResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSystemGroupResponseObject); ok {
+		if err := validResponse.VisitCreateSystemGroupResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6476,25 +10270,25 @@ func (sh *strictHandler) PostKeys(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteKeysKeyID operation middleware
-func (sh *strictHandler) DeleteKeysKeyID(w http.ResponseWriter, r *http.Request, keyID KeyIDPath) {
-	var request DeleteKeysKeyIDRequestObject
+// DeleteSystemGroup operation middleware
+func (sh *strictHandler) DeleteSystemGroup(w http.ResponseWriter, r *http.Request, systemGroupID SystemGroupIDPath) {
+	var request DeleteSystemGroupRequestObject
 
-	request.KeyID = keyID
+	request.SystemGroupID = systemGroupID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteKeysKeyID(ctx, request.(DeleteKeysKeyIDRequestObject))
+		return sh.ssi.DeleteSystemGroup(ctx, request.(DeleteSystemGroupRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteKeysKeyID")
+		handler = middleware(handler, "DeleteSystemGroup")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteKeysKeyIDResponseObject); ok {
-		if err := validResponse.VisitDeleteKeysKeyIDResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteSystemGroupResponseObject); ok {
+		if err := validResponse.VisitDeleteSystemGroupResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6502,25 +10296,25 @@ func (sh *strictHandler) DeleteKeysKeyID(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetKeysKeyID operation middleware
-func (sh *strictHandler) GetKeysKeyID(w http.ResponseWriter, r *http.Request, keyID KeyIDPath) {
-	var request GetKeysKeyIDRequestObject
+// GetSystemGroupByID operation middleware
+func (sh *strictHandler) GetSystemGroupByID(w http.ResponseWriter, r *http.Request, systemGroupID SystemGroupIDPath) {
+	var request GetSystemGroupByIDRequestObject
 
-	request.KeyID = keyID
+	request.SystemGroupID = systemGroupID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetKeysKeyID(ctx, request.(GetKeysKeyIDRequestObject))
+		return sh.ssi.GetSystemGroupByID(ctx, request.(GetSystemGroupByIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetKeysKeyID")
+		handler = middleware(handler, "GetSystemGroupByID")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetKeysKeyIDResponseObject); ok {
-		if err := validResponse.VisitGetKeysKeyIDResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSystemGroupByIDResponseObject); ok {
+		if err := validResponse.VisitGetSystemGroupByIDResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6528,35 +10322,32 @@ func (sh *strictHandler) GetKeysKeyID(w http.ResponseWriter, r *http.Request, ke
 	}
 }
 
-// UpdateKey operation middleware
-func (sh *strictHandler) UpdateKey(w http.ResponseWriter, r *http.Request, keyID KeyIDPath) {
-	var request UpdateKeyRequestObject
+// UpdateSystemGroup operation middleware
+func (sh *strictHandler) UpdateSystemGroup(w http.ResponseWriter, r *http.Request, systemGroupID SystemGroupIDPath) {
+	var request UpdateSystemGroupRequestObject
 
-	request.KeyID = keyID
+	request.SystemGroupID = systemGroupID
 
-	var body UpdateKeyApplicationMergePatchPlusJSONRequestBody
+	var body UpdateSystemGroupApplicationMergePatchPlusJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		if !errors.Is(err, io.EOF) {
-			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-			return
-		}
-	} else {
-		request.Body = &body
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateKey(ctx, request.(UpdateKeyRequestObject))
+		return sh.ssi.UpdateSystemGroup(ctx, request.(UpdateSystemGroupRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateKey")
+		handler = middleware(handler, "UpdateSystemGroup")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateKeyResponseObject); ok {
-		if err := validResponse.VisitUpdateKeyResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSystemGroupResponseObject); ok {
+		if err := validResponse.VisitUpdateSystemGroupResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6564,32 +10355,25 @@ func (sh *strictHandler) UpdateKey(w http.ResponseWriter, r *http.Request, keyID
 	}
 }
 
-// ImportKeyMaterial operation middleware
-func (sh *strictHandler) ImportKeyMaterial(w http.ResponseWriter, r *http.Request, keyID KeyIDPath) {
-	var request ImportKeyMaterialRequestObject
-
-	request.KeyID = keyID
+// UnlinkSystemGroupAction operation middleware
+func (sh *strictHandler) UnlinkSystemGroupAction(w http.ResponseWriter, r *http.Request, systemGroupID SystemGroupIDPath) {
+	var request UnlinkSystemGroupActionRequestObject
 
-	var body ImportKeyMaterialJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.SystemGroupID = systemGroupID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ImportKeyMaterial(ctx, request.(ImportKeyMaterialRequestObject))
+		return sh.ssi.UnlinkSystemGroupAction(ctx, request.(UnlinkSystemGroupActionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ImportKeyMaterial")
+		handler = middleware(handler, "UnlinkSystemGroupAction")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ImportKeyMaterialResponseObject); ok {
-		if err := validResponse.VisitImportKeyMaterialResponse(w); err != nil {
+	} else if validResponse, ok := response.(UnlinkSystemGroupActionResponseObject); ok {
+		if err := validResponse.VisitUnlinkSystemGroupActionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6597,25 +10381,32 @@ func (sh *strictHandler) ImportKeyMaterial(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetKeyImportParams operation middleware
-func (sh *strictHandler) GetKeyImportParams(w http.ResponseWriter, r *http.Request, keyID KeyIDPath) {
-	var request GetKeyImportParamsRequestObject
+// LinkSystemGroupAction operation middleware
+func (sh *strictHandler) LinkSystemGroupAction(w http.ResponseWriter, r *http.Request, systemGroupID SystemGroupIDPath) {
+	var request LinkSystemGroupActionRequestObject
 
-	request.KeyID = keyID
+	request.SystemGroupID = systemGroupID
+
+	var body LinkSystemGroupActionApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetKeyImportParams(ctx, request.(GetKeyImportParamsRequestObject))
+		return sh.ssi.LinkSystemGroupAction(ctx, request.(LinkSystemGroupActionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetKeyImportParams")
+		handler = middleware(handler, "LinkSystemGroupAction")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetKeyImportParamsResponseObject); ok {
-		if err := validResponse.VisitGetKeyImportParamsResponse(w); err != nil {
+	} else if validResponse, ok := response.(LinkSystemGroupActionResponseObject); ok {
+		if err := validResponse.VisitLinkSystemGroupActionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6623,26 +10414,26 @@ func (sh *strictHandler) GetKeyImportParams(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetKeyVersions operation middleware
-func (sh *strictHandler) GetKeyVersions(w http.ResponseWriter, r *http.Request, keyID KeyIDPath, params GetKeyVersionsParams) {
-	var request GetKeyVersionsRequestObject
+// GetSystemGroupSystems operation middleware
+func (sh *strictHandler) GetSystemGroupSystems(w http.ResponseWriter, r *http.Request, systemGroupID SystemGroupIDPath, params GetSystemGroupSystemsParams) {
+	var request GetSystemGroupSystemsRequestObject
 
-	request.KeyID = keyID
+	request.SystemGroupID = systemGroupID
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetKeyVersions(ctx, request.(GetKeyVersionsRequestObject))
+		return sh.ssi.GetSystemGroupSystems(ctx, request.(GetSystemGroupSystemsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetKeyVersions")
+		handler = middleware(handler, "GetSystemGroupSystems")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetKeyVersionsResponseObject); ok {
-		if err := validResponse.VisitGetKeyVersionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSystemGroupSystemsResponseObject); ok {
+		if err := validResponse.VisitGetSystemGroupSystemsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6785,6 +10576,39 @@ func (sh *strictHandler) LinkSystemAction(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// ValidateSystemLink operation middleware
+func (sh *strictHandler) ValidateSystemLink(w http.ResponseWriter, r *http.Request, systemID SystemIDPath) {
+	var request ValidateSystemLinkRequestObject
+
+	request.SystemID = systemID
+
+	var body ValidateSystemLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ValidateSystemLink(ctx, request.(ValidateSystemLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ValidateSystemLink")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ValidateSystemLinkResponseObject); ok {
+		if err := validResponse.VisitValidateSystemLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetRecoveryActions operation middleware
 func (sh *strictHandler) GetRecoveryActions(w http.ResponseWriter, r *http.Request, systemID SystemIDPath) {
 	var request GetRecoveryActionsRequestObject
@@ -6868,6 +10692,61 @@ func (sh *strictHandler) GetTenantKeystores(w http.ResponseWriter, r *http.Reque
 	}
 }
 
+// GetTenantSystemAutoLinkConfiguration operation middleware
+func (sh *strictHandler) GetTenantSystemAutoLinkConfiguration(w http.ResponseWriter, r *http.Request) {
+	var request GetTenantSystemAutoLinkConfigurationRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTenantSystemAutoLinkConfiguration(ctx, request.(GetTenantSystemAutoLinkConfigurationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTenantSystemAutoLinkConfiguration")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTenantSystemAutoLinkConfigurationResponseObject); ok {
+		if err := validResponse.VisitGetTenantSystemAutoLinkConfigurationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateTenantSystemAutoLinkConfiguration operation middleware
+func (sh *strictHandler) UpdateTenantSystemAutoLinkConfiguration(w http.ResponseWriter, r *http.Request) {
+	var request UpdateTenantSystemAutoLinkConfigurationRequestObject
+
+	var body UpdateTenantSystemAutoLinkConfigurationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateTenantSystemAutoLinkConfiguration(ctx, request.(UpdateTenantSystemAutoLinkConfigurationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateTenantSystemAutoLinkConfiguration")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateTenantSystemAutoLinkConfigurationResponseObject); ok {
+		if err := validResponse.VisitUpdateTenantSystemAutoLinkConfigurationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetTenantWorkflowConfiguration operation middleware
 func (sh *strictHandler) GetTenantWorkflowConfiguration(w http.ResponseWriter, r *http.Request) {
 	var request GetTenantWorkflowConfigurationRequestObject
@@ -7150,220 +11029,310 @@ func (sh *strictHandler) TransitionWorkflow(w http.ResponseWriter, r *http.Reque
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9+3PbtrI4/q9geM+d6/RKsmwnaePvnJmvIsuJrm3ZR5Kb01NnXJiEJNQUoBKkHbXj",
-	"//0zWDz4AiXKttK0SX+pI+KxWCwW+8LuH57P5wvOCIuFd/iHRz7h+SIk8Pfbn85PTshySH5LiIjlLwER",
-	"fkQXMeXMO4Tv6JYskR8RLH9DkWraQuMZgS9zHJOI4hDd0zBENwTR+YJHMQkQZTFH3bOT5hwzPCWBbC5i",
-	"HpEGoozGFIfhEt3TeIZEjONEoIve4Kg/eHfdP7s4H45bV2xIpnJOKmBaGpEAxRyJBfHpZInuZyQiKNZw",
-	"mOkBUhK0rpjX8EQyn+No6R16XfgZYQRL2nkbUTZFP/EkQuf3DJ2Q5Qs5itfw7nCYEIkJHE55ROPZ3Dv0",
-	"Or3R/qvXXqMKPRMeoQDH+AYLggjzo6Vq0vBuybLL2YROkwgQ2D/yDr29/YOXr15//0PzTRvfNP2ATJry",
-	"p6b8Tf4kf/EaHsNz4h16N0t+e6137VoBGQFivEOPJE2fsDjCYXPPa3jxckE0XN7DQyPdX7HgTJDyBpsv",
-	"CE9iEultZlODp1uybEnkyC2grLBBsG0EJSymYZ4UZGtNBcV9cFGUBu7ZcU8YvglJ4B1OcChIw6OBd+i9",
-	"+eH7169eHuw399oT0gz8G9yUPzXlb/In+YvX8Ki4iKiCWfd+yk7OSYwljHJtmkA7sXfo7bf3Xzfb3zcP",
-	"9sZ77cOD9mG7/R+v4SWLYHWTh8cQB2yXd+jld7FANQ0vYQGJPvDodhLye716SUvv1/CK9+t5RUTmmDIg",
-	"JT8RMZ+T6H+EZQutKzbAMb0j/SNJQfJgm1bNRcTvaKB4CKIBYTGdUBI1EGYBwr5PhDgiMaahQL7eJHLF",
-	"ZvxeMiD5EyN+LCT3yA6bn9zNL2BZO+95GKxhF1kg1D4vFzGXfyWiSbCIm/tpu47v84TFiob29vb29/f3",
-	"Dw4ODryGbnApSARfccQO8b04pHh+eJhtepgIEu3689umnkkSfLDglEmymcXxQhzu7t7ORcvO38Jz/Dtn",
-	"+F60fD4HDqF485yweEvACRLdUZ88DroqClP7qa4Ds5mo82GETs5Gz8N0Z+VzxTRxZtZ9OxeHFv48Am7J",
-	"cleOLwdu7u3jm+bBSz9ovnqt5zXTeg1PE3ckmd2HUXog3xs2/n5DNi45gJC8GDj5+yIn7w06b097R4hO",
-	"kEhgQyeJvIvdaK06Ie/rcfK/3rGQzL8X0FheHhn+92WflBo3Zu3jY+/NOEr+StfmQk39I4kErHiv4cU8",
-	"xqH+QcAvG9ytfyoPWHeL60Nc4BbV1/doKWIyv8CxP7uUKKBsekrZrUStPaxP2auIxHbXH+SECxzhOYlJ",
-	"pI69PCcXOJ6VmddxiKeIsoD6Sva8n5F4RiJJnBGJk4jBnQ07iVgyvyER4hPJbJIwBllCfv4tIRElAfJ5",
-	"GBJfjtxCl0IOt8BTyhSDko2W6IqloKF/iFu6ACniHzFfKC2C8RjhyYT4MYpnVDQQbZEWzJKdXkJGAkRC",
-	"4AkCzel0FksNRMxxGEr4Z1jBdsVg9QjwrNgolQsHcNIr5x/QSm6xPyNzrBA1wUkY28Okt/qG85BgBgd/",
-	"QsOYRGp3hQO58HlHvJDoxItFuDRCkEZg64pdMSmgTXgY8nuJMb4gEY55JBCOCBLJQonyh7JlE/V+S3CI",
-	"dshvLxqIqwWmXXEcR/QmiYk4RCVqChql3wZ4ThpI0XoDRTwkDaMNyj2R622gGEdTEp+UiLMlwTnlU+rj",
-	"EHUGR2gHs+AFLKinTu2hHhqR3xBJqjGvkJhD/Rx/OiVsKgn2VbttUS9ieatmMG+OmgP3V+xPxD6OYjrB",
-	"fiyxbv4eAzrNvxTu07MwJIInkU/U7xgOkewBOwFsZwXCm+hHHNIA4WiaqPMg9bJfoNsvsJL+oD/ud04b",
-	"aNj78fykdyT/+L9edyz/6v37oj+Uf3zo9MfXnYuL4fmPsin8s3s+OO4Pzzrj/vlANu11L8f9wbsGGl12",
-	"u73R6PjytIGOO/3T3lElHFkMKHBGP43GvbPqDnb5qvlpf3DSQJcD9f/Rh/64+z5DaOLwiiHU1Cox+c2s",
-	"9rEkt9d209w04smif+RmpJKO+keSO2EEDc3cC9ncTq3HAJ6trCrmpk9B0bdeffY/4dEcyzs1SWjguUAv",
-	"Xy5rVwGaFsr1ci/JcXF9/tXVXVDlEj4/1PLyqwa6fNnGHMF9uaMvJqlNtF9Ukbhs6r7O2g1vThmdJ3P4",
-	"WwNGWUymJFKQwYVWh9LV1edGqhnlc+M15puiVUs6WcTuV2M25hWI3c9ids+J2Xt9YdXBrZUjndhNR/q8",
-	"+H2QsylVE27dl+22EjFZbLSzxSIEcZKz3V+FXFbe7h1lNGkr/ZIo4pEaKAB7WOfoetj712VvNPa0FvT6",
-	"4BX5/s2e3/ye4P3my0nwQ/PNDXndPLjBN6/3bvbJ99+/Ab1FCDwloJiDCQzd8GCJAk4ECJc+Z3KNqTFb",
-	"wyq0fJ8I7/Blu/0AS00R+Y+ITLxD7792U4P+rvoqdnsS+DM970PZdiJ39WW7jXbe4gBpqF4YUUsu2Ejg",
-	"REjBNQbJQmqkJEI+ZhJqHqXi8SLiUr3VooxaY5AQWBGfk3gmxREYhwq0IJFP6J3SN28IwsgPKWExAoyj",
-	"HdKathpojkOJFBLYAcWSxfhTA1F2B1e0+V2jF00iPKds2pCQBcQnC6mb2VYRT6Q68aIlNbqX7YNtkMjl",
-	"oHM5fn8+7P8HNLHH0ciYawsm6lz00ZInaIbvAJUhn1KWo4mD56eJA7RzzKMbGgSE1aUIUDJFzHmQo4Cb",
-	"JEYRmSSCAE/DSTzjEf2dIBrrXXi5jV0YnI+vj88vB0dPPaZAe0oIBiqf8IQFOfy/fH78v0Q7Ax6jYznX",
-	"WvzziE4pM9sQ0EDBSVkgT1USRfJYRWQREUFYrLReKc+CBg1qVLpCHsnDKfvLYw0HlqOACj/kgqgpOSOI",
-	"fKIiFnr/3mxj/6SAf9rvPp7LjlMazG6hclphZhmIsp1k9/PN8+/nG7QjZdGQ+usZrDk4Pk9CtZU3BMmZ",
-	"QyJXojkqhgtDDqjcpcqFofZaKRx84tphtWf7b9x3/Mv9N2hnzDk6w2xprgSxFuREkAjNsECSwFDMOZrL",
-	"/nolCuNoSu8IQ3gOZg8JHJ0TtHPlRRLYkM6p5MxX3ouW1/BmBAfaSDQkcbRsdiZSMSrB3LewzPg9Cjmb",
-	"oh04Cj5ngdSwJVbUvSJmgM97TCVCJzySmI6jpbqULNpbORmqJCrJDX61HdGiPxj3hoPO6fWoN/yxN7zu",
-	"DYfnw0eTf5/FJGI4NGxBXavc95OIBA21dO0ZgMuZzkkL9RnysVD+cypEQuQ1LeRRl9QWYz+WV1GElAiN",
-	"cCDFShGDaSJzhF49v5jySoopdk0jtSboWPd6IsrgRiISyOOfMPJpoQzeklYocEXos4jIHWEQrBCjScTn",
-	"aJKEE8MNs5SSLjEbOAGOEfnvRcQXJIqpogEcgu21SMGmgzHqCOtkl4KfFZOV/Fw09TU8awpSYREOg9PI",
-	"tNBmL2XLMCqFceRIOjPGwlV7NcrPJ0HQQOEowkul6Kgf+M2vxI9li67EAsizDjdVB3XBJ4OyrRoF5Cnl",
-	"wqk44blldcq5Yw58ItSGUYG66pf8DKkKovo123vyBKX2lpftN69LikbDiziPux03NPIb6nbs9epXzDiL",
-	"48Xh7i6muLW4pS2ft/S3ls/n8ufd3r87Zxenvf/eb3dDngT/vd8ech7Lf3ZafhTXglQkagvW7GkGLSPd",
-	"Q6lTRnn7WeHfLj0d+ePq3T6lKiggv5nKpO3EX9GgX9643KnYX20yyLgcaxF3lj5chJ3FiBp5zfpH6Qbk",
-	"15ppgwwuizTfXd2tK7EIBoCUrI56noMMuoN1I83nnKGB2mM72uvXPzgGO1091in3cUjjOmCdrx7pPJpi",
-	"Rn83Br50tC6fL6R0oa2szqEv64+NQ3TJaJxlgPmO7sYWnp89fVS9htfFDEdLSRU5T4EDwpW01R14sAaJ",
-	"bYmnhtd1UxqcjtzhKB8261qvSflwZiWEhGEWHxkj0ob9nddA6q11nf0AxyCGyHN/PyPKj6F6o3ssTBAf",
-	"2hkedw8ODt4gZRJ6kSOO/fb+y2b7TfOgPd7fO2zvay+wtR7JSZpyFudBkTNw8M1XG8AkVJdS3r2fcQtT",
-	"CmoOmpq2rSpABpW3nhS4szdfXYBu+M3/n7lp8rfI/qtXDlhUmAYJekZcxWF4PvEOf64h1HkPjSI9BmnU",
-	"Ry2ebMYpnJiGN+cR6bMJz42Ux9QpZbdaEATxlTIkeyHKFD1IkQ/f8ETJiXhBr0FKFqWrWhzu7s5IuGjl",
-	"cbfmVKtDTURcRVAJo78lJBO6ZrZT93sWWjJCeSk0bzy+yIrOnuv+VMqjG3p9NK2cneIPRSRUMjjPLeHK",
-	"IhMvaE7iuZ2L3bv9XSmN7tZZ6JXnNLFnmahed5lvujipJe6ibCriKPHjBJSG7PpsUFNRtAlcJ5b4Mwb+",
-	"UdBR9Can4zXkeQZDqCaFZRGhVkVpobEUaEH6QFTIDbwJlTfWxwzdEDvUDLMgJNaWnBsNCyJaua25HJwM",
-	"zj8MrNJZIiPQdj85SKETKMhgddCmvEDPgXKrqpYIM5ljhiKCA1iaMeyqRjepEoaFPL4sqJ62gbBA9yQM",
-	"5f8XXAgqB6RMbSroQuBlETy8A3tkEbmJJnGO/BlmUyIQl6ok3FJy5nkiYmOhKeAddjrkU+qjgPgUYo3y",
-	"KB9nbT3Kmn5DjBGdBGsJXB9ag8dKsj5LEZ0nVmuAWMV/8+y/CIMawjX1O/A1H5bZf2arizt/lP7LbOY7",
-	"7bLOoo0K5clObZoCYTQGeQV1CjaJ9VoSxfO+5cDr0AHw9DtnmR4PykSzWmKYltbxSL+XPBfnLFwWTALp",
-	"ctyq8iAjLJRhUbjbW4W81y+dqnDoCjzlYXkuJnW0n71xb9AZjK87R2f9QX80HnbGwG5Oej+VfjNNL4/6",
-	"8oePOYDdw6w+MIA/q8mC5pDf+0o67nfOujPi32bi3PNknRvHKYkI4E/9zhnKNFSchfi3yqBOmC/ZE7Sy",
-	"sX85VePkbHStNyu3V9dKWt9rwhozrU7IsrLhx0qVB2g3B6qFNE8Ve/s/bKrcFFBVA+ep7TSP9M2UezNo",
-	"z2D6CSp+eawycwUPyQaBjeawaN+KiWEs0ELZAvhk9rVqf4D6YCGr0JCfvxiFPiEREHaWo5vVZR5dqEHi",
-	"JbpK2u3916ij3J9nNsIa7fQ7Zy+cB6PmuSgS7lpeCrA+2YgFo2zTbqUuySeSM0QBg35nBbqLzIp1sGlR",
-	"Pl7IXmDuTHQAcSbOLI+xNYSS+1x9Vcmdhi2+NqLBWnb0Bcoc9ezZZUAYuW8CHE19j62+oV1WmPeP8VLA",
-	"MwEqEHwG2XStZ8Kc04rrJdQ3onVgWFeEyF95nQ+j3E21sWWthIE+PEO8wBF23X3qayYEFqi7/DYU7bz9",
-	"6fzkBRI+YTiivFUi+EVyE1L/hCzdGFCf4fFFzKX6IP+n3yraF6z2+aR2WaonlBIWGpuwYdnOYFtFkqcE",
-	"05T/ve296w/QxeXb034XnfR+gh+v2Fm//7b/a2fwdnr72+yWvntz337b+VfvuNM573b+9UNHfu9OT7qd",
-	"f7VarSsGvXqDo/JABTp89erARfP3EV4sKJt20lcpq9nah1IH53ZqBNczS0HA+HwOniuHK660h6WnSmsG",
-	"7+Ta59/grO+cLjT7+GNtvwvT9CF9IrK2k3HglVH6USG1U1x6mYR1iJK26VmvlwuRqR3afb9shtnU+1i4",
-	"kMoAqYmnEV7MqK8D6UEZR2d4oQIu4TVA/0gZA+AfcFcKoOyy4SL35usxUFdQsattJb4U2y3g+HGeJ71v",
-	"8KJ+WvK2DP6pZaj9g8b55T/PL/d2zy/3G+f/HBMRn0fTxuk/35IopKzR/Sc4etZKVdmXdCXuK5DsBc8Q",
-	"1fMV86JB78wErBGgjqgXa0gRXUQgJJ9x28++aNvFcUzmC8k1q8HLPp9xbk72HDtOgvls7m51Bozaq1/f",
-	"5XRY+yKvhKCUSTkskq7DtVLCAd9KWcrRAOZlHP0YFGxZkiqO3sLtoiIU0nf0rVpCjn0yuIkOpCEwfV2S",
-	"BRid1DGpcQJ7mdbWVLOmT/9I0al9t1h/BSkS5Wr060Mbwv3CZiegUoAUgvsUa+HHPlLHBsXlpbse49W4",
-	"8PI9HvKPKNf0PjNNM2Lrmi7gt3rIPox0S7oQmlv2fsBzflYWbdCxZOfmaeqMhIC2hkK6Rng62hWz+QHA",
-	"m5h9/6yHhoAnDhsHJvF0KJzEHE0Jk5cFCf4/Cc4CRzH1kxBHDcmd9BBwMuxUEDzZ+TCSX5keLF2eBlGu",
-	"CYcUi9I4KDfMfy6HvexA0PuK5ZMdSMgmSRiiy+GpdogWRcDCm9R7Un6TCuDsSsX7wIe/Qb2Af5M6PkL9",
-	"/nQtYYziXGjF2vZj2eyh9H71EfwERkD36dMFB9t3BLxAK+ex++i+JvLvkjbTpl3vmgrSqFQ0rWW9lkkg",
-	"7bLera1MM3wC7mTr5QKniWRZIPiY4+MC9rkfdTQ8H7OuusYrH7Nao4GQx6nER8EtZkQFiJsV5m1QQYVd",
-	"Jxg0HnvNrsCTvXRzTXJXsIYd6JrGM/20CeyDvURSR73LuNa9VyDyocHIZjdGbhTX9bHa6rEaY4zcN2/J",
-	"spnbZIc1xM2o9H2srvg1p8Hc3ZKkTIDhcxP8GlnZzZRyR7oOH1q/1s9zmldQ2J8B31pFpQjvky3BpWVs",
-	"0ypcuo0ebyCuPNSucOYCL7OMo2wRyISDrQwusw3ljZCN0VrbCxqmvQY15Nds7NWDThZyQpZi3btN2aYW",
-	"ue6t22aYsvK+y886Kiq7xij4NBAyCVFWY+vSNlTU5DxR1YT0jE6H9dLTX/n6fo6rU2/q3+j6rOHuKFgA",
-	"ys8j0gAv9URG2Q5RRHweWeURKzN9hJmgNnAIYqUQj9Dx+fBt/+ioN9AZMUqUByN3nbFhIxXANcf+jDLS",
-	"tGFPChj1pkUHhRnRe4JpmEQE+TgRJB9TdDE8/7E/6p8P+oN31+P+We/8cuy6iUkhMMgRgZWC4jgWGoT8",
-	"5CdGWReUMwCWzkmAeBK30BEJSUyUJgsKMAtQRJrqDkA0Rpz52XdHYIyEuKygVbmAMZ0TEeP5oryEDyaA",
-	"WOEx3Tn1BCrSccTo4ODgzYtWMYQYEkC1IQFUu71BCHFNFlhLHvtcEo7yd7ksjSY4Leba7aSPgfFJlcgc",
-	"PDwkOCHLM9PEuU7dLu/gsrPk4xL338T/+TEIfwqHIXn/r39mF3mDBXn9so7zqSDoOOCskHqeQ+Lbsoz3",
-	"NLFutST3zLJbMRdbDcdWrsNDKXfbegtSrv12JZtB3Ts6R+GZXHLXFffwnDIb4eA+xM8rRz2/F/TrclB8",
-	"gY4D9ijaNMaWevJhhfh1UTr1qxSa7OIlPky3TbSXrEvcOV3WrZB7MqxeC1OVCGG2XJBI+Fjn7YuVAZRY",
-	"K75opam+EGV+mAQE+SFPAjuKDYJBIb0lqPNh1ECd35OIQH6Sd5xPQ4LggWcD3c+oP0N8MlH5VVHqZzbD",
-	"iaJpX2WFXBcRkzr73W9WlWM1nzkdnhbwKB/qU0w7WWPiUex89NvP4dyZt+CELHczBAC+GsPdQPrWCWul",
-	"JHnUH6l/wAjgxyH6b5uf/Kh32lPZ4uCv3lEjI7fLQfQTCHAj6wf9UvrlTCXoKzmeREvp/LKvuWGgM2V2",
-	"LLUi7ebBSTyTkrxKFFDMO60OOIQtmBQPkEIBnjnwSOkhso1UUPwZpFtYROSO8kSES+0XbxV1EbmRE9Nb",
-	"p/3Nzbgg0ZwKodVWxmM0jTDLGBKUubyFjnrjTvd9f/BuV/1lsA3ushRdcjKJHHViIGnNDSEMzXF0K7Up",
-	"qQNjswYfhyH491UcXSxhUWk5W3bfusMeZPmDebQgnxILxGaZB/Oahs2hawoayH8RkIYEldQA6RNjVRZA",
-	"8l9BYpQsWlqXU1MoKEKrZ6UzpQvLJeewSo9eoHZeSh1BKj+ZOIA0QashWa/hFVea+cmmYy9SsRxBkbHX",
-	"MI93vIZndx++6w2zf0Nj9cLnYz3lYFwUt1axbnsI3LdJHbY91n5Bh2C9XGRZA2SdlBt/uCI0D7ZzQrBU",
-	"79W2QfyigHxKMUeC+ElEwqVRb/KhQrDZkP9hyZPoivF7hopVK5AiCnSn8rOxmKOTsxEA9x6AK+WIRzvv",
-	"a8Fm3NUrgUL8noFHWCXjlnPn8tpDG5PiWQEsuZWSXexUiSCKDcQmDDMbiSjlf0n8VwxGy6Q6MdeRpm2d",
-	"axgqBuQCXfQHF31VCgWdwuVfeG5R8d7GeuzR5WX/yBFaUCFRbE2/XiEJplcgXA9UGMCKIqD52X2qqnyV",
-	"G/jr9AR5T92jIzesQn+nL+5iOEJwsP/y1UQLTnrufrCFMIMKeVRP+SzOHMsaN+FzG6v4Wf330Zp+cZed",
-	"Cr+htWdW/CMe1+s1tA23qKqf4hsSulgOfEBYKDtXU70DXWCae2sCGdHlePeMRNfABvSGev9uS6ahg3cz",
-	"SLt1hYB3rOaF1cR1ToAlneJYCtaMXmiTgtUcu0BKEuSPVbgzR6cIhElSZoP77fyiBJpy2GBI2ZUmMDO5",
-	"wVSnVh7v+my+smj42ewFKF2ZjZB60UPDfLVRreazycy/l2mE7zAN8Q0Nabxs/s5ZpmCFh+OwuY8zjX0+",
-	"N8/XU3rMdJhwnmm9glI+PjSqGJBd+MHz8hNFD+tYiUk7X81S1BZdcBGfR9b89BQIGt4wyyZqJggB6Utz",
-	"rXsskGY1aOeG+PJ8Gd1St3mxNn3I62b7ZbP9Q66IRL30IcXcWKXLpdoIE1CxCPHS6OIMsq2T1lRXGMiY",
-	"aHQLMZPiqFY0Lvu5RSi/Ito5jjC7nSQRrHHtsxmTo6DaimmbZMFMTXGdiz4odCquMaATePinU6jFmUWy",
-	"QqKhzcwKrltdp3t2sCT1JU2F4pIjV+Y7M8miIYSq7jNL3Sv3Rvy5Qndd1RLWW/ZKZsJnDKqqK2oqrGTF",
-	"zGJ0Y/VDh9KWR+stWnrpo1K836Z2rKoUKmNtZUpEeS6tFHXPBwOoZ6BU/uw/L4bn3d5opBR0Vaogw2gz",
-	"J7Oi0sXjyMc9Wj0i0qlEt0lLcS0DgGNLVemGGpv5+WOJ4ean2TfVViLR8cWVuWoMa1M1Q1zZHRV/M98d",
-	"kmeNfTbZCeCpN1jF1KiljRbZLGk1sh+5j2jVdDo/5UZzuOmlagbZepPxq++a1S58vSvqQfv2DQ0N71Nz",
-	"yps6A78656UryAluWYV/MujFI74R6LkbU4HyZD3dRNJt4oXXb8iOcIyHZBIRMXO5CVMTjuES5u0ZGM2k",
-	"OCqWzJ9FnNHfjeJDPpnEvIaJlY03m0n0mhvWsw5UrK2a9VQbDDSpnFXZClZEPY1nxObCwswkJ5aqsH7f",
-	"V0RO3dAl5UtWrz83H/ueR5NbfS2sfJlseH8te0OmyporP4YNslH2Dlu9RAutrbVM/XGiQFnhr/PERYE2",
-	"JD6/I9Gy47s9+noBNuWWKpxUvp58zLqY+S5rzDDNs6DdZybptEpBoMtGROSORNpbZbxhNqV7+WD5mA1V",
-	"Tbr185WcaxYC3z4suVFJxKkzEMBBGzkV2y4+A1ddnL/lwbIS76oJgjblUI7qIAx7D6uZ9EIz0uywNx7+",
-	"5DW8bmfQ7Z06pNUCUekBXIsa42mVGclaj/DUQTL1Gb/u/5TYq6JSXV+E3dhEO5bQlu65WP/qlm/kV1M/",
-	"JQiACB2gx3i692TAARAn3OC7daTEWp0TTfXKZeGe3za3ImPUSormAEi/ey8EGB7k4rEOHpUVLZ1MH6zL",
-	"weii1+0f90EzPO3/2IMEaFBkaDzsd07zHjXdoG66s+ptq04Ec7Pkt+uulVzC+4cG1EZd1yeXfqYIMgxQ",
-	"De2TRUE1zDYDMvVpeAIbgAGMgFF6Tlp80QDBYb1PCxotL0hEeXCEq56qmJT/KToCvBQmsQy2Ci0icrh8",
-	"Gp7vV1cQWxFKB3UsK3RqO2MaT5cWpimdx0pPJ/5UDwFz/EmuoIgAFc+hLnNBVDaMPC5yetBBex0u9NfO",
-	"YhHxO1yVO0W3ykCDTQdkSAVgwVlTg5NunVBEJJbKFGfr0FJEB9Sdw5SlNWcaWgBqSHGL38o/eKTJJLDQ",
-	"5f28OTTtO4vOlWj/Muvw2zxBeIhFrIXooGEIShUCo0JR1zrjf/vRucOhqLdOR11Qg+bOhydyRfBJXt0R",
-	"EdaGmIhCcrtHJO2e4DkNVwQkq+85415p2tGcQmG/tZNBYZ/quVTdn5VTveU3dSaia6wZlfm0SxM+Us6o",
-	"edWrSiAh+GPplKWxdCU4XHlPN3PU5uyKitSyO5IjBQ2v69rJGkRdCsO4RsIGM0Yn7fHQ8AxXGyVzEwhT",
-	"a5BCNzsSiXQ+xZVp5VSbUgoDNYR5SGXmQjsQ5LngiyQE5yFl2oigKoepqpIv6tbIqUjH2PBs+eW1739M",
-	"yzS7+ofMXVnwpW/lrXa2IPR6z8Dj4TXPH5TueV3XT5Ct3VybpLJ95BjK5R+ScfqubzVV2R65p4BGZNkS",
-	"OZlhUyh/BOnRQWA20/jqZdhmaI4Dgm5Munl1vMSW4O/o8V2Aa5Fzo5u/KK9uXBik9t2uA4GHEItd5XKE",
-	"OG1DCiZ0WB+PDw4BDrppjxJlEIR5s8yWLq6b02MVH3FOvLVgR0ZjumEBFd1HVyx5CrjV4NRjYZuAhEPq",
-	"k01lsrpucjNjzlFus5GWF3KRZiq1ekPMEflE/CQmxUWU33Q7a/2vx5etqBmQCOr42jgPywczUFe9eMu5",
-	"F2tz/zLMm9wDF+7edQNMzTBplOkqKy8cnYwMVbi6ckJB/ggVKdiAl6GkVZJcJye3GUPTaX9wAi8G9B+j",
-	"D/1x9719TSA/XRx1xr3ri2H/rAPGXv3DaNwZ94pmqIEzsLsMQg+m/7PAsHElRkGrrGCSqQyEQ1t6hEfo",
-	"HkfwpF2VC8qp5HUqwJwVn/c7H/abEIcMFBkbYX8wujw+7nf7vcH4unNxMTz/sTcceQ3vw/nw5Pj0/MN1",
-	"77T/rv+2f9of/3Tdfd/rnlzrsJKG1x/0x32pYFz3B6rZaQGLlcM7GFm9vAEGfeTTIsSUmVUWVpe7D1Nb",
-	"BAnpVPmOrFhChYnmC5eIMpFMJtSnquIsmhOiBE9jVzF6B/IjChHpzjwCgtyRiMYuX4r+gkJyR0LD9dxb",
-	"86EzHKg4nv7g+Dz7yCZdXtpmfdIAR5kXC+jqii9V6pOjLCh8SIvmaGylImTJc6Q2wiFzDAomLBLkhsna",
-	"rMp2qgVhgcTDilF1E/egFcavXyEbzqpRTZsNhlUBUCNfW8wdBmcVISV8VV44a8XTCG6tQUgdh3JJonYY",
-	"htWSqizB6mt+85UFwSSsVQxAso5h7/9syJp6hVbIY5s2rS2hwvOcgoxqwWg9i/hX78VzdumPN7i5PC92",
-	"C1Ye04Iaa9Bvo9lOej/p8jjd88Fx/93lUD0R/OiMfau+AjPzFO/i557LuKaf2Yz0F7SdPMU48WVrxM+n",
-	"jxQpC/0vkvLcoSOTZ//oitlGSmI8RIzcr2uqpEzZlAQCMZ7RS67YSe8n2SQjXB6iK/NO98qTst+Vfax7",
-	"5ZkOSk6tHjN/hNIZtDybwi0hraHsOwMr1ioUq1gPFFdyeFMxU0+3HOFuk6yXUHvM9IOwx6VTrSgt8CEz",
-	"hwLGxnTpt91Cyn90OoshPfz9TFmw0ubK4yjAnxdzBPlPWldsrBMpMxrCqZEyVaYXVcnnCw8WdVSMj9n/",
-	"xNlMsrdkqYgOGAmoBcy6MTPZz+qYTqqqReVRrjPhC3l/WS6nuz4C/ylBuaZlJs5JNxMVjsi6s93hkLqn",
-	"yrEuHE3UujALdnmUrcOCI4LUKBtPXzg+9m+LeQNfI3MAUgpddY6eHJRgBtpmWEIasPjowISSWap8xa/R",
-	"s88Xq7RskVGzRaYsb4bmNjM356GplqutEfpxb1u39Up1jbUqI8CtkdjKn1cIb+7ZiiLjc85oM8Skdo7+",
-	"uN85Bc3jx/OTog7S+/dFfwh/fej0jaUCmsO/Yd7hmZm29+9e93Ks9O7RZbfbG42OL09zD26yKnp+wNUw",
-	"F5HyF4A7dSE5Ig1z3x7lkCqGDKYjrmIoxWEyKNWqpUWk1/A0niyWnbqoEweu8lN5FMywmB0nrCJC9j0W",
-	"MzTRn9WzR/tw2ZZ/ySZ7Gb3v7Mnte98p1X7Rv9VWWy3Q6AbLiTlDFyfd0X/t7dkCz8BOG+UC51pOm/CE",
-	"BeiK/TwjEfm4YwpxB9wXLY4FFU2+IKzFo+nu4tYXe3v6f00/iaLdu/3Wy/auz0U793sTfm/C761ZPA9f",
-	"tK7YFWuiX7onZ9fDUedaQnl93uld/HKIOmiehDFtLpJowQVBc+LPMKMisyiJy+Goo2ujNUGwh/wnJvSa",
-	"Ka/MFZNjop0deafMcYg6YjmfkziiPurZLHDoQl5JbPoC3YTcv9VaEArIhDLl1pTgof/aa+Vg7vRG15KF",
-	"fRh2NNiPB7TTG4GYfx/hxRWzA+Uzp5SQJencAUyehpwtaqaQz1F6+XA+gPvMdYefkGW2JOdI5znbOTkb",
-	"vYAXwLlCEV2TaepM582BhFk73bMT8UIX25Z9qEAB0ZEykOKO6ScyidD5tyQ6wU8aExbY4P8kpiE8rNEe",
-	"7Ms+2HhpbCpFSrHOZJjx9lrtVlseMUnoeEG9Q++g1W4deFKdjWfAAXanNrbEWfd+SOIkYsLmxwCbZxiq",
-	"2pBSQk4EidANCTmDyGzYZltzrB94h947EtuCoFk1uqJwXdpkV9zSxQWOZ5BaYE3bmNduCqKqagxZobR/",
-	"Xy5+v91WwqzEe6yNwCb9z+6v2huu7oVaUTEgLAN5lfAaUXJHArk/L9WsrsEsdLuyEbQ9qNP2ANruv6nR",
-	"dv+NbPuqDgyykVyLMEZ2ublpuVcVu/+zp3+AXA/c9eRByZOSfBi513VGTSSBJCjQXE15YAgsg8LzN8qK",
-	"uVwQVIojQyQUBMWziN+jGxzYXL47L9vtFw6qVCCYgqO6tbHkPd/+u/ZeVZ0x76D0o5WUZ0nx+KFEl3vb",
-	"h0tL+VulyHYdimy/+UzUq80gigwNLZSo+KFh2OQuxfPUjOQk7WMeIYL9GbLFuNKAxYaufU4nSCT+zFn+",
-	"Oi0QfcU2rRDdctG5nFEtpd852yapF2vGV1J+thr6esJvbwtKXWXdAeb5ydd0BoAk9X2+qir/qnPxx1RV",
-	"53lQByIkLnOqTomPrQSv62/dLCHHL0SA5IlX9YBWb5fwfTMBQkNVdde/rIJxyzzwZZ22Lz/T/ttdKW+G",
-	"4z6vLySmuzzND7xCRNzGJre3f3H+7YQ5uVlVFLBwP+tWxj5TNN5SQsyR1ACnBDZfK2P5zVc9ze371L2v",
-	"c7nNSTQlTVjI/z6CBNTD9oeHh4c/g9i0VfWL4VBf1m2msKOosOrOuiXL3T9uybJ/9LAb4hsS7v4B/xvg",
-	"OSlcYK7ryOQX3IxSYT6roa6O0VBpGXd4hH45Ictf0ISSMHihbQAKuECrSBZw9N13Wkf67juojUqYz6X4",
-	"qZ87+jgMVUgRsHs1BWHBglNWqqCqEtP89/4x/h2iMr1DsBiY57WHnp22JMA1MvS9Loik1o3cMRcJCTTU",
-	"YD1RSPga7+kiOiAM2ZSxMAQPZVgqyL3a2nNMYn+2KollOndaegKyU7uu9BOyVMM88bA8n+VnE4PSZ7IS",
-	"pflFHbw+3Q+VKBSzwL5pqdghky78KzoZ73Qg7GqMlA9HlXXKjBPpXI8xRzhW6ftBOc9KN1cMLLxaeZ/w",
-	"COrh35oyCeBdBkfvr5I5w+8BMeGdEMmBY1X7SzHR1hXrmze9InMZqHS3NHs/4DAiOFga+wHYv2iM7mkY",
-	"GnjLzCItltiqNIqdR+oWffrh/bgdc0M5E+tD3uzvtiQ4LpcBuUeZ7VZoIwHStkizffnEvpIulBCmN2SZ",
-	"34uv6ewZCxoLTDYnSzXuq6hQDXad3yGb2ioMHfVk06dMUB/EFveAd/13lNy33PrmSQmUEp3r/BG2mlQh",
-	"BAfEot8SAtkStFxEPi0wC4wuk1JzOTznb+78cFYS/purzm4CzZ+E4sd1PhJtmy71bLmOgtw5TFnOGw6G",
-	"Zix0huX+UQPp0sD6D9nGxNY2cs/HVU18oOUGHPAxnooWSsOMwmVDcT9TCio7r4pnkme/ob+ramtGfLGV",
-	"hAr1r8qSpOTzrtO6jZulXEK6TLLl8rfG13TzuV05dcD9yr06rgrBK8+j854CBaqQLnBDW3eZbKxFtFVh",
-	"+C5u76PMo2XAv5nDa6jZbNWGrefpVUINXHaVlvKNaMQhxGyZQNqflXF9HtHgy1Nly0RgqaVolq8SKFab",
-	"6J+D+NRY26e/7Zrz3dX5t2zZr0P734z8NYz8Tz0o9W/6XZ9EsYo5JSt1Vs3e43xSZjDghfBwOzOQjXgq",
-	"V7Stp7J2s0D9Fbh+F3CQA/tvrhJW7Lx5ZvN8BGrS5tYgTKOBSXVO51usvgOcYofsecyjEiN7Lhr8uxtH",
-	"TCrob0KPPSgOotxYc2t4iyR2ZjsR6bhgbsBRTP0kxFEdgu8Egew95luj9y2ZySHBtlOYeenE0jd5o4JA",
-	"e1TVuiAxmBSAlniEIrIIsU+eRLOaxW8egJ+vknui6jSvtYRDOxNLLc+cehWiPIwsJp9U1j+3LqBiAXVW",
-	"myv2S5mcf0FgD0/fsFZLMk57e873CRV+5LxlYEC22ziNgctg76gCsSqiYU0mhK/BrP81WPKzh03Tak3f",
-	"cc72WKpk3ULjGYEXQEKVThQovue6irAN6p7zgITi8Ipdse++g1rvO+WS3y++++6KNeFs2ALEVOjC3nCq",
-	"Y466ZyfNYgVvNSzUyN4pFevOjBqROaYMXNtpTLodpSHHzpXeprEc+VKohBe/yJOhI5eg8jhImEt0P6P+",
-	"TC1YrlJX43axCW3239zSr/kC0L5O2G9C0ivIwfTYLTR/aHjvNxug0Bwoq+7BqvI1ZL0LqIkEIcjMB7cO",
-	"UAhmgap7rjiUeCY3hBOXOnC+NjJNoH0GmzWHKLZ/Mj6/OUMqHfPCBIlt7tZI7YTqiaM9yCoYRYccGveo",
-	"KSGuLCNUqLrIyhNII+TzSK0HJI1sgXWxwk8iTsgjbY/f/CEb+EMqPCBP9Xms9XJsY3ufVSr6psvnHRir",
-	"LLFPdVLUcUt8lqi1JzkePpev4Zt74QnuhXVkXLw7d5X8fUKWZ/rSqn4p2oemCEOqhIWS0DOyvJbfXbI/",
-	"aoLQ90Ip9Fafpwz9ohNWXvfPLs6H419UZUCXeN0vAfrlxXlKpg9gVonGCtt/avzNatBIgEQCr3YnSRgu",
-	"v6KLwFJ3lqprHyDIylTXoaGpIJM4reDVcJygHThAAFx6nTzhOCkxpZ8F/gsVVnIwfpNarNRSRUUriacG",
-	"Qet8LHWIuWjjzWpKm0rMpu9239785a2ZGk3f3HHVBtCS3q5N8lXykC63vZbebYSELjEQhqZyuZOmO2GY",
-	"Fjb/0vIIrW88AXeGWcFWyTpTRt5B0hoEXcb5r2+vt+SUIaAMYZpfcrSpd0Pl56ymVHkSRKGyt34SJO8G",
-	"NYhwEeux/bTlXTYTVW70Z9rnL4+F6eVrNyJkWlxPF3+oP7QVdCP2ld7PaugW0pl+NSAzLNANIQyFlN3q",
-	"V4Vl12bjisW55O5l56d64BcKXQ4+TiIG7wZddKjmflRYpsHE9q9hXQDsGwm72VpaIK0u7e5KEqthxlfR",
-	"YOw28yq0QGv6bXmRtB3EdsnkSOp7x39UeMw6ivtmj896YPTZgK12kcYaCys8xVJb7wr3KGjQdt+bCDK0",
-	"68mpQIzbHPGaqTFXxAaNURzR6RTSmqNTOXPvjrDYMWJ+tPJYh1cMoabhrs43PpAigk4mJCIsTuu08TBA",
-	"nJFGAZrRPY39mYGnzthpUnpRGjgigrCguMrSeTndwml5rM066+VVMIFxGgiFsump5iYrXbVV/eq7bDMj",
-	"rLgL9CvjDU1+n/GySj6Hbf1LtJevZ0kVt1VEfH5HoqU6COtD4awsbjrqwg3CLQMNC8N/6YJQHt6vPj/h",
-	"RcVuV116Tl/LWHF7hIujmIsORmiYcntLE59F2fRQXlFSGltCkVsSmJ7y9y5mPjFqJzD8BlKSUENfKw6a",
-	"HBEWbIEon9//4qJHmMBpKSvuTi2e/BUT9rAGPUuOGROGWVx4BGLi8OqYlW1bZUdW47l55Ri+ndjBt/kI",
-	"IjfV3/4hEKw23YrMbitEuEPDnXtvC8Ss3/q0eFVOhK1JB7ZyViGifcs04Z726yAQ94bVoJa1ATWPIgXV",
-	"eR01bDdWZg1RPHw59PgtrKaGmvBUQk/Zoim6tYYHFh7iWpuGGqRVzf5g/K1T1ze3Y4kJ5mtO5wkiTwJi",
-	"8/3XHdPcgGDIoUIkUj8QWRohwXoi+dqqmqhVfw2PgHKEkiGSSpJMBIkex5NwEs8Ii+FxeKAqoLgo7tJM",
-	"sMX9tXOs390vjn1ALSI384B3kbAutVf3tgblhhkIdc3EXBHL0kZlP27GHLafzLZuqEK6hq3yk1xV0a8g",
-	"QWCWNAxxpr/VfFho6wUX3DTaMsUZVLPVBXOhQl1P2enT6U3SPu1NUSaHQ1SZWmUnpOx2NwHb1q4A01Z1",
-	"WaVMuett2KVyhdcdNGPR86cFBqflZ/+kN2h/WS3BSecVZyXHyHf91YWZuqb0Uqa+dCbxskpxCZ8FndOQ",
-	"4ihtd4+FTfGrL4CqUkt/UdJ/foau6mS5vBe330h/RSUmOnHSZ60j8If5s04Ak8jeHJZuVgcWW/J7TERR",
-	"Ctz21ZRVDPj8Fi+/MsUab8ZKs3S0K0y96iq+itlUv8SApkapyZIU+UT8JNapQ1BaJ7lMZGlx5AzEz0Bn",
-	"22PFmRrTtdLQt79KKWP7dG58vCl1afF4HenLUUh0505Dc3I2Ugk7oIXX8JIo9A69P2APyMPh7u4fMy7i",
-	"h11/frt7t7f7hzIbPHgN7w5HFN/ouJqZPTw6qbwXch+H8ufDH9o/APLVmPlWszheZCon639CNWvQFtR0",
-	"+T7mr4ajzpA2sfWPbDYsuTp9PvT7fSrAUa7IOJPMR9LZR4tEV6XkNItJmmIHngaUix45sje6OxdNwI76",
-	"SQ5TsXM0t025PKBlXa5BMopxqaMOBHB1s6H/VeBXA+zqpOq0nTn66FpX5S7WBpKaR3SX1Dry8PHh/wUA",
-	"AP//qvmjlSslAQA=",
+	"H4sIAAAAAAAC/+z9e3PbONIoDn8VFJ99apM9kizbSXbit7bqKLaS6Pi6kjzZ2VHeDExCEtYUqSXAOJqU",
+	"v/uvGjeCJChStuVkxtk/dhwRlwbQaPS9v3p+vFjGEYk48w6+euQLXixDIv5+88v58TFZDcl/U8I4/BIQ",
+	"5id0yWkceQfiO7omK+QnBMNvKJFNO2g8J+LLAnOSUByiGxqG6IoguljGCScBohGP0eHpcXuBIzwjATRn",
+	"PE5IC9GIcorDcIVuKJ8jxjFPGbronx0Nzt59GpxenA/HnUk0JDOYkzIxLU1IgHiM2JL4dLpCN3OSEMQV",
+	"HHp6ASkJOpPIa3ksXSxwsvIOvEPxM8JILOnZm4RGM/RLnCbo/CZCx2T1HEbxWt5nHKYEdgKHszihfL7w",
+	"Drxef7T38pXXqtqeaZygAHN8hRlBJPKTlWzS8q7J6jCOpnSWJmIDB0fegbe7t//i5au//9R+3cVXbT8g",
+	"0zb81Ibf4Cf4xWt5EV4Q78C7WsXXn9SpfZJAJmJjvAOPpG2fRDzBYXvXa3l8tSQKLu/2tpWdL1vGESPl",
+	"A9ZfEJ5ykqhjjmZ6n67JqgObA0dAo8IBiWMjKI04DfOoQJnBguI5uDBKAffge08ifBWSwDuY4pCRlkcD",
+	"78B7/dPfX718sb/X3u1OSTvwr3AbfmrDb/AT/OK1PMouEiphVr3vc5ILwjHACGtTCNrj3oG319171e7+",
+	"vb2/O97tHux3D7rdf3stL10G65vc3gU5xHF5B17+FAtY0/LSKCDJhzi5nobxjVo94NL7Glrxvp5WJGSB",
+	"aSRQyU8Zjxck+SszZKEzic4wp5/J4AgwCC62btVeJvFnGkgagmhAIk6nlCQthKMAYd8njB0RjmnIkK8O",
+	"iUyieXwDBAh+iojPGVAPe9j85G56IZb17H0cBjXkwgZCnvNqyWP4K2Vtghlv72Xter4fpxGXOLS7u7u3",
+	"t7e3v7+/77VUg0tGEvEVJ9EBvmEHFC8ODuymBykjyY6/uG6rmQDhg2VMI+4deHPOl+xgZ+d6wTpm/g5e",
+	"4N/jCN+wjh8vBIWQtHlBIr4l4BhJPlOf3A26KgyT5ymfA32YqPdhhI5PRw9DdOflexUp5LTWfb1gBwb+",
+	"/AZck9UOjA8Dt3f38FV7/4UftF++UvPqab2Wp5A7AWL3YZRdyPeajL/fkIwDBWCcJJKSvy9S8v5Z781J",
+	"/wjRKWKpONBpGoZV21p1Q943o+R/vGsBxL8fUA6Ph0X/vu+b0uDFbHx9zLvJk/SP9Gwu5dQ/k4SJFe+2",
+	"PB5zHKofmPhlg7f1m9KAuldcXeICtah+vkcrxsniAnN/fglbQKPZCY2uYWvNZb3PWSWEm1O/hQmXOMEL",
+	"wkkirz3ckwvM52Xi9TbEM0SjgPoCKuDr+ZwkgJwJ4WkSiTdbnCSK0sUVSVA8RQlhacgFLwGf/5uShJIA",
+	"+XEYEh9G7qBLBsMt8YxGkkBBoxWaRBlo6C/smi4FF/EXHi+lFBHFHOHplPgc8TllLUQ7pCNmsacHyEiA",
+	"SChoAkMLOptzkEDYAochwD/HErZJJFaPxD5LMkph4QKc7Mn5i2gFR+zPyQLLjZriNOTmMqmjvorjkOBI",
+	"XPwpDTlJemlAef+zlvEKGyyaPGPPYUvxchmuNCOkNrEziSYRMGnTOAzjG9i1eEkSzOOEIZwQxNKlZOcP",
+	"oGUb9f+b4hA9I/993kKxXGTWFXOe0KuUE3aAsM/jpAXzxGnikxYiAON4tSQtLan1xJMFJ6B+eEOm8rlp",
+	"o5N4Rn0cot7ZEXqGo+B5R87/m933N9H5t1zv3xDH1wThCA3fHqL9/f3XiNMFYRwv5GlfxWkUSGZQP1/Q",
+	"AI4WfhRgMpisr8Tlg0mEUFsuCJH/or8C3f6/ijoAGf6rvQa5KGi219172e7utru74y4QGKAxf63GAXmc",
+	"OSRY4C8nJJrB1dntdrsGCxiHB95CAnnFvy8EKJGUoFX67QwvSAtJgtdCSRySllYJwJZygS0cJzPCj0sU",
+	"ag2eqKM7UEPDcZD0rlv/ct3Oa3rr2PtJ9C2vX8LpFPscdl3/LS+f/pfc+4wgDtVNlb9jQUmhhzgJ8fas",
+	"vZg/45AGCCezVBJFEM5/E91+EysZnA3Gg95JCw37P58f94/gj//XPxzDX/1/XQyG8MeH3mD8qXdxMTz/",
+	"uXei/nl4fvZ2MDztjQfnZ9C0f3g5Hpy9a6HR5eFhfzR6e3nSQm97g5P+USUc9g5IcEa/jMb90+oOZvmy",
+	"+cng7LiFLs/kf0cfBuPD9w4aIdYL2KZW+9C3fZZg4Dndryng0eAI6Bg2ZBeJHhqIJfQzMKjBxAsudWya",
+	"78tgUlSuOTMwjZMF5t6Bl6Y08NxriNNlkzWIhlWgizEeG/Qyl1S7CqEyQLle7iWVx/4Gq2u6oMolPD7U",
+	"8Gg0wSYp7Yg3xg29HOixwQcmtBr4MtPLYwRd0DPFIIJU331eRWWgqZut7La8BY3oIl2IvxVgNOJkRhIJ",
+	"meAp3jW9rZIFQe+qL21uwEffZzF584WsW8LjQ8/ja9KM2vQuBki0di9ADfT48G+K5Er+s9F8rxrPeVyB",
+	"5ns2nu868fxGcXBNcMNI187NzUZ63P0FKqgUcIINfdHtSsE74lpntVyGQsiOo53/sDiywBA9Eku/aHQC",
+	"JEniRA4UCCtB7+jTsP/Py/5o7Cnd0Kv9l+Tvr3f99t8J3mu/mAY/tV9fkVft/St89Wr3ao/8/e+vhTaH",
+	"MTwjQl0pDAPoKg5WKIgJEyI3KO7jZJGZ+BSsTGk9UuYdvOh2b8VSs438S0Km3oH3PzuZmXNHfmU7fQD+",
+	"VM17W9Yow6m+6HbRszc4QAqq51r2gAVrvQQBewTmgtUGPR1JkI8jgDpOMqXBMol9wpji7eUag5SIFcUL",
+	"wufAn4txKENLkviEfpZauCsCj1NIScSR2HH0jHRmnRZa4BA2hQRmQLaKOP4CNtTPgmfVv6vtRdMEL2g0",
+	"awFkAfHJEjRWplUSp6Bked7xblvei+7+NlDk8qx3OX5/Phz8u390ZxwZx8quI4jZKk7RHH8WWxnGMxrl",
+	"cGL/4XFiHz17GydXNAhI1BQjhOqN8TgOchhwlXKUkGnKiKBpOOXzOKG/E0S5OoUX2ziFs/Pxp7fnl2dH",
+	"972mJMgECcDyKahOcvv/4uH3/wV6dhZz9Bbmqt3/OKEzGuljCGgg4aRgG0R+miRwrRKyTAgjERf7KgQ8",
+	"6Cv1CtkK4wQuJ/SHay0ubIwCyvwwZkROGUcEkS+UcabO7/U2zg8k3pPB4d2p7DjDQfsIpSkfR4aASI2y",
+	"fZ6vH/48X6NnINiE1K8nsPri+HEayqO8IghmDgmsRFFUjHw1oHQikYZdedZiSVqXVzhheWZ7r91v/Iu9",
+	"1+jZOI7RKY5W+klgtSCnjCRojhkCBEM8jtEC+quVyB1HM/qZRAgvhDI4nkqF47OJlwCwIV1QoMwT73nH",
+	"a3lzggOlOh8SnqzaQqNYhnlgYAFjdxhHM/RMXAU/jgL2XO6KfFfYXOznDaawodM4IUgo7eWjZLa9k+Oh",
+	"SqwSHPDL7bAWg7Nxf3jWO/k06g9/7g8/9YfD8+Gd0X8QcZJEONRkQcyGYt9PExK05NKVvVQ8znRBOmgQ",
+	"IR8z6VVEGUsJWpKEwVUHbOPY5/AUJUiKAAgHwFYyLnR11hV6+fBsyktgU8yaRnJNomPT54lIMwRJSADX",
+	"P43Il6U0AwKuUKkDhz7LRKi/SYAoR9MkXqBpGk41NbQxJVuiOOXexWAspI6Dr94yiZck4ZSwgvWuiMGH",
+	"Lv27LcBknLK01+V16TZnHGBO2jCM4LxxcB6FK815F9jllgbpzcpNCDInEw2TuONig1RXZZcqQlk0DDQB",
+	"hnxZ0oQw1/704dOqtDEddCTFG8FUvO6iAK9Yzo0rBiofTKJFygQFxRwtYsbR/quXrsbSMFXY7N09MI7W",
+	"bnZpPVo1t0aOgtWIZuhmDk9rEock21GEfc4EYc8B9TCykiQp60FzY+Ad5689/xAzfsncF2Rs3QtoZ95H",
+	"8+zV3Ja9B7gtUrgtgnaGM9DcQPi0vaRLEtIIprGU269eCJlc/3PXMecyIVP6xX1QU5oAhzDHCfaFIVc/",
+	"9gBBC66EusArRHkeosX1p97VYdCfvps3WXlCPsfX7pMZks+xvzHx2n+A49APzfp3RdPjkWytVUjuHWXE",
+	"Twh30BkAJLN3x1FGMSr3dfCf4/A0Ol/+Mxnxy88fvvzye9f4O6g29au8tdUnv0oMzCjLR9M+vvoP8Tms",
+	"Tq/3hDLueIOEed259qJzgVk9s5e4t15bank9UW2HbXI63q0ZCycJXpVWLoddt+CRQQfHuYpvTtyMYCW/",
+	"er3D8eDnvtfylAXOa3nKPOd9tJaftatFz8wdoQxTL0IYPksbO0qIHycBCYxANiaRNFblT08Y3h3871Hu",
+	"eY4Naya5n4TOZoLhMUb9ez/VmX1VgBVIzgmHFzlwS90Km2C6WfbanPNBNSjZ8ftxkpDQuOqUOKvss/Ww",
+	"6ddjzQY15Z0qXyszGLrBzBzxnamdcRpxTLdaGror0IoEjnO+JqsjEhLeaDbJG2z/zdcC6Tqk1m2sHU3I",
+	"lCTA9N2FMdmM5Ip1ZJtvgdxS99FGBSeBMoTg/jQZhkLKx2mLVNlAfB+6nPL57/0vyxDTyHKYdxML5duV",
+	"3xjxzDko+qB3aokmLMdIM5sU2lv0q3d8OvokCevuMVn1bLH1E5e/t2kAazGbZDsi7P3kwN8F/jKQjXe7",
+	"0sCi/1ncN+HJOY8dPPf78fgCyY/2C6VolPVGveuDIuziXFgdLi7F//fGh++9lnfUP+mP+/mnSn8r85RO",
+	"6w7YfBzzI0k+pdoZvsmtQiLURFl9sjl3wFN6p6lra7a7e90XPwnAOEkAmP//jreGWgyO1tEL5d6bORAI",
+	"b6vcs5i9ANLJCN4dEP7GOXIDZFUFYcSRobDaMRka8oT6LrnxoUQ0QOKBwXTHoisE9BgRefFk3IkQK0lI",
+	"PktbUq4thiARAtdYiPOYoRsShh30gfJ5nHJEOYqB+bVuGE4IMj2KS3ewE/Y5v3xVR3DlJJ65Lwpf60gM",
+	"CaTBvUReAVGHceh4Yt6kNORtGmVit/gjJ5cLERzniK133P/lU+/odHA2GI2HvbHQz5WOTloImjv6mhml",
+	"Vl379HLNCRbdXlsexYs8bqwj6dLZoHdq9VAuIw6RrrgLpdWfX/QrFl58PXMw6l2pP8uLOKT+qnyYwlML",
+	"Qg4IY5tt7lKMKL3AWOVFdm607CqJjrUP6g1RoLrIldrdDTEHuvX1Za3SH+WvtFyWUU0K79E0YoQbiQJ+",
+	"K7yUzZyKrMNUHkNmO1r541h/qtL9vHyiGHw2gTEiG94Wc3zg2sOMSRFXHqOY6c6zUIbUAGZPFYtRnkl3",
+	"ch+dGVI9d2gBAQn68BQjAK6mpddVvOhIvrFfhY/ZbaXiUXrc1nN6fP77u6z9EfGpCBzRw1QI1GVeS0DM",
+	"54QmEte8VlNes0zDb8vMk0A6SirA0V81QG5svwM86nI7AEoIVjaeoj4MftdzB3o/7WN8B7eGBOhqpalS",
+	"npZAZ3G/m5ELi3o1WdvQal+84fZlzC6MWWwre5zNeVTeehdWOQ9P71FGh9VV+EwZvQozjszErsYRYUhH",
+	"1F8J3mQSlVFScCYOkUICtSklyKDACUFTNYaaPkOyMjWoxxSMAhJREqA4QVNMQxKYTVnHBvfuQtCUQ4ly",
+	"mbct4tlxu9Yg2x3OiX/9QFOCWsSwkZ4zsKfEF5qjK4NUiYjD/AUpI2Du6dBpFhZEGqhxZAi2Sx9Hc3Ze",
+	"eElFbN26Y9NKnBwrUf/42r1bemrXolXCAxGwWvHelvdBd9BxFswkP8jxvjmNiYUfJjpDpqtwkOmRbqEi",
+	"UWR4gXZq1AG2Tan0KD+fU01R2phD2AXhUedgenvoUMTKIrtV8cDdFiDhumlZgWTQrXY5SJmkZ5ShQ/lL",
+	"fgbLeiD6tbu7eZnpRff1KyebGPPDXhV3GHN02DOcil8x45zz5cHODqa4s7ymHT/uWJIb/LzT/1fv9OKk",
+	"/7973cMwToP/3esO45jDP3sdP+GNIGWpPIKaM7W2ZaR6VNg/1NKzkT+uP+17693KB7dN9Zs1zT30b47t",
+	"LGvHszZI72UR5w/XdzuEXUxW9nZ4R30X/Ts8qxtpsYgjdCbP2Iz26pVL8XayfqyT2Mch5U3AOl8/0nky",
+	"w1EmVmSjHcaLJfg3qcAn59CXzcfGIbqMhJmWugMY3Y0t/aa6ql7LO8QRTlbex9zlfNl1QLgWtw7PPLEG",
+	"2G3Yp5Z36MY0cTtyl6N82UzKg4aYL+4sQCgUIEfajX3D/s5nYI3hBthRzIm2ZN/MiWRaZG/BsmjHl2fD",
+	"t4ciqFYK0c+Lhu4X7e7r9n53vLd70N3byHFEABgn9a4jkFkBPEdyzjixvsYbqyOrADmrfPWAy7ZfvqYA",
+	"XcVX63SEL12wiKglrcirVvGtRQ8zxhvdo2iVLK7yKPuXed5z8VMWQRCsIqSNELJwQJn4G1gb4f8cxRwF",
+	"wgIHwywaPZ31PjpV0GzLYlfvBGNB1JKWOhL5maSkdaxGYqpULRY8ZSzrwK+99r8/wv91268/ffzabb3a",
+	"v/2L09RBkgVlzM2SXmQfpQItAzK/q81ebYNc2bje7VqbkJu7MdicB99JecsI3UDLbS0O5D6YD3xapK3A",
+	"mtJxoJknnbVhlMtBRAtlD1Amq3H/rHc2LikxXIoN3fTyaAA/fNxY455txr35PWvFW2X0DMT34fMyvAM9",
+	"Yq2NtSj2LKGXkBRSlROlFCOa38fvmV5+9/d93fFlw5Sldp+71yT0Fz7PramoaOGrZW7nfxVU3uTYq7J4",
+	"A80tG7zXWbiLGo4yqNKwbMGlFERyATgh1iK8VklFsh66JhoTNx2VublI0Nfe+DgMz6fewa8NfNa929bX",
+	"0g0xqb4aoZIep+wyECdkEE3j3Ej5XYUERsrPXXjn0whBL0Qj+bQLF+grsOSKnV7STyIIgJX0AJBha07C",
+	"ZSfPmNWIDHLLCeNV3Goa0f+mLldyy7/h3owqq/D9E64VVmSA56LZMjbGDb3i+00YQbZ/0i+ClJyQJmYz",
+	"8ZLm1CnXC7bzea+5h8TEq1UKqnWXUdqF5Aa5iy8A40nq81TERNjrM5nsiu9o4LrdxJ9HIh8KfNeHnI3X",
+	"AmEhyDklFzbURGAAI0KZTBUFDArj5pHwcQTMhx5qjqMgJCZUNjcaZoTlvXMvz47Pzj+cmZiasktfHHHy",
+	"xeWvmbkqqjblBXqOLTeROCXETBc4QkCGxdJUOyQbXWUxJsJCIBZeNW1Lu2zAf5cxkyYTGslDFWRVBJGz",
+	"OPwswi2Lm5sqFI/BkzyaEYZiiJQRIjDMLBg+FYBW2Hdx0hCK6ht7RWHLx3YomwwWviI6RpjUm5zVpdX7",
+	"WInWp9lG55HVxFeto7958l+EQQ7hmrrC42RTBuldycdCHJF0BzEhmwxh5ReMeoWQqwZy5P19RepF0bKv",
+	"yLcTQsuwKNe/dZvn5HiSBooFsWOSj3e5jxq1teQuc2dRiVeD3qkwbVm+k3k0y43j5AyYoBfgLmk1lDed",
+	"+NfS04hEPpAL0cokYKzym6x0mmzdxbvSgUs5UA2kXqveE3Ot9FTYqgZ7noVq5jd9MwFPD9rXO30POa88",
+	"Vgk4caDs/k5nBVzYgutZjZuYWsi6bVjnFjnUSqcchdWrszJfy0H4Ck3SbnfvFZKeTBD3rNLcomeD3ulz",
+	"58VoeC+KiFtL2wSs99ZgvCtp1x5Yd1HhqbMZOj+gxkIT/PyO1SBK7vMal8fT0SdxxJ/0U11Ljr5DHqCZ",
+	"8boMSERu2gKOtnrHaqVw92EPmzmbdlCfCupk+Vxf2TpMhlxaxdYkKmkJBf+a1ysiZRHXS8Z57Wb+BNzK",
+	"ywdQS7+/i4sGdMr76dS6ZWi6VfHchopDMN4bxg+D5VkASMdcoSVqZlYsYcRA1Ma4wAl28QLyq5WSU9z2",
+	"csES9Ay8VJ4j5pMIJzTulAjAMr0KqQ/aI+cOyM+wbMRjEG/gP6qAhgkmMDU9VMYIWdcDYKFcu6RBO73b",
+	"RZf4NvzvTf/d4AxdXL45GRyCY5/4cRKdDgZvBv/pnb2ZXf93fk3fvb7pvun9s/+21zs/7P3zpx58P5wd",
+	"H/b+2elA1lH4X//sqDxQATFfvtx30YCbBC+XNJr1slTp68n8h1IH53GqDW6mNhMJbMHWL1RnJT8kh5tV",
+	"IX9+zeC9XPt8Yvj6ztlC7Yzktf0udNPbLG95bSftvVTe0o9yU3vFpbu0v9AEKZ2jcflxbWRmhK8K2dxk",
+	"ZzPXq8IDXQZITjxL8HJOfRVzI5QF6BQvZb67mYrQ5LH+h+Ad2CRyKlZyhQjuAnUFFrvaVu6XJLuFPb6b",
+	"2406N1HmaVZyNTn7h+Ip9/Zb55f/OL/c3Tm/3Gud/2NMGD9PZq2Tf7whSUij1uE/jhpFJ9vlHUrUlyHo",
+	"JWpjyHTamUlBnIzyUeWxLqOgXtWECBV+FJt+6jsJdjDnZLEEqlkNnu366Twc+x47boL+rNkGeQdMiLcs",
+	"CZEP55a/OTYoI1IOjanrcq3l+KSfc4nrUwDmeT5VoUTo2gArjt6I10W6Z2bFnTqNmD5Tx2ITmVBBoPs6",
+	"QxySJE7kNWlwA/tWa6NKqukzOPJuc8U0mq8g20RYjSqJYTJoPjcls6iI7WKxT7FifvKBgx3n0l0VIho8",
+	"ePket/nKHjW9T3VTi42v6SKcdm7tah1uzh++OqwzsBc0Mn8a1ga9jRPFMpMEzUkotq0lN11teDbaJDJF",
+	"q4QrlV2URw0t8k3F4uCEyj4bCqc8RjMSkQRO5/8H4CxxwqmfhjhpAXVSQ4ibYaYSueugkAuXzluUWQBp",
+	"EGFNOKSYlcZBuWH+fTns2wMxGWuQr8AFkEH1IHQ5PFHeYEUWsFAo5YaUC6UIcHZAEbHvi7+FuCX+TZo4",
+	"SKmiKLWIMeI5v9La9sJ8elsqqnIHeiJGQDo3bL3HvxI3lfnace0+up+JXLMNtQuuHOUFbhQEb6P5b6Qi",
+	"ybrU+/SJZvAP8KUzVjhh1AGSJRgffX1cwD58ELKPo0P5jFcW1zBKFAbXqURHhdlOswoibSHTqaULImwd",
+	"Y9C66zO7Zp/Mo5trknuCFewCrymfq8zYQl/aTwE7Oht48232Ugz1jmz2YuRGcT0f67VA63cMNELXZNXO",
+	"HbJDO+QmVOo9lk98zW1QbWUAv/zJf2iEv1MKptyVbkKH6tf6OLd5DYZ9C/hqBZUivPfWjJeWsU0teRH6",
+	"eyjMKy+1K5arQMsM4Wity0m51tvNNNSpj7SDem0v0TDrddaAf7Udz29VBTvQl9alzYc2jdB1t+6YxZSV",
+	"711+1lFR2NVKwfuBYFXpW79bl6bh7W3ljapGpAc0wtRzT3/k5/shnk51qH+i57OB+aegASjHhmYOaDJD",
+	"sdQdZnnxhPCIxTJ4giNGjWOT8OVCcYLeng/fDI6O+meqQlcJ88TIh07ftZF0MFtgSMNA2sYtSwIjUwor",
+	"pzXNeoMKLE0I8nHKSN7nCWp2DUaD8zMoMD0enPbPL8eul5gUHJccHmIZKI5roUDoFP1kpQQLzlgCWLog",
+	"AYpTDslylTM1ZVIAjgKUkLZ8A2SiH99O+0yZ9hsLOpULGOuSguUlfNDRU3Ifs5OTGagTFUQlShM+7zgS",
+	"hf4dshzvbph4tyEJbMSPPRaHI+1dLk2jyVQYK7MTwjmbVAnNhYWHBKA00k2c61TtcoNls+T9Jvde83//",
+	"HIS/hMOQvP/nP+xFQtzHqxdNjE8FRscBZwXX8xAc35Z5vPuxdes5uQfm3YoFghsYtnIdbksFhes1SLn2",
+	"2+Vszpq+0TkMtwocf6p4h2vyNMNGPSgf9fBW0KdloPgODQfRnXBTK1ua8YcV7NdF6davE2jsxcN+6G6b",
+	"SC+2Sdw5nW1WyFVskMUaqKxDM18tScJ8HOq6A9Lya7T4UDhWlx5FNPLDNCDIh1QVZhQ9CUMhvSZgGmih",
+	"3u9pQkR5qHdxPAsJEtktWuhmTn3IgjmVRf9RZmfWw7Gial+WKq/ziMmM/c7NUIbVG2FDNfYXJu9UztWn",
+	"WAu9wcQj7sx4MsjtubNszDFZ7VgIIGw1mroJ7lsWYBec5NFgJP8hRhB2HKL+Rhf9syPghkWaUlG9VvwF",
+	"hW4zvh0GUSEawoys6qkA9yszUMZlwxPrSJkf+uoXRnSmkRlLrojq/Mh8TiJOdcr6OOcSKi+4cFvQFXai",
+	"lQ7DgCpnwNFCGxBQ/LmodgOVQmicsnCl7OKdoiwCBznVvTESfly5GbOoRV13SUfBKfikuryDjvrj3uH7",
+	"wdm7HfmX3m3oZm0XTAabI2+MqBl2RUiEFji5JoGUgbFeg4/DUNj3pV8hB1hksr6OObfDYV9UHRbzKEY+",
+	"Qxbhm6WzBSkc1peuzWgA/yKCG2KUq/xZjEPBuCsC9JcRjoTfn5Dl5BQSitDIWdlM2cJytZGM0KMWqPNn",
+	"0gUB4cfyA1BI67U8jbJeyyuu1PppcHpxPhxbP2gsNnl3YQCFbV7LM6cvvqsDM3+LxmKl3sdKliZ3fcdF",
+	"dmsd6dbtKl6TJmS7OnqTW4nGj8lKVMGGgz9Y45onjnNKME8TVTpM+C8yUc6Ox4gRP01IuNLiTd5VSBy2",
+	"KL8DJYcmUXwTmcRvxmIrkQJ9luUxIx6j49ORAO69AO59HAYF2N43gk2bq9cCheKbaBJpYDowt1Y4CQ2F",
+	"aPNeXw8BMFArybuYqVKmQu+5dsO0PRGB/wfkn0RiNKvSFMvH28M8nkwBlnd0UR9c+FXJFPQKj38h/KQi",
+	"HshY7NHl5eDI4VpQwVFsTb5ewwlmT6B4HijTgBVZQP2z+1ZV2So3sNepCfKWujt7bhiB/rN6uIvuCMH+",
+	"3ouXU8U4qbkHwRbcDCr4UTXlgxhzDGnchM5tLOLb8u+dJf3iKTsFftXooQX/JObNeg1Nw+2K6oIWHuIl",
+	"vqIh1WsresIrj76qui6243rm/8daiCyWfAW3GtgDnVhUcNJNDzznCFwMeg+IH2JnCtEcSfGt1Qm2R0uJ",
+	"VhZhzSm1pBfUDWWiykWykqVyqZA000Uzh39aoUjMQZWjECqiWnYkgXw/N8r9eC1ZhkZHBL0f5HS0V1Lx",
+	"YJZrZU5bzi45fDmkOkcOz1k1F6Y+CkkxSy2Sne/9V12V7VLdbucrXnjjMoCQ6ENY6aCVpqp80p/XqQ8+",
+	"55/IZZhCPVoguckU+wRdQer3EtYXiLajKrlNXK1e5gZmYLVsemHhpbkW1jZlR/mxIXVyv1UbvyOlce/x",
+	"opzgKxK6+DbxAWEmjQVtMQJaYprb7q+wQzDeTUSST4KXUqvx/iVKlan7ZK322hVH07OCqSREDdgIs2/F",
+	"sSSslnLNVPhrOHZh9wDkyr3TZ1oEQhfaNRFSZn5WAk1avbEoO2tg9XV9W9mpk993xeC8NNvwqz4Lobmy",
+	"DgLI0G1LfzWhAfpzytoEM97etRrhz5iGErtW7d/jiFjtMQ/be9hq7McLnaMkw1erwzSOrdZrMOXjbauK",
+	"izML339YpkziQ93tkWC06m4Ru4ghksLo8O8DQcsbqtRDItO7K5uTrl+xlg+zB1E1FhrlYTAJloQW6VFk",
+	"rHWVefLAlZ1DTWGerfi7FXNSNd5xyWGszyllLbulT9WFYq6jXBfMJatkF/J4ddCw3zvSKgo4kixPjDpw",
+	"UetWZlDs64aCl1QptmBIyvMqAxjUa3mqV15roL6VNjW3nnsLcXl83aapNn8v7/7wlhFlrb4sV8FOLBLR",
+	"7JCts4AIZqh5P3h3OdRqyNEvo3H/tJwPsdiufEi2wNcwz63QoylGTpQtlGOgZ1fEx4vMSqDaPK/Ngvuq",
+	"3X3R7v4EXhz7m5VPLqZ4L2FYtTktoGwZ4pW2qkDDFiKdWScX+m0FtLE5KBaVyvhykFuE9BBDz94mOLqe",
+	"pgl/nudB3AHQOhtWtT3aNLHBzIyqkCkPVPMyQiWgU5HSQtUi59Yio0K+7M0MRC79jHSPc/FF8kuW0del",
+	"EVybtl/0V5FdjROIqF65bEQPFYRVHKeZ/0DpEXtA9/imSkO5K7bCsBinUh2yWjrypN42qZY+Kj/VG1ok",
+	"WeNCvSNt9hoO3o77R1aolmgIoCndgi4CI3tokIOYSHOaqLDkPrncW3h4fnbWPxxr45D9z4vh+WF/NJKm",
+	"nLe9gbIgSci8j451yryCxw+EqO7RmqGr7LtVrOWNjEYO5FHPWz3aPH78mWAoqZ2XyAhgXPJ/lfkXNRHt",
+	"pTyGDJ3DNHRKu0kakqz+Vz7amiZi71oam0VJMHOVO5PoMKFCiadMVyLymgtrqsR3sF3LIR2VkB6KgDZz",
+	"aoF1FmkGS6+wL9hE1szvuZ66fa0fpuD5K+lFNrZyMkmZsAXKjfTuRTD1ofLYjHZn0tnslq2dcXT5pnd4",
+	"eH55Nm7CGxQ0KU0CMOWWykJNrjpA4jPS3+vQ0k3gdGo7kScsuzllCpcr+9Ygla37UKum0yrETeZwH2HV",
+	"DFwrMBuOX83OmYjVIgma6YBTHRWh7es8ngkS+iAxHOrYy9mlLpI4kIlKUYijgPl4STQn3r98oCIJlbM/",
+	"boLKIlWsBIuk7aXZlwY5KhkJiahF3ohnHOnWOWasvp+AcyQ7QFfFzFflcjwyfNyCCFFfI5iqqvYFnBgo",
+	"V1m/JtEhjv4qXHkY4Qb5tI5Vr3AS2behVvuT1ed+2e0WLgtkQ6KcZOdYnbvz4/pr1RPnNCRMlahxPQnw",
+	"TS9dKWOEG4e4aOKtsbeniBr3MTrYhyf+VIDeXf1hjXhv5Y+90G2qfiyYH2blD+juvfawvzdq+8chbdX0",
+	"aUiWIfYJa0qdHozm1D3QowrZuDebJWQmVHEuEbkJ0TDRqXUuhKahHtyru4kBZY1Ht9s2nyCh0yZjy2aN",
+	"h5X+unWjylaNB1XJzQEZagbOWjYeXFDPpvHI6l5rDF43ssNlxyaIctqWhUOFQ88t22xsdnA1tDT3Lq15",
+	"QPPvJzyhCGYMDb2pvwZrwkDHc5IrXiCayiFzOLB5MOd4nuX4v8PgSdXGDAfv3vWHmWZMrZx8JpEsVsdI",
+	"xFtodDy4uCi2mkTQAIdw7ibXkwo3VGRGeqpKZVfmnZQv8KRBAEuFnCbTj7m0YpoqN2PZtxOgbKO2gcds",
+	"czWurodab77IKL19KaPlfWnPYqmgO/CkMqakKXeCW/YZvTfoRbXLRqDnFPsSFNCZ/YxDGlQ4NQ0zcqCB",
+	"D2l0jT5nfYoX/yqM/WsnVZaFuJXqZ5nI22NtizC5yFAFmKS5x5ZrLXIyl2ZAwO44MZWKMYqRXoKOSIEL",
+	"PBVuVar4vtwE5dLH0ivpTu4MRrvBCcSHs5rtCGKhVLR3RcwBBbrwdEp8jubxDaLComnX9XjwDap5ouTu",
+	"tbJjtpZYfaOdU7tK5ORemQKqIVMev0mhnFOZZSAr/qIOU7TO5xEYnPaGv3wCY+/Z+fhTFrfisBk1qzPj",
+	"EBUM7PVh9BsWZtE7/CAC4maiodKAHmGOh2SaEDZf76qrjQWqn4y3EO/nKvLnSRzR37W7F/nCSQKRSazw",
+	"XNt+kneQTBsKpRVrq97/al9z+R2dVrmZN+SUcOTgZdg9GCWdOHjzsW/iZHqtrENrk1prE1AjV3W5T0bc",
+	"LxJLk59BuspnD5IcobMly8t9lPND4kONp5XUW1VihqkmpUvnlQgcBqWd7/JBHWYlC6htldDZ62HDrgiC",
+	"ZyVRgY46kFKyns6L5eNoSHiyajJfKS7TQOCbnIQCAp5Q5xtZRwHN4i24mu75mzhYVe67bIJEm5azEGSF",
+	"8Vx2T9RMaqE5V6/x8Bev5R32zg77Jw72vIBUaoDqRY0s5UxhKeILy/QTD2fSHFWZNP+oFsJRZq+T2q7t",
+	"mwjXT7mZjbC08jGeVTlWG39qPHOQk+ZMgep/H2Vx0cOruZfDxlrjMZ45EJTj2ZqqXfBVEUkcBHmWOgOd",
+	"49nuvQEXgDjhFiHhZchrDG6yl41Q/uK6vRVJslEtOAdA8qfC1r3az6V52V9TDK66hk02mSK6l2eji/7h",
+	"4O1AsO4ng5/7otj1CO7WeDjoneRdO1WD9YR5vWVKHlt1fZmrVXxdx3K8sSvU3La8eYM+uao2RZDFANXQ",
+	"3ltMkMNs04IkZ7gPGRAD5B2DSrmq8xuQpKErsO48CUhCAkNOccrjtpBKRYcOOiM34Urq2j+LllqywQlR",
+	"SoxJZJWuKXjkqwRzNGE8806CofPm13qpJuf/lLON7Ha7NRsp1169kZqLr9lClbyn/2VJk9UFSWgcHOGq",
+	"VKKqsW1TwCumC/9g4zwG9iFaKJP0dwvddl3oVpnqCGaeVvivmRmzfEcmiK5M2Coj0fGXZhuwwF9gBcUN",
+	"kPk2lF6JyGol+b3IqQ33u3V7ob72lhDSh6tq26hWFjRYd0AaVQQs2HbrcxIAJxQJ4SQSOVBrtqW4HTFK",
+	"iKi1rSPFgpaSMlog08TX8EecKDQJDHSTqHKb9pxWmRLuX9oB2Q29+mVvodAIMeNKUg1aGqEEqKr+flDr",
+	"0t9td1+397vjvd2D7t5GLv2Q0l6XM89fUrJwJgaFFYlPwAMlhBmzZ8oK8bpX8dX/zRcur+WJpnhBwzUJ",
+	"4+T3nFW7NO1oQfm8yWQi2KN6LvF5/VRv4qsmE9Ea5X9lPfbShHdk2BryTAALfEGYMTqLslxHJThKZf02",
+	"jgHN+fBKVLNPJIcKCl7Xs2M7H7uk8iYhZnqMXtbjtuVpqjZKFzpRSaNBCt3MSCRR/jRry/7JNqUSE3II",
+	"HVum50LPRBKuZbxMQywTBShNHQn0EIQ9b8oeVDjitDyccDrFPq+3EOqWWXX+D9ZbWQjT3UpsoYagmRf+",
+	"3eFVf32STNynpj75esKN8NLuc9vS0cQhGWd5l9djlemRS9WsWZYtoZMeNoPyZ8GGu1N3UFa/DNMMLXBA",
+	"dDIHfb3YluDvqfFdgCuWc6OXv8iv1j7uLwqPe+O3XSVqqzKhjY2pyaCC6qGvxwcHA+eNs+gNGsHTAAeB",
+	"M+eAh3AOdk68tWRUEeU0K56wDi7gltDNPEaqDwnuDW41OM1I2CYg4ZD6ZFOerGnwm54xF/5mqsWWF3Jh",
+	"vmVyA48R+UL8lJPiIkpgZUPryN9m+2UCfwOSUMgJY6I39WxWjdvKjMQ54bwx9S/DvMk7cOHu3TQBmB4m",
+	"ywK2zpQiro7FQxWerhxTkL9CRQzW4FmYtI6T6+X4Nq2xOxmcHYuMjuqP0YfB+PC9yfYIny6OeuP+J2Wh",
+	"z34YjXvie/+k/3MpmF6NVjqoMjB9Aci3B8hYULTQVlDnm++IRpISyn8ZV7cEKR8MhK/ilOfF9A2cJbI6",
+	"CM5iDNqyYUFhKWAHZ6PLt28Hh4M+FPO+gPIM/eHIa3kfzofHb0/OP3zqnwzeDd4MTgbjXz4dvu8fHn8y",
+	"AZ6Ds8F4AELHp8GZbHZS2MXK4Tf00sjWqLcPfJAxjfQqC6vLvZGZfoKEdCaNtoZVoUzH7UOazYil0yn1",
+	"Rf5WHqMFIZIZ1boWLYsgX9ninLUfGBhuKXcZMdUXFJLPJNSU0H00H3rDMxlROzh7e24nRs2Wl7W5k4eK",
+	"AXS9s0qVSFVenvxgmHq9WxlbWTLZiiYuvd9ZQa1Fgtwwth7L4WdMosDpzpaNqpq4B61QiP2nwos7G1W3",
+	"2WBYGYA88pU5wqHNFw0QgxYFzZ7a4E7NhjTx5Chx2Q5lsVxSlXZYfs0fPkksjFYEAEjHsP//TPC4zBxc",
+	"qD2cNW3MtYqUqgW+1YDReRCWsFl8h730uyvhXGYtcwRrr2lBtNXbb6LJj/u/eC1HnpSPztjz6ifQmqf4",
+	"Kj/0XNon5IFVS39Afcp9FBbft5T8cDJKEbPQ/0HAzx04zHmDo0lkGkne8QBF5KauqeQ3oSkJGIpiS1aZ",
+	"RMf9X6CJxWYeoInOrT7xUJygiUmwPvF0B8mxVo+Zv0LZDIqzzeAeHLnbK073AH2dCE3uxAO4SjrkiddC",
+	"Ey9Q634fpwmDli9uJ1EDtYLTT6pWdFlH0A7nxL92GMBxJJP4OrxXp7Y9UtnmVGrguxXWNZVlCmW8rDkk",
+	"MMZFU+XjBOUMo7M5F35eN3OpK8uaS9smU4G9SIRGdibRWJXUjmgo7iJwalYvyhDAWkhdrZzcfBEsbNUU",
+	"viYricqCPAlhIzIGU6sOXhMljYjCZ3VbrtKzMXgVDe1UXe+w/xlCuaY1QU+qGasweTadrSLYYDDNE0Sc",
+	"TOW6cBTsxIl1W4UngXa633D6UlI99bfZ+ZZx588uQIah6+7Rvf1I9EDb9CTJ/I/v7EtSUoCt8X90S+/n",
+	"y3WyO7OEd6akdxszNlZs56Gp5taNuvtuWc63la+8Ri9msYU1fGCj1H3rZysyog85o6kVlGlPQAFyIuSZ",
+	"n8+Pi5JN/18XAxn996E30PqP3on+t5h3eKqn7f+rf3g5ltL86PIQEmq9vTzJBQzagn9+wPUwFzflDwB3",
+	"Zqwq316e+3Yn01fRyzMbcR1BKQ5jbakSWM1Gei1P7ZPZZaeE69yDBC+XNJplWfZLWzDHbP42jSoc3t9j",
+	"NkdT9VmmTTTZl00acLvsz+h9bxeO731v7+Wrgmwmf2ssDBug0RWGieMIXRwfjv5ndxexJfHpVBVnaaFF",
+	"nOR0X5pPk1F7k+jXOUnIx2dzzpfsYGcniH3WiTGjrB0vSdSJk9nO8tpnu7vqP21Q5O183uu86O74Mevm",
+	"fm+L39vi986cL8LnnUkEZXB+Ozw+/TQc9T4BlJ/Oe/2L3w5QDy3SkNP2Mk2WMSNoQfw5jiizFgV7ORz1",
+	"0DK9CqnfFuKCqISjIylU1OEkgjHRs2fwpixwiHpstVgQnlAf9U09QHQBT1I0ey7jGJVshQIypZE0oAJ4",
+	"6H92OzmYe/2RCIH7MOwpsO8OaK8/EsIDFB+dRGagfELc0mZ5LfObDUweh5wt6gQHZcvJYXr5ct4KQ53r",
+	"DQfT6GlWom6kKt49Oz4dPRcZREGDJvI5gRrwUNccO1UZnkTptGeHp8fseQcJbhz6UIYConxyoL9I4Q+s",
+	"QcpUJTbYTmGR5SQKTCxPymko4uSUrfxyIDTHlMtX6HRkpeo/8HY73U4XrhggOl5S78Db73Q7+x4IyXwu",
+	"KMAOXtJxfE2krX5GuCvuh6dJJBN5APBcNM87Y7dUYUAZOSuc88QStHNeHInYExFCQ/yEqLhiMRSsU6w5",
+	"EROJ1lLKMmV1gGaJfw4C78B7R3jvYqCgzgv8v7oJedZkh11TyCszFznXa9ryuHFTwf7KxqLmmPJOgN3c",
+	"63ZVchBOJItsFZfa+Y+y5cu3pu4l0usWPLjA2tJZJZSAJvy25b3odqvGMwDuQCPRdr9J233Rdu91g7Z7",
+	"r6HtyyYwQCNYC9MWAThigWvmkGUkx6+e9aPIhx+7gmAku8pApDMIq3xYVQFy5IeURLyDpHwC37HPWfa+",
+	"JTLeYBKZ9B4qAFvW2OCxvqGMRBxhhn7rpXweJ/R3cawH6A3BCUnQJO12930xg/iT/DaJaMQ4wYG+QRIU",
+	"GRA7JzggCesg66ZQlt0Mk0NCbt0ksomFrGSJgNRBiUTrrvo4UjX8xCvOY6QqkmdtXBdN7qPGOU8SVsK4",
+	"VqM+KEq70NmcNtJBoCpiL6PwIqtY6cbtPgp4Siza6l3rvmjS9oVs2+Redl8/0h2Wu6MvoUYh50W+bVkP",
+	"0c5XgZGDo1t5s0Pi0s0NxSuTv+MdjSZM57TTb5G64gkxpjX1GpkX6Jospc89TkGMjmauCyEntS7EZm+P",
+	"WlfVM/GiapXfD4ZtH2vkihthTUvzK5XswZuVcGB50FPqPgppeZyH/Ps6enj0G1MLuKV9SJiylnEVe6iq",
+	"EgeUy7RNTMRvJ4EV5jNWXGxEbuCVEWFZnUnUszsB9VB8qup9M6ehHD2MocqAnEOWPMtiiapYWGsF3x8T",
+	"W994KtIL26vY7mUxE1XxvvKw+vqE/xyMsMFhCIS3V2hfDvtnfT34/Pcd5d0E87oZ5b5swEzdbsPAZo4Y",
+	"km9OmU4Uy00szUwGN0AJDRrNJpG6uyQKljEF5tqWHEWMIwnMV1OdTyhxNMutxcpJJAeXXwgo6YVdfBlD",
+	"ykjCjLpBNEMSFw3MHfRB1gHXzqGTaHBk6sHIMVUZIKHZwI7E/S3xkymcBL0NGJMIM3RDwrCDzmKd0E20",
+	"kIZkKftGsSIIYSxMc5AKlxPjnD2JsmXB7rrIhDofEC1+3xb7DWOreRT3JC9XHYvd3QIIsii663IrCP/w",
+	"91mtQ1gbnfctd6+tBupi+4VirmsfPqaMxFmXysqc7ADxXClRu9KrKSsKdzzQOdlbAst1EO3aiqfQEKaU",
+	"HLgseNSZRBd6cpGCRJabBA1SqaarKUKCmQYR2mU1XCve2FwRyC1icGUxyz+5isagkZ/faI3Bkq1Ch/l6",
+	"ARKTZyZ8bq3WUTuZC4VhGOpHR71IVwSKQjLEYzcCmJzXT0lJmGUN/5OjnzldjW/qhwaaQeHkpJgHJQOo",
+	"R3hsuAqhAxRZiK4IKrk3IdUtq8zqC+W/6DaJSMhANEjiG3SFA8MkPHvR7T6vVLXpfLrbeOjl2A6MEB++",
+	"nYKtEq5H0a59lxozgZwaF0q4nRHPHYoXmVebE+Hfxgki2J/rhz6wwtJbyIfOkM2Npf5cYb30D9KaZl8Z",
+	"tP7KJpHMIstXQqm99wrJKpu2dezZoHf6XE/l5GgFuHIpg97pNlF90DsVk+WYWhfmZ8lxmfeYbG8BSjmL",
+	"C8zz46d0BwRKqlde4CKJfFFAYdA7tWuar7sXX8V/azTIR+J3hI1DgbxyYFulnKHBUQl5ZQ/R6k6aRQXV",
+	"BvpfOeNTUgKaUykfhuOVb846Zqc8yw+8hnHcxiF3t/9w/ulYPDisKgxYupPGSt9Dpg7bYAKPETikzIg4",
+	"fOUbkj982dNUNrjn2Td53BYkmZG2WMj/uQMKyLS5t0pp89jIppw8f9hAnSgsd8fUB2n0Zu2oGt1N/HEK",
+	"db0lpZPVvlCv8FVoP+16PGwSKXWQvCWZStTU/FhTSr6zhnC+01XG73F9Wn9yKb1c4P2HGdCQfb056J2F",
+	"15USUZW0rzpbGI8qEX49pkOVfl3tPyE40AHj5oaBhlPV99ftiJUhGuagYFF8GyfOybKy9ThksapbJ7Lg",
+	"wUMFerbOeq2BWOsjPVj3QHQ3ktuH/e00EbWA/vD3aaS9KJynNCC+u8MTCD/giNd6Ai3iz0KnV3jvRCYY",
+	"bB7fSlnuAa5Oq0FzsZIfsl8T2a+AQfG0DoGuyWrn6zVZAe6E+IqEO1/FfyBjTQF5XGhwAm03xgAxnzn/",
+	"9cH8Ygb0LE7Qb8dk9RuaUhIGz5VbtwROu6QZwNHf/qa0z3/7G7ocniAS+TGo8FSuXGV4V93VFMb6Xgjq",
+	"lEa7/917i38XKX28A+EErpNcH3hm2hLNbVn0sy7bQCPM7mlhnAQKasrUKoLOU8T34nYoynVMVhbCg5Gx",
+	"Ct2rpYW3BIo6GBQsZRmw5iZZHMkxWXVc3P0xWclh7nlZHo5b30QIeCTOXuxQFUefnYeARjCOOiFixQlx",
+	"maGv88RkgDU4q3akfDmqJAE9TpJ5wGPOwS6ijHaWhmgSwWDaADKNE1HzUrovRAESAcMidvc/QJzF7wHR",
+	"eYBEcD5WTvuSiHYm0UAnhGbWYyAG0qV05E+6VKSywQgbIuXohoahhrdMLHhsMKRSRDhPpCbi/pd3S0KC",
+	"BOwiZvw8MQqteubf8bickRtkHbfcNhJoc60+vmkOvwAvpCJLHcgqfxZP6e4Zv/1A7QkyWON+igruHXV6",
+	"K7v4WBgC3hYcRLI8mHPM0JIkC8pknlgeo8+U3FSono5LoJTwXBUfMLXDC1kVBFv035Qkq4wvIl+WOAo0",
+	"95lhcznjwp9cYVXc3qfgYeJG0PxNKLs3rfc+Ufb9Us+O6yrAyWEa5QKchbEeS/NFCw2OWjKaK05aJmzS",
+	"JGFq5XKPw6ViUpKS3ntQNqmDsswR4aolqZ8M58wHVssUFXD37XBPbNiXY/1EytpwMh+Mk5MEOu+6rdt4",
+	"WYrzuFC2tPHGX+fqsZVQTcB94p4xpdOqu4/Od2rna/Gnjf0FymhjrMqdCoVT8XjvZGIuA/5DrdRAzI7W",
+	"HVg9Ta9xta7wNtgIRxxMzJYRpPuohOupmrPKSGCwpejaUMVQrHdzeAjkk2NtH/+26xJRhP9RvCOa4P4P",
+	"R4kGjhL3vSjNX/odnyRcphFqHEljSa5W4gZrIONLXjLwNhRZD22g/ghU/1DsQQ7sP7lIWHHyCjceEEF1",
+	"8doGiKklMBDnlL21+g1wsh3Q822clAjZQ+Hgn105ogsy/2B6zEVxIOXGklvLW6bcWRaDZeMKdQNOOPXT",
+	"ECdNEL4XBNB7HG8N37ekJgeo3czMC+cu/eA3quJwqYhaZYQLlYLApThBCVmG2Cf3wllF4jcPbZT59GYJ",
+	"Xs4l3W6kCRftdJQa3LksTZRAuS/Kf8IpC8h4CuXNNol+K6Pzb0jow7O0xNWcjFPfnrN9yjD9q5UDGMHb",
+	"bZzv3qWwL69hrUdDTcr8p6DWfwqafPuyKVxtaDvO6R7zd/SarGSqNsh7oQLTGeI3sQqHN4FxizggITuA",
+	"XKF/+xsUfkfP3ohQ91/iNEHnN0I19fxvf4O0nMd2JD1lWRQ9jXiMDk+P2wuVXFKHX8th34th38dhUDVq",
+	"QhYi2weNrLg+M0oLxtb53pXrKYx8yWSOm9/gZijPJfgqOcwVZMHx53LBsEr4lDLiIhNK7b+5pl/RBYH7",
+	"qmy+DuurQAfdY6fQ/Lblvd9sgEJzgVlNL1aVrcG2LqA2YoQgPZ94dQSGgCVFnKmkUOyBzBDOvZSjNN9M",
+	"1d7ezYZDFNvfez9/GEMqDfNMO4ltbtbI9IQya625yNIZJVCdlHn0Z5lzVmlGKBM/KksgTZAfJ3I9gtOw",
+	"M4WwNXYSdkzuqHv8YQ/ZwB5SYQG5r82j1sqxjeN9UK7ohyyfN2Cs08Te10jRxCzxKF5r9zI8PJat4Yd5",
+	"4R7mhTo0Lr6dO5L/PiarU/VoVWfbGIimUDcowcslCXJvneHfXbw/agum77kU6I08TyP0m6ps+GlwenE+",
+	"HP+GRPldF3s9KAH6/fl5AtEXYFaxxnK3v6n/zXrQSAAOWD5hbJqG4eoJPQQGu22sbnyBRKGdpgYNhQUZ",
+	"ahatGo4b9ExcIHSde07ucZ0kmzKwgf9OmZUcjD+4FsO1VGHRWuRpgNCqxEYTZC7qeG1JaVOOWffdbuzN",
+	"H16bqbbphzmuWgFaktuVSr6KHxKpeWvtGTCqlQOPmbpD2kgna8W4Se1QTPG0EjXKakGw8qeggZerRfqg",
+	"NZrJfzdN2WjhF2Q4sf4pirPoalUYXaU05G0ayW84CiaRDCoHspteMVmCSJiOLbNaPBU0GMZRc8gwY5SQ",
+	"KUlEJjJhKLPnVXQ7wgtSHYAFq9ySg3uGRU71aLbr3y6zQg2IT1uDa52Q41oY8rvzFf6zsTLXgamCLc7f",
+	"naxAkBxZ1zbAOhWqRn8m05hUaG0B6Ds5jMql/dDaNgkWt2+0S3mbEdT1r3UNjlQ/01s44e4jkZqnyvwV",
+	"cKYCX2r0t9IF1nwRBp7C45lLglyhylWE7p7os11lboZFj6LTXY+035tq99HUtfUvo0zQ9a42lXxWQ2Uk",
+	"emi2LqtnO5slZIaFdo9jnmonb5qoHswplY/s+Z+W8GItvUp6ye/1n7IkT26JFpLmf2/gXGR36KBTla4O",
+	"JwQR7aoYisJ1QL+x+lec6NK8svsk4quliPmdGQJtCj63UJxAQVaooMPDlfYVvFrl8BwNjtakmrMOfUvS",
+	"jD1DDUp9OyNBDZBPWKCxz2fNdSjS7p2v1r+ayjj5KzM2t6CY5TEhor4Mnk5l5ccK4SWP2xuSchv6H2JM",
+	"EzGmIaa06t703EA1DzpINg2f8zsJOY3QoPuNaOXTk3wqkGT9M71OBjIFX1o5QShO7PyyBcKkq56r99rH",
+	"kawieZWlHIqj7LtUYeoqR6C2zJ53eJtl5zgyL7lq63qzJdDboGvblb4siK1cUGuQW0zSlA34Nvcv/eGf",
+	"Uy/wPRj/sBPS6HodE3EZQQuLhXdcXZmGUTLH5aCySaQ7igKSmsuAYUkg/gny3dKk9UwIS0NhdJCJoUem",
+	"rqSpbscIXD1OQmcmNwmyhXM9/04ha9/ukZIAD8VGrLkwqTobX2UDS+hsRpKnyCXJ2N7oWttsK1H1Lk/a",
+	"Sf0F4LErcKwzifqQilA1VecDGmUxZJyg0Q0FgixK0iJsnHdkTQHVDdZlXSKdbk/Fn8gcjSqvYX76bdyt",
+	"k63erMd4LZs9lAKZNhSbvyUpePKEwOI770sIat9M+c+G9dRLj5+fJgmJQLFUzQvXyFxqzIe4d60noX1d",
+	"r3hlT1vo43mF0B3uSYOroFPCyNyI1tV04novDO+M499NyX+9gieD39/EpFA2JhRwU53G+XJ91l2w/soe",
+	"aBkzmQZU5UAGrkwOwlzI+tZ82vIp64mq2YDkiZrt1fLlBqGfxbHV4oV61pUWfSPylbmEyKE7aDC16KjI",
+	"QnFFSKSlXLd80JI6q8GR1sOXWqiM5iET5S8S4ZEimPlqBuEe+tjHk3J/oPB6/esGuFurwSmKyJXioiqm",
+	"UUTtGg3LvUTAHzagjWxA4qhdqFGnjsfm6F35bQohQ+bc20DVsKUkiGKe1zvgyJWihvKSrkNoOBwj5kcr",
+	"j3UwiRBqa+rqTGoMwwR0KvwuuVFFIkjvEUekVYDG1rg0Gxs+MrwgCMu/7YETwkgUFFe5RmvycLflrroS",
+	"O62FpRERiEKj2YmiJmtzU1T1a56jopkuRpVV+MZ6mFoAn5IDQ9EAUUmS1rxWB59xSGGY6gDhYRoJHn+Z",
+	"ED+OZNb9vHQqNV4zTCPGnVQNLNzArC3wtUi/H61USWMwAeZrw2Imq2lgjm7iNAzQVRj719mTCf5BNziJ",
+	"aDTLNROu4KIp5S1dbmASYXQTJ9fTML5BVwTm1hhrAnyUTrdEKH5WG6OlOrG3j0IqtqFKFXunzvoOtQIe",
+	"XkiOrn820GwEt9S+Phm+Q6Ph3a85XFuwMMj3rj4kzojcuqNWZld41xeG/97lnTy8LtQ7P35Cj8hFxWlX",
+	"8bbOJ2IsmTqEi6NoflaM0NL69pXOO0ej2QFwoiB0rdAU05AEuif8fogjnxiHVSAaLSQFnpbiHh04OSJR",
+	"sAWk3BblzoMqJnAGgRRPpxHJfsKIPWyAz0AxZYBvIbm1zi/YJFzetJU80bqA4bH4dmwG32Zy59xUf/oE",
+	"52K12VFYpy03wp3y1nn28u73Uh5rAaxB8o8kDQnL6jSmPF5gTqHa7UryMBG5CVcooExgJRj3bTNPPdKM",
+	"clAVk/tuGY3WTf40MEuZI+Bg2+I8/Yr0ylXoVpEVfCiTNz8gEin3kilNGAhc3J8LsScNheacJAsaqenK",
+	"Ff1lcC8zKiGjMq/21GyGnVvIKt4MMR9PsLnTTXl6YXIK4R/sXlWScS33NyDgumnhNjSkzB9U70emye5p",
+	"nwY1dh9YMyq8Pl74TqhgU8JqbNiuR10NUtx+P/j4I+tjE+e5eyJ6RhYH0TRuQAMLdaKMBUoxFdXkT4y/",
+	"dez6kRihRAStMrclhMijANv8/FXHLDZNmN0oYylJtPUtK+peiyRPLdBcrvopZMjKIYqFJJUomTKS3I0m",
+	"4ZTPSQQCERipYRwnxl3qCbZ4vmaO+tP97sgHbFwF8YBlIbEueVb6+dm4QL4vo8nRBzOA46Dsj5sRhw0u",
+	"/CZ05A6OpdkatkpP9DRPpX69jRoaObPfGta90R2KTjXKwCCSTSCccDrFPqhNJlFfelVk0+ua8sr3RUr3",
+	"B6iy8uczkGF3ZDDYDhMWiueViSn0LFvSkOjhq0wKZnu+WUoKswHfKh/FH1ZKcOJ5xV3JEfIdf07862pn",
+	"j0P4jCiIu7qPDEmTONES/hfiM6MLGlKcZO1usBUSJ4/Phfswwx8U9R+eoIvdcBqhr3+gvhv1NYa68LPR",
+	"Ffiq/2zibp5LOmjwZn3ea4N+d/H/zoDbvpiyjgCfX+PVExOs8Wak1MajHZGSfx1dFe5uUm6GplqosVGK",
+	"fCF+ylVlS8QTHDHqLgY7Nt8siB8Az7ZHijOIH9sw80fiMraP59pVJ8MuxR7XoT6MQpLP7iqpx6cjWU9S",
+	"tPBaXpqE3oH3VZwBuT3Y2fk6jxm/3fEX1zufd3e+SrXBrdfyPuOE4ivlBT03l2eKwc/vwAtjH4fw88FP",
+	"3Z/E5ssx863mnC+9lkeidAGAq3/Cf6S0IKfL99F/FXFibNRfEA6k3URhdep+qPJylAl/J+VOmtWaBTz7",
+	"aDbxq6MoS1ZkM6sAKzLX37ZczfMiRkXnfCvXUC5VsXM0V0PXgIZ0uQaxBONSR+XP5epmAjWrOsmcANVd",
+	"ZYM1669esauTnO7U0adyHjtvravjUOQCLfczypdML6N6mC+OXr00oFxGOxhFmVxO1t9u4xyCz+OE/i4x",
+	"IqB4FsWMU5/ZI1hNXENcDNA4vibSMXyBwemAID+kJOL2MKaZd/vx9v8bABbpDW9/vwEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
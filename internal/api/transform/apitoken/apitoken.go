@@ -0,0 +1,39 @@
+package apitoken
+
+import (
+	"time"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/model"
+)
+
+// ToAPI transforms an API token model to an API token without its secret.
+func ToAPI(token model.APIToken) (*cmkapi.APIToken, error) {
+	return &cmkapi.APIToken{
+		Id:         &token.ID,
+		Name:       token.Name,
+		GroupID:    token.GroupID,
+		Prefix:     &token.Prefix,
+		Status:     new(cmkapi.APITokenStatus(token.Status(time.Now()))),
+		CreatedBy:  &token.CreatedBy,
+		CreatedAt:  &token.CreatedAt,
+		ExpiresAt:  &token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		RevokedAt:  token.RevokedAt,
+	}, nil
+}
+
+// FromAPI transforms an API token to an API token model.
+// The secret and the audit fields are set on creation.
+func FromAPI(apiToken cmkapi.APIToken) *model.APIToken {
+	token := &model.APIToken{
+		Name:    apiToken.Name,
+		GroupID: apiToken.GroupID,
+	}
+
+	if apiToken.ExpiresAt != nil {
+		token.ExpiresAt = *apiToken.ExpiresAt
+	}
+
+	return token
+}
@@ -0,0 +1,75 @@
+package apitoken_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform/apitoken"
+	"github.com/openkcm/cmk/internal/model"
+)
+
+func TestToAPI(t *testing.T) {
+	t.Run("Should convert to API type without secret", func(t *testing.T) {
+		token := model.APIToken{
+			ID:        uuid.New(),
+			Name:      "ci-pipeline",
+			GroupID:   uuid.New(),
+			Prefix:    "cmk_abcdefgh",
+			TokenHash: "hash",
+			CreatedBy: "user",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		res, err := apitoken.ToAPI(token)
+		assert.NoError(t, err)
+		assert.Equal(t, token.ID, *res.Id)
+		assert.Equal(t, token.Name, res.Name)
+		assert.Equal(t, token.GroupID, res.GroupID)
+		assert.Equal(t, token.Prefix, *res.Prefix)
+		assert.Equal(t, token.CreatedBy, *res.CreatedBy)
+		assert.Equal(t, cmkapi.APITokenStatusACTIVE, *res.Status)
+		assert.Nil(t, res.Token)
+		assert.Nil(t, res.RevokedAt)
+	})
+
+	t.Run("Should report revoked tokens", func(t *testing.T) {
+		token := model.APIToken{
+			ID:        uuid.New(),
+			ExpiresAt: time.Now().Add(time.Hour),
+			RevokedAt: new(time.Now()),
+		}
+
+		res, err := apitoken.ToAPI(token)
+		assert.NoError(t, err)
+		assert.Equal(t, cmkapi.APITokenStatusREVOKED, *res.Status)
+		assert.Equal(t, token.RevokedAt, res.RevokedAt)
+	})
+}
+
+func TestFromAPI(t *testing.T) {
+	t.Run("Should convert from API type", func(t *testing.T) {
+		groupID := uuid.New()
+		expiresAt := time.Now().Add(time.Hour)
+
+		res := apitoken.FromAPI(cmkapi.APIToken{
+			Name:      "ci-pipeline",
+			GroupID:   groupID,
+			ExpiresAt: &expiresAt,
+			Token:     new("ignored"),
+		})
+
+		assert.Equal(t, "ci-pipeline", res.Name)
+		assert.Equal(t, groupID, res.GroupID)
+		assert.Equal(t, expiresAt, res.ExpiresAt)
+		assert.Empty(t, res.TokenHash)
+	})
+
+	t.Run("Should leave expiry unset", func(t *testing.T) {
+		res := apitoken.FromAPI(cmkapi.APIToken{Name: "ci-pipeline", GroupID: uuid.New()})
+		assert.True(t, res.ExpiresAt.IsZero())
+	})
+}
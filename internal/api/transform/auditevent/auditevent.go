@@ -0,0 +1,34 @@
+package auditevent
+
+import (
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/utils/sanitise"
+)
+
+// ToAPI transforms an audit event model to an API audit event.
+func ToAPI(event model.AuditEvent) (*cmkapi.AuditEvent, error) {
+	err := sanitise.Sanitize(&event)
+	if err != nil {
+		return nil, err
+	}
+
+	apiEvent := &cmkapi.AuditEvent{
+		Id:        &event.ID,
+		EventType: &event.EventType,
+		Resource:  &event.ObjectID,
+		Actor:     &event.ActorID,
+		CreatedAt: &event.CreatedAt,
+	}
+
+	if event.CorrelationID != "" {
+		apiEvent.CorrelationID = &event.CorrelationID
+	}
+
+	attributes := event.GetAttributes()
+	if len(attributes) > 0 {
+		apiEvent.Attributes = &attributes
+	}
+
+	return apiEvent, nil
+}
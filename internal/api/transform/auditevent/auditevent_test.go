@@ -0,0 +1,52 @@
+package auditevent_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/api/transform/auditevent"
+	"github.com/openkcm/cmk/internal/model"
+)
+
+func TestToAPI(t *testing.T) {
+	t.Run("Should convert audit event to API type", func(t *testing.T) {
+		event := model.AuditEvent{
+			ID:            uuid.New(),
+			EventType:     "keyDelete",
+			ObjectID:      uuid.NewString(),
+			ActorID:       "user@example.com",
+			CorrelationID: uuid.NewString(),
+			Attributes:    json.RawMessage(`{"tenant":"tenant1"}`),
+			CreatedAt:     time.Now().UTC(),
+		}
+
+		res, err := auditevent.ToAPI(event)
+		assert.NoError(t, err)
+		assert.Equal(t, event.ID, *res.Id)
+		assert.Equal(t, event.EventType, *res.EventType)
+		assert.Equal(t, event.ObjectID, *res.Resource)
+		assert.Equal(t, event.ActorID, *res.Actor)
+		assert.Equal(t, event.CorrelationID, *res.CorrelationID)
+		assert.Equal(t, event.CreatedAt, *res.CreatedAt)
+		assert.Equal(t, map[string]string{"tenant": "tenant1"}, *res.Attributes)
+	})
+
+	t.Run("Should omit empty optional fields", func(t *testing.T) {
+		event := model.AuditEvent{
+			ID:        uuid.New(),
+			EventType: "keyCreate",
+			ObjectID:  uuid.NewString(),
+			ActorID:   "user@example.com",
+			CreatedAt: time.Now().UTC(),
+		}
+
+		res, err := auditevent.ToAPI(event)
+		assert.NoError(t, err)
+		assert.Nil(t, res.CorrelationID)
+		assert.Nil(t, res.Attributes)
+	})
+}
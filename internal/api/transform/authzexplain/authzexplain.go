@@ -0,0 +1,84 @@
+package authzexplain
+
+import (
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/utils/ptr"
+)
+
+// FromAPI transforms an API explain request to a manager explain request.
+func FromAPI(apiRequest cmkapi.AuthzExplainRequest) manager.AuthzExplainRequest {
+	return manager.AuthzExplainRequest{
+		UserIdentifier: ptr.GetSafeDeref(apiRequest.UserIdentifier),
+		Groups:         apiRequest.Groups,
+		Method:         string(apiRequest.Method),
+		Path:           apiRequest.Path,
+		ResourceID:     apiRequest.ResourceID,
+	}
+}
+
+// ToAPI transforms an authorization explanation to an API explanation.
+func ToAPI(explanation manager.AuthzExplanation) *cmkapi.AuthzExplanation {
+	apiExplanation := &cmkapi.AuthzExplanation{
+		AllowListed: explanation.AllowListed,
+		Allowed:     explanation.Allowed,
+		Reason:      explanation.Reason,
+		Groups:      make([]cmkapi.AuthzExplainedGroup, 0, len(explanation.Groups)),
+		Policies:    make([]cmkapi.AuthzExplainedPolicy, 0, len(explanation.Policies)),
+	}
+
+	if explanation.Endpoint != "" {
+		apiExplanation.Endpoint = new(explanation.Endpoint)
+	}
+
+	if explanation.Restriction != nil {
+		apiExplanation.Restriction = &cmkapi.AuthzRestriction{
+			ResourceType: string(explanation.Restriction.APIResourceTypeName),
+			Action:       string(explanation.Restriction.APIAction),
+		}
+	}
+
+	for _, group := range explanation.Groups {
+		apiGroup := cmkapi.AuthzExplainedGroup{
+			IamIdentifier: new(group.IAMIdentifier),
+			Found:         group.Found,
+		}
+
+		if group.Role != "" {
+			apiGroup.Role = new(string(group.Role))
+		}
+
+		if group.BaseRole != "" {
+			apiGroup.BaseRole = new(string(group.BaseRole))
+		}
+
+		apiExplanation.Groups = append(apiExplanation.Groups, apiGroup)
+	}
+
+	for _, policy := range explanation.Policies {
+		apiExplanation.Policies = append(apiExplanation.Policies, cmkapi.AuthzExplainedPolicy{
+			Role:            string(policy.Role),
+			PolicyID:        string(policy.PolicyID),
+			GrantsAccess:    policy.GrantsAccess,
+			RoleElevationID: policy.RoleElevationID,
+		})
+	}
+
+	if explanation.GroupFilter != nil {
+		decision := explanation.GroupFilter
+		apiExplanation.GroupFilter = &cmkapi.AuthzGroupFilterDecision{
+			GroupFiltered:   decision.GroupFiltered,
+			ResourceChecked: decision.ResourceChecked,
+		}
+
+		if decision.ResourceChecked {
+			apiExplanation.GroupFilter.ResourceAllowed = new(decision.ResourceAllowed)
+		}
+
+		if decision.Reason != "" {
+			apiExplanation.GroupFilter.Reason = new(decision.Reason)
+		}
+	}
+
+	return apiExplanation
+}
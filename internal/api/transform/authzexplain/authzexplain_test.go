@@ -0,0 +1,80 @@
+package authzexplain_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform/authzexplain"
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/manager"
+)
+
+func TestFromAPI(t *testing.T) {
+	resourceID := uuid.New()
+
+	request := authzexplain.FromAPI(cmkapi.AuthzExplainRequest{
+		UserIdentifier: new("user"),
+		Groups:         []string{"group"},
+		Method:         cmkapi.AuthzExplainRequestMethodGET,
+		Path:           "/keys",
+		ResourceID:     &resourceID,
+	})
+
+	assert.Equal(t, manager.AuthzExplainRequest{
+		UserIdentifier: "user",
+		Groups:         []string{"group"},
+		Method:         "GET",
+		Path:           "/keys",
+		ResourceID:     &resourceID,
+	}, request)
+}
+
+func TestToAPI(t *testing.T) {
+	t.Run("Should convert explained decision", func(t *testing.T) {
+		elevationID := uuid.New()
+
+		explanation := authzexplain.ToAPI(manager.AuthzExplanation{
+			Endpoint: "GET /keys",
+			Restriction: &authz.Restricted{
+				APIResourceTypeName: authz.APIResourceTypeKey,
+				APIAction:           authz.APIActionRead,
+			},
+			Groups: []manager.ExplainedGroup{
+				{IAMIdentifier: "admins", Found: true, Role: "KEY_OPERATOR", BaseRole: constants.KeyAdminRole},
+				{IAMIdentifier: "unknown"},
+			},
+			Policies: []manager.ExplainedPolicy{
+				{Role: "KEY_OPERATOR", PolicyID: "KEY_OPERATOR", GrantsAccess: true},
+				{Role: constants.KeyAdminRole, PolicyID: "KEY_ADMINISTRATOR", RoleElevationID: &elevationID},
+			},
+			Allowed:     true,
+			Reason:      "Granted",
+			GroupFilter: &manager.AccessDecision{GroupFiltered: true},
+		})
+
+		assert.True(t, explanation.Allowed)
+		assert.Equal(t, "GET /keys", *explanation.Endpoint)
+		assert.Equal(t, &cmkapi.AuthzRestriction{ResourceType: "Key", Action: "read"}, explanation.Restriction)
+		assert.Equal(t, string(constants.KeyAdminRole), *explanation.Groups[0].BaseRole)
+		assert.Nil(t, explanation.Groups[1].Role)
+		assert.True(t, explanation.Policies[0].GrantsAccess)
+		assert.Nil(t, explanation.Policies[0].RoleElevationID)
+		assert.Equal(t, &elevationID, explanation.Policies[1].RoleElevationID)
+		assert.True(t, explanation.GroupFilter.GroupFiltered)
+		assert.Nil(t, explanation.GroupFilter.ResourceAllowed)
+		assert.Nil(t, explanation.GroupFilter.Reason)
+	})
+
+	t.Run("Should omit missing endpoint", func(t *testing.T) {
+		explanation := authzexplain.ToAPI(manager.AuthzExplanation{Reason: "No match"})
+
+		assert.Nil(t, explanation.Endpoint)
+		assert.Nil(t, explanation.Restriction)
+		assert.Nil(t, explanation.GroupFilter)
+		assert.Empty(t, explanation.Groups)
+	})
+}
@@ -0,0 +1,43 @@
+package capabilities
+
+import (
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/keymanagement"
+)
+
+var (
+	apiKeyAlgorithms = map[keymanagement.KeyAlgorithm]cmkapi.KeyAlgorithm{
+		keymanagement.AES256: cmkapi.KeyAlgorithmAES256,
+	}
+	apiKeyTypes = map[keymanagement.KeyType]cmkapi.KeyType{
+		keymanagement.BYOK: cmkapi.KeyTypeBYOK,
+		keymanagement.HYOK: cmkapi.KeyTypeHYOK,
+	}
+)
+
+// ToAPI transforms the capabilities of a keystore provider to API capabilities.
+// Algorithms and key types without API representation are left out.
+func ToAPI(provider string, capabilities keymanagement.Capabilities) cmkapi.KeystoreCapabilities {
+	return cmkapi.KeystoreCapabilities{
+		Provider:   provider,
+		Declared:   capabilities.Declared,
+		Version:    int(capabilities.Version), //nolint:gosec
+		Algorithms: toAPIValues(capabilities.Algorithms, apiKeyAlgorithms),
+		KeyTypes:   toAPIValues(capabilities.KeyTypes, apiKeyTypes),
+		Import:     capabilities.SupportsImport(),
+		Rotation:   capabilities.SupportsRotation(),
+		Regions:    append([]cmkapi.KeyRegion{}, capabilities.Regions...),
+	}
+}
+
+func toAPIValues[K comparable, V any](values []K, apiValues map[K]V) []V {
+	result := make([]V, 0, len(values))
+
+	for _, value := range values {
+		if apiValue, ok := apiValues[value]; ok {
+			result = append(result, apiValue)
+		}
+	}
+
+	return result
+}
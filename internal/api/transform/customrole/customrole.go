@@ -0,0 +1,91 @@
+package customrole
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/utils/ptr"
+	"github.com/openkcm/cmk/utils/sanitise"
+)
+
+var ErrFromAPI = errors.New("failed to transform custom role from API")
+
+// ToAPI transforms a custom role model to an API custom role.
+func ToAPI(role model.CustomRole) (*cmkapi.CustomRole, error) {
+	err := sanitise.Sanitize(&role)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := role.GetPermissions()
+	if err != nil {
+		return nil, err
+	}
+
+	return &cmkapi.CustomRole{
+		Id:          &role.ID,
+		Name:        string(role.Name),
+		Description: &role.Description,
+		BaseRole:    cmkapi.CustomRoleBaseRole(role.BaseRole),
+		Permissions: PermissionsToAPI(permissions),
+	}, nil
+}
+
+// FromAPI transforms an API custom role to a custom role model.
+func FromAPI(apiRole cmkapi.CustomRole) (*model.CustomRole, error) {
+	role := &model.CustomRole{
+		ID:          uuid.New(),
+		Name:        constants.BusinessRole(apiRole.Name),
+		Description: ptr.GetSafeDeref(apiRole.Description),
+		BaseRole:    constants.BusinessRole(apiRole.BaseRole),
+	}
+
+	err := role.SetPermissions(PermissionsFromAPI(apiRole.Permissions))
+	if err != nil {
+		return nil, errs.Wrap(ErrFromAPI, err)
+	}
+
+	return role, nil
+}
+
+// PermissionsFromAPI transforms API custom role permissions to model permissions.
+func PermissionsFromAPI(apiPermissions []cmkapi.CustomRolePermission) []model.CustomRolePermission {
+	permissions := make([]model.CustomRolePermission, 0, len(apiPermissions))
+	for _, apiPermission := range apiPermissions {
+		actions := make([]authz.APIAction, 0, len(apiPermission.Actions))
+		for _, action := range apiPermission.Actions {
+			actions = append(actions, authz.APIAction(action))
+		}
+
+		permissions = append(permissions, model.CustomRolePermission{
+			ResourceType: authz.APIResourceType(apiPermission.ResourceType),
+			Actions:      actions,
+		})
+	}
+
+	return permissions
+}
+
+// PermissionsToAPI transforms model custom role permissions to API permissions.
+func PermissionsToAPI(permissions []model.CustomRolePermission) []cmkapi.CustomRolePermission {
+	apiPermissions := make([]cmkapi.CustomRolePermission, 0, len(permissions))
+	for _, permission := range permissions {
+		actions := make([]string, 0, len(permission.Actions))
+		for _, action := range permission.Actions {
+			actions = append(actions, string(action))
+		}
+
+		apiPermissions = append(apiPermissions, cmkapi.CustomRolePermission{
+			ResourceType: string(permission.ResourceType),
+			Actions:      actions,
+		})
+	}
+
+	return apiPermissions
+}
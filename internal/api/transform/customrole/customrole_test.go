@@ -0,0 +1,66 @@
+package customrole_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform/customrole"
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/model"
+)
+
+func TestToAPI(t *testing.T) {
+	t.Run("Should convert to API type", func(t *testing.T) {
+		role := model.CustomRole{
+			ID:          uuid.New(),
+			Name:        "KEY_OPERATOR",
+			Description: "test",
+			BaseRole:    constants.KeyAdminRole,
+		}
+		assert.NoError(t, role.SetPermissions([]model.CustomRolePermission{
+			{
+				ResourceType: authz.APIResourceTypeKey,
+				Actions:      []authz.APIAction{authz.APIActionRead, authz.APIActionUpdate},
+			},
+		}))
+
+		res, err := customrole.ToAPI(role)
+		assert.NoError(t, err)
+		assert.Equal(t, role.ID, *res.Id)
+		assert.Equal(t, "KEY_OPERATOR", res.Name)
+		assert.Equal(t, "test", *res.Description)
+		assert.Equal(t, cmkapi.CustomRoleBaseRoleKEYADMINISTRATOR, res.BaseRole)
+		assert.Equal(t, []cmkapi.CustomRolePermission{
+			{ResourceType: "Key", Actions: []string{"read", "update"}},
+		}, res.Permissions)
+	})
+}
+
+func TestFromAPI(t *testing.T) {
+	t.Run("Should convert from API type", func(t *testing.T) {
+		res, err := customrole.FromAPI(cmkapi.CustomRole{
+			Name:     "KEY_OPERATOR",
+			BaseRole: cmkapi.CustomRoleBaseRoleKEYADMINISTRATOR,
+			Permissions: []cmkapi.CustomRolePermission{
+				{ResourceType: "Key", Actions: []string{"read", "update"}},
+			},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, res.ID)
+		assert.Equal(t, constants.BusinessRole("KEY_OPERATOR"), res.Name)
+		assert.Equal(t, constants.KeyAdminRole, res.BaseRole)
+
+		permissions, err := res.GetPermissions()
+		assert.NoError(t, err)
+		assert.Equal(t, []model.CustomRolePermission{
+			{
+				ResourceType: authz.APIResourceTypeKey,
+				Actions:      []authz.APIAction{authz.APIActionRead, authz.APIActionUpdate},
+			},
+		}, permissions)
+	})
+}
@@ -0,0 +1,29 @@
+package resourcegrant
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/model"
+)
+
+// ToAPI transforms a resource grant model to an API resource grant.
+func ToAPI(grant model.ResourceGrant) (*cmkapi.ResourceGrant, error) {
+	return &cmkapi.ResourceGrant{
+		Id:           &grant.ID,
+		ResourceType: cmkapi.ResourceGrantType(grant.ResourceType),
+		ResourceID:   grant.ResourceID,
+		Access:       cmkapi.ResourceGrantAccess(grant.Access),
+	}, nil
+}
+
+// FromAPI transforms an API resource grant of a group to a resource grant model.
+func FromAPI(apiGrant cmkapi.ResourceGrant, groupID uuid.UUID) *model.ResourceGrant {
+	return &model.ResourceGrant{
+		ID:           uuid.New(),
+		GroupID:      groupID,
+		ResourceType: model.ResourceGrantType(apiGrant.ResourceType),
+		ResourceID:   apiGrant.ResourceID,
+		Access:       model.ResourceGrantAccess(apiGrant.Access),
+	}
+}
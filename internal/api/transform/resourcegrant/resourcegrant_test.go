@@ -0,0 +1,50 @@
+package resourcegrant_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform/resourcegrant"
+	"github.com/openkcm/cmk/internal/model"
+)
+
+func TestToAPI(t *testing.T) {
+	t.Run("Should convert to API type", func(t *testing.T) {
+		grant := model.ResourceGrant{
+			ID:           uuid.New(),
+			GroupID:      uuid.New(),
+			ResourceType: model.ResourceGrantTypeSystem,
+			ResourceID:   uuid.New(),
+			Access:       model.ResourceGrantAccessOperate,
+		}
+
+		res, err := resourcegrant.ToAPI(grant)
+		assert.NoError(t, err)
+		assert.Equal(t, grant.ID, *res.Id)
+		assert.Equal(t, cmkapi.ResourceGrantTypeSYSTEM, res.ResourceType)
+		assert.Equal(t, grant.ResourceID, res.ResourceID)
+		assert.Equal(t, cmkapi.ResourceGrantAccessOPERATE, res.Access)
+	})
+}
+
+func TestFromAPI(t *testing.T) {
+	t.Run("Should convert from API type", func(t *testing.T) {
+		groupID := uuid.New()
+		resourceID := uuid.New()
+
+		res := resourcegrant.FromAPI(cmkapi.ResourceGrant{
+			ResourceType: cmkapi.ResourceGrantTypeKEYCONFIGURATION,
+			ResourceID:   resourceID,
+			Access:       cmkapi.ResourceGrantAccessREAD,
+		}, groupID)
+
+		assert.NotEqual(t, uuid.Nil, res.ID)
+		assert.Equal(t, groupID, res.GroupID)
+		assert.Equal(t, model.ResourceGrantTypeKeyConfiguration, res.ResourceType)
+		assert.Equal(t, resourceID, res.ResourceID)
+		assert.Equal(t, model.ResourceGrantAccessRead, res.Access)
+	})
+}
@@ -0,0 +1,96 @@
+package systemgroup
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/utils/ptr"
+	"github.com/openkcm/cmk/utils/sanitise"
+)
+
+var ErrFromAPI = errors.New("failed to transform system group from API")
+
+// ToAPI transforms a system group model to an API system group.
+func ToAPI(group model.SystemGroup) (*cmkapi.SystemGroup, error) {
+	err := sanitise.Sanitize(&group)
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := group.GetSelector()
+	if err != nil {
+		return nil, err
+	}
+
+	return &cmkapi.SystemGroup{
+		Id:          &group.ID,
+		Name:        group.Name,
+		Description: &group.Description,
+		Selector:    SelectorToAPI(selector),
+		Status: &cmkapi.SystemGroupStatus{
+			Total:        group.Status.Total,
+			Connected:    group.Status.Connected,
+			Disconnected: group.Status.Disconnected,
+			Processing:   group.Status.Processing,
+			Failed:       group.Status.Failed,
+			Drifted:      group.Status.Drifted,
+		},
+	}, nil
+}
+
+// FromAPI transforms an API system group to a system group model.
+// Explicit member systems are not part of the model and are handled by the caller.
+func FromAPI(apiGroup cmkapi.SystemGroup) (*model.SystemGroup, error) {
+	group := &model.SystemGroup{
+		ID:          uuid.New(),
+		Name:        apiGroup.Name,
+		Description: ptr.GetSafeDeref(apiGroup.Description),
+	}
+
+	err := group.SetSelector(SelectorFromAPI(apiGroup.Selector))
+	if err != nil {
+		return nil, errs.Wrap(ErrFromAPI, err)
+	}
+
+	return group, nil
+}
+
+// SelectorFromAPI transforms an API system selector to a model selector.
+func SelectorFromAPI(selector *cmkapi.SystemSelector) *model.SystemSelector {
+	if selector == nil {
+		return nil
+	}
+
+	return &model.SystemSelector{
+		Type:       model.SystemType(ptr.GetSafeDeref(selector.Type)),
+		Region:     ptr.GetSafeDeref(selector.Region),
+		Properties: ptr.GetSafeDeref(selector.Properties),
+	}
+}
+
+// SelectorToAPI transforms a model selector to an API system selector.
+func SelectorToAPI(selector *model.SystemSelector) *cmkapi.SystemSelector {
+	if selector == nil {
+		return nil
+	}
+
+	apiSelector := &cmkapi.SystemSelector{}
+
+	if selector.Type != "" {
+		apiSelector.Type = new(string(selector.Type))
+	}
+
+	if selector.Region != "" {
+		apiSelector.Region = &selector.Region
+	}
+
+	if len(selector.Properties) > 0 {
+		apiSelector.Properties = &selector.Properties
+	}
+
+	return apiSelector
+}
@@ -0,0 +1,89 @@
+package systemgroup_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform/systemgroup"
+	"github.com/openkcm/cmk/internal/model"
+)
+
+func TestToAPI(t *testing.T) {
+	t.Run("Should convert explicit group to API type", func(t *testing.T) {
+		group := model.SystemGroup{
+			ID:          uuid.New(),
+			Name:        "landscape",
+			Description: "test",
+			Status: model.SystemGroupStatus{
+				Total:     4,
+				Connected: 2,
+				Failed:    1,
+				Drifted:   1,
+			},
+		}
+
+		res, err := systemgroup.ToAPI(group)
+		assert.NoError(t, err)
+		assert.Equal(t, group.ID, *res.Id)
+		assert.Equal(t, group.Name, res.Name)
+		assert.Equal(t, group.Description, *res.Description)
+		assert.Nil(t, res.Selector)
+		assert.Equal(t, &cmkapi.SystemGroupStatus{
+			Total:     4,
+			Connected: 2,
+			Failed:    1,
+			Drifted:   1,
+		}, res.Status)
+	})
+
+	t.Run("Should convert selector group to API type", func(t *testing.T) {
+		group := model.SystemGroup{
+			ID:   uuid.New(),
+			Name: "landscape",
+		}
+		assert.NoError(t, group.SetSelector(&model.SystemSelector{
+			Region:     "eu10",
+			Properties: map[string]string{"landscape": "prod"},
+		}))
+
+		res, err := systemgroup.ToAPI(group)
+		assert.NoError(t, err)
+		assert.Nil(t, res.Selector.Type)
+		assert.Equal(t, "eu10", *res.Selector.Region)
+		assert.Equal(t, map[string]string{"landscape": "prod"}, *res.Selector.Properties)
+	})
+}
+
+func TestFromAPI(t *testing.T) {
+	t.Run("Should convert from API type", func(t *testing.T) {
+		res, err := systemgroup.FromAPI(cmkapi.SystemGroup{
+			Name:        "landscape",
+			Description: new("test"),
+			Selector: &cmkapi.SystemSelector{
+				Type: new(string(model.SystemTypeSUBACCOUNT)),
+			},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, res.ID)
+		assert.Equal(t, "landscape", res.Name)
+		assert.Equal(t, "test", res.Description)
+
+		selector, err := res.GetSelector()
+		assert.NoError(t, err)
+		assert.Equal(t, &model.SystemSelector{Type: model.SystemTypeSUBACCOUNT}, selector)
+	})
+
+	t.Run("Should fail on invalid selector type", func(t *testing.T) {
+		_, err := systemgroup.FromAPI(cmkapi.SystemGroup{
+			Name: "landscape",
+			Selector: &cmkapi.SystemSelector{
+				Type: new("BOGUS"),
+			},
+		})
+		assert.ErrorIs(t, err, systemgroup.ErrFromAPI)
+		assert.ErrorIs(t, err, model.ErrInvalidSystemType)
+	})
+}
@@ -0,0 +1,61 @@
+package tenantconfigs
+
+import (
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/utils/ptr"
+)
+
+// SystemAutoLinkConfigToAPI transforms a model.SystemAutoLinkConfig to an API TenantSystemAutoLinkConfiguration.
+func SystemAutoLinkConfigToAPI(config *model.SystemAutoLinkConfig) *cmkapi.TenantSystemAutoLinkConfiguration {
+	if config == nil {
+		return nil
+	}
+
+	rules := make([]cmkapi.SystemAutoLinkRule, 0, len(config.Rules))
+	for _, rule := range config.Rules {
+		apiRule := cmkapi.SystemAutoLinkRule{
+			KeyConfigurationID: rule.KeyConfigurationID,
+		}
+
+		if rule.Name != "" {
+			apiRule.Name = new(rule.Name)
+		}
+
+		if rule.Type != "" {
+			apiRule.Type = new(string(rule.Type))
+		}
+
+		if rule.Region != "" {
+			apiRule.Region = new(rule.Region)
+		}
+
+		if len(rule.Properties) > 0 {
+			apiRule.Properties = new(rule.Properties)
+		}
+
+		rules = append(rules, apiRule)
+	}
+
+	return &cmkapi.TenantSystemAutoLinkConfiguration{
+		Rules: rules,
+	}
+}
+
+// SystemAutoLinkConfigFromAPI transforms an API TenantSystemAutoLinkConfiguration to a model.SystemAutoLinkConfig.
+func SystemAutoLinkConfigFromAPI(apiConfig cmkapi.TenantSystemAutoLinkConfiguration) *model.SystemAutoLinkConfig {
+	rules := make([]model.SystemAutoLinkRule, 0, len(apiConfig.Rules))
+	for _, apiRule := range apiConfig.Rules {
+		rules = append(rules, model.SystemAutoLinkRule{
+			Name:               ptr.GetSafeDeref(apiRule.Name),
+			Type:               model.SystemType(ptr.GetSafeDeref(apiRule.Type)),
+			Region:             ptr.GetSafeDeref(apiRule.Region),
+			Properties:         ptr.GetSafeDeref(apiRule.Properties),
+			KeyConfigurationID: apiRule.KeyConfigurationID,
+		})
+	}
+
+	return &model.SystemAutoLinkConfig{
+		Rules: rules,
+	}
+}
@@ -0,0 +1,94 @@
+package tenantconfigs_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform/tenantconfigs"
+	"github.com/openkcm/cmk/internal/model"
+)
+
+func TestSystemAutoLinkConfigToAPI(t *testing.T) {
+	t.Run("Should return nil for nil config", func(t *testing.T) {
+		assert.Nil(t, tenantconfigs.SystemAutoLinkConfigToAPI(nil))
+	})
+
+	t.Run("Should map rules", func(t *testing.T) {
+		keyConfigID := uuid.New()
+		config := &model.SystemAutoLinkConfig{
+			Rules: []model.SystemAutoLinkRule{
+				{
+					Name:               "prod",
+					Type:               model.SystemTypeSUBACCOUNT,
+					Region:             "eu10",
+					Properties:         map[string]string{"landscape": "prod"},
+					KeyConfigurationID: keyConfigID,
+				},
+				{
+					KeyConfigurationID: keyConfigID,
+				},
+			},
+		}
+
+		expected := &cmkapi.TenantSystemAutoLinkConfiguration{
+			Rules: []cmkapi.SystemAutoLinkRule{
+				{
+					Name:               new("prod"),
+					Type:               new(string(model.SystemTypeSUBACCOUNT)),
+					Region:             new("eu10"),
+					Properties:         &map[string]string{"landscape": "prod"},
+					KeyConfigurationID: keyConfigID,
+				},
+				{
+					KeyConfigurationID: keyConfigID,
+				},
+			},
+		}
+
+		assert.Equal(t, expected, tenantconfigs.SystemAutoLinkConfigToAPI(config))
+	})
+
+	t.Run("Should return empty rules for empty config", func(t *testing.T) {
+		apiConfig := tenantconfigs.SystemAutoLinkConfigToAPI(&model.SystemAutoLinkConfig{})
+		assert.NotNil(t, apiConfig.Rules)
+		assert.Empty(t, apiConfig.Rules)
+	})
+}
+
+func TestSystemAutoLinkConfigFromAPI(t *testing.T) {
+	keyConfigID := uuid.New()
+	apiConfig := cmkapi.TenantSystemAutoLinkConfiguration{
+		Rules: []cmkapi.SystemAutoLinkRule{
+			{
+				Name:               new("prod"),
+				Type:               new(string(model.SystemTypeSYSTEM)),
+				Region:             new("eu10"),
+				Properties:         &map[string]string{"landscape": "prod"},
+				KeyConfigurationID: keyConfigID,
+			},
+			{
+				KeyConfigurationID: keyConfigID,
+			},
+		},
+	}
+
+	expected := &model.SystemAutoLinkConfig{
+		Rules: []model.SystemAutoLinkRule{
+			{
+				Name:               "prod",
+				Type:               model.SystemTypeSYSTEM,
+				Region:             "eu10",
+				Properties:         map[string]string{"landscape": "prod"},
+				KeyConfigurationID: keyConfigID,
+			},
+			{
+				KeyConfigurationID: keyConfigID,
+			},
+		},
+	}
+
+	assert.Equal(t, expected, tenantconfigs.SystemAutoLinkConfigFromAPI(apiConfig))
+}
@@ -0,0 +1,104 @@
+package apierrors
+
+import (
+	"net/http"
+
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/repo"
+)
+
+// ErrInvalidAPIToken is returned for every rejected API token, so that
+// clients can't tell unknown tokens from revoked or expired ones
+var ErrInvalidAPIToken = &APIError{
+	Code:    "INVALID_API_TOKEN",
+	Message: "API token is invalid, expired or revoked",
+	Status:  http.StatusUnauthorized,
+}
+
+var apiToken = []errs.ExposedErrors[*APIError]{
+	{
+		InternalErrorChain: []error{manager.ErrListAPITokens},
+		ExposedError: &APIError{
+			Code:    "LIST_API_TOKENS",
+			Message: "Failed to list API tokens",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrGetAPIToken},
+		ExposedError: &APIError{
+			Code:    "GET_API_TOKEN",
+			Message: "Failed to get the API token",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrGetAPIToken, repo.ErrNotFound},
+		ExposedError: &APIError{
+			Code:    "API_TOKEN_NOT_FOUND",
+			Message: "API token does not exist",
+			Status:  http.StatusNotFound,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrCreateAPIToken, repo.ErrUniqueConstraint},
+		ExposedError: &APIError{
+			Code:    "API_TOKEN_EXISTS",
+			Message: "API token already exists",
+			Status:  http.StatusConflict,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrCreateAPIToken},
+		ExposedError: &APIError{
+			Code:    "CREATE_API_TOKEN",
+			Message: "Failed to create the API token",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrRevokeAPIToken},
+		ExposedError: &APIError{
+			Code:    "REVOKE_API_TOKEN",
+			Message: "Failed to revoke the API token",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrInvalidAPITokenExpiry},
+		ExposedError: &APIError{
+			Code:    "INVALID_API_TOKEN_EXPIRY",
+			Message: "API token expiry must be in the future and within the maximum validity",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrAPITokenNotAllowed},
+		ExposedError: &APIError{
+			Code:    "API_TOKEN_NOT_ALLOWED",
+			Message: "API tokens cannot create API tokens",
+			Status:  http.StatusForbidden,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrAPITokenGroupNotOwn},
+		ExposedError: &APIError{
+			Code:    "API_TOKEN_GROUP_NOT_ALLOWED",
+			Message: "API tokens can only be bound to a group of the creator",
+			Status:  http.StatusForbidden,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrInvalidAPIToken},
+		ExposedError:       ErrInvalidAPIToken,
+	},
+	{
+		InternalErrorChain: []error{manager.ErrAPITokenExpired},
+		ExposedError:       ErrInvalidAPIToken,
+	},
+	{
+		InternalErrorChain: []error{manager.ErrAPITokenRevoked},
+		ExposedError:       ErrInvalidAPIToken,
+	},
+}
@@ -0,0 +1,19 @@
+package apierrors
+
+import (
+	"net/http"
+
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/manager"
+)
+
+var auditEvent = []errs.ExposedErrors[*APIError]{
+	{
+		InternalErrorChain: []error{manager.ErrListAuditEvents},
+		ExposedError: &APIError{
+			Code:    "LIST_AUDIT_EVENTS",
+			Message: "Failed to list audit events",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+}
@@ -0,0 +1,19 @@
+package apierrors
+
+import (
+	"net/http"
+
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/manager"
+)
+
+var authzExplain = []errs.ExposedErrors[*APIError]{
+	{
+		InternalErrorChain: []error{manager.ErrExplainAuthz},
+		ExposedError: &APIError{
+			Code:    "EXPLAIN_AUTHZ",
+			Message: "Failed to explain the authorization decision",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+}
@@ -0,0 +1,19 @@
+package apierrors
+
+import (
+	"net/http"
+
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/manager"
+)
+
+var capabilities = []errs.ExposedErrors[*APIError]{
+	{
+		InternalErrorChain: []error{manager.ErrGetCapabilities},
+		ExposedError: &APIError{
+			Code:    "GET_CAPABILITIES",
+			Message: "Failed to get keystore capabilities",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+}
@@ -0,0 +1,126 @@
+package apierrors
+
+import (
+	"net/http"
+
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+)
+
+var customRole = []errs.ExposedErrors[*APIError]{
+	{
+		InternalErrorChain: []error{manager.ErrListCustomRoles},
+		ExposedError: &APIError{
+			Code:    "LIST_ROLES",
+			Message: "Failed to list custom roles",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrGetCustomRole},
+		ExposedError: &APIError{
+			Code:    "GET_ROLE",
+			Message: "Failed to get the custom role",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrGetCustomRole, repo.ErrNotFound},
+		ExposedError: &APIError{
+			Code:    "ROLE_NOT_FOUND",
+			Message: "Custom role does not exist",
+			Status:  http.StatusNotFound,
+		},
+	},
+	{
+		InternalErrorChain: []error{model.ErrInvalidCustomRoleName},
+		ExposedError: &APIError{
+			Code:    "INVALID_ROLE_NAME",
+			Message: "Invalid name for custom role",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{authz.ErrCustomRoleName},
+		ExposedError: &APIError{
+			Code:    "INVALID_ROLE_NAME",
+			Message: "Custom role name must not be a built-in role",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{authz.ErrCustomRoleBaseRole},
+		ExposedError: &APIError{
+			Code:    "INVALID_ROLE_BASE_ROLE",
+			Message: "Custom role base role must be a built-in role",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{authz.ErrCustomRoleNoPermission},
+		ExposedError: &APIError{
+			Code:    "INVALID_ROLE_PERMISSIONS",
+			Message: "Custom role must grant at least one action",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{authz.ErrCustomRolePermission},
+		ExposedError: &APIError{
+			Code:    "INVALID_ROLE_PERMISSIONS",
+			Message: "Custom role permissions must be granted by its base role",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrCreateCustomRole, repo.ErrUniqueConstraint},
+		ExposedError: &APIError{
+			Code:    "ROLE_NAME_EXISTS",
+			Message: "Custom role with this name already exists",
+			Status:  http.StatusConflict,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrCreateCustomRole},
+		ExposedError: &APIError{
+			Code:    "CREATE_ROLE",
+			Message: "Failed to create the custom role",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrUpdateCustomRole},
+		ExposedError: &APIError{
+			Code:    "UPDATE_ROLE",
+			Message: "Failed to update the custom role",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrCustomRoleInUse},
+		ExposedError: &APIError{
+			Code:    "DELETE_ROLE_IN_USE",
+			Message: "Custom role is referenced by a group",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrDeleteCustomRole},
+		ExposedError: &APIError{
+			Code:    "DELETE_ROLE",
+			Message: "Failed to delete the custom role",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrDeleteCustomRole, repo.ErrNotFound},
+		ExposedError: &APIError{
+			Code:    "ROLE_NOT_FOUND",
+			Message: "Custom role does not exist",
+			Status:  http.StatusNotFound,
+		},
+	},
+}
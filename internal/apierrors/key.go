@@ -146,6 +146,38 @@ var key = []errs.ExposedErrors[*APIError]{
 			Status:  http.StatusBadRequest,
 		},
 	},
+	{
+		InternalErrorChain: []error{ErrCreateKey, manager.ErrUnsupportedKeyType},
+		ExposedError: &APIError{
+			Code:    "UNSUPPORTED_KEY_TYPE",
+			Message: "The key type is not supported by the keystore provider",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{ErrCreateKey, manager.ErrUnsupportedKeyAlgorithm},
+		ExposedError: &APIError{
+			Code:    "UNSUPPORTED_KEY_ALGORITHM",
+			Message: "The key algorithm is not supported by the keystore provider",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{ErrCreateKey, manager.ErrUnsupportedRegion},
+		ExposedError: &APIError{
+			Code:    "UNSUPPORTED_REGION",
+			Message: "The region is not supported by the keystore provider",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrImportNotSupported},
+		ExposedError: &APIError{
+			Code:    "IMPORT_NOT_SUPPORTED",
+			Message: "Key material import is not supported by the keystore provider",
+			Status:  http.StatusBadRequest,
+		},
+	},
 	{
 		InternalErrorChain: []error{ErrCreateKey, gorm.ErrRecordNotFound},
 		ExposedError: &APIError{
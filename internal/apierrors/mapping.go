@@ -26,12 +26,19 @@ var APIErrorMapper = errs.NewMapper(slices.Concat(
 	keyVersion,
 	workflow,
 	system,
+	systemGroup,
 	label,
 	tags,
 	key,
 	tenantconfig,
 	groups,
+	customRole,
+	resourceGrant,
 	tenants,
 	userinfo,
+	auditEvent,
+	capabilities,
+	authzExplain,
+	apiToken,
 	defaultMapper,
 ), highPrio)
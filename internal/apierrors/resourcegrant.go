@@ -0,0 +1,93 @@
+package apierrors
+
+import (
+	"net/http"
+
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+)
+
+var resourceGrant = []errs.ExposedErrors[*APIError]{
+	{
+		InternalErrorChain: []error{manager.ErrListResourceGrants},
+		ExposedError: &APIError{
+			Code:    "LIST_GRANTS",
+			Message: "Failed to list resource grants",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrGetResourceGrant},
+		ExposedError: &APIError{
+			Code:    "GET_GRANT",
+			Message: "Failed to get the resource grant",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrGetResourceGrant, repo.ErrNotFound},
+		ExposedError: &APIError{
+			Code:    "GRANT_NOT_FOUND",
+			Message: "Resource grant does not exist",
+			Status:  http.StatusNotFound,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrResourceGrantNoTarget},
+		ExposedError: &APIError{
+			Code:    "GRANT_RESOURCE_NOT_FOUND",
+			Message: "Key configuration or system of the resource grant does not exist",
+			Status:  http.StatusNotFound,
+		},
+	},
+	{
+		InternalErrorChain: []error{model.ErrInvalidResourceGrantType},
+		ExposedError: &APIError{
+			Code:    "INVALID_GRANT_RESOURCE_TYPE",
+			Message: "Invalid resource type for resource grant",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{model.ErrInvalidResourceGrantAccess},
+		ExposedError: &APIError{
+			Code:    "INVALID_GRANT_ACCESS",
+			Message: "Invalid access for resource grant",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrCreateResourceGrant, repo.ErrUniqueConstraint},
+		ExposedError: &APIError{
+			Code:    "GRANT_EXISTS",
+			Message: "Group already has a grant on this resource",
+			Status:  http.StatusConflict,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrCreateResourceGrant},
+		ExposedError: &APIError{
+			Code:    "CREATE_GRANT",
+			Message: "Failed to create the resource grant",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrDeleteResourceGrant},
+		ExposedError: &APIError{
+			Code:    "DELETE_GRANT",
+			Message: "Failed to delete the resource grant",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrCheckResourceGrants},
+		ExposedError: &APIError{
+			Code:    "CHECK_GRANTS",
+			Message: "Failed to check resource grants",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+}
@@ -157,6 +157,22 @@ var system = []errs.ExposedErrors[*APIError]{
 			Status:  http.StatusBadRequest,
 		},
 	},
+	{
+		InternalErrorChain: []error{manager.ErrValidateSystemLink},
+		ExposedError: &APIError{
+			Code:    "VALIDATE_SYSTEM_LINK",
+			Message: "failed to validate system link",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrValidateSystemLink, manager.ErrGettingKeyConfigByID, repo.ErrNotFound},
+		ExposedError: &APIError{
+			Code:    "KEY_CONFIGURATION_NOT_FOUND",
+			Message: "Key configuration not found",
+			Status:  http.StatusNotFound,
+		},
+	},
 	{
 		InternalErrorChain: []error{manager.ErrKeyConfigurationIDNotFound},
 		ExposedError: &APIError{
@@ -0,0 +1,166 @@
+package apierrors
+
+import (
+	"net/http"
+
+	"github.com/openkcm/cmk/internal/api/transform/systemgroup"
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+)
+
+var systemGroup = []errs.ExposedErrors[*APIError]{
+	{
+		InternalErrorChain: []error{manager.ErrListSystemGroups},
+		ExposedError: &APIError{
+			Code:    "LIST_SYSTEM_GROUPS",
+			Message: "Failed to list system groups",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrGetSystemGroup},
+		ExposedError: &APIError{
+			Code:    "GET_SYSTEM_GROUP",
+			Message: "Failed to get the system group",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrGetSystemGroup, repo.ErrNotFound},
+		ExposedError: &APIError{
+			Code:    "SYSTEM_GROUP_NOT_FOUND",
+			Message: "System group does not exist",
+			Status:  http.StatusNotFound,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrSystemGroupStatus},
+		ExposedError: &APIError{
+			Code:    "GET_SYSTEM_GROUP_STATUS",
+			Message: "Failed to get the status of the system group",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{systemgroup.ErrFromAPI, model.ErrInvalidSystemType},
+		ExposedError: &APIError{
+			Code:    "INVALID_SYSTEM_GROUP_SELECTOR",
+			Message: "Selector type must be one of SYSTEM or SUBACCOUNT",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrUpdateSystemGroup, model.ErrInvalidSystemType},
+		ExposedError: &APIError{
+			Code:    "INVALID_SYSTEM_GROUP_SELECTOR",
+			Message: "Selector type must be one of SYSTEM or SUBACCOUNT",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrSystemGroupEmptySelector},
+		ExposedError: &APIError{
+			Code:    "INVALID_SYSTEM_GROUP_SELECTOR",
+			Message: "Selector must set at least one of type, region or properties",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrSystemGroupMembership},
+		ExposedError: &APIError{
+			Code:    "INVALID_SYSTEM_GROUP_MEMBERSHIP",
+			Message: "System group must either use a selector or explicit systems",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrSystemGroupUnknownSystems},
+		ExposedError: &APIError{
+			Code:    "SYSTEM_GROUP_UNKNOWN_SYSTEMS",
+			Message: "System group references systems that do not exist",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{model.ErrInvalidSystemGroupName},
+		ExposedError: &APIError{
+			Code:    "INVALID_SYSTEM_GROUP_NAME",
+			Message: "Invalid name for system group",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrCreateSystemGroup, repo.ErrUniqueConstraint},
+		ExposedError: &APIError{
+			Code:    "SYSTEM_GROUP_NAME_EXISTS",
+			Message: "System group with this name already exists",
+			Status:  http.StatusConflict,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrUpdateSystemGroup, repo.ErrUniqueConstraint},
+		ExposedError: &APIError{
+			Code:    "SYSTEM_GROUP_NAME_EXISTS",
+			Message: "System group with this name already exists",
+			Status:  http.StatusConflict,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrUpdateSystemGroup, manager.ErrNameCannotBeEmpty},
+		ExposedError: &APIError{
+			Code:    "RENAME_INVALID_NAME",
+			Message: "System group name cannot be empty",
+			Status:  http.StatusBadRequest,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrCreateSystemGroup},
+		ExposedError: &APIError{
+			Code:    "CREATE_SYSTEM_GROUP",
+			Message: "Failed to create the system group",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrUpdateSystemGroup},
+		ExposedError: &APIError{
+			Code:    "UPDATE_SYSTEM_GROUP",
+			Message: "Failed to update the system group",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrDeleteSystemGroup},
+		ExposedError: &APIError{
+			Code:    "DELETE_SYSTEM_GROUP",
+			Message: "Failed to delete the system group",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrDeleteSystemGroup, repo.ErrNotFound},
+		ExposedError: &APIError{
+			Code:    "SYSTEM_GROUP_NOT_FOUND",
+			Message: "System group does not exist",
+			Status:  http.StatusNotFound,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrListSystemGroupSystems},
+		ExposedError: &APIError{
+			Code:    "LIST_SYSTEM_GROUP_SYSTEMS",
+			Message: "Failed to list the systems of the system group",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{manager.ErrSystemGroupAction},
+		ExposedError: &APIError{
+			Code:    "SYSTEM_GROUP_ACTION",
+			Message: "Failed to apply the action on the system group",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+}
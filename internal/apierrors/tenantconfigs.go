@@ -6,12 +6,16 @@ import (
 
 	"github.com/openkcm/cmk/internal/errs"
 	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
 )
 
 var (
 	ErrGetDefaultKeystore = errors.New("failed to get default keystore")
 	ErrGetWorkflowConfig  = errors.New("failed to get workflow config")
 	ErrSetWorkflowConfig  = errors.New("failed to set workflow config")
+
+	ErrGetSystemAutoLinkConfig = errors.New("failed to get system auto-link config")
+	ErrSetSystemAutoLinkConfig = errors.New("failed to set system auto-link config")
 )
 
 var tenantconfig = []errs.ExposedErrors[*APIError]{
@@ -83,4 +87,42 @@ var tenantconfig = []errs.ExposedErrors[*APIError]{
 			Status:  http.StatusInternalServerError,
 		},
 	},
+	{
+		InternalErrorChain: []error{ErrGetSystemAutoLinkConfig},
+		ExposedError: &APIError{
+			Code:    "GET_SYSTEM_AUTO_LINK_CONFIG",
+			Message: "Failed to get system auto-link configuration",
+			Status:  http.StatusInternalServerError,
+		},
+	},
+	{
+		InternalErrorChain: []error{ErrSetSystemAutoLinkConfig, model.ErrInvalidSystemType},
+		ExposedError: &APIError{
+			Code:    "INVALID_SETTING",
+			Message: "type must be one of SYSTEM or SUBACCOUNT",
+			Status:  http.StatusBadRequest,
+		},
+		ContextGetter: func(_ error) map[string]any {
+			return map[string]any{"setting": "type"}
+		},
+	},
+	{
+		InternalErrorChain: []error{ErrSetSystemAutoLinkConfig, manager.ErrAutoLinkRuleKeyConfig},
+		ExposedError: &APIError{
+			Code:    "INVALID_SETTING",
+			Message: "keyConfigurationID must reference an existing key configuration",
+			Status:  http.StatusBadRequest,
+		},
+		ContextGetter: func(_ error) map[string]any {
+			return map[string]any{"setting": "keyConfigurationID"}
+		},
+	},
+	{
+		InternalErrorChain: []error{ErrSetSystemAutoLinkConfig},
+		ExposedError: &APIError{
+			Code:    "SET_SYSTEM_AUTO_LINK_CONFIG",
+			Message: "Failed to update system auto-link configuration",
+			Status:  http.StatusInternalServerError,
+		},
+	},
 }
@@ -77,36 +77,9 @@ type App struct {
 
 // New creates a new instance of App
 func New(cfg *conf.Config) (*App, error) {
-	taskQueueCfg := cfg.Scheduler.TaskQueue
-
-	taskQueueHost, err := commoncfg.LoadValueFromSourceRef(taskQueueCfg.Host)
+	redisOpts, err := RedisClientOpt(cfg.Scheduler.TaskQueue)
 	if err != nil {
-		return nil, errs.Wrap(ErrLoadingDatabaseHost, err)
-	}
-
-	var redisOpts asynq.RedisClientOpt
-
-	switch taskQueueCfg.SecretRef.Type {
-	case commoncfg.InsecureSecretType:
-		taskQueueUsername, taskQueuePassword, err := loadALCAuthFromConfig(taskQueueCfg)
-		if err != nil {
-			return nil, err
-		}
-
-		redisOpts = asynq.RedisClientOpt{
-			Addr:     net.JoinHostPort(string(taskQueueHost), taskQueueCfg.Port),
-			Password: string(taskQueuePassword),
-			Username: string(taskQueueUsername),
-		}
-	case commoncfg.MTLSSecretType:
-		redisOpts, err = buildMTLSRedisClientOpt(taskQueueCfg, taskQueueHost)
-		if err != nil {
-			return nil, errs.Wrap(ErrMTLSRedisClientOpt, err)
-		}
-	case commoncfg.ApiTokenSecretType, commoncfg.BasicSecretType, commoncfg.OAuth2SecretType:
-		return nil, ErrSecretTypeQueue
-	default:
-		return nil, ErrSecretTypeQueue
+		return nil, err
 	}
 
 	return &App{
@@ -260,6 +233,40 @@ func (a *App) Inspector() *asynq.Inspector {
 	return asynq.NewInspector(a.taskQueueCfg)
 }
 
+// RedisClientOpt returns the options of a client connecting to the Redis
+// described by the configuration
+func RedisClientOpt(redisCfg conf.Redis) (asynq.RedisClientOpt, error) {
+	host, err := commoncfg.LoadValueFromSourceRef(redisCfg.Host)
+	if err != nil {
+		return asynq.RedisClientOpt{}, errs.Wrap(ErrLoadingDatabaseHost, err)
+	}
+
+	switch redisCfg.SecretRef.Type {
+	case commoncfg.InsecureSecretType:
+		username, password, err := loadALCAuthFromConfig(redisCfg)
+		if err != nil {
+			return asynq.RedisClientOpt{}, err
+		}
+
+		return asynq.RedisClientOpt{
+			Addr:     net.JoinHostPort(string(host), redisCfg.Port),
+			Password: string(password),
+			Username: string(username),
+		}, nil
+	case commoncfg.MTLSSecretType:
+		redisOpts, err := buildMTLSRedisClientOpt(redisCfg, host)
+		if err != nil {
+			return asynq.RedisClientOpt{}, errs.Wrap(ErrMTLSRedisClientOpt, err)
+		}
+
+		return redisOpts, nil
+	case commoncfg.ApiTokenSecretType, commoncfg.BasicSecretType, commoncfg.OAuth2SecretType:
+		return asynq.RedisClientOpt{}, ErrSecretTypeQueue
+	default:
+		return asynq.RedisClientOpt{}, ErrSecretTypeQueue
+	}
+}
+
 func buildMTLSRedisClientOpt(
 	taskQueueCfg conf.Redis,
 	taskQueueHost []byte,
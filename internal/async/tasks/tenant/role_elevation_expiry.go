@@ -0,0 +1,69 @@
+package tasks
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/openkcm/cmk/internal/async"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/log"
+	"github.com/openkcm/cmk/internal/repo"
+)
+
+type RoleElevationExpirer interface {
+	ExpireRoleElevations(ctx context.Context) error
+}
+
+type RoleElevationExpiryProcessor struct {
+	expirer RoleElevationExpirer
+	repo    repo.Repo
+}
+
+func NewRoleElevationExpiryProcessor(
+	expirer RoleElevationExpirer,
+	repo repo.Repo,
+	opts ...async.TaskOption,
+) async.TenantTaskHandler {
+	p := &RoleElevationExpiryProcessor{
+		expirer: expirer,
+		repo:    repo,
+	}
+
+	for _, o := range opts {
+		o(p)
+	}
+
+	return p
+}
+
+func (p *RoleElevationExpiryProcessor) ProcessTask(ctx context.Context, task *asynq.Task) error {
+	err := p.expirer.ExpireRoleElevations(ctx)
+	if err != nil {
+		p.logError(ctx, err)
+	}
+	return nil
+}
+
+func (p *RoleElevationExpiryProcessor) Role() constants.InternalRole {
+	return constants.InternalTaskWorkflowExpirationRole
+}
+
+func (p *RoleElevationExpiryProcessor) TenantQuery() *repo.Query {
+	return repo.NewQuery()
+}
+
+func (p *RoleElevationExpiryProcessor) FanOutFunc() async.FanOutFunc {
+	return async.TenantFanOut
+}
+
+func (p *RoleElevationExpiryProcessor) TaskType() string {
+	return config.TypeRoleElevationExpire
+}
+
+func (p *RoleElevationExpiryProcessor) logError(ctx context.Context, err error) {
+	// Returned errors are retries in batch processor
+	// If we don't want a retry we just log here and return nil
+	log.Error(ctx, "Error during role elevation expiry batch processing", err)
+}
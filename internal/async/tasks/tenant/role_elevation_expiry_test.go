@@ -0,0 +1,105 @@
+package tasks_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+
+	tasks "github.com/openkcm/cmk/internal/async/tasks/tenant"
+	"github.com/openkcm/cmk/internal/authz"
+	authz_loader "github.com/openkcm/cmk/internal/authz/loader"
+	authz_repo "github.com/openkcm/cmk/internal/authz/repo"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+var allowedRoleElevationExpiryTestActions = []authz.RepoAction{
+	authz.RepoActionCount,
+	authz.RepoActionList,
+	authz.RepoActionUpdate,
+}
+
+type RoleElevationExpirerMock struct {
+	authzLoader *authz_loader.AuthzLoader[authz.RepoResourceType,
+		authz.RepoAction]
+}
+
+func (m *RoleElevationExpirerMock) ExpireRoleElevations(ctx context.Context) error {
+	for _, testAction := range allowedRoleElevationExpiryTestActions {
+		isAllowed, err := authz.CheckAuthz(ctx, m.authzLoader.AuthzHandler,
+			authz.RepoResourceTypeRoleElevation, testAction)
+		if err != nil {
+			return err
+		}
+		if !isAllowed {
+			return authz.ErrAuthzDecision
+		}
+	}
+	return nil
+}
+
+var errMockExpiryFailed = errors.New("mock role elevation expiry failed")
+
+type RoleElevationExpirerMockFailed struct{}
+
+func (m *RoleElevationExpirerMockFailed) ExpireRoleElevations(_ context.Context) error {
+	return errMockExpiryFailed
+}
+
+func TestRoleElevationExpiryProcessTask(t *testing.T) {
+	db, _, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
+	r := sql.NewRepository(db)
+
+	authzRepoLoader := authz_loader.NewRepoAuthzLoader(t.Context(),
+		r, &config.Config{})
+
+	authzRepo := authz_repo.NewAuthzRepo(r, authzRepoLoader)
+
+	processor := tasks.NewRoleElevationExpiryProcessor(
+		&RoleElevationExpirerMock{authzLoader: authzRepoLoader}, authzRepo)
+
+	task := asynq.NewTask(config.TypeRoleElevationExpire, nil)
+
+	t.Run("Should complete successfully", func(t *testing.T) {
+		logger, buf := testutils.NewLogBuffer()
+		slog.SetDefault(logger)
+
+		ctx, err := cmkcontext.InjectInternalUserData(context.Background(),
+			constants.InternalTaskWorkflowExpirationRole)
+		assert.NoError(t, err)
+		err = processor.ProcessTask(ctx, task)
+		assert.NoError(t, err)
+		assert.NotContains(t, strings.ToLower(buf.String()), "error")
+	})
+
+	t.Run("Should have right taskType", func(t *testing.T) {
+		assert.Equal(t, config.TypeRoleElevationExpire, processor.TaskType())
+	})
+
+	t.Run("Should have default tenant query", func(t *testing.T) {
+		assert.Equal(t, repo.NewQuery(), processor.TenantQuery())
+	})
+
+	t.Run("Should log error on task failure", func(t *testing.T) {
+		logger, buf := testutils.NewLogBuffer()
+		slog.SetDefault(logger)
+
+		failProcessor := tasks.NewRoleElevationExpiryProcessor(&RoleElevationExpirerMockFailed{}, r)
+		ctx, err := cmkcontext.InjectInternalUserData(context.Background(),
+			constants.InternalTaskWorkflowExpirationRole)
+		assert.NoError(t, err)
+		err = failProcessor.ProcessTask(ctx, task)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "Error during role elevation expiry batch processing")
+		assert.Contains(t, buf.String(), "mock role elevation expiry failed")
+	})
+}
@@ -0,0 +1,64 @@
+package tasks
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/openkcm/cmk/internal/async"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/log"
+	"github.com/openkcm/cmk/internal/repo"
+)
+
+type SystemKeyDriftChecker interface {
+	CheckKeyDrift(ctx context.Context) error
+}
+
+type SystemKeyDriftProcessor struct {
+	checker SystemKeyDriftChecker
+	repo    repo.Repo
+}
+
+func NewSystemKeyDriftChecker(
+	checker SystemKeyDriftChecker,
+	repo repo.Repo,
+	opts ...async.TaskOption,
+) async.TenantTaskHandler {
+	s := &SystemKeyDriftProcessor{
+		checker: checker,
+		repo:    repo,
+	}
+	for _, o := range opts {
+		o(s)
+	}
+
+	return s
+}
+
+func (s *SystemKeyDriftProcessor) ProcessTask(ctx context.Context, task *asynq.Task) error {
+	// Drift is checked again on the next run, so errors are only logged
+	err := s.checker.CheckKeyDrift(ctx)
+	if err != nil {
+		log.Error(ctx, "Error during system key drift check", err)
+	}
+
+	return nil
+}
+
+func (s *SystemKeyDriftProcessor) TenantQuery() *repo.Query {
+	return repo.NewQuery()
+}
+
+func (s *SystemKeyDriftProcessor) FanOutFunc() async.FanOutFunc {
+	return async.TenantFanOut
+}
+
+func (s *SystemKeyDriftProcessor) TaskType() string {
+	return config.TypeSystemKeyDrift
+}
+
+func (s *SystemKeyDriftProcessor) Role() constants.InternalRole {
+	return constants.InternalTaskSystemKeyDriftRole
+}
@@ -0,0 +1,109 @@
+package tasks_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+
+	tasks "github.com/openkcm/cmk/internal/async/tasks/tenant"
+	"github.com/openkcm/cmk/internal/authz"
+	authz_loader "github.com/openkcm/cmk/internal/authz/loader"
+	authz_repo "github.com/openkcm/cmk/internal/authz/repo"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+type SystemKeyDriftCheckerMock struct {
+	authzLoader *authz_loader.AuthzLoader[authz.RepoResourceType,
+		authz.RepoAction]
+	action authz.RepoAction
+}
+
+func (s *SystemKeyDriftCheckerMock) CheckKeyDrift(ctx context.Context) error {
+	isAllowed, err := authz.CheckAuthz(ctx, s.authzLoader.AuthzHandler,
+		authz.RepoResourceTypeSystem, s.action)
+	if err != nil {
+		return err
+	}
+	if !isAllowed {
+		return authz.ErrAuthzDecision
+	}
+	return nil
+}
+
+var errMockKeyDrift = errors.New("mock key drift check failed")
+
+type SystemKeyDriftCheckerMockFailed struct{}
+
+func (s *SystemKeyDriftCheckerMockFailed) CheckKeyDrift(_ context.Context) error {
+	return errMockKeyDrift
+}
+
+func TestSystemKeyDriftProcessTask(t *testing.T) {
+	db, _, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
+	r := sql.NewRepository(db)
+
+	authzRepoLoader := authz_loader.NewRepoAuthzLoader(t.Context(),
+		r, &config.Config{})
+
+	authzRepo := authz_repo.NewAuthzRepo(r, authzRepoLoader)
+
+	task := asynq.NewTask(config.TypeSystemKeyDrift, nil)
+
+	t.Run("Should complete successfully", func(t *testing.T) {
+		logger, buf := testutils.NewLogBuffer()
+		slog.SetDefault(logger)
+
+		mock := &SystemKeyDriftCheckerMock{authzLoader: authzRepoLoader, action: authz.RepoActionCount}
+		processor := tasks.NewSystemKeyDriftChecker(mock, authzRepo)
+
+		ctx, err := cmkcontext.InjectInternalUserData(t.Context(), constants.InternalTaskSystemKeyDriftRole)
+		assert.NoError(t, err)
+		err = processor.ProcessTask(ctx, task)
+		assert.NoError(t, err)
+		assert.NotContains(t, strings.ToLower(buf.String()), "error")
+	})
+
+	t.Run("Should have right taskType and tenant query", func(t *testing.T) {
+		processor := tasks.NewSystemKeyDriftChecker(&SystemKeyDriftCheckerMockFailed{}, authzRepo)
+		assert.Equal(t, config.TypeSystemKeyDrift, processor.TaskType())
+		assert.Equal(t, repo.NewQuery(), processor.TenantQuery())
+	})
+
+	t.Run("Should log on unauthorized processing", func(t *testing.T) {
+		logger, buf := testutils.NewLogBuffer()
+		slog.SetDefault(logger)
+
+		mock := &SystemKeyDriftCheckerMock{authzLoader: authzRepoLoader, action: authz.RepoActionUpdate}
+		processor := tasks.NewSystemKeyDriftChecker(mock, authzRepo)
+
+		ctx, err := cmkcontext.InjectInternalUserData(t.Context(), constants.InternalTaskSystemKeyDriftRole)
+		assert.NoError(t, err)
+		err = processor.ProcessTask(ctx, task)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "Error during system key drift check")
+		assert.Contains(t, buf.String(), "authorization decision error")
+	})
+
+	t.Run("Should log error on task failure", func(t *testing.T) {
+		logger, buf := testutils.NewLogBuffer()
+		slog.SetDefault(logger)
+
+		processor := tasks.NewSystemKeyDriftChecker(&SystemKeyDriftCheckerMockFailed{}, r)
+		ctx, err := cmkcontext.InjectInternalUserData(t.Context(), constants.InternalTaskSystemKeyDriftRole)
+		assert.NoError(t, err)
+		err = processor.ProcessTask(ctx, task)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "Error during system key drift check")
+		assert.Contains(t, buf.String(), "mock key drift check failed")
+	})
+}
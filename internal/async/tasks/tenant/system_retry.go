@@ -0,0 +1,65 @@
+package tasks
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/openkcm/cmk/internal/async"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/log"
+	"github.com/openkcm/cmk/internal/repo"
+)
+
+type FailedSystemRetrier interface {
+	RetryFailedSystems(ctx context.Context) error
+}
+
+type SystemRetryProcessor struct {
+	retrier FailedSystemRetrier
+	repo    repo.Repo
+}
+
+func NewSystemRetryProcessor(
+	retrier FailedSystemRetrier,
+	repo repo.Repo,
+	opts ...async.TaskOption,
+) async.TenantTaskHandler {
+	s := &SystemRetryProcessor{
+		retrier: retrier,
+		repo:    repo,
+	}
+	for _, o := range opts {
+		o(s)
+	}
+
+	return s
+}
+
+func (s *SystemRetryProcessor) ProcessTask(ctx context.Context, task *asynq.Task) error {
+	// Failed systems are picked up again on the next run,
+	// so errors are only logged and not retried by the task
+	err := s.retrier.RetryFailedSystems(ctx)
+	if err != nil {
+		log.Error(ctx, "Error during failed system retry", err)
+	}
+
+	return nil
+}
+
+func (s *SystemRetryProcessor) TenantQuery() *repo.Query {
+	return repo.NewQuery()
+}
+
+func (s *SystemRetryProcessor) FanOutFunc() async.FanOutFunc {
+	return async.TenantFanOut
+}
+
+func (s *SystemRetryProcessor) TaskType() string {
+	return config.TypeSystemRetry
+}
+
+func (s *SystemRetryProcessor) Role() constants.InternalRole {
+	return constants.InternalTaskSystemRetryRole
+}
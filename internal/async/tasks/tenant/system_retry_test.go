@@ -0,0 +1,141 @@
+package tasks_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+
+	tasks "github.com/openkcm/cmk/internal/async/tasks/tenant"
+	"github.com/openkcm/cmk/internal/authz"
+	authz_loader "github.com/openkcm/cmk/internal/authz/loader"
+	authz_repo "github.com/openkcm/cmk/internal/authz/repo"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+var allowedSystemRetryActions = map[authz.RepoResourceType][]authz.RepoAction{
+	authz.RepoResourceTypeSystem: {
+		authz.RepoActionCount,
+		authz.RepoActionList,
+		authz.RepoActionUpdate,
+	},
+	authz.RepoResourceTypeEvent: {
+		authz.RepoActionFirst,
+		authz.RepoActionUpdate,
+	},
+}
+
+type FailedSystemRetrierMock struct {
+	authzLoader *authz_loader.AuthzLoader[authz.RepoResourceType,
+		authz.RepoAction]
+}
+
+func (s *FailedSystemRetrierMock) RetryFailedSystems(ctx context.Context) error {
+	for resourceType, actions := range allowedSystemRetryActions {
+		for _, testAction := range actions {
+			isAllowed, err := authz.CheckAuthz(ctx, s.authzLoader.AuthzHandler, resourceType, testAction)
+			if err != nil {
+				return err
+			}
+			if !isAllowed {
+				return authz.ErrAuthzDecision
+			}
+		}
+	}
+	return nil
+}
+
+type FailedSystemRetrierMockUnauthz struct {
+	authzLoader *authz_loader.AuthzLoader[authz.RepoResourceType,
+		authz.RepoAction]
+}
+
+func (s *FailedSystemRetrierMockUnauthz) RetryFailedSystems(ctx context.Context) error {
+	isAllowed, err := authz.CheckAuthz(ctx, s.authzLoader.AuthzHandler,
+		authz.RepoResourceTypeEvent, authz.RepoActionDelete)
+	if err != nil {
+		return err
+	}
+	if !isAllowed {
+		return authz.ErrAuthzDecision
+	}
+	return nil
+}
+
+var errMockSystemRetry = errors.New("mock system retry failed")
+
+type FailedSystemRetrierMockFailed struct{}
+
+func (s *FailedSystemRetrierMockFailed) RetryFailedSystems(_ context.Context) error {
+	return errMockSystemRetry
+}
+
+func TestSystemRetryProcessTask(t *testing.T) {
+	db, _, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
+	r := sql.NewRepository(db)
+
+	authzRepoLoader := authz_loader.NewRepoAuthzLoader(t.Context(),
+		r, &config.Config{})
+
+	authzRepo := authz_repo.NewAuthzRepo(r, authzRepoLoader)
+
+	mock := &FailedSystemRetrierMock{authzLoader: authzRepoLoader}
+	processor := tasks.NewSystemRetryProcessor(mock, authzRepo)
+
+	task := asynq.NewTask(config.TypeSystemRetry, nil)
+
+	t.Run("Should complete successfully", func(t *testing.T) {
+		logger, buf := testutils.NewLogBuffer()
+		slog.SetDefault(logger)
+
+		ctx, err := cmkcontext.InjectInternalUserData(t.Context(), constants.InternalTaskSystemRetryRole)
+		assert.NoError(t, err)
+		err = processor.ProcessTask(ctx, task)
+		assert.NoError(t, err)
+		assert.NotContains(t, strings.ToLower(buf.String()), "error")
+	})
+
+	t.Run("Should have right taskType", func(t *testing.T) {
+		assert.Equal(t, config.TypeSystemRetry, processor.TaskType())
+	})
+
+	t.Run("Should have default tenant query", func(t *testing.T) {
+		assert.Equal(t, repo.NewQuery(), processor.TenantQuery())
+	})
+
+	t.Run("Should log on unauthorized processing", func(t *testing.T) {
+		logger, buf := testutils.NewLogBuffer()
+		slog.SetDefault(logger)
+
+		mock := &FailedSystemRetrierMockUnauthz{authzLoader: authzRepoLoader}
+		processor := tasks.NewSystemRetryProcessor(mock, authzRepo)
+		ctx, err := cmkcontext.InjectInternalUserData(t.Context(), constants.InternalTaskSystemRetryRole)
+		assert.NoError(t, err)
+		err = processor.ProcessTask(ctx, task)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "Error during failed system retry")
+		assert.Contains(t, buf.String(), "authorization decision error")
+	})
+
+	t.Run("Should log error on task failure", func(t *testing.T) {
+		logger, buf := testutils.NewLogBuffer()
+		slog.SetDefault(logger)
+
+		failProcessor := tasks.NewSystemRetryProcessor(&FailedSystemRetrierMockFailed{}, r)
+		ctx, err := cmkcontext.InjectInternalUserData(t.Context(), constants.InternalTaskSystemRetryRole)
+		assert.NoError(t, err)
+		err = failProcessor.ProcessTask(ctx, task)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "Error during failed system retry")
+		assert.Contains(t, buf.String(), "mock system retry failed")
+	})
+}
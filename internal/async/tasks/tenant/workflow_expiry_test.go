@@ -115,13 +115,13 @@ func setupWorkflowExpiry(t *testing.T) (*manager.WorkflowManager, repo.Repo, str
 	eventFactory, err := eventprocessor.NewEventFactory(t.Context(), cfg, r)
 	assert.NoError(t, err)
 
-	certManager := manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg)
-	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, nil, certManager)
+	certManager := manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg, nil)
+	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, nil, certManager, nil)
 	cmkAuditor := auditor.New(t.Context(), cfg)
 	userManager := manager.NewUserManager(r, cmkAuditor)
-	tagManager := manager.NewTagManager(r)
+	tagManager := manager.NewTagManager(r, cmkAuditor)
 	keyConfigManager := manager.NewKeyConfigManager(r, certManager, userManager, tagManager, cmkAuditor, eventFactory, cfg)
-	groupManager := manager.NewGroupManager(r, svcRegistry, userManager)
+	groupManager := manager.NewGroupManager(r, svcRegistry, userManager, cmkAuditor)
 
 	clientsFactory, err := clients.NewFactory(cfg.Services)
 	assert.NoError(t, err)
@@ -132,7 +132,7 @@ func setupWorkflowExpiry(t *testing.T) (*manager.WorkflowManager, repo.Repo, str
 	keyManager := manager.NewKeyManager(r, svcRegistry, tenantConfigManager, keyConfigManager,
 		userManager, certManager, nil, cmkAuditor, nil)
 	wm := manager.NewWorkflowManager(r, svcRegistry, keyManager, keyConfigManager, systemManager,
-		groupManager, userManager, nil, tenantConfigManager, cfg)
+		groupManager, userManager, nil, tenantConfigManager, cfg, cmkAuditor)
 
 	return wm, r, tenants[0]
 }
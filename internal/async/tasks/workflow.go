@@ -20,6 +20,7 @@ import (
 type WorkflowUpdater interface {
 	AutoAssignApprovers(ctx context.Context, workflowID uuid.UUID) (*model.Workflow, error)
 	HandleTerminalWorkflow(ctx context.Context, workflow *model.Workflow) error
+	SendTransitionAuditLogs(ctx context.Context, workflow *model.Workflow, oldState model.WorkflowState)
 }
 
 type WorkflowProcessor struct {
@@ -108,10 +109,11 @@ func (s *WorkflowProcessor) putWorkflowInFailedState(
 	if err != nil {
 		return err
 	}
+	oldState := workflow.State
 	workflow.State = model.WorkflowStateFailed
 	workflow.FailureReason = failureReason
 
-	return s.repo.Transaction(ctx, func(ctx context.Context) error {
+	err = s.repo.Transaction(ctx, func(ctx context.Context) error {
 		_, err := s.repo.Patch(ctx, workflow, *repo.NewQuery())
 		if err != nil {
 			return err
@@ -119,4 +121,11 @@ func (s *WorkflowProcessor) putWorkflowInFailedState(
 
 		return s.updater.HandleTerminalWorkflow(ctx, workflow)
 	})
+	if err != nil {
+		return err
+	}
+
+	s.updater.SendTransitionAuditLogs(ctx, workflow, oldState)
+
+	return nil
 }
@@ -42,10 +42,17 @@ var allowedWorkflowApproversTestActions = []authz.RepoAction{
 	authz.RepoActionUpdate,
 }
 
+type auditedTransition struct {
+	workflowID uuid.UUID
+	oldState   model.WorkflowState
+	newState   model.WorkflowState
+}
+
 type WorkflowAssignMock struct {
 	authzLoader *authz_loader.AuthzLoader[authz.RepoResourceType,
 		authz.RepoAction]
-	repo repo.Repo
+	repo    repo.Repo
+	audited []auditedTransition
 }
 
 func (s *WorkflowAssignMock) AutoAssignApprovers(
@@ -103,6 +110,18 @@ func (s *WorkflowAssignMock) HandleTerminalWorkflow(ctx context.Context, workflo
 	return nil
 }
 
+func (s *WorkflowAssignMock) SendTransitionAuditLogs(
+	_ context.Context,
+	workflow *model.Workflow,
+	oldState model.WorkflowState,
+) {
+	s.audited = append(s.audited, auditedTransition{
+		workflowID: workflow.ID,
+		oldState:   oldState,
+		newState:   workflow.State,
+	})
+}
+
 type WorkflowAssignMockUnauthz struct {
 	authzLoader *authz_loader.AuthzLoader[authz.RepoResourceType,
 		authz.RepoAction]
@@ -125,6 +144,13 @@ func (s *WorkflowAssignMockUnauthz) HandleTerminalWorkflow(ctx context.Context,
 	return nil
 }
 
+func (s *WorkflowAssignMockUnauthz) SendTransitionAuditLogs(
+	_ context.Context,
+	_ *model.Workflow,
+	_ model.WorkflowState,
+) {
+}
+
 func TestWorkflowAssignAction(t *testing.T) {
 	db, tenants, _ := testutils.NewTestDB(t, testutils.TestDBConfig{}, testutils.WithGenerateTenants(1))
 	rawRepo := sql.NewRepository(db)
@@ -156,9 +182,8 @@ func TestWorkflowAssignAction(t *testing.T) {
 
 	authzRepo := authz_repo.NewAuthzRepo(rawRepo, authzRepoLoader)
 
-	assigner := tasks.NewWorkflowProcessor(
-		&WorkflowAssignMock{authzLoader: authzRepoLoader, repo: authzRepo}, authzRepo,
-	)
+	assignMock := &WorkflowAssignMock{authzLoader: authzRepoLoader, repo: authzRepo}
+	assigner := tasks.NewWorkflowProcessor(assignMock, authzRepo)
 
 	unauthzAssigner := tasks.NewWorkflowProcessor(
 		&WorkflowAssignMockUnauthz{authzLoader: authzRepoLoader}, authzRepo,
@@ -210,6 +235,11 @@ func TestWorkflowAssignAction(t *testing.T) {
 
 		assert.Equal(t, model.WorkflowStateFailed, updatedWorkflow.State)
 		assert.Equal(t, "bad workflow error", updatedWorkflow.FailureReason)
+		assert.Contains(t, assignMock.audited, auditedTransition{
+			workflowID: uuid.MustParse(BadWorkflowID),
+			oldState:   model.WorkflowStateInitial,
+			newState:   model.WorkflowStateFailed,
+		}, "failed transition should be audited")
 	})
 
 	t.Run("Panic during processing", func(t *testing.T) {
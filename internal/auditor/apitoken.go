@@ -0,0 +1,46 @@
+package auditor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+)
+
+// SendAPITokenCreateAuditLog sends an audit log for a created API token
+func (a *Auditor) SendAPITokenCreateAuditLog(ctx context.Context, tokenID string) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewCredentialCreateEvent(metadata, tokenID, otlpaudit.CREDTYPE_SECRET)
+	})
+}
+
+// SendAPITokenRevokeAuditLog sends an audit log for a revoked API token
+func (a *Auditor) SendAPITokenRevokeAuditLog(ctx context.Context, tokenID string) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewCredentialRevokationEvent(metadata, tokenID, otlpaudit.CREDTYPE_SECRET)
+	})
+}
+
+// SendAPITokenUseAuditLog sends an audit log for a request authenticated
+// with an API token. The value describes the request.
+func (a *Auditor) SendAPITokenUseAuditLog(ctx context.Context, tokenID, value string) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewUserLoginSuccessEvent(
+			metadata, tokenID, "", otlpaudit.MFATYPE_NONE, otlpaudit.USERTYPE_TECHNICAL, value,
+		)
+	})
+}
+
+// SendAPITokenRejectAuditLog sends an audit log for a request rejected
+// because of an expired, revoked or unknown API token
+func (a *Auditor) SendAPITokenRejectAuditLog(
+	ctx context.Context,
+	tokenID string,
+	reason otlpaudit.FailReason,
+	value string,
+) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewUserLoginFailureEvent(metadata, tokenID, "", reason, value)
+	})
+}
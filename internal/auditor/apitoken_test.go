@@ -0,0 +1,50 @@
+package auditor_test
+
+import (
+	"context"
+	"testing"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+
+	"github.com/openkcm/cmk/internal/auditor"
+)
+
+func TestAuditor_SendAPITokenAuditLogs(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.CredentialCreateEvent, map[string]string{
+			otlpaudit.ObjectIDKey:       "token-id",
+			otlpaudit.CredentialTypeKey: string(otlpaudit.CREDTYPE_SECRET),
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendAPITokenCreateAuditLog(ctx, "token-id")
+		})
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.CredentialRevokationEvent, map[string]string{
+			otlpaudit.ObjectIDKey:       "token-id",
+			otlpaudit.CredentialTypeKey: string(otlpaudit.CREDTYPE_SECRET),
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendAPITokenRevokeAuditLog(ctx, "token-id")
+		})
+	})
+
+	t.Run("use", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.UserLoginSuccessEvent, map[string]string{
+			otlpaudit.ObjectIDKey: "token-id",
+			otlpaudit.UserTypeKey: string(otlpaudit.USERTYPE_TECHNICAL),
+			otlpaudit.ValueKey:    "GET /keys",
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendAPITokenUseAuditLog(ctx, "token-id", "GET /keys")
+		})
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.UserLoginFailureEvent, map[string]string{
+			otlpaudit.ObjectIDKey:      "token-id",
+			otlpaudit.FailureReasonKey: string(otlpaudit.FAILREASON_TOKENREVOKED),
+			otlpaudit.ValueKey:         "GET /keys",
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendAPITokenRejectAuditLog(ctx, "token-id", otlpaudit.FAILREASON_TOKENREVOKED, "GET /keys")
+		})
+	})
+}
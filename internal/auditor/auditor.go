@@ -30,18 +30,37 @@ type AuditLogger interface {
 // Auditor handles audit logging for CMK operations
 type Auditor struct {
 	auditLogger AuditLogger
+	eventStore  EventStore
+	exporter    EventExporter
 }
 
 // New creates a new Auditor instance
-func New(ctx context.Context, config *config.Config) *Auditor {
+func New(ctx context.Context, config *config.Config, opts ...Option) *Auditor {
 	auditLogger, err := otlpaudit.NewLogger(&config.Audit)
 	if err != nil {
 		log.Error(ctx, "failed to create audit logger", err)
 	}
 
-	return &Auditor{
+	auditor := &Auditor{
 		auditLogger: auditLogger,
 	}
+
+	for _, o := range opts {
+		o(auditor)
+	}
+
+	if !config.AuditStore.Enabled {
+		auditor.eventStore = nil
+	}
+
+	switch {
+	case !config.AuditExport.Enabled:
+		auditor.exporter = nil
+	case auditor.exporter == nil:
+		auditor.exporter = sharedExporter(ctx, config)
+	}
+
+	return auditor
 }
 
 // getEventMetadata extracts common metadata from context
@@ -81,7 +100,7 @@ func (a *Auditor) sendEvent(ctx context.Context, createEventFn func(otlpaudit.Ev
 		return ErrNilAuditor
 	}
 
-	if a.auditLogger == nil {
+	if a.auditLogger == nil && a.eventStore == nil && a.exporter == nil {
 		log.Warn(ctx, "audit logger not available, skipping audit event")
 
 		return nil
@@ -97,6 +116,15 @@ func (a *Auditor) sendEvent(ctx context.Context, createEventFn func(otlpaudit.Ev
 		return errs.Wrap(ErrCreateEvent, err)
 	}
 
+	a.storeEvents(ctx, logs)
+	a.exportEvents(ctx, logs)
+
+	if a.auditLogger == nil {
+		log.Warn(ctx, "audit logger not available, skipping audit event")
+
+		return nil
+	}
+
 	err = a.auditLogger.SendEvent(ctx, logs)
 	if err != nil {
 		return errs.Wrap(ErrSendEvent, err)
@@ -0,0 +1,16 @@
+package auditor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+)
+
+// SendCertificateCreateAuditLog sends an audit log for a newly issued certificate
+func (a *Auditor) SendCertificateCreateAuditLog(ctx context.Context, certificateID string) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewCredentialCreateEvent(metadata, certificateID, otlpaudit.CREDTYPE_X509CERT)
+	})
+}
@@ -0,0 +1,19 @@
+package auditor_test
+
+import (
+	"context"
+	"testing"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+
+	"github.com/openkcm/cmk/internal/auditor"
+)
+
+func TestAuditor_SendCertificateCreateAuditLog(t *testing.T) {
+	assertSentEvent(t, otlpaudit.CredentialCreateEvent, map[string]string{
+		otlpaudit.ObjectIDKey:       "certificate-id",
+		otlpaudit.CredentialTypeKey: string(otlpaudit.CREDTYPE_X509CERT),
+	}, func(a *auditor.Auditor, ctx context.Context) error {
+		return a.SendCertificateCreateAuditLog(ctx, "certificate-id")
+	})
+}
@@ -0,0 +1,89 @@
+package auditor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	// SequenceNumberKey is the attribute holding the position of an event in the tenant hash chain
+	SequenceNumberKey = "sequenceNumber"
+	// PreviousHashKey is the attribute holding the hash of the preceding event in the tenant hash chain
+	PreviousHashKey = "previousHash"
+)
+
+// GenesisHash is the previous hash of the first event of a tenant hash chain
+var GenesisHash = strings.Repeat("0", sha256.Size*2)
+
+// ChainLink is the position of an audit event in the tenant hash chain
+type ChainLink struct {
+	SequenceNumber int64
+	Hash           string
+}
+
+// HashEvent returns the hash of an audit event. The attributes of a chained
+// event contain its sequence number and previous hash, so the hash covers
+// the link to the preceding event as well.
+func HashEvent(event Event) (string, error) {
+	// Maps are encoded with sorted keys, which keeps the encoding canonical
+	content, err := json.Marshal(struct {
+		Attributes map[string]string `json:"attributes"`
+		Timestamp  string            `json:"timestamp"`
+	}{
+		Attributes: event.Attributes,
+		Timestamp:  event.Timestamp.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// linkEvents appends the log records of an audit event to the hash chain
+// starting at head. The chain attributes are added to the log records,
+// so the sent events carry them as well.
+func linkEvents(logs plog.Logs, head ChainLink) ([]Event, error) {
+	var events []Event
+
+	for _, resourceLogs := range logs.ResourceLogs().All() {
+		for _, scopeLogs := range resourceLogs.ScopeLogs().All() {
+			for _, record := range scopeLogs.LogRecords().All() {
+				// The store keeps timestamps with microsecond precision
+				timestamp := record.Timestamp().AsTime().Truncate(time.Microsecond)
+				if record.Timestamp() == 0 {
+					timestamp = time.Now().UTC().Truncate(time.Microsecond)
+				}
+
+				record.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+				record.Attributes().PutStr(SequenceNumberKey, strconv.FormatInt(head.SequenceNumber+1, 10))
+				record.Attributes().PutStr(PreviousHashKey, head.Hash)
+
+				event := eventFromRecord(record)
+				event.SequenceNumber = head.SequenceNumber + 1
+				event.PreviousHash = head.Hash
+
+				hash, err := HashEvent(event)
+				if err != nil {
+					return nil, err
+				}
+
+				event.Hash = hash
+				events = append(events, event)
+
+				head = ChainLink{SequenceNumber: event.SequenceNumber, Hash: hash}
+			}
+		}
+	}
+
+	return events, nil
+}
@@ -0,0 +1,36 @@
+package auditor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+)
+
+// SendConfigCreateAuditLog sends an audit log for configuration creation
+func (a *Auditor) SendConfigCreateAuditLog(ctx context.Context, objectID, value string) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewConfigurationCreateEvent(metadata, objectID, value)
+	})
+}
+
+// SendConfigUpdateAuditLog sends an audit log for a configuration change
+// with the values before and after the change.
+// A change without previous value is audited as configuration creation
+func (a *Auditor) SendConfigUpdateAuditLog(ctx context.Context, objectID, oldValue, newValue string) error {
+	if oldValue == "" {
+		return a.SendConfigCreateAuditLog(ctx, objectID, newValue)
+	}
+
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewConfigurationUpdateEvent(metadata, objectID, oldValue, newValue)
+	})
+}
+
+// SendConfigDeleteAuditLog sends an audit log for configuration deletion
+func (a *Auditor) SendConfigDeleteAuditLog(ctx context.Context, objectID, value string) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewConfigurationDeleteEvent(metadata, objectID, value)
+	})
+}
@@ -0,0 +1,49 @@
+package auditor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+
+	"github.com/openkcm/cmk/internal/auditor"
+)
+
+func TestAuditor_SendConfigAuditLogs(t *testing.T) {
+	t.Run("update with before and after values", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.ConfigUpdateEvent, map[string]string{
+			otlpaudit.ObjectIDKey: "WORKFLOW_CONFIG",
+			otlpaudit.OldValueKey: `{"enabled":false}`,
+			otlpaudit.NewValueKey: `{"enabled":true}`,
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendConfigUpdateAuditLog(ctx, "WORKFLOW_CONFIG", `{"enabled":false}`, `{"enabled":true}`)
+		})
+	})
+
+	t.Run("update without previous value is a create", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.ConfigCreateEvent, map[string]string{
+			otlpaudit.ObjectIDKey: "WORKFLOW_CONFIG",
+			otlpaudit.ValueKey:    `{"enabled":true}`,
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendConfigUpdateAuditLog(ctx, "WORKFLOW_CONFIG", "", `{"enabled":true}`)
+		})
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.ConfigDeleteEvent, map[string]string{
+			otlpaudit.ObjectIDKey: "key-id",
+			otlpaudit.ValueKey:    "env=prod",
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendConfigDeleteAuditLog(ctx, "key-id", "env=prod")
+		})
+	})
+
+	t.Run("delete without value fails", func(t *testing.T) {
+		a := createTestAuditor("http://localhost")
+
+		err := a.SendConfigDeleteAuditLog(createTestContext(), "key-id", "")
+		assert.ErrorIs(t, err, auditor.ErrCreateEvent)
+	})
+}
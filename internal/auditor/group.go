@@ -0,0 +1,33 @@
+package auditor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+)
+
+// SendGroupCreateAuditLog sends an audit log for user group creation
+func (a *Auditor) SendGroupCreateAuditLog(ctx context.Context, groupID, value string) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewGroupCreateEvent(metadata, groupID, value, false)
+	})
+}
+
+// SendGroupUpdateAuditLog sends an audit log for a changed user group property
+func (a *Auditor) SendGroupUpdateAuditLog(
+	ctx context.Context,
+	groupID, propertyName, oldValue, newValue string,
+) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewGroupUpdateEvent(metadata, groupID, propertyName, oldValue, newValue, false)
+	})
+}
+
+// SendGroupDeleteAuditLog sends an audit log for user group deletion
+func (a *Auditor) SendGroupDeleteAuditLog(ctx context.Context, groupID, value string) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewGroupDeleteEvent(metadata, groupID, value, false)
+	})
+}
@@ -0,0 +1,41 @@
+package auditor_test
+
+import (
+	"context"
+	"testing"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+
+	"github.com/openkcm/cmk/internal/auditor"
+)
+
+func TestAuditor_SendGroupAuditLogs(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.GroupCreateEvent, map[string]string{
+			otlpaudit.ObjectIDKey: "group-id",
+			otlpaudit.ValueKey:    "admins",
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendGroupCreateAuditLog(ctx, "group-id", "admins")
+		})
+	})
+
+	t.Run("update", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.GroupUpdateEvent, map[string]string{
+			otlpaudit.ObjectIDKey:     "group-id",
+			otlpaudit.PropertyNameKey: "name",
+			otlpaudit.OldValueKey:     "admins",
+			otlpaudit.NewValueKey:     "operators",
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendGroupUpdateAuditLog(ctx, "group-id", "name", "admins", "operators")
+		})
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.GroupDeleteEvent, map[string]string{
+			otlpaudit.ObjectIDKey: "group-id",
+			otlpaudit.ValueKey:    "admins",
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendGroupDeleteAuditLog(ctx, "group-id", "admins")
+		})
+	})
+}
@@ -3,11 +3,18 @@ package auditor_test
 import (
 	"context"
 	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
 
 	"github.com/google/uuid"
 	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/pdata/plog"
 
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+
 	"github.com/openkcm/cmk/internal/auditor"
 	"github.com/openkcm/cmk/internal/config"
 )
@@ -60,3 +67,42 @@ func checkLogsExist(logs *plog.Logs) error {
 
 	return nil
 }
+
+// assertSentEvent sends an event through a test auditor and asserts the
+// event type and attributes received by the collector
+func assertSentEvent(
+	t *testing.T,
+	eventType string,
+	expAttrs map[string]string,
+	send func(*auditor.Auditor, context.Context) error,
+) {
+	t.Helper()
+
+	received := false
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+
+			unmarshaler := plog.JSONUnmarshaler{}
+			logs, err := unmarshaler.UnmarshalLogs(body)
+			assert.NoError(t, err)
+
+			attrs, err := getAttributes(&logs)
+			assert.NoError(t, err)
+			assert.Equal(t, eventType, attrs[otlpaudit.EventTypeKey])
+
+			for k, v := range expAttrs {
+				assert.Equal(t, v, attrs[k], k)
+			}
+
+			received = true
+
+			w.WriteHeader(http.StatusOK)
+		}))
+	defer server.Close()
+
+	err := send(createTestAuditor(server.URL), createTestContext())
+	assert.NoError(t, err)
+	assert.True(t, received)
+}
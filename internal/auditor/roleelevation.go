@@ -0,0 +1,40 @@
+package auditor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+)
+
+// roleElevationChannelType is the channel of role elevation use events,
+// the key configuration the elevated role is used on
+const roleElevationChannelType = "KEY_CONFIGURATION"
+
+// SendRoleElevationGrantAuditLog sends an audit log for a granted role elevation
+func (a *Auditor) SendRoleElevationGrantAuditLog(ctx context.Context, elevationID, value string) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewConfigurationCreateEvent(metadata, elevationID, value)
+	})
+}
+
+// SendRoleElevationUseAuditLog sends an audit log for an access on the key
+// configuration allowed through a role elevation
+func (a *Auditor) SendRoleElevationUseAuditLog(
+	ctx context.Context,
+	elevationID, keyConfigurationID, value string,
+) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewConfigurationReadEvent(
+			metadata, elevationID, roleElevationChannelType, keyConfigurationID, value,
+		)
+	})
+}
+
+// SendRoleElevationExpireAuditLog sends an audit log for an expired role elevation
+func (a *Auditor) SendRoleElevationExpireAuditLog(ctx context.Context, elevationID, value string) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewConfigurationDeleteEvent(metadata, elevationID, value)
+	})
+}
@@ -0,0 +1,43 @@
+package auditor_test
+
+import (
+	"context"
+	"testing"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+
+	"github.com/openkcm/cmk/internal/auditor"
+)
+
+func TestAuditor_SendRoleElevationAuditLogs(t *testing.T) {
+	value := "user-id KEY_ADMINISTRATOR until 2026-01-01T00:00:00Z"
+
+	t.Run("grant", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.ConfigCreateEvent, map[string]string{
+			otlpaudit.ObjectIDKey: "elevation-id",
+			otlpaudit.ValueKey:    value,
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendRoleElevationGrantAuditLog(ctx, "elevation-id", value)
+		})
+	})
+
+	t.Run("use", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.ConfigReadEvent, map[string]string{
+			otlpaudit.ObjectIDKey:    "elevation-id",
+			otlpaudit.ChannelTypeKey: "KEY_CONFIGURATION",
+			otlpaudit.ChannelIDKey:   "key-config-id",
+			otlpaudit.ValueKey:       value,
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendRoleElevationUseAuditLog(ctx, "elevation-id", "key-config-id", value)
+		})
+	})
+
+	t.Run("expire", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.ConfigDeleteEvent, map[string]string{
+			otlpaudit.ObjectIDKey: "elevation-id",
+			otlpaudit.ValueKey:    value,
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendRoleElevationExpireAuditLog(ctx, "elevation-id", value)
+		})
+	})
+}
@@ -0,0 +1,95 @@
+package auditor
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/openkcm/cmk/internal/auditor/siem"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/log"
+)
+
+// EventExporter forwards audit events to a SIEM next to the audit log
+type EventExporter interface {
+	Export(ctx context.Context, events []siem.Event)
+}
+
+// exporters holds the SIEM exporter of each config. A service creates
+// several auditors from the same config, which share one sink connection.
+var exporters sync.Map
+
+// WithEventExporter sets the exporter forwarding every audit event to a SIEM
+// instead of the one built from the config.
+// The exporter is only used if the audit export is enabled in the config.
+func WithEventExporter(exporter EventExporter) Option {
+	return func(a *Auditor) {
+		a.exporter = exporter
+	}
+}
+
+func sharedExporter(ctx context.Context, cfg *config.Config) EventExporter {
+	if exporter, ok := exporters.Load(cfg); ok {
+		//nolint:forcetypeassert
+		return exporter.(EventExporter)
+	}
+
+	exporter, err := siem.New(cfg)
+	if err != nil {
+		log.Error(ctx, "failed to create audit exporter", err)
+		return nil
+	}
+
+	actual, loaded := exporters.LoadOrStore(cfg, exporter)
+	if loaded {
+		_ = exporter.Close(ctx)
+	}
+
+	//nolint:forcetypeassert
+	return actual.(EventExporter)
+}
+
+// CloseExporters flushes and closes the shared exporters, waiting at most the
+// shutdown timeout of the audit export. Services call it on shutdown, so the
+// context may already be cancelled.
+func CloseExporters(ctx context.Context, cfg *config.Config) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cfg.AuditExport.ShutdownTimeout)
+	defer cancel()
+
+	exporters.Range(func(key, exporter any) bool {
+		exporters.Delete(key)
+
+		//nolint:forcetypeassert
+		err := exporter.(*siem.Exporter).Close(ctx)
+		if err != nil {
+			log.Error(ctx, "failed to close audit exporter", err)
+		}
+
+		return true
+	})
+}
+
+// exportEvents forwards the audit events to the SIEM. It runs after the
+// events are chained, so exported events carry the chain attributes.
+func (a *Auditor) exportEvents(ctx context.Context, logs plog.Logs) {
+	if a.exporter == nil {
+		return
+	}
+
+	var events []siem.Event
+
+	for _, resourceLogs := range logs.ResourceLogs().All() {
+		for _, scopeLogs := range resourceLogs.ScopeLogs().All() {
+			for _, record := range scopeLogs.LogRecords().All() {
+				event := eventFromRecord(record)
+				events = append(events, siem.Event{
+					Timestamp:  event.Timestamp,
+					Attributes: event.Attributes,
+				})
+			}
+		}
+	}
+
+	a.exporter.Export(ctx, events)
+}
@@ -0,0 +1,158 @@
+package siem
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+
+	"github.com/openkcm/cmk/internal/config"
+)
+
+const (
+	vendor  = "OpenKCM"
+	product = "CMK"
+	// cefSeverity is the CEF severity of audit events, which are informational
+	cefSeverity = 3
+	// leefTimeFormat is the default LEEF devTime format "MMM dd yyyy HH:mm:ss.SSS zzz"
+	leefTimeFormat = "Jan 02 2006 15:04:05.000 MST"
+)
+
+// Event is an audit event as exported to a SIEM
+type Event struct {
+	Timestamp  time.Time
+	Attributes map[string]string
+}
+
+func (e Event) eventType() string {
+	eventType := e.Attributes[otlpaudit.EventTypeKey]
+	if eventType == "" {
+		return "unknown"
+	}
+
+	return eventType
+}
+
+func (e Event) sortedKeys() []string {
+	return slices.Sorted(maps.Keys(e.Attributes))
+}
+
+// Formatter encodes an audit event as a single line message
+type Formatter func(event Event, version string) ([]byte, error)
+
+// NewFormatter returns the formatter of a configured export format
+func NewFormatter(format config.AuditExportFormat) (Formatter, error) {
+	switch format {
+	case config.AuditExportFormatCEF:
+		return FormatCEF, nil
+	case config.AuditExportFormatLEEF:
+		return FormatLEEF, nil
+	case config.AuditExportFormatJSONL:
+		return FormatJSONL, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+}
+
+// FormatCEF encodes an event in the ArcSight Common Event Format.
+// The event attributes are added as extensions, next to the receipt time.
+func FormatCEF(event Event, version string) ([]byte, error) {
+	var b strings.Builder
+
+	eventType := escapeCEFHeader(event.eventType())
+	fmt.Fprintf(&b, "CEF:0|%s|%s|%s|%s|%s|%d|",
+		vendor, product, escapeCEFHeader(version), eventType, eventType, cefSeverity)
+
+	b.WriteString("rt=" + strconv.FormatInt(event.Timestamp.UnixMilli(), 10))
+
+	if actor := event.Attributes[otlpaudit.UserInitiatorIDKey]; actor != "" {
+		b.WriteString(" suser=" + escapeCEFExtension(actor))
+	}
+
+	for _, key := range event.sortedKeys() {
+		b.WriteString(" " + extensionKey(key) + "=" + escapeCEFExtension(event.Attributes[key]))
+	}
+
+	return []byte(b.String()), nil
+}
+
+// FormatLEEF encodes an event in the IBM QRadar Log Event Extended Format 2.0
+// with tab separated attributes
+func FormatLEEF(event Event, version string) ([]byte, error) {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "LEEF:2.0|%s|%s|%s|%s|",
+		vendor, product, escapeLEEFHeader(version), escapeLEEFHeader(event.eventType()))
+
+	b.WriteString("devTime=" + event.Timestamp.UTC().Format(leefTimeFormat))
+
+	if actor := event.Attributes[otlpaudit.UserInitiatorIDKey]; actor != "" {
+		b.WriteString("\tusrName=" + escapeLEEFValue(actor))
+	}
+
+	for _, key := range event.sortedKeys() {
+		b.WriteString("\t" + extensionKey(key) + "=" + escapeLEEFValue(event.Attributes[key]))
+	}
+
+	return []byte(b.String()), nil
+}
+
+// FormatJSONL encodes an event as a single line JSON object holding the
+// attributes and the timestamp of the event
+func FormatJSONL(event Event, version string) ([]byte, error) {
+	return json.Marshal(struct {
+		Timestamp  string            `json:"timestamp"`
+		Vendor     string            `json:"vendor"`
+		Product    string            `json:"product"`
+		Version    string            `json:"version"`
+		EventType  string            `json:"eventType"`
+		Attributes map[string]string `json:"attributes"`
+	}{
+		Timestamp:  event.Timestamp.UTC().Format(time.RFC3339Nano),
+		Vendor:     vendor,
+		Product:    product,
+		Version:    version,
+		EventType:  event.eventType(),
+		Attributes: event.Attributes,
+	})
+}
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+	leefHeaderEscaper   = strings.NewReplacer(`|`, `\|`, "\n", " ", "\r", " ")
+	leefValueEscaper    = strings.NewReplacer("\t", " ", "\n", " ", "\r", " ")
+)
+
+func escapeCEFHeader(s string) string {
+	return cefHeaderEscaper.Replace(s)
+}
+
+func escapeCEFExtension(s string) string {
+	return cefExtensionEscaper.Replace(s)
+}
+
+func escapeLEEFHeader(s string) string {
+	return leefHeaderEscaper.Replace(s)
+}
+
+func escapeLEEFValue(s string) string {
+	return leefValueEscaper.Replace(s)
+}
+
+// extensionKey keeps the alphanumeric characters of an attribute name,
+// as CEF and LEEF keys must not contain spaces or separators
+func extensionKey(key string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+
+		return -1
+	}, key)
+}
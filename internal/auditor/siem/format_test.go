@@ -0,0 +1,70 @@
+package siem_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/auditor/siem"
+	"github.com/openkcm/cmk/internal/config"
+)
+
+var testEvent = siem.Event{
+	Timestamp: time.Date(2026, 1, 2, 3, 4, 5, 6000000, time.UTC),
+	Attributes: map[string]string{
+		"eventType":       "cmkDelete",
+		"objectID":        "key|1",
+		"userInitiatorID": "user=1",
+		"value":           "line\nbreak\ttab",
+	},
+}
+
+func TestFormatCEF(t *testing.T) {
+	message, err := siem.FormatCEF(testEvent, "1.2|3")
+	assert.NoError(t, err)
+
+	assert.Equal(t,
+		`CEF:0|OpenKCM|CMK|1.2\|3|cmkDelete|cmkDelete|3|rt=1767323045006 suser=user\=1 `+
+			`eventType=cmkDelete objectID=key|1 userInitiatorID=user\=1 value=line\nbreak`+"\ttab",
+		string(message))
+}
+
+func TestFormatLEEF(t *testing.T) {
+	message, err := siem.FormatLEEF(testEvent, "1.2")
+	assert.NoError(t, err)
+
+	assert.Equal(t,
+		"LEEF:2.0|OpenKCM|CMK|1.2|cmkDelete|devTime=Jan 02 2026 03:04:05.006 UTC\tusrName=user=1"+
+			"\teventType=cmkDelete\tobjectID=key|1\tuserInitiatorID=user=1\tvalue=line break tab",
+		string(message))
+}
+
+func TestFormatJSONL(t *testing.T) {
+	message, err := siem.FormatJSONL(testEvent, "1.2")
+	assert.NoError(t, err)
+	assert.NotContains(t, string(message), "\n")
+
+	var decoded map[string]any
+
+	err = json.Unmarshal(message, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-01-02T03:04:05.006Z", decoded["timestamp"])
+	assert.Equal(t, "cmkDelete", decoded["eventType"])
+	assert.Equal(t, "1.2", decoded["version"])
+	assert.Equal(t, "key|1", decoded["attributes"].(map[string]any)["objectID"])
+}
+
+func TestNewFormatter(t *testing.T) {
+	for _, format := range []config.AuditExportFormat{
+		config.AuditExportFormatCEF, config.AuditExportFormatLEEF, config.AuditExportFormatJSONL,
+	} {
+		formatter, err := siem.NewFormatter(format)
+		assert.NoError(t, err)
+		assert.NotNil(t, formatter)
+	}
+
+	_, err := siem.NewFormatter("otlp")
+	assert.ErrorIs(t, err, siem.ErrUnknownFormat)
+}
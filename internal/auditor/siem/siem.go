@@ -0,0 +1,205 @@
+// Package siem exports audit events to a SIEM that cannot ingest OTLP logs.
+// Events are encoded as CEF, LEEF or JSON lines and pushed to a syslog or
+// webhook sink from a buffer, so a slow or unavailable sink never blocks
+// the audited operation.
+package siem
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/log"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown audit export format")
+	ErrUnknownSink   = errors.New("unknown audit export sink")
+	ErrSendMessages  = errors.New("failed to send audit messages")
+)
+
+const (
+	// maxBatchSize is the maximum number of buffered messages sent at once
+	maxBatchSize = 100
+	maxBackoff   = time.Minute
+	// unknownVersion is the product version of events if the build info has none
+	unknownVersion = "unknown"
+)
+
+// Message is a formatted audit event
+type Message struct {
+	Timestamp time.Time
+	EventType string
+	Body      []byte
+}
+
+// Sink delivers formatted audit events to a SIEM
+type Sink interface {
+	Send(ctx context.Context, messages []Message) error
+	Close() error
+}
+
+// Exporter formats audit events and sends them to a sink in the background.
+// Failed deliveries are retried with exponential backoff. Events are
+// dropped once the buffer is full or the retries are exhausted.
+type Exporter struct {
+	format       Formatter
+	version      string
+	sink         Sink
+	buffer       chan Message
+	maxRetries   int
+	retryBackoff time.Duration
+
+	mu     sync.RWMutex
+	closed bool
+	done   chan struct{}
+}
+
+// New creates the exporter of the audit export config
+func New(cfg *config.Config) (*Exporter, error) {
+	var (
+		sink Sink
+		err  error
+	)
+
+	switch cfg.AuditExport.Sink {
+	case config.AuditExportSinkSyslog:
+		sink, err = NewSyslogSink(&cfg.AuditExport.Syslog)
+	case config.AuditExportSinkWebhook:
+		sink, err = NewWebhookSink(&cfg.AuditExport.Webhook, cfg.AuditExport.Format)
+	default:
+		err = ErrUnknownSink
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewExporter(&cfg.AuditExport, cfg.Application.BuildInfo.Version, sink)
+}
+
+// NewExporter creates an exporter sending to the given sink
+func NewExporter(cfg *config.AuditExport, version string, sink Sink) (*Exporter, error) {
+	format, err := NewFormatter(cfg.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	if version == "" {
+		version = unknownVersion
+	}
+
+	e := &Exporter{
+		format:       format,
+		version:      version,
+		sink:         sink,
+		buffer:       make(chan Message, max(cfg.BufferSize, 1)),
+		maxRetries:   cfg.MaxRetries,
+		retryBackoff: cfg.RetryBackoff,
+		done:         make(chan struct{}),
+	}
+
+	go e.run()
+
+	return e, nil
+}
+
+// Export formats the events and adds them to the buffer.
+// It never blocks on the sink.
+func (e *Exporter) Export(ctx context.Context, events []Event) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.closed {
+		log.Warn(ctx, "audit exporter closed, dropping audit events")
+		return
+	}
+
+	for _, event := range events {
+		body, err := e.format(event, e.version)
+		if err != nil {
+			log.Error(ctx, "failed to format audit event for export", err)
+			continue
+		}
+
+		message := Message{Timestamp: event.Timestamp, EventType: event.eventType(), Body: body}
+
+		select {
+		case e.buffer <- message:
+		default:
+			log.Warn(ctx, "audit export buffer full, dropping audit event",
+				slog.String("eventType", message.EventType))
+		}
+	}
+}
+
+// Close stops accepting events and sends the buffered ones, until the
+// context is done
+func (e *Exporter) Close(ctx context.Context) error {
+	e.mu.Lock()
+	if !e.closed {
+		e.closed = true
+		close(e.buffer)
+	}
+	e.mu.Unlock()
+
+	select {
+	case <-e.done:
+	case <-ctx.Done():
+		log.Warn(ctx, "audit export buffer not flushed before close")
+	}
+
+	return e.sink.Close()
+}
+
+func (e *Exporter) run() {
+	defer close(e.done)
+
+	for message := range e.buffer {
+		batch := []Message{message}
+
+	drain:
+		for len(batch) < maxBatchSize {
+			select {
+			case next, ok := <-e.buffer:
+				if !ok {
+					break drain
+				}
+
+				batch = append(batch, next)
+			default:
+				break drain
+			}
+		}
+
+		e.send(batch)
+	}
+}
+
+func (e *Exporter) send(batch []Message) {
+	ctx := context.Background()
+	backoff := e.retryBackoff
+
+	for attempt := 0; ; attempt++ {
+		err := e.sink.Send(ctx, batch)
+		if err == nil {
+			return
+		}
+
+		if attempt >= e.maxRetries {
+			log.Error(ctx, "failed to export audit events, dropping them", err,
+				slog.Int("count", len(batch)))
+
+			return
+		}
+
+		log.Warn(ctx, "failed to export audit events, retrying",
+			log.ErrorAttr(err), slog.Int("attempt", attempt+1))
+
+		time.Sleep(backoff)
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
@@ -0,0 +1,132 @@
+package siem_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/cmk/internal/auditor/siem"
+	"github.com/openkcm/cmk/internal/config"
+)
+
+var errSinkUnavailable = errors.New("sink unavailable")
+
+// fakeSink fails the first sends, then records the messages
+type fakeSink struct {
+	mu       sync.Mutex
+	failures int
+	attempts int
+	messages []siem.Message
+	sending  chan struct{}
+	block    chan struct{}
+}
+
+func (s *fakeSink) Send(_ context.Context, messages []siem.Message) error {
+	if s.block != nil {
+		select {
+		case s.sending <- struct{}{}:
+		default:
+		}
+
+		<-s.block
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attempts++
+	if s.attempts <= s.failures {
+		return errSinkUnavailable
+	}
+
+	s.messages = append(s.messages, messages...)
+
+	return nil
+}
+
+func (s *fakeSink) Close() error {
+	return nil
+}
+
+func newExporter(t *testing.T, sink *fakeSink, bufferSize, maxRetries int) *siem.Exporter {
+	t.Helper()
+
+	exporter, err := siem.NewExporter(&config.AuditExport{
+		Format:       config.AuditExportFormatJSONL,
+		BufferSize:   bufferSize,
+		MaxRetries:   maxRetries,
+		RetryBackoff: time.Millisecond,
+	}, "1.0", sink)
+	require.NoError(t, err)
+
+	return exporter
+}
+
+func TestExporter(t *testing.T) {
+	events := []siem.Event{testEvent, testEvent}
+
+	t.Run("Should export events", func(t *testing.T) {
+		sink := &fakeSink{}
+		exporter := newExporter(t, sink, 10, 0)
+
+		exporter.Export(t.Context(), events)
+		require.NoError(t, exporter.Close(t.Context()))
+
+		assert.Len(t, sink.messages, 2)
+		assert.Equal(t, "cmkDelete", sink.messages[0].EventType)
+		assert.Equal(t, testEvent.Timestamp, sink.messages[0].Timestamp)
+	})
+
+	t.Run("Should retry failed sends", func(t *testing.T) {
+		sink := &fakeSink{failures: 2}
+		exporter := newExporter(t, sink, 10, 2)
+
+		exporter.Export(t.Context(), events)
+		require.NoError(t, exporter.Close(t.Context()))
+
+		assert.Len(t, sink.messages, 2)
+		assert.Equal(t, 3, sink.attempts)
+	})
+
+	t.Run("Should drop events after the last retry", func(t *testing.T) {
+		sink := &fakeSink{failures: 3}
+		exporter := newExporter(t, sink, 10, 2)
+
+		exporter.Export(t.Context(), events)
+		require.NoError(t, exporter.Close(t.Context()))
+
+		assert.Empty(t, sink.messages)
+		assert.Equal(t, 3, sink.attempts)
+	})
+
+	t.Run("Should drop events if the buffer is full", func(t *testing.T) {
+		sink := &fakeSink{sending: make(chan struct{}, 1), block: make(chan struct{})}
+		exporter := newExporter(t, sink, 1, 0)
+
+		// The first event is taken by the blocked send,
+		// of the next two one fills the buffer and one is dropped
+		exporter.Export(t.Context(), events[:1])
+		<-sink.sending
+		exporter.Export(t.Context(), events)
+
+		close(sink.block)
+		require.NoError(t, exporter.Close(t.Context()))
+
+		assert.Len(t, sink.messages, 2)
+	})
+
+	t.Run("Should drop events after close", func(t *testing.T) {
+		sink := &fakeSink{}
+		exporter := newExporter(t, sink, 10, 0)
+		require.NoError(t, exporter.Close(t.Context()))
+
+		exporter.Export(t.Context(), events)
+
+		assert.Empty(t, sink.messages)
+	})
+}
@@ -0,0 +1,162 @@
+package siem
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/errs"
+)
+
+const (
+	// syslogPriority is facility 13 (log audit) with severity 5 (notice)
+	syslogPriority     = 13*8 + 5
+	syslogNilValue     = "-"
+	syslogMaxMsgID     = 32
+	syslogDialTimeout  = 10 * time.Second
+	syslogWriteTimeout = 10 * time.Second
+)
+
+// SyslogSink sends messages as RFC 5424 syslog messages over TCP, framed by
+// octet counting as of RFC 6587. The connection is opened on first use and
+// reopened after a failed write.
+type SyslogSink struct {
+	address   string
+	tlsConfig *tls.Config
+	appName   string
+	hostname  string
+	procID    string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewSyslogSink creates a syslog sink. A mtls secret enables TLS.
+func NewSyslogSink(cfg *config.AuditExportSyslog) (*SyslogSink, error) {
+	sink := &SyslogSink{
+		address: cfg.Address,
+		appName: cfg.AppName,
+		procID:  strconv.Itoa(os.Getpid()),
+	}
+
+	if sink.appName == "" {
+		sink.appName = syslogNilValue
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = syslogNilValue
+	}
+
+	sink.hostname = hostname
+
+	if cfg.SecretRef.Type == commoncfg.MTLSSecretType {
+		sink.tlsConfig, err = commoncfg.LoadMTLSConfig(&cfg.SecretRef.MTLS)
+		if err != nil {
+			return nil, errs.Wrap(config.ErrLoadMTLSConfig, err)
+		}
+	}
+
+	return sink, nil
+}
+
+func (s *SyslogSink) Send(ctx context.Context, messages []Message) error {
+	var frames bytes.Buffer
+
+	for _, message := range messages {
+		line := s.format(message)
+		frames.WriteString(strconv.Itoa(len(line)) + " ")
+		frames.Write(line)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return errs.Wrap(ErrSendMessages, err)
+		}
+
+		s.conn = conn
+	}
+
+	err := s.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout))
+	if err == nil {
+		_, err = s.conn.Write(frames.Bytes())
+	}
+
+	if err != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+
+		return errs.Wrap(ErrSendMessages, err)
+	}
+
+	return nil
+}
+
+func (s *SyslogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+
+	err := s.conn.Close()
+	s.conn = nil
+
+	return err
+}
+
+func (s *SyslogSink) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: syslogDialTimeout}
+	if s.tlsConfig == nil {
+		return dialer.DialContext(ctx, "tcp", s.address)
+	}
+
+	tlsDialer := &tls.Dialer{NetDialer: dialer, Config: s.tlsConfig}
+
+	return tlsDialer.DialContext(ctx, "tcp", s.address)
+}
+
+// format returns the RFC 5424 syslog message of an exported message,
+// with the event type as message ID and no structured data
+func (s *SyslogSink) format(message Message) []byte {
+	return fmt.Appendf(nil, "<%d>1 %s %s %s %s %s %s %s",
+		syslogPriority,
+		message.Timestamp.UTC().Format(time.RFC3339Nano),
+		s.hostname,
+		s.appName,
+		s.procID,
+		msgID(message.EventType),
+		syslogNilValue,
+		message.Body,
+	)
+}
+
+// msgID returns the event type if it is a valid syslog MSGID,
+// which is printable US-ASCII of at most 32 characters
+func msgID(eventType string) string {
+	if eventType == "" || len(eventType) > syslogMaxMsgID {
+		return syslogNilValue
+	}
+
+	for _, c := range []byte(eventType) {
+		if c < 33 || c > 126 {
+			return syslogNilValue
+		}
+	}
+
+	return eventType
+}
@@ -0,0 +1,84 @@
+package siem_test
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/cmk/internal/auditor/siem"
+	"github.com/openkcm/cmk/internal/config"
+)
+
+// readFrame reads a syslog message framed by octet counting
+func readFrame(t *testing.T, r *bufio.Reader) string {
+	t.Helper()
+
+	length, err := r.ReadString(' ')
+	require.NoError(t, err)
+
+	n, err := strconv.Atoi(strings.TrimSpace(length))
+	require.NoError(t, err)
+
+	message := make([]byte, n)
+	_, err = io.ReadFull(r, message)
+	require.NoError(t, err)
+
+	return string(message)
+}
+
+func TestSyslogSink(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer listener.Close()
+
+	sink, err := siem.NewSyslogSink(&config.AuditExportSyslog{
+		Address:   listener.Addr().String(),
+		SecretRef: commoncfg.SecretRef{Type: commoncfg.InsecureSecretType},
+		AppName:   "cmk",
+	})
+	require.NoError(t, err)
+
+	defer sink.Close()
+
+	timestamp := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	err = sink.Send(t.Context(), []siem.Message{
+		{Timestamp: timestamp, EventType: "cmkDelete", Body: []byte("CEF:0|first")},
+		{Timestamp: timestamp, EventType: "has space", Body: []byte("CEF:0|second")},
+	})
+	require.NoError(t, err)
+
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	header := `^<109>1 2026-01-02T03:04:05Z \S+ cmk \d+ `
+
+	assert.Regexp(t, regexp.MustCompile(header+`cmkDelete - CEF:0\|first$`), readFrame(t, r))
+	assert.Regexp(t, regexp.MustCompile(header+`- - CEF:0\|second$`), readFrame(t, r))
+}
+
+func TestSyslogSinkUnavailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	address := listener.Addr().String()
+	listener.Close()
+
+	sink, err := siem.NewSyslogSink(&config.AuditExportSyslog{Address: address})
+	require.NoError(t, err)
+
+	err = sink.Send(t.Context(), []siem.Message{{Body: []byte("message")}})
+	assert.ErrorIs(t, err, siem.ErrSendMessages)
+}
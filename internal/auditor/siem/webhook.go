@@ -0,0 +1,78 @@
+package siem
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/openkcm/common-sdk/pkg/commonhttp"
+
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/errs"
+)
+
+// WebhookSink posts messages to a HTTP endpoint, one message per line
+type WebhookSink struct {
+	url         string
+	contentType string
+	client      *http.Client
+}
+
+// NewWebhookSink creates a webhook sink. JSON lines are posted as
+// application/x-ndjson, CEF and LEEF as text/plain.
+func NewWebhookSink(cfg *config.AuditExportWebhook, format config.AuditExportFormat) (*WebhookSink, error) {
+	client, err := commonhttp.NewHTTPClient(&cfg.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := "text/plain; charset=utf-8"
+	if format == config.AuditExportFormatJSONL {
+		contentType = "application/x-ndjson"
+	}
+
+	return &WebhookSink{
+		url:         cfg.URL,
+		contentType: contentType,
+		client:      client,
+	}, nil
+}
+
+func (w *WebhookSink) Send(ctx context.Context, messages []Message) error {
+	var body bytes.Buffer
+
+	for _, message := range messages {
+		body.Write(message.Body)
+		body.WriteByte('\n')
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, &body)
+	if err != nil {
+		return errs.Wrap(ErrSendMessages, err)
+	}
+
+	req.Header.Set("Content-Type", w.contentType)
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errs.Wrap(ErrSendMessages, err)
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errs.Wrapf(ErrSendMessages, fmt.Sprintf("webhook responded with status %d", resp.StatusCode))
+	}
+
+	return nil
+}
+
+func (w *WebhookSink) Close() error {
+	w.client.CloseIdleConnections()
+
+	return nil
+}
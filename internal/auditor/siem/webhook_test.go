@@ -0,0 +1,53 @@
+package siem_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/cmk/internal/auditor/siem"
+	"github.com/openkcm/cmk/internal/config"
+)
+
+func TestWebhookSink(t *testing.T) {
+	var (
+		body        string
+		contentType string
+		status      = http.StatusOK
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		body = string(b)
+		contentType = r.Header.Get("Content-Type")
+
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sink, err := siem.NewWebhookSink(&config.AuditExportWebhook{URL: server.URL}, config.AuditExportFormatJSONL)
+	require.NoError(t, err)
+
+	messages := []siem.Message{{Body: []byte(`{"a":1}`)}, {Body: []byte(`{"b":2}`)}}
+
+	t.Run("Should post messages as lines", func(t *testing.T) {
+		err := sink.Send(t.Context(), messages)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "{\"a\":1}\n{\"b\":2}\n", body)
+		assert.Equal(t, "application/x-ndjson", contentType)
+	})
+
+	t.Run("Should fail on error status", func(t *testing.T) {
+		status = http.StatusServiceUnavailable
+
+		err := sink.Send(t.Context(), messages)
+		assert.ErrorIs(t, err, siem.ErrSendMessages)
+	})
+}
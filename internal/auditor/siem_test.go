@@ -0,0 +1,113 @@
+package auditor_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+
+	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/auditor/siem"
+	"github.com/openkcm/cmk/internal/config"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+type fakeEventExporter struct {
+	events []siem.Event
+}
+
+func (e *fakeEventExporter) Export(_ context.Context, events []siem.Event) {
+	e.events = append(e.events, events...)
+}
+
+func TestAuditor_EventExporter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	newAuditor := func(enabled bool, exporter auditor.EventExporter) *auditor.Auditor {
+		cfg := config.Config{
+			BaseConfig:  commoncfg.BaseConfig{Audit: commoncfg.Audit{Endpoint: server.URL}},
+			AuditStore:  config.AuditStore{Enabled: true},
+			AuditExport: config.AuditExport{Enabled: enabled},
+		}
+
+		return auditor.New(t.Context(), &cfg,
+			auditor.WithEventStore(&fakeEventStore{}), auditor.WithEventExporter(exporter))
+	}
+
+	cmkID := uuid.NewString()
+	ctx := cmkcontext.CreateTenantContext(t.Context(), uuid.NewString())
+
+	t.Run("Should export chained events if enabled", func(t *testing.T) {
+		exporter := &fakeEventExporter{}
+
+		err := newAuditor(true, exporter).SendCmkDeleteAuditLog(ctx, cmkID)
+		assert.NoError(t, err)
+
+		assert.Len(t, exporter.events, 1)
+		assert.Equal(t, otlpaudit.CmkDeleteEvent, exporter.events[0].Attributes[otlpaudit.EventTypeKey])
+		assert.Equal(t, cmkID, exporter.events[0].Attributes[otlpaudit.ObjectIDKey])
+		assert.Equal(t, "1", exporter.events[0].Attributes[auditor.SequenceNumberKey])
+		assert.False(t, exporter.events[0].Timestamp.IsZero())
+	})
+
+	t.Run("Should not export events if disabled", func(t *testing.T) {
+		exporter := &fakeEventExporter{}
+
+		err := newAuditor(false, exporter).SendCmkDeleteAuditLog(ctx, cmkID)
+		assert.NoError(t, err)
+
+		assert.Empty(t, exporter.events)
+	})
+}
+
+func TestCloseExporters(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		received++
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := config.Config{
+		AuditExport: config.AuditExport{
+			Enabled:         true,
+			Format:          config.AuditExportFormatJSONL,
+			Sink:            config.AuditExportSinkWebhook,
+			Webhook:         config.AuditExportWebhook{URL: server.URL},
+			BufferSize:      10,
+			ShutdownTimeout: 5 * time.Second,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(cmkcontext.CreateTenantContext(t.Context(), uuid.NewString()))
+
+	err := auditor.New(ctx, &cfg).SendCmkDeleteAuditLog(ctx, uuid.NewString())
+	assert.NoError(t, err)
+
+	// Services close the exporters once their context is cancelled
+	cancel()
+	auditor.CloseExporters(ctx, &cfg)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, 1, received)
+}
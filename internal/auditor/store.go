@@ -0,0 +1,84 @@
+package auditor
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+
+	"github.com/openkcm/cmk/internal/log"
+)
+
+// Event is an audit event as kept by a local audit store
+type Event struct {
+	EventType      string
+	ObjectID       string
+	ActorID        string
+	CorrelationID  string
+	Attributes     map[string]string
+	Timestamp      time.Time
+	SequenceNumber int64
+	PreviousHash   string
+	Hash           string
+}
+
+// LinkFunc links audit events to the hash chain of a tenant after head
+type LinkFunc func(head ChainLink) ([]Event, error)
+
+// EventStore keeps a tenant local copy of the sent audit events
+type EventStore interface {
+	// AppendEvents stores the events link returns for the head of the hash
+	// chain of the tenant in context, or the zero link if the tenant has no
+	// chained events yet. Reading the head and storing the events must be
+	// atomic across all CMK instances sharing the store.
+	AppendEvents(ctx context.Context, link LinkFunc) error
+}
+
+type Option func(*Auditor)
+
+// WithEventStore sets the store keeping a local copy of every audit event.
+// The store is only used if the audit store is enabled in the config.
+func WithEventStore(store EventStore) Option {
+	return func(a *Auditor) {
+		a.eventStore = store
+	}
+}
+
+// storeEvents appends the audit events to the hash chain of the tenant
+// and writes them to the local store.
+// The audit log stays the primary sink so store failures are only logged.
+func (a *Auditor) storeEvents(ctx context.Context, logs plog.Logs) {
+	if a.eventStore == nil {
+		return
+	}
+
+	err := a.eventStore.AppendEvents(ctx, func(head ChainLink) ([]Event, error) {
+		if head.SequenceNumber == 0 {
+			head = ChainLink{Hash: GenesisHash}
+		}
+
+		return linkEvents(logs, head)
+	})
+	if err != nil {
+		log.Error(ctx, "failed to store audit event", err)
+	}
+}
+
+// eventFromRecord maps the log record of an audit event to a store event
+func eventFromRecord(record plog.LogRecord) Event {
+	attributes := make(map[string]string, record.Attributes().Len())
+	for k, v := range record.Attributes().All() {
+		attributes[k] = v.AsString()
+	}
+
+	return Event{
+		EventType:     attributes[otlpaudit.EventTypeKey],
+		ObjectID:      attributes[otlpaudit.ObjectIDKey],
+		ActorID:       attributes[otlpaudit.UserInitiatorIDKey],
+		CorrelationID: attributes[otlpaudit.EventCorrelationIDKey],
+		Attributes:    attributes,
+		Timestamp:     record.Timestamp().AsTime(),
+	}
+}
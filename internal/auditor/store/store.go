@@ -0,0 +1,133 @@
+// Package store keeps a copy of the audit events in the tenant schema,
+// so tenant auditors can query them from inside CMK.
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/log"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+var (
+	ErrStoreAuditEvent = errors.New("failed to store audit event")
+	ErrGetChainHead    = errors.New("failed to get audit hash chain head")
+	ErrLockChain       = errors.New("failed to lock audit hash chain")
+)
+
+const (
+	// chainLockPrefix prefixes the tenant ID in the advisory lock key
+	// serialising the appends to the hash chain of the tenant
+	chainLockPrefix = "audit-chain/"
+	// appendAttempts bounds the retries of an append losing the race for
+	// a sequence number, e.g. against an instance not taking the lock
+	appendAttempts = 3
+)
+
+// Store writes audit events to the audit_events table of the tenant in context
+type Store struct {
+	repo repo.Repo
+}
+
+var _ auditor.EventStore = (*Store)(nil) // Assert interface impl
+
+func New(r repo.Repo) *Store {
+	return &Store{repo: r}
+}
+
+// AppendEvents reads the head of the hash chain, links the events and stores
+// them in one transaction holding the chain lock of the tenant, so concurrent
+// appends of all instances are serialised. The transaction is its own, not
+// the one in context, so the lock is held shortly and the events are
+// committed before they are exported, even if the caller rolls back.
+func (s *Store) AppendEvents(ctx context.Context, link auditor.LinkFunc) error {
+	tenantID, err := cmkcontext.ExtractTenantID(ctx)
+	if err != nil {
+		return errs.Wrap(ErrStoreAuditEvent, err)
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = s.repo.NewTransaction(ctx, func(ctx context.Context) error {
+			err := s.repo.LockXact(ctx, chainLockPrefix+tenantID)
+			if err != nil {
+				return errs.Wrap(ErrLockChain, err)
+			}
+
+			head, err := s.ChainHead(ctx)
+			if err != nil {
+				return err
+			}
+
+			events, err := link(head)
+			if err != nil {
+				return errs.Wrap(ErrStoreAuditEvent, err)
+			}
+
+			return s.StoreEvents(ctx, events)
+		})
+		if !errors.Is(err, repo.ErrUniqueConstraint) || attempt == appendAttempts {
+			return err
+		}
+
+		log.Warn(ctx, "audit hash chain head changed, retrying append")
+	}
+}
+
+func (s *Store) StoreEvents(ctx context.Context, events []auditor.Event) error {
+	for _, event := range events {
+		attributes, err := json.Marshal(event.Attributes)
+		if err != nil {
+			return errs.Wrap(ErrStoreAuditEvent, err)
+		}
+
+		createdAt := event.Timestamp
+		if createdAt.IsZero() {
+			createdAt = time.Now().UTC()
+		}
+
+		err = s.repo.Create(ctx, &model.AuditEvent{
+			ID:             uuid.New(),
+			EventType:      event.EventType,
+			ObjectID:       event.ObjectID,
+			ActorID:        event.ActorID,
+			CorrelationID:  event.CorrelationID,
+			Attributes:     attributes,
+			CreatedAt:      createdAt,
+			SequenceNumber: event.SequenceNumber,
+			PreviousHash:   event.PreviousHash,
+			Hash:           event.Hash,
+		})
+		if err != nil {
+			return errs.Wrap(ErrStoreAuditEvent, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) ChainHead(ctx context.Context) (auditor.ChainLink, error) {
+	event := &model.AuditEvent{}
+
+	_, err := s.repo.First(ctx, event, *repo.NewQuery().Order(repo.OrderField{
+		Field:     repo.SequenceNumberField,
+		Direction: repo.Desc,
+	}))
+	if errors.Is(err, repo.ErrNotFound) {
+		return auditor.ChainLink{}, nil
+	}
+
+	if err != nil {
+		return auditor.ChainLink{}, errs.Wrap(ErrGetChainHead, err)
+	}
+
+	return auditor.ChainLink{SequenceNumber: event.SequenceNumber, Hash: event.Hash}, nil
+}
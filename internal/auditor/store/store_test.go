@@ -0,0 +1,191 @@
+package store_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/auditor/store"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+func TestStoreEvents(t *testing.T) {
+	db, tenants, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
+	r := sql.NewRepository(db)
+	s := store.New(r)
+	ctx := cmkcontext.CreateTenantContext(t.Context(), tenants[0])
+
+	timestamp := time.Now().UTC().Truncate(time.Second)
+
+	err := s.StoreEvents(ctx, []auditor.Event{
+		{
+			EventType:     "keyDisable",
+			ObjectID:      "key-1",
+			ActorID:       "user-1",
+			CorrelationID: "request-1",
+			Attributes:    map[string]string{"tenant": tenants[0]},
+			Timestamp:     timestamp,
+		},
+		{
+			EventType: "keyDelete",
+			ObjectID:  "key-1",
+			ActorID:   "user-1",
+		},
+	})
+	assert.NoError(t, err)
+
+	var events []*model.AuditEvent
+
+	err = r.List(ctx, model.AuditEvent{}, &events, *repo.NewQuery().Order(repo.OrderField{
+		Field:     repo.CreatedField,
+		Direction: repo.Asc,
+	}))
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	assert.Equal(t, "keyDisable", events[0].EventType)
+	assert.Equal(t, "key-1", events[0].ObjectID)
+	assert.Equal(t, "user-1", events[0].ActorID)
+	assert.Equal(t, "request-1", events[0].CorrelationID)
+	assert.Equal(t, map[string]string{"tenant": tenants[0]}, events[0].GetAttributes())
+	assert.True(t, timestamp.Equal(events[0].CreatedAt))
+
+	assert.Equal(t, "keyDelete", events[1].EventType)
+	assert.False(t, events[1].CreatedAt.IsZero())
+}
+
+func TestAppendEvents(t *testing.T) {
+	db, tenants, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
+	r := sql.NewRepository(db)
+	ctx := cmkcontext.CreateTenantContext(t.Context(), tenants[0])
+
+	cfg := &config.Config{AuditStore: config.AuditStore{Enabled: true}}
+
+	t.Run("Should keep one chain for concurrent auditors", func(t *testing.T) {
+		// Every auditor stands for a service instance with its own store
+		var wg sync.WaitGroup
+
+		for range 4 {
+			a := auditor.New(ctx, cfg, auditor.WithEventStore(store.New(r)))
+
+			wg.Go(func() {
+				for range 5 {
+					assert.NoError(t, a.SendCmkDeleteAuditLog(ctx, uuid.NewString()))
+				}
+			})
+		}
+
+		wg.Wait()
+
+		report, err := store.New(r).VerifyChain(ctx)
+		assert.NoError(t, err)
+		assert.True(t, report.Valid())
+		assert.Equal(t, 20, report.Events)
+		assert.Equal(t, int64(20), report.Head.SequenceNumber)
+	})
+
+	t.Run("Should keep events when the caller transaction rolls back", func(t *testing.T) {
+		s := store.New(r)
+
+		head, err := s.ChainHead(ctx)
+		assert.NoError(t, err)
+
+		a := auditor.New(ctx, cfg, auditor.WithEventStore(s))
+
+		err = r.Transaction(ctx, func(ctx context.Context) error {
+			assert.NoError(t, a.SendCmkDeleteAuditLog(ctx, uuid.NewString()))
+			return assert.AnError
+		})
+		assert.Error(t, err)
+
+		after, err := s.ChainHead(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, head.SequenceNumber+1, after.SequenceNumber)
+
+		report, err := s.VerifyChain(ctx)
+		assert.NoError(t, err)
+		assert.True(t, report.Valid())
+	})
+}
+
+func TestVerifyChain(t *testing.T) {
+	db, tenants, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
+	r := sql.NewRepository(db)
+	s := store.New(r)
+	ctx := cmkcontext.CreateTenantContext(t.Context(), tenants[0])
+
+	cfg := &config.Config{AuditStore: config.AuditStore{Enabled: true}}
+	a := auditor.New(ctx, cfg, auditor.WithEventStore(s))
+
+	for range 4 {
+		err := a.SendCmkDeleteAuditLog(ctx, uuid.NewString())
+		assert.NoError(t, err)
+	}
+
+	listChain := func(t *testing.T) []*model.AuditEvent {
+		t.Helper()
+
+		var events []*model.AuditEvent
+
+		err := r.List(ctx, model.AuditEvent{}, &events, *repo.NewQuery().Order(repo.OrderField{
+			Field:     repo.SequenceNumberField,
+			Direction: repo.Asc,
+		}))
+		assert.NoError(t, err)
+
+		return events
+	}
+
+	t.Run("Should verify untouched chain", func(t *testing.T) {
+		head, err := s.ChainHead(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), head.SequenceNumber)
+
+		report, err := s.VerifyChain(ctx)
+		assert.NoError(t, err)
+		assert.True(t, report.Valid())
+		assert.Equal(t, 4, report.Events)
+		assert.Equal(t, head, report.Head)
+	})
+
+	t.Run("Should report modified event", func(t *testing.T) {
+		events := listChain(t)
+
+		_, err := r.Patch(ctx, &model.AuditEvent{ID: events[1].ID, ObjectID: "tampered"}, *repo.NewQuery())
+		assert.NoError(t, err)
+
+		report, err := s.VerifyChain(ctx)
+		assert.NoError(t, err)
+		assert.False(t, report.Valid())
+		assert.Len(t, report.Issues, 1)
+		assert.Equal(t, store.ChainIssueModified, report.Issues[0].Kind)
+		assert.Equal(t, events[1].ID, report.Issues[0].EventID)
+	})
+
+	t.Run("Should report gap", func(t *testing.T) {
+		events := listChain(t)
+
+		_, err := r.Delete(ctx, &model.AuditEvent{ID: events[2].ID}, *repo.NewQuery())
+		assert.NoError(t, err)
+
+		report, err := s.VerifyChain(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, report.Events)
+		assert.Contains(t, report.Issues, store.ChainIssue{
+			Kind:           store.ChainIssueGap,
+			SequenceNumber: 4,
+			EventID:        events[3].ID,
+			Detail:         "missing events 3 to 3",
+		})
+	})
+}
@@ -0,0 +1,154 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+
+	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+)
+
+var ErrVerifyChain = errors.New("failed to verify audit hash chain")
+
+// verifyBatchSize is the number of events read per query while replaying the chain
+const verifyBatchSize = 500
+
+type ChainIssueKind string
+
+const (
+	// ChainIssueGap reports missing sequence numbers before an event
+	ChainIssueGap ChainIssueKind = "GAP"
+	// ChainIssueBrokenLink reports an event whose previous hash does not
+	// match the hash of the preceding event
+	ChainIssueBrokenLink ChainIssueKind = "BROKEN_LINK"
+	// ChainIssueModified reports an event whose content does not match its hash
+	ChainIssueModified ChainIssueKind = "MODIFIED"
+)
+
+// ChainIssue is a problem found in the hash chain of a tenant
+type ChainIssue struct {
+	Kind           ChainIssueKind `json:"kind"`
+	SequenceNumber int64          `json:"sequenceNumber"`
+	EventID        uuid.UUID      `json:"eventId"`
+	Detail         string         `json:"detail"`
+}
+
+// ChainReport is the result of replaying the hash chain of a tenant
+type ChainReport struct {
+	Events int               `json:"events"`
+	Head   auditor.ChainLink `json:"head"`
+	Issues []ChainIssue      `json:"issues"`
+}
+
+// Valid reports whether the replayed chain has no issues
+func (r ChainReport) Valid() bool {
+	return len(r.Issues) == 0
+}
+
+// VerifyChain replays the hash chain of the tenant in context from the
+// local store and reports gaps and modified events.
+// Events removed from the end of the chain can only be detected by
+// comparing the reported head with the audit log.
+func (s *Store) VerifyChain(ctx context.Context) (*ChainReport, error) {
+	report := &ChainReport{
+		Head:   auditor.ChainLink{Hash: auditor.GenesisHash},
+		Issues: []ChainIssue{},
+	}
+
+	for {
+		var events []model.AuditEvent
+
+		ck := repo.NewCompositeKey().Where(repo.SequenceNumberField, report.Head.SequenceNumber, repo.Gt)
+		query := repo.NewQuery().
+			Where(repo.NewCompositeKeyGroup(ck)).
+			Order(repo.OrderField{Field: repo.SequenceNumberField, Direction: repo.Asc}).
+			SetLimit(verifyBatchSize)
+
+		err := s.repo.List(ctx, model.AuditEvent{}, &events, *query)
+		if err != nil {
+			return nil, errs.Wrap(ErrVerifyChain, err)
+		}
+
+		for _, event := range events {
+			report.Issues = append(report.Issues, verifyEvent(event, report.Head)...)
+			report.Head = auditor.ChainLink{SequenceNumber: event.SequenceNumber, Hash: event.Hash}
+			report.Events++
+		}
+
+		if len(events) < verifyBatchSize {
+			return report, nil
+		}
+	}
+}
+
+// verifyEvent checks an event against the preceding link of the chain
+func verifyEvent(event model.AuditEvent, previous auditor.ChainLink) []ChainIssue {
+	var issues []ChainIssue
+
+	newIssue := func(kind ChainIssueKind, detail string) ChainIssue {
+		return ChainIssue{
+			Kind:           kind,
+			SequenceNumber: event.SequenceNumber,
+			EventID:        event.ID,
+			Detail:         detail,
+		}
+	}
+
+	switch {
+	case event.SequenceNumber != previous.SequenceNumber+1:
+		issues = append(issues, newIssue(ChainIssueGap, fmt.Sprintf(
+			"missing events %d to %d", previous.SequenceNumber+1, event.SequenceNumber-1,
+		)))
+	case event.PreviousHash != previous.Hash:
+		issues = append(issues, newIssue(ChainIssueBrokenLink, "previous hash does not match preceding event"))
+	}
+
+	detail := checkContent(event)
+	if detail != "" {
+		issues = append(issues, newIssue(ChainIssueModified, detail))
+	}
+
+	return issues
+}
+
+// checkContent recomputes the hash of an event and checks the event columns
+// against the hashed attributes. It returns a description of the mismatch.
+func checkContent(event model.AuditEvent) string {
+	attributes := event.GetAttributes()
+	if attributes == nil {
+		return "attributes are missing or invalid"
+	}
+
+	columns := map[string]string{
+		otlpaudit.EventTypeKey:          event.EventType,
+		otlpaudit.ObjectIDKey:           event.ObjectID,
+		otlpaudit.UserInitiatorIDKey:    event.ActorID,
+		otlpaudit.EventCorrelationIDKey: event.CorrelationID,
+		auditor.SequenceNumberKey:       strconv.FormatInt(event.SequenceNumber, 10),
+		auditor.PreviousHashKey:         event.PreviousHash,
+	}
+	for key, value := range columns {
+		if attributes[key] != value {
+			return key + " does not match the event attributes"
+		}
+	}
+
+	hash, err := auditor.HashEvent(auditor.Event{Attributes: attributes, Timestamp: event.CreatedAt})
+	if err != nil {
+		return err.Error()
+	}
+
+	if hash != event.Hash {
+		return "hash does not match event content"
+	}
+
+	return ""
+}
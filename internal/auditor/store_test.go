@@ -0,0 +1,169 @@
+package auditor_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+
+	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/config"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+type fakeEventStore struct {
+	events []auditor.Event
+}
+
+func (s *fakeEventStore) AppendEvents(_ context.Context, link auditor.LinkFunc) error {
+	var head auditor.ChainLink
+	if len(s.events) > 0 {
+		last := s.events[len(s.events)-1]
+		head = auditor.ChainLink{SequenceNumber: last.SequenceNumber, Hash: last.Hash}
+	}
+
+	events, err := link(head)
+	if err != nil {
+		return err
+	}
+
+	s.events = append(s.events, events...)
+
+	return nil
+}
+
+func TestAuditor_EventStore(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	newAuditor := func(enabled bool, store auditor.EventStore) *auditor.Auditor {
+		cfg := config.Config{
+			BaseConfig: commoncfg.BaseConfig{Audit: commoncfg.Audit{Endpoint: server.URL}},
+			AuditStore: config.AuditStore{Enabled: enabled},
+		}
+
+		return auditor.New(t.Context(), &cfg, auditor.WithEventStore(store))
+	}
+
+	tenantID := uuid.NewString()
+	cmkID := uuid.NewString()
+	ctx := cmkcontext.CreateTenantContext(t.Context(), tenantID)
+
+	t.Run("Should store events if enabled", func(t *testing.T) {
+		store := &fakeEventStore{}
+
+		err := newAuditor(true, store).SendCmkDeleteAuditLog(ctx, cmkID)
+		assert.NoError(t, err)
+
+		assert.Len(t, store.events, 1)
+		assert.Equal(t, otlpaudit.CmkDeleteEvent, store.events[0].EventType)
+		assert.Equal(t, cmkID, store.events[0].ObjectID)
+		assert.Equal(t, uuid.Max.String(), store.events[0].ActorID)
+		assert.Equal(t, tenantID, store.events[0].Attributes[otlpaudit.TenantIDKey])
+	})
+
+	t.Run("Should not store events if disabled", func(t *testing.T) {
+		store := &fakeEventStore{}
+
+		err := newAuditor(false, store).SendCmkDeleteAuditLog(ctx, cmkID)
+		assert.NoError(t, err)
+
+		assert.Empty(t, store.events)
+	})
+}
+
+func TestAuditor_HashChain(t *testing.T) {
+	var sent []map[string]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		logs, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(body)
+		assert.NoError(t, err)
+
+		attrs, err := getAttributes(&logs)
+		assert.NoError(t, err)
+
+		sent = append(sent, attrs)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := config.Config{
+		BaseConfig: commoncfg.BaseConfig{Audit: commoncfg.Audit{Endpoint: server.URL}},
+		AuditStore: config.AuditStore{Enabled: true},
+	}
+	store := &fakeEventStore{}
+	a := auditor.New(t.Context(), &cfg, auditor.WithEventStore(store))
+	ctx := cmkcontext.CreateTenantContext(t.Context(), uuid.NewString())
+
+	for range 3 {
+		err := a.SendCmkDeleteAuditLog(ctx, uuid.NewString())
+		assert.NoError(t, err)
+	}
+
+	assert.Len(t, store.events, 3)
+	assert.Len(t, sent, 3)
+
+	previous := auditor.GenesisHash
+
+	for i, event := range store.events {
+		assert.Equal(t, int64(i+1), event.SequenceNumber)
+		assert.Equal(t, previous, event.PreviousHash)
+		assert.Equal(t, strconv.Itoa(i+1), event.Attributes[auditor.SequenceNumberKey])
+		assert.Equal(t, previous, event.Attributes[auditor.PreviousHashKey])
+
+		hash, err := auditor.HashEvent(event)
+		assert.NoError(t, err)
+		assert.Equal(t, hash, event.Hash)
+
+		// The sent event carries the same link
+		assert.Equal(t, strconv.Itoa(i+1), sent[i][auditor.SequenceNumberKey])
+		assert.Equal(t, previous, sent[i][auditor.PreviousHashKey])
+
+		previous = event.Hash
+	}
+}
+
+func TestHashEvent(t *testing.T) {
+	event := auditor.Event{
+		Attributes: map[string]string{"a": "1", "b": "2"},
+		Timestamp:  time.Date(2026, 1, 2, 3, 4, 5, 6000, time.UTC),
+	}
+
+	hash, err := auditor.HashEvent(event)
+	assert.NoError(t, err)
+	assert.Len(t, hash, len(auditor.GenesisHash))
+
+	t.Run("Should not depend on timezone", func(t *testing.T) {
+		local := event
+		local.Timestamp = event.Timestamp.In(time.FixedZone("test", 3600))
+
+		localHash, err := auditor.HashEvent(local)
+		assert.NoError(t, err)
+		assert.Equal(t, hash, localHash)
+	})
+
+	t.Run("Should change with attributes", func(t *testing.T) {
+		modified := event
+		modified.Attributes = map[string]string{"a": "1", "b": "3"}
+
+		modifiedHash, err := auditor.HashEvent(modified)
+		assert.NoError(t, err)
+		assert.NotEqual(t, hash, modifiedHash)
+	})
+}
@@ -0,0 +1,48 @@
+package auditor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+)
+
+// SendWorkflowStartAuditLog sends an audit log for workflow creation.
+// The workflow artifact is used as channel of the workflow events
+func (a *Auditor) SendWorkflowStartAuditLog(
+	ctx context.Context,
+	workflowID, artifactID, artifactType, actionType string,
+) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewWorkflowStartEvent(metadata, workflowID, artifactID, artifactType, actionType, false)
+	})
+}
+
+// SendWorkflowUpdateAuditLog sends an audit log for a workflow state transition
+func (a *Auditor) SendWorkflowUpdateAuditLog(ctx context.Context, workflowID, oldState, newState string) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewWorkflowUpdateEvent(metadata, workflowID, oldState, newState, false)
+	})
+}
+
+// SendWorkflowExecuteAuditLog sends an audit log for the execution of a workflow action.
+// The state is the outcome of the execution
+func (a *Auditor) SendWorkflowExecuteAuditLog(
+	ctx context.Context,
+	workflowID, artifactID, artifactType, state string,
+) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewWorkflowExecuteEvent(metadata, workflowID, artifactID, artifactType, state, false)
+	})
+}
+
+// SendWorkflowTerminateAuditLog sends an audit log for a workflow ending without execution
+func (a *Auditor) SendWorkflowTerminateAuditLog(
+	ctx context.Context,
+	workflowID, artifactID, artifactType, state string,
+) error {
+	return a.sendEvent(ctx, func(metadata otlpaudit.EventMetadata) (plog.Logs, error) {
+		return otlpaudit.NewWorkflowTerminateEvent(metadata, workflowID, artifactID, artifactType, state, false)
+	})
+}
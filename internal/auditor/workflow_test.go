@@ -0,0 +1,60 @@
+package auditor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+
+	"github.com/openkcm/cmk/internal/auditor"
+)
+
+func TestAuditor_SendWorkflowAuditLogs(t *testing.T) {
+	t.Run("start", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.WorkflowStartEvent, map[string]string{
+			otlpaudit.ObjectIDKey:    "workflow-id",
+			otlpaudit.ChannelIDKey:   "key-id",
+			otlpaudit.ChannelTypeKey: "KEY",
+			otlpaudit.ValueKey:       "DELETE",
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendWorkflowStartAuditLog(ctx, "workflow-id", "key-id", "KEY", "DELETE")
+		})
+	})
+
+	t.Run("update", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.WorkflowUpdateEvent, map[string]string{
+			otlpaudit.ObjectIDKey: "workflow-id",
+			otlpaudit.OldValueKey: "WAIT_APPROVAL",
+			otlpaudit.NewValueKey: "WAIT_CONFIRMATION",
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendWorkflowUpdateAuditLog(ctx, "workflow-id", "WAIT_APPROVAL", "WAIT_CONFIRMATION")
+		})
+	})
+
+	t.Run("execute", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.WorkflowExecuteEvent, map[string]string{
+			otlpaudit.ObjectIDKey: "workflow-id",
+			otlpaudit.ValueKey:    "SUCCESSFUL",
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendWorkflowExecuteAuditLog(ctx, "workflow-id", "key-id", "KEY", "SUCCESSFUL")
+		})
+	})
+
+	t.Run("terminate", func(t *testing.T) {
+		assertSentEvent(t, otlpaudit.WorkflowTerminateEvent, map[string]string{
+			otlpaudit.ObjectIDKey: "workflow-id",
+			otlpaudit.ValueKey:    "REJECTED",
+		}, func(a *auditor.Auditor, ctx context.Context) error {
+			return a.SendWorkflowTerminateAuditLog(ctx, "workflow-id", "key-id", "KEY", "REJECTED")
+		})
+	})
+
+	t.Run("terminate without artifact fails", func(t *testing.T) {
+		a := createTestAuditor("http://localhost")
+
+		err := a.SendWorkflowTerminateAuditLog(createTestContext(), "workflow-id", "", "", "REJECTED")
+		assert.ErrorIs(t, err, auditor.ErrCreateEvent)
+	})
+}
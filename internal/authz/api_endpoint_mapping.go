@@ -126,6 +126,10 @@ var RestrictionsByAPI = map[string]Restricted{
 		APIResourceTypeName: APIResourceTypeSystem,
 		APIAction:           APIActionSystemModifyLink,
 	},
+	"POST /systems/{systemID}/link:validate": {
+		APIResourceTypeName: APIResourceTypeSystem,
+		APIAction:           APIActionSystemModifyLink,
+	},
 	"POST /systems/{systemID}/recoveryActions": {
 		APIResourceTypeName: APIResourceTypeSystem,
 		APIAction:           APIActionSystemModifyLink,
@@ -135,6 +139,40 @@ var RestrictionsByAPI = map[string]Restricted{
 		APIAction:           APIActionSystemModifyLink,
 	},
 
+	// System groups endpoints
+	"GET /systemGroups": {
+		APIResourceTypeName: APIResourceTypeSystemGroup,
+		APIAction:           APIActionRead,
+	},
+	"POST /systemGroups": {
+		APIResourceTypeName: APIResourceTypeSystemGroup,
+		APIAction:           APIActionCreate,
+	},
+	"GET /systemGroups/{systemGroupID}": {
+		APIResourceTypeName: APIResourceTypeSystemGroup,
+		APIAction:           APIActionRead,
+	},
+	"PATCH /systemGroups/{systemGroupID}": {
+		APIResourceTypeName: APIResourceTypeSystemGroup,
+		APIAction:           APIActionUpdate,
+	},
+	"DELETE /systemGroups/{systemGroupID}": {
+		APIResourceTypeName: APIResourceTypeSystemGroup,
+		APIAction:           APIActionDelete,
+	},
+	"GET /systemGroups/{systemGroupID}/systems": {
+		APIResourceTypeName: APIResourceTypeSystemGroup,
+		APIAction:           APIActionRead,
+	},
+	"PATCH /systemGroups/{systemGroupID}/link": {
+		APIResourceTypeName: APIResourceTypeSystemGroup,
+		APIAction:           APIActionSystemModifyLink,
+	},
+	"DELETE /systemGroups/{systemGroupID}/link": {
+		APIResourceTypeName: APIResourceTypeSystemGroup,
+		APIAction:           APIActionSystemModifyLink,
+	},
+
 	// Workflows endpoints
 	"POST /workflows": {
 		APIResourceTypeName: APIResourceTypeWorkFlow,
@@ -190,6 +228,65 @@ var RestrictionsByAPI = map[string]Restricted{
 		APIResourceTypeName: APIResourceTypeUserGroup,
 		APIAction:           APIActionDelete,
 	},
+	"GET /groups/{groupID}/grants": {
+		APIResourceTypeName: APIResourceTypeUserGroup,
+		APIAction:           APIActionRead,
+	},
+	"POST /groups/{groupID}/grants": {
+		APIResourceTypeName: APIResourceTypeUserGroup,
+		APIAction:           APIActionUpdate,
+	},
+	"DELETE /groups/{groupID}/grants/{grantID}": {
+		APIResourceTypeName: APIResourceTypeUserGroup,
+		APIAction:           APIActionUpdate,
+	},
+
+	// API tokens endpoints. Key administrators create tokens for their own
+	// groups, revoking tokens of any group is left to tenant administrators.
+	"GET /apiTokens": {
+		APIResourceTypeName: APIResourceTypeAPIToken,
+		APIAction:           APIActionRead,
+	},
+	"POST /apiTokens": {
+		APIResourceTypeName: APIResourceTypeAPIToken,
+		APIAction:           APIActionCreate,
+	},
+	"GET /apiTokens/{tokenID}": {
+		APIResourceTypeName: APIResourceTypeAPIToken,
+		APIAction:           APIActionRead,
+	},
+	"DELETE /apiTokens/{tokenID}": {
+		APIResourceTypeName: APIResourceTypeAPIToken,
+		APIAction:           APIActionDelete,
+	},
+
+	// Custom roles endpoints
+	"GET /roles": {
+		APIResourceTypeName: APIResourceTypeRole,
+		APIAction:           APIActionRead,
+	},
+	"POST /roles": {
+		APIResourceTypeName: APIResourceTypeRole,
+		APIAction:           APIActionCreate,
+	},
+	"GET /roles/{roleID}": {
+		APIResourceTypeName: APIResourceTypeRole,
+		APIAction:           APIActionRead,
+	},
+	"PATCH /roles/{roleID}": {
+		APIResourceTypeName: APIResourceTypeRole,
+		APIAction:           APIActionUpdate,
+	},
+	"DELETE /roles/{roleID}": {
+		APIResourceTypeName: APIResourceTypeRole,
+		APIAction:           APIActionDelete,
+	},
+
+	// Authorization endpoints
+	"POST /authz/explain": {
+		APIResourceTypeName: APIResourceTypeRole,
+		APIAction:           APIActionRead,
+	},
 
 	// Tenant endpoints
 	"GET /tenantConfigurations/keystores": {
@@ -204,8 +301,26 @@ var RestrictionsByAPI = map[string]Restricted{
 		APIResourceTypeName: APIResourceTypeTenantSettings,
 		APIAction:           APIActionUpdate,
 	},
+	"GET /tenantConfigurations/systemAutoLink": {
+		APIResourceTypeName: APIResourceTypeTenantSettings,
+		APIAction:           APIActionRead,
+	},
+	"PUT /tenantConfigurations/systemAutoLink": {
+		APIResourceTypeName: APIResourceTypeTenantSettings,
+		APIAction:           APIActionUpdate,
+	},
+	"GET /capabilities": {
+		APIResourceTypeName: APIResourceTypeTenantSettings,
+		APIAction:           APIActionRead,
+	},
 	"GET /tenantInfo": {
 		APIResourceTypeName: APIResourceTypeTenant,
 		APIAction:           APIActionRead,
 	},
+
+	// Audit events endpoints
+	"GET /auditEvents": {
+		APIResourceTypeName: APIResourceTypeAuditEvent,
+		APIAction:           APIActionRead,
+	},
 }
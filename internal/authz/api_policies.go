@@ -27,10 +27,17 @@ var APIPolicies = RolePolicies[constants.BusinessRole, APIResourceType, APIActio
 						APIActionRead,
 					},
 				},
+				{
+					Type: APIResourceTypeSystemGroup,
+					Actions: []APIAction{
+						APIActionRead,
+					},
+				},
 				{
 					Type: APIResourceTypeWorkFlow,
 					Actions: []APIAction{
 						APIActionRead,
+						APIActionCreate, // For requesting role elevations
 					},
 				},
 				{
@@ -51,6 +58,24 @@ var APIPolicies = RolePolicies[constants.BusinessRole, APIResourceType, APIActio
 						APIActionRead,
 					},
 				},
+				{
+					Type: APIResourceTypeAuditEvent,
+					Actions: []APIAction{
+						APIActionRead,
+					},
+				},
+				{
+					Type: APIResourceTypeRole,
+					Actions: []APIAction{
+						APIActionRead,
+					},
+				},
+				{
+					Type: APIResourceTypeAPIToken,
+					Actions: []APIAction{
+						APIActionRead,
+					},
+				},
 			},
 		},
 	},
@@ -91,6 +116,16 @@ var APIPolicies = RolePolicies[constants.BusinessRole, APIResourceType, APIActio
 						APIActionUpdate,
 					},
 				},
+				{
+					Type: APIResourceTypeSystemGroup,
+					Actions: []APIAction{
+						APIActionRead,
+						APIActionCreate,
+						APIActionDelete,
+						APIActionUpdate,
+						APIActionSystemModifyLink,
+					},
+				},
 				{
 					Type: APIResourceTypeWorkFlow,
 					Actions: []APIAction{
@@ -106,6 +141,13 @@ var APIPolicies = RolePolicies[constants.BusinessRole, APIResourceType, APIActio
 						APIActionRead,
 					},
 				},
+				{
+					Type: APIResourceTypeAPIToken,
+					Actions: []APIAction{
+						APIActionRead,
+						APIActionCreate,
+					},
+				},
 			},
 		},
 	},
@@ -136,6 +178,23 @@ var APIPolicies = RolePolicies[constants.BusinessRole, APIResourceType, APIActio
 						APIActionUpdate,
 					},
 				},
+				{
+					Type: APIResourceTypeRole,
+					Actions: []APIAction{
+						APIActionRead,
+						APIActionCreate,
+						APIActionDelete,
+						APIActionUpdate,
+					},
+				},
+				{
+					Type: APIResourceTypeAPIToken,
+					Actions: []APIAction{
+						APIActionRead,
+						APIActionCreate,
+						APIActionDelete,
+					},
+				},
 			},
 		},
 	},
@@ -2,6 +2,9 @@ package authz
 
 import (
 	"errors"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/openkcm/cmk/internal/constants"
 	"github.com/openkcm/cmk/internal/errs"
@@ -16,8 +19,26 @@ type InternalUserCheck struct {
 	Role constants.InternalRole
 }
 
+// ElevatedUserCheck identifies a business user holding role elevations
+// on a key configuration
+type ElevatedUserCheck struct {
+	TenantID           TenantID
+	UserName           string
+	KeyConfigurationID uuid.UUID
+}
+
+// RoleElevation is a role granted to a business user on a key configuration
+// until it expires
+type RoleElevation struct {
+	TenantID           TenantID
+	UserName           string
+	KeyConfigurationID uuid.UUID
+	Role               constants.BusinessRole
+	ExpiresAt          time.Time
+}
+
 type AuthorizationKey[
-	User BusinessUserCheck | InternalUserCheck,
+	User BusinessUserCheck | InternalUserCheck | ElevatedUserCheck,
 	Resource APIResourceType | RepoResourceType,
 	Action APIAction | RepoAction,
 ] struct {
@@ -69,11 +90,18 @@ func (l *BusinessUserAuthzData[ResourceType, Action]) InitialiseAuthzKeys() {
 	l.AuthzKeys = make(map[AuthorizationKey[BusinessUserCheck, ResourceType, Action]]struct{})
 }
 
+// AddUser adds the authorization keys of the users. Roles are looked up in
+// the built-in role policies first, then in the tenant specific ones.
 func (l *BusinessUserAuthzData[ResourceType, Action]) AddUser(
 	user map[constants.BusinessRole]*BusinessUser,
+	tenantPolicies RolePolicies[constants.BusinessRole, ResourceType, Action],
 ) error {
 	for role, tenantAuth := range user {
 		policies, ok := l.RolePolicies[role]
+		if !ok {
+			policies, ok = tenantPolicies[role]
+		}
+
 		if !ok {
 			return errs.Wrap(ErrValidation, ErrInvalidRole)
 		}
@@ -100,6 +128,48 @@ func (l *BusinessUserAuthzData[ResourceType, Action]) AddUser(
 	return nil
 }
 
+// elevationKeys returns the authorization keys of the role elevations with the
+// time they end. Roles are looked up like in AddUser.
+func (l *BusinessUserAuthzData[ResourceType, Action]) elevationKeys(
+	elevations []RoleElevation,
+	tenantPolicies RolePolicies[constants.BusinessRole, ResourceType, Action],
+) (map[AuthorizationKey[ElevatedUserCheck, ResourceType, Action]]time.Time, error) {
+	keys := make(map[AuthorizationKey[ElevatedUserCheck, ResourceType, Action]]time.Time)
+
+	for _, elevation := range elevations {
+		policies, ok := l.RolePolicies[elevation.Role]
+		if !ok {
+			policies, ok = tenantPolicies[elevation.Role]
+		}
+
+		if !ok {
+			return nil, errs.Wrap(ErrValidation, ErrInvalidRole)
+		}
+
+		for _, policy := range policies {
+			for _, resource := range policy.ResourceTypes {
+				for _, action := range resource.Actions {
+					key := AuthorizationKey[ElevatedUserCheck, ResourceType, Action]{
+						User: ElevatedUserCheck{
+							TenantID:           elevation.TenantID,
+							UserName:           elevation.UserName,
+							KeyConfigurationID: elevation.KeyConfigurationID,
+						},
+						ResourceType: resource.Type,
+						Action:       action,
+					}
+
+					if elevation.ExpiresAt.After(keys[key]) {
+						keys[key] = elevation.ExpiresAt
+					}
+				}
+			}
+		}
+	}
+
+	return keys, nil
+}
+
 // NewInternalUserAuthzData creates and return a InternalUserAuthzData.
 // There are no separate functions to add the entities,
 // they are created on construction, since the policies and roles are all static
@@ -81,10 +81,14 @@ func checkBusinessUserAuthz[
 		return false, errs.Wrap(ErrExtractBusinessUserData, err)
 	}
 
+	// Without scope the request is not checked against role elevations
+	keyConfigID, _ := cmkcontext.ExtractKeyConfigurationScope(ctx)
+
 	user := BusinessUserRequest{
-		TenantID: TenantID(tenant),
-		UserName: identifier,
-		Groups:   groups,
+		TenantID:           TenantID(tenant),
+		UserName:           identifier,
+		Groups:             groups,
+		KeyConfigurationID: keyConfigID,
 	}
 
 	log.Debug(
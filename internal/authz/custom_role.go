@@ -0,0 +1,101 @@
+package authz
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/errs"
+)
+
+var (
+	ErrCustomRoleName         = errors.New("custom role name must not be a built-in role")
+	ErrCustomRoleBaseRole     = errors.New("custom role base role must be a built-in role")
+	ErrCustomRoleNoPermission = errors.New("custom role must have at least one permission")
+	ErrCustomRolePermission   = errors.New("custom role permission is not granted by its base role")
+)
+
+// BuiltInRoles are the business roles defined by CMK
+var BuiltInRoles = []constants.BusinessRole{
+	constants.KeyAdminRole,
+	constants.TenantAdminRole,
+	constants.TenantAuditorRole,
+}
+
+// CustomRole is a tenant defined business role. A custom role narrows its
+// built-in base role: its API permissions are a subset of the API policies
+// of the base role, and on the repository it acts as the base role.
+type CustomRole struct {
+	Name        constants.BusinessRole
+	BaseRole    constants.BusinessRole
+	Permissions []Resource[APIResourceType, APIAction]
+}
+
+func IsBuiltInRole(role constants.BusinessRole) bool {
+	return slices.Contains(BuiltInRoles, role)
+}
+
+// Validate checks that the custom role does not shadow a built-in role
+// and only grants permissions of its base role
+func (r CustomRole) Validate() error {
+	if IsBuiltInRole(r.Name) {
+		return errs.Wrap(ErrValidation, ErrCustomRoleName)
+	}
+
+	if !IsBuiltInRole(r.BaseRole) {
+		return errs.Wrap(ErrValidation, ErrCustomRoleBaseRole)
+	}
+
+	if len(r.Permissions) == 0 {
+		return errs.Wrap(ErrValidation, ErrCustomRoleNoPermission)
+	}
+
+	for _, permission := range r.Permissions {
+		if len(permission.Actions) == 0 {
+			return errs.Wrap(ErrValidation, ErrCustomRoleNoPermission)
+		}
+
+		for _, action := range permission.Actions {
+			if !slices.Contains(APIResourceTypeActions[permission.Type], action) ||
+				!isGranted(APIPolicies[r.BaseRole], permission.Type, action) {
+				return errs.Wrapf(
+					errs.Wrap(ErrValidation, ErrCustomRolePermission),
+					string(permission.Type)+":"+string(action),
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// APICustomRolePolicies returns the API policies of a custom role
+func APICustomRolePolicies(role CustomRole) []Policy[APIResourceType, APIAction] {
+	return []Policy[APIResourceType, APIAction]{
+		{
+			ID:            constants.CustomRolePolicy,
+			ResourceTypes: role.Permissions,
+		},
+	}
+}
+
+// RepoCustomRolePolicies returns the repository policies of a custom role,
+// which are the ones of its base role
+func RepoCustomRolePolicies(role CustomRole) []Policy[RepoResourceType, RepoAction] {
+	return RepoBusinessPolicies[role.BaseRole]
+}
+
+func isGranted[
+	ResourceType APIResourceType | RepoResourceType,
+	Action APIAction | RepoAction,
+](policies []Policy[ResourceType, Action], resourceType ResourceType, action Action) bool {
+	for _, policy := range policies {
+		for _, resource := range policy.ResourceTypes {
+			if resource.Type == resourceType && slices.Contains(resource.Actions, action) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,166 @@
+package authz_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/testutils"
+)
+
+func keyOperatorRole() authz.CustomRole {
+	return authz.CustomRole{
+		Name:     "KEY_OPERATOR",
+		BaseRole: constants.KeyAdminRole,
+		Permissions: []authz.Resource[authz.APIResourceType, authz.APIAction]{
+			{
+				Type:    authz.APIResourceTypeKey,
+				Actions: []authz.APIAction{authz.APIActionRead, authz.APIActionUpdate},
+			},
+		},
+	}
+}
+
+func TestCustomRoleValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*authz.CustomRole)
+		err    error
+	}{
+		{
+			name:   "Should be valid",
+			mutate: func(_ *authz.CustomRole) {},
+		},
+		{
+			name:   "Should fail on built-in role name",
+			mutate: func(r *authz.CustomRole) { r.Name = constants.TenantAdminRole },
+			err:    authz.ErrCustomRoleName,
+		},
+		{
+			name:   "Should fail on unknown base role",
+			mutate: func(r *authz.CustomRole) { r.BaseRole = "KEY_VIEWER" },
+			err:    authz.ErrCustomRoleBaseRole,
+		},
+		{
+			name:   "Should fail without permissions",
+			mutate: func(r *authz.CustomRole) { r.Permissions = nil },
+			err:    authz.ErrCustomRoleNoPermission,
+		},
+		{
+			name:   "Should fail on permission without actions",
+			mutate: func(r *authz.CustomRole) { r.Permissions[0].Actions = nil },
+			err:    authz.ErrCustomRoleNoPermission,
+		},
+		{
+			name:   "Should fail on unknown action",
+			mutate: func(r *authz.CustomRole) { r.Permissions[0].Actions = []authz.APIAction{"launch"} },
+			err:    authz.ErrCustomRolePermission,
+		},
+		{
+			name: "Should fail on permission not granted by base role",
+			mutate: func(r *authz.CustomRole) {
+				r.Permissions[0] = authz.Resource[authz.APIResourceType, authz.APIAction]{
+					Type:    authz.APIResourceTypeUserGroup,
+					Actions: []authz.APIAction{authz.APIActionCreate},
+				}
+			},
+			err: authz.ErrCustomRolePermission,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role := keyOperatorRole()
+			tt.mutate(&role)
+
+			err := role.Validate()
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, authz.ErrValidation)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestCustomRolePolicies(t *testing.T) {
+	role := keyOperatorRole()
+
+	t.Run("Should grant the permissions on the API", func(t *testing.T) {
+		policies := authz.APICustomRolePolicies(role)
+		assert.Len(t, policies, 1)
+		assert.Equal(t, constants.CustomRolePolicy, policies[0].ID)
+		assert.Equal(t, role.Permissions, policies[0].ResourceTypes)
+	})
+
+	t.Run("Should act as the base role on the repository", func(t *testing.T) {
+		assert.Equal(t,
+			authz.RepoBusinessPolicies[constants.KeyAdminRole],
+			authz.RepoCustomRolePolicies(role),
+		)
+	})
+}
+
+func TestIsAllowedCustomRole(t *testing.T) {
+	audit := auditor.New(context.Background(), &config.Config{})
+
+	authHandler, err := authz.NewAuthorizationHandler(audit,
+		make(authz.RolePolicies[constants.InternalRole, authz.APIResourceType, authz.APIAction]),
+		authz.APIPolicies, authz.APIResourceTypeActions, &sync.Mutex{})
+	assert.NoError(t, err)
+
+	role := keyOperatorRole()
+	users := map[constants.BusinessRole]*authz.BusinessUser{
+		role.Name: {
+			TenantID: "tenant1",
+			Groups:   []string{"Operators"},
+		},
+	}
+
+	t.Run("Should fail on custom role without tenant policies", func(t *testing.T) {
+		err := authHandler.UpdateBusinessUserData(users, nil)
+		assert.ErrorIs(t, err, authz.ErrInvalidRole)
+	})
+
+	err = authHandler.UpdateBusinessUserData(users, authz.RolePolicies[
+		constants.BusinessRole, authz.APIResourceType, authz.APIAction]{
+		role.Name: authz.APICustomRolePolicies(role),
+	})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		action authz.APIAction
+		allow  bool
+	}{
+		{name: "Should allow granted action", action: authz.APIActionUpdate, allow: true},
+		{name: "Should deny action only granted by base role", action: authz.APIActionDelete, allow: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testutils.CreateCtxWithTenant("tenant1")
+			ctx = context.WithValue(ctx, constants.UserType, constants.BusinessUser)
+
+			decision, _ := authHandler.IsBusinessUserAllowed(ctx, authz.Request[
+				authz.BusinessUserRequest, authz.APIResourceType, authz.APIAction]{
+				User: authz.BusinessUserRequest{
+					TenantID: "tenant1",
+					UserName: "test_user",
+					Groups:   []string{"Operators"},
+				},
+				ResourceTypeName: authz.APIResourceTypeKey,
+				Action:           tt.action,
+			})
+			assert.Equal(t, tt.allow, decision)
+		})
+	}
+}
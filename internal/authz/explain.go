@@ -0,0 +1,78 @@
+package authz
+
+import (
+	"strings"
+)
+
+// MatchAPIPattern returns the "METHOD path" pattern of RestrictionsByAPI or
+// AllowListByAPI matching a request. The path is relative to the base path,
+// path parameters match any segment and literal segments take precedence.
+func MatchAPIPattern(method, path string) (string, bool) {
+	path, _, _ = strings.Cut(path, "?")
+	segments := splitPath(path)
+
+	best := ""
+	bestLiterals := -1
+
+	match := func(pattern string) {
+		patternMethod, patternPath, found := strings.Cut(pattern, " ")
+		if !found || !strings.EqualFold(patternMethod, method) {
+			return
+		}
+
+		literals, ok := matchSegments(splitPath(patternPath), segments)
+		if ok && literals > bestLiterals {
+			best = pattern
+			bestLiterals = literals
+		}
+	}
+
+	for pattern := range RestrictionsByAPI {
+		match(pattern)
+	}
+
+	for pattern := range AllowListByAPI {
+		match(pattern)
+	}
+
+	return best, bestLiterals >= 0
+}
+
+// IsGranted returns true if one of the policies grants the action on the resource type
+func IsGranted[
+	ResourceType APIResourceType | RepoResourceType,
+	Action APIAction | RepoAction,
+](policies []Policy[ResourceType, Action], resourceType ResourceType, action Action) bool {
+	return isGranted(policies, resourceType, action)
+}
+
+// matchSegments returns the number of literal segments if the path matches the pattern
+func matchSegments(pattern, path []string) (int, bool) {
+	if len(pattern) != len(path) {
+		return 0, false
+	}
+
+	literals := 0
+
+	for i, segment := range pattern {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if path[i] == "" {
+				return 0, false
+			}
+
+			continue
+		}
+
+		if segment != path[i] {
+			return 0, false
+		}
+
+		literals++
+	}
+
+	return literals, true
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
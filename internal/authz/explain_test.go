@@ -0,0 +1,45 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/constants"
+)
+
+func TestMatchAPIPattern(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		expected string
+		found    bool
+	}{
+		{"Literal path", "GET", "/keys", "GET /keys", true},
+		{"Path parameter", "DELETE", "/keys/123", "DELETE /keys/{keyID}", true},
+		{"Lower case method", "get", "/keys/123/versions", "GET /keys/{keyID}/versions", true},
+		{"Query is ignored", "GET", "/keys?$top=5", "GET /keys", true},
+		{"Literal over parameter", "POST", "/groups/iamCheck", "POST /groups/iamCheck", true},
+		{"Allow listed", "GET", "/userInfo", "GET /userInfo", true},
+		{"Unknown method", "PUT", "/keys", "", false},
+		{"Unknown path", "GET", "/unknown", "", false},
+		{"Empty parameter", "GET", "/keys//versions", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pattern, found := authz.MatchAPIPattern(tt.method, tt.path)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.expected, pattern)
+		})
+	}
+}
+
+func TestIsGranted(t *testing.T) {
+	policies := authz.APIPolicies[constants.TenantAuditorRole]
+
+	assert.True(t, authz.IsGranted(policies, authz.APIResourceTypeKey, authz.APIActionRead))
+	assert.False(t, authz.IsGranted(policies, authz.APIResourceTypeKey, authz.APIActionDelete))
+}
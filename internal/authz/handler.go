@@ -5,6 +5,9 @@ import (
 	"errors"
 	"slices"
 	"sync"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/openkcm/cmk/internal/auditor"
 	"github.com/openkcm/cmk/internal/constants"
@@ -33,6 +36,12 @@ type Handler[
 	InternalUserAuthzData InternalUserAuthzData[Resource, Action]
 	BusinessUserAuthzData BusinessUserAuthzData[Resource, Action]
 
+	// elevationKeys hold the authorization keys of the role elevations of
+	// business users with the time the elevation ends
+	elevationKeys map[AuthorizationKey[ElevatedUserCheck, Resource, Action]]time.Time
+	// elevatedUsers hold the time the last role elevation of a business user ends
+	elevatedUsers map[ElevatedUserCheck]time.Time
+
 	Auditor *auditor.Auditor
 
 	resourceActions map[Resource][]Action
@@ -53,7 +62,10 @@ var (
 	ErrActionInvalidForResource = errors.New("action is invalid for resource type")
 )
 
-var InfoAuthorizationPassed = "Authorization check passed"
+var (
+	InfoAuthorizationPassed  = "Authorization check passed"
+	InfoRoleElevationApplied = "Authorization check passed by role elevation"
+)
 
 func NewAuthorizationHandler[
 	Resource APIResourceType | RepoResourceType,
@@ -79,6 +91,8 @@ func NewAuthorizationHandler[
 		resourceActions:       resourceActions,
 		BusinessUserAuthzData: *businessUserAuthzData,
 		InternalUserAuthzData: *internalUserAuthzData,
+		elevationKeys:         make(map[AuthorizationKey[ElevatedUserCheck, Resource, Action]]time.Time),
+		elevatedUsers:         make(map[ElevatedUserCheck]time.Time),
 		Auditor:               auditor,
 		mu:                    mu,
 	}, nil
@@ -86,16 +100,62 @@ func NewAuthorizationHandler[
 
 func (as *Handler[Resource, Action]) ResetBusinessUserData() {
 	as.BusinessUserAuthzData.InitialiseAuthzKeys()
+	as.elevationKeys = make(map[AuthorizationKey[ElevatedUserCheck, Resource, Action]]time.Time)
+	as.elevatedUsers = make(map[ElevatedUserCheck]time.Time)
 }
 
+// UpdateBusinessUserData adds the business users of a tenant. The tenant
+// policies hold the policies of the custom roles defined by the tenant.
 func (as *Handler[Resource, Action]) UpdateBusinessUserData(
 	user map[constants.BusinessRole]*BusinessUser,
+	tenantPolicies RolePolicies[constants.BusinessRole, Resource, Action],
 ) error {
-	return as.BusinessUserAuthzData.AddUser(user)
+	return as.BusinessUserAuthzData.AddUser(user, tenantPolicies)
+}
+
+// UpdateRoleElevations adds the role elevations of business users. The tenant
+// policies hold the policies of the custom roles defined by the tenant.
+func (as *Handler[Resource, Action]) UpdateRoleElevations(
+	elevations []RoleElevation,
+	tenantPolicies RolePolicies[constants.BusinessRole, Resource, Action],
+) error {
+	keys, err := as.BusinessUserAuthzData.elevationKeys(elevations, tenantPolicies)
+	if err != nil {
+		return err
+	}
+
+	if as.elevationKeys == nil {
+		as.elevationKeys = make(map[AuthorizationKey[ElevatedUserCheck, Resource, Action]]time.Time, len(keys))
+	}
+
+	if as.elevatedUsers == nil {
+		as.elevatedUsers = make(map[ElevatedUserCheck]time.Time)
+	}
+
+	for key, expiresAt := range keys {
+		if expiresAt.After(as.elevationKeys[key]) {
+			as.elevationKeys[key] = expiresAt
+		}
+
+		user := ElevatedUserCheck{TenantID: key.User.TenantID, UserName: key.User.UserName}
+		if expiresAt.After(as.elevatedUsers[user]) {
+			as.elevatedUsers[user] = expiresAt
+		}
+	}
+
+	return nil
+}
+
+// HasRoleElevations checks if the business user holds an active role elevation
+// on any key configuration, locking is done by caller
+func (as *Handler[Resource, Action]) HasRoleElevations(tenantID TenantID, userName string) bool {
+	expiresAt, ok := as.elevatedUsers[ElevatedUserCheck{TenantID: tenantID, UserName: userName}]
+	return ok && time.Now().Before(expiresAt)
 }
 
 // IsBusinessUserAllowed checks if the given Business User is allowed to perform
-// the given Action on the given Resource
+// the given Action on the given Resource, through the roles of its groups or
+// an active role elevation on the key configuration of the request
 func (as *Handler[Resource, Action]) IsBusinessUserAllowed(
 	ctx context.Context,
 	request Request[BusinessUserRequest, Resource, Action],
@@ -135,6 +195,24 @@ func (as *Handler[Resource, Action]) IsBusinessUserAllowed(
 		}
 	}
 
+	if request.User.UserName != "" && request.User.KeyConfigurationID != uuid.Nil {
+		elevationKey := AuthorizationKey[ElevatedUserCheck, Resource, Action]{
+			User: ElevatedUserCheck{
+				TenantID:           request.User.TenantID,
+				UserName:           request.User.UserName,
+				KeyConfigurationID: request.User.KeyConfigurationID,
+			},
+			ResourceType: request.ResourceTypeName,
+			Action:       request.Action,
+		}
+
+		expiresAt, ok := as.elevationKeys[elevationKey]
+		if ok && time.Now().Before(expiresAt) {
+			LogDecision(ctx, request, as.Auditor, true, Reason(InfoRoleElevationApplied))
+			return true, nil
+		}
+	}
+
 	// If no matching policy is found, deny authorization
 	LogDecision(ctx, request, as.Auditor, false, Reason(ErrAuthorizationDecision.Error()))
 
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/openkcm/cmk/internal/auditor"
@@ -168,7 +170,7 @@ func TestIsAllowed(t *testing.T) {
 					authz.APIPolicies, authz.APIResourceTypeActions, &sync.Mutex{})
 				assert.NoError(t, err)
 
-				err = authHandler.UpdateBusinessUserData(tt.entities)
+				err = authHandler.UpdateBusinessUserData(tt.entities, nil)
 				if err != nil {
 					if tt.expectedErrHandler {
 						return
@@ -199,6 +201,84 @@ func TestIsAllowed(t *testing.T) {
 	}
 }
 
+func TestIsAllowedWithRoleElevation(t *testing.T) {
+	audit := auditor.New(t.Context(), &config.Config{})
+
+	authHandler, err := authz.NewAuthorizationHandler(audit,
+		make(authz.RolePolicies[constants.InternalRole, authz.APIResourceType, authz.APIAction]),
+		authz.APIPolicies, authz.APIResourceTypeActions, &sync.Mutex{})
+	assert.NoError(t, err)
+
+	err = authHandler.UpdateBusinessUserData(map[constants.BusinessRole]*authz.BusinessUser{
+		constants.TenantAuditorRole: {TenantID: "tenant1", Groups: []string{"auditors"}},
+	}, nil)
+	assert.NoError(t, err)
+
+	keyConfigID := uuid.New()
+
+	err = authHandler.UpdateRoleElevations([]authz.RoleElevation{
+		{
+			TenantID: "tenant1", UserName: "elevated", KeyConfigurationID: keyConfigID,
+			Role: constants.KeyAdminRole, ExpiresAt: time.Now().Add(time.Hour),
+		},
+		{
+			TenantID: "tenant1", UserName: "expired", KeyConfigurationID: keyConfigID,
+			Role: constants.KeyAdminRole, ExpiresAt: time.Now().Add(-time.Hour),
+		},
+	}, nil)
+	assert.NoError(t, err)
+
+	ctx := testutils.CreateCtxWithTenant("tenant1")
+	ctx = context.WithValue(ctx, constants.UserType, constants.BusinessUser)
+
+	isAllowed := func(user string, scope uuid.UUID) bool {
+		allowed, _ := authHandler.IsBusinessUserAllowed(ctx,
+			authz.Request[authz.BusinessUserRequest, authz.APIResourceType, authz.APIAction]{
+				User: authz.BusinessUserRequest{
+					TenantID:           "tenant1",
+					UserName:           user,
+					Groups:             []string{"auditors"},
+					KeyConfigurationID: scope,
+				},
+				ResourceTypeName: authz.APIResourceTypeKeyConfiguration,
+				Action:           authz.APIActionUpdate,
+			})
+
+		return allowed
+	}
+
+	assert.True(t, isAllowed("elevated", keyConfigID))
+	assert.False(t, isAllowed("expired", keyConfigID))
+	assert.False(t, isAllowed("other", keyConfigID))
+
+	t.Run("Should only apply on the key configuration of the elevation", func(t *testing.T) {
+		assert.False(t, isAllowed("elevated", uuid.New()))
+		assert.False(t, isAllowed("elevated", uuid.Nil))
+	})
+
+	t.Run("Should report users with active elevations", func(t *testing.T) {
+		assert.True(t, authHandler.HasRoleElevations("tenant1", "elevated"))
+		assert.False(t, authHandler.HasRoleElevations("tenant1", "expired"))
+		assert.False(t, authHandler.HasRoleElevations("tenant2", "elevated"))
+	})
+
+	t.Run("Should fail on unknown role", func(t *testing.T) {
+		err := authHandler.UpdateRoleElevations([]authz.RoleElevation{
+			{
+				TenantID: "tenant1", UserName: "elevated", KeyConfigurationID: keyConfigID,
+				Role: "UNKNOWN", ExpiresAt: time.Now().Add(time.Hour),
+			},
+		}, nil)
+		assert.ErrorIs(t, err, authz.ErrInvalidRole)
+	})
+
+	t.Run("Should drop elevations on reset", func(t *testing.T) {
+		authHandler.ResetBusinessUserData()
+		assert.False(t, isAllowed("elevated", keyConfigID))
+		assert.False(t, authHandler.HasRoleElevations("tenant1", "elevated"))
+	})
+}
+
 const (
 	totalNumber       = 10000
 	testUsername      = "test_user"
@@ -229,7 +309,7 @@ func BenchmarkIsAllowed(b *testing.B) {
 		b.Fatalf("Failed to create authorization handler: %v", err)
 	}
 
-	err = authHandler.UpdateBusinessUserData(entities)
+	err = authHandler.UpdateBusinessUserData(entities, nil)
 	if err != nil {
 		b.Fatalf("Failed to create authorization handler: %v", err)
 	}
@@ -7,9 +7,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	slogctx "github.com/veqryn/slog-context"
 
 	"github.com/openkcm/cmk/internal/auditor"
+	audit_store "github.com/openkcm/cmk/internal/auditor/store"
 	"github.com/openkcm/cmk/internal/authz"
 	"github.com/openkcm/cmk/internal/config"
 	"github.com/openkcm/cmk/internal/constants"
@@ -26,6 +28,11 @@ var (
 	ErrEmptyTenantID      = errors.New("tenantID cannot be empty")
 )
 
+// elevationLookupInterval is the time the role elevations of a user loaded on
+// a denied request are not looked up again, so denials do not reach the database
+// each time. Elevations granted in the meantime apply after it at the latest.
+const elevationLookupInterval = 30 * time.Second
+
 type AuthzLoader[
 	Resource authz.APIResourceType | authz.RepoResourceType,
 	Action authz.APIAction | authz.RepoAction,
@@ -35,6 +42,13 @@ type AuthzLoader[
 	AuthzHandler *authz.Handler[Resource, Action]
 	mu           *sync.Mutex // protects AuthzHandler.Entities and AuthorizationData
 	Auditor      *auditor.Auditor
+
+	// elevationLookups hold the time the role elevations of a user were last loaded
+	elevationLookups map[authz.ElevatedUserCheck]time.Time
+
+	// customRolePolicies maps tenant defined roles to policies,
+	// custom roles are not loaded if nil
+	customRolePolicies func(authz.CustomRole) []authz.Policy[Resource, Action]
 }
 
 func NewAuthzLoader[
@@ -47,8 +61,9 @@ func NewAuthzLoader[
 	internalRolePolicies authz.RolePolicies[constants.InternalRole, ResourceType, Action],
 	businessRolePolicies authz.RolePolicies[constants.BusinessRole, ResourceType, Action],
 	resourceTypeActions map[ResourceType][]Action,
+	customRolePolicies func(authz.CustomRole) []authz.Policy[ResourceType, Action],
 ) *AuthzLoader[ResourceType, Action] {
-	audit := auditor.New(ctx, config)
+	audit := auditor.New(ctx, config, auditor.WithEventStore(audit_store.New(repo)))
 
 	mu := sync.Mutex{}
 
@@ -70,6 +85,10 @@ func NewAuthzLoader[
 		AuthzHandler: authzHandler,
 		Auditor:      audit,
 		mu:           &mu,
+
+		elevationLookups: make(map[authz.ElevatedUserCheck]time.Time),
+
+		customRolePolicies: customRolePolicies,
 	}
 }
 
@@ -80,7 +99,15 @@ func NewAPIAuthzLoader(
 ) *AuthzLoader[authz.APIResourceType, authz.APIAction] {
 	// No internal user access allowed to api
 	APIInternalPolicies := make(authz.RolePolicies[constants.InternalRole, authz.APIResourceType, authz.APIAction])
-	return NewAuthzLoader(ctx, repo, config, APIInternalPolicies, authz.APIPolicies, authz.APIResourceTypeActions)
+	return NewAuthzLoader(
+		ctx,
+		repo,
+		config,
+		APIInternalPolicies,
+		authz.APIPolicies,
+		authz.APIResourceTypeActions,
+		authz.APICustomRolePolicies,
+	)
 }
 
 func NewRepoAuthzLoader(
@@ -95,6 +122,7 @@ func NewRepoAuthzLoader(
 		authz.RepoInternalPolicies,
 		authz.RepoBusinessPolicies,
 		authz.RepoResourceTypeActions,
+		authz.RepoCustomRolePolicies,
 	)
 }
 
@@ -112,6 +140,101 @@ func (am *AuthzLoader[Resource, Action]) LoadTenantAllowedActions(ctx context.Co
 	return am.loadTenantAllowedActions(ctx, tenantID)
 }
 
+// LoadUserRoleElevations adds the active role elevations of the business user
+// in context, so elevations granted after the tenant was loaded are honored
+// without waiting for the periodic refresh. The elevations of a user are looked
+// up at most once per elevationLookupInterval.
+func (am *AuthzLoader[Resource, Action]) LoadUserRoleElevations(ctx context.Context) error {
+	tenantID, err := cmkcontext.ExtractTenantID(ctx)
+	if err != nil {
+		// Same as for LoadTenantAllowedActions, not relevant without tenant
+		return nil
+	}
+
+	identifier, err := cmkcontext.ExtractBusinessUserDataIdentifier(ctx)
+	if err != nil || identifier == "" {
+		// Only identified business users hold role elevations
+		return nil //nolint:nilerr
+	}
+
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
+	user := authz.ElevatedUserCheck{TenantID: authz.TenantID(tenantID), UserName: identifier}
+	if time.Since(am.elevationLookups[user]) < elevationLookupInterval {
+		return nil
+	}
+
+	elevations, err := am.getRoleElevations(ctx, tenantID, identifier)
+	if err != nil {
+		return err
+	}
+
+	if am.elevationLookups == nil {
+		am.elevationLookups = make(map[authz.ElevatedUserCheck]time.Time)
+	}
+
+	am.elevationLookups[user] = time.Now()
+
+	if len(elevations) == 0 {
+		return nil
+	}
+
+	tenantPolicies, err := am.getCustomRolePolicies(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = am.AuthzHandler.UpdateRoleElevations(elevations, tenantPolicies)
+	if err != nil {
+		return errs.Wrap(ErrLoadAuthzAllowList, err)
+	}
+
+	return nil
+}
+
+// HasRoleElevations checks if the business user in context holds an active
+// role elevation, in which case requests need their key configuration scope
+func (am *AuthzLoader[Resource, Action]) HasRoleElevations(ctx context.Context) bool {
+	tenantID, err := cmkcontext.ExtractTenantID(ctx)
+	if err != nil {
+		return false
+	}
+
+	identifier, err := cmkcontext.ExtractBusinessUserDataIdentifier(ctx)
+	if err != nil || identifier == "" {
+		return false
+	}
+
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
+	return am.AuthzHandler.HasRoleElevations(authz.TenantID(tenantID), identifier)
+}
+
+// KeyConfigurationOf returns the key configuration the key or system with the
+// given ID belongs to, or uuid.Nil if it belongs to none
+func (am *AuthzLoader[Resource, Action]) KeyConfigurationOf(
+	ctx context.Context,
+	resource repo.Resource,
+) (uuid.UUID, error) {
+	_, err := am.repo.First(ctx, resource, *repo.NewQuery())
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	switch r := resource.(type) {
+	case *model.Key:
+		return r.KeyConfigurationID, nil
+	case *model.System:
+		if r.KeyConfigurationID != nil {
+			return *r.KeyConfigurationID, nil
+		}
+	}
+
+	return uuid.Nil, nil
+}
+
 func (am *AuthzLoader[Resource, Action]) ReloadTenantAllowedActions(ctx context.Context) error {
 	am.mu.Lock()
 	defer am.mu.Unlock()
@@ -132,6 +255,7 @@ func (am *AuthzLoader[Resource, Action]) ReloadTenantAllowedActions(ctx context.
 
 func (am *AuthzLoader[Resource, Action]) ResetBusinessUserData() {
 	am.TenantIDs = make(map[authz.TenantID]struct{})
+	am.elevationLookups = make(map[authz.ElevatedUserCheck]time.Time)
 	am.AuthzHandler.ResetBusinessUserData()
 }
 
@@ -212,8 +336,23 @@ func (am *AuthzLoader[Resource, Action]) loadTenantAllowedActions(
 		}
 	}
 
-	if len(tenantRoleGroupsMap) > 0 {
-		err = am.AuthzHandler.UpdateBusinessUserData(tenantRoleGroupsMap)
+	elevations, err := am.getRoleElevations(ctx, tenantID, "")
+	if err != nil {
+		return err
+	}
+
+	if len(tenantRoleGroupsMap) > 0 || len(elevations) > 0 {
+		tenantPolicies, err := am.getCustomRolePolicies(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = am.AuthzHandler.UpdateBusinessUserData(tenantRoleGroupsMap, tenantPolicies)
+		if err != nil {
+			return errs.Wrap(ErrLoadAuthzAllowList, err)
+		}
+
+		err = am.AuthzHandler.UpdateRoleElevations(elevations, tenantPolicies)
 		if err != nil {
 			return errs.Wrap(ErrLoadAuthzAllowList, err)
 		}
@@ -238,6 +377,77 @@ func (am *AuthzLoader[Resource, Action]) getGroups(ctx context.Context) ([]model
 	return groups, nil
 }
 
+// getRoleElevations returns the active role elevations of the tenant,
+// restricted to the user if userID is not empty
+func (am *AuthzLoader[Resource, Action]) getRoleElevations(
+	ctx context.Context,
+	tenantID string,
+	userID string,
+) ([]authz.RoleElevation, error) {
+	ck := repo.NewCompositeKey().
+		Where(repo.ExpiredField, false).
+		Where(repo.ExpiresAtField, time.Now(), repo.Gt)
+	if userID != "" {
+		ck = ck.Where(repo.UserIDField, userID)
+	}
+
+	var elevations []authz.RoleElevation
+
+	err := repo.ProcessInBatch(ctx, am.repo, repo.NewQuery().Where(repo.NewCompositeKeyGroup(ck)), repo.DefaultLimit,
+		func(batch []*model.RoleElevation) error {
+			for _, elevation := range batch {
+				elevations = append(elevations, authz.RoleElevation{
+					TenantID:           authz.TenantID(tenantID),
+					UserName:           elevation.UserID,
+					KeyConfigurationID: elevation.KeyConfigurationID,
+					Role:               elevation.Role,
+					ExpiresAt:          elevation.ExpiresAt,
+				})
+			}
+
+			return nil
+		})
+	if err != nil {
+		return nil, errs.Wrap(ErrLoadAuthzAllowList, err)
+	}
+
+	return elevations, nil
+}
+
+// getCustomRolePolicies returns the policies of the roles defined by the tenant.
+// Invalid roles are skipped, so they do not block loading the built-in roles.
+func (am *AuthzLoader[Resource, Action]) getCustomRolePolicies(
+	ctx context.Context,
+) (authz.RolePolicies[constants.BusinessRole, Resource, Action], error) {
+	policies := make(authz.RolePolicies[constants.BusinessRole, Resource, Action])
+	if am.customRolePolicies == nil {
+		return policies, nil
+	}
+
+	var roles []model.CustomRole
+
+	err := am.repo.List(ctx, &model.CustomRole{}, &roles, *repo.NewQuery())
+	if err != nil {
+		return nil, errs.Wrap(ErrLoadAuthzAllowList, err)
+	}
+
+	for _, role := range roles {
+		authzRole, err := role.ToAuthz()
+		if err == nil {
+			err = authzRole.Validate()
+		}
+
+		if err != nil {
+			log.Error(ctx, "skipping invalid custom role", err, slog.String("role", string(role.Name)))
+			continue
+		}
+
+		policies[role.Name] = am.customRolePolicies(authzRole)
+	}
+
+	return policies, nil
+}
+
 func (am *AuthzLoader[Resource, Action]) isTenantKnown(ctx context.Context, tenantID string) bool {
 	var tenant model.Tenant
 
@@ -2,6 +2,7 @@ package authz_loader_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -93,3 +94,44 @@ func TestAuthzManager_LoadEntitiesInAllowList(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, am.AuthzHandler.BusinessUserAuthzData.AuthzKeys, len(tenants)*numKeysPerTenant)
 }
+
+func TestAuthzLoader_LoadUserRoleElevations(t *testing.T) {
+	r := repomock.NewInMemoryRepository()
+
+	ctx := testutils.CreateCtxWithTenant("tenant1")
+	err := r.Create(ctx, &model.Tenant{ID: "tenant1", Status: "Test"})
+	assert.NoError(t, err)
+
+	userID := uuid.NewString()
+	ctx = testutils.InjectBusinessUserDataIntoContext(ctx, userID, []string{"group1"})
+
+	am := authz_loader.NewAPIAuthzLoader(t.Context(), r, &config.Config{})
+
+	err = am.LoadUserRoleElevations(ctx)
+	assert.NoError(t, err)
+	assert.False(t, am.HasRoleElevations(ctx))
+
+	err = r.Create(ctx, &model.RoleElevation{
+		ID:                 uuid.New(),
+		UserID:             userID,
+		KeyConfigurationID: uuid.New(),
+		Role:               constants.KeyAdminRole,
+		WorkflowID:         uuid.New(),
+		ExpiresAt:          time.Now().Add(time.Hour),
+	})
+	assert.NoError(t, err)
+
+	t.Run("Should not look up elevations again right after a lookup", func(t *testing.T) {
+		err := am.LoadUserRoleElevations(ctx)
+		assert.NoError(t, err)
+		assert.False(t, am.HasRoleElevations(ctx))
+	})
+
+	t.Run("Should look up elevations again after reset", func(t *testing.T) {
+		am.ResetBusinessUserData()
+
+		err := am.LoadUserRoleElevations(ctx)
+		assert.NoError(t, err)
+		assert.True(t, am.HasRoleElevations(ctx))
+	})
+}
@@ -0,0 +1,290 @@
+package authz
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/errs"
+)
+
+var (
+	ErrUnknownEndpoint       = errors.New("no API endpoint matches the method and path")
+	ErrUnknownRole           = errors.New("unknown role")
+	ErrUnknownGroup          = errors.New("unknown group")
+	ErrInvalidPolicyTestCase = errors.New("invalid policy test case")
+)
+
+type Decision string
+
+const (
+	DecisionAllow Decision = "ALLOW"
+	DecisionDeny  Decision = "DENY"
+)
+
+// EffectivePolicies are the API and repository policies of the business
+// roles, and the roles of the groups of a tenant
+type EffectivePolicies struct {
+	API    RolePolicies[constants.BusinessRole, APIResourceType, APIAction]
+	Repo   RolePolicies[constants.BusinessRole, RepoResourceType, RepoAction]
+	Groups map[string]constants.BusinessRole
+}
+
+// BuiltInPolicies returns the effective policies of the built-in roles
+func BuiltInPolicies() EffectivePolicies {
+	return EffectivePolicies{
+		API:    maps.Clone(APIPolicies),
+		Repo:   maps.Clone(RepoBusinessPolicies),
+		Groups: map[string]constants.BusinessRole{},
+	}
+}
+
+// AddCustomRole adds the policies of a tenant defined role
+func (p EffectivePolicies) AddCustomRole(role CustomRole) {
+	p.API[role.Name] = APICustomRolePolicies(role)
+	p.Repo[role.Name] = RepoCustomRolePolicies(role)
+}
+
+// Roles returns the business roles sorted by name
+func (p EffectivePolicies) Roles() []constants.BusinessRole {
+	return slices.Sorted(maps.Keys(p.API))
+}
+
+// DecideAPIAccess returns the decision of the API authorization for a role
+// on a request, and the endpoint pattern the request matches
+func (p EffectivePolicies) DecideAPIAccess(
+	role constants.BusinessRole,
+	method, path string,
+) (Decision, string, error) {
+	policies, ok := p.API[role]
+	if !ok {
+		return "", "", errs.Wrapf(ErrUnknownRole, string(role))
+	}
+
+	endpoint, found := MatchAPIPattern(method, path)
+	if !found {
+		return "", "", errs.Wrapf(ErrUnknownEndpoint, method+" "+path)
+	}
+
+	return decideEndpoint(policies, endpoint), endpoint, nil
+}
+
+// APIMatrix returns the decisions of every role on every API endpoint
+func (p EffectivePolicies) APIMatrix() AccessMatrix {
+	endpoints := slices.Collect(maps.Keys(RestrictionsByAPI))
+	endpoints = slices.AppendSeq(endpoints, maps.Keys(AllowListByAPI))
+	slices.SortFunc(endpoints, compareEndpoints)
+
+	matrix := AccessMatrix{Header: "ENDPOINT", Roles: p.Roles()}
+	for _, endpoint := range endpoints {
+		row := AccessMatrixRow{Permission: endpoint}
+		for _, role := range matrix.Roles {
+			row.Decisions = append(row.Decisions, decideEndpoint(p.API[role], endpoint))
+		}
+
+		matrix.Rows = append(matrix.Rows, row)
+	}
+
+	return matrix
+}
+
+// RepoMatrix returns the decisions of every role on every repository
+// resource type and action
+func (p EffectivePolicies) RepoMatrix() AccessMatrix {
+	matrix := AccessMatrix{Header: "RESOURCE ACTION", Roles: p.Roles()}
+	for _, resourceType := range slices.Sorted(maps.Keys(RepoResourceTypeActions)) {
+		for _, action := range RepoResourceTypeActions[resourceType] {
+			row := AccessMatrixRow{Permission: string(resourceType) + " " + string(action)}
+			for _, role := range matrix.Roles {
+				row.Decisions = append(row.Decisions, decision(isGranted(p.Repo[role], resourceType, action)))
+			}
+
+			matrix.Rows = append(matrix.Rows, row)
+		}
+	}
+
+	return matrix
+}
+
+func decideEndpoint(policies []Policy[APIResourceType, APIAction], endpoint string) Decision {
+	if _, ok := AllowListByAPI[endpoint]; ok {
+		return DecisionAllow
+	}
+
+	restriction, ok := RestrictionsByAPI[endpoint]
+	if !ok {
+		return DecisionDeny
+	}
+
+	return decision(isGranted(policies, restriction.APIResourceTypeName, restriction.APIAction))
+}
+
+func decision(granted bool) Decision {
+	if granted {
+		return DecisionAllow
+	}
+
+	return DecisionDeny
+}
+
+// compareEndpoints orders "METHOD path" endpoints by path, then method
+func compareEndpoints(a, b string) int {
+	aMethod, aPath, _ := strings.Cut(a, " ")
+	bMethod, bPath, _ := strings.Cut(b, " ")
+
+	if c := strings.Compare(aPath, bPath); c != 0 {
+		return c
+	}
+
+	return strings.Compare(aMethod, bMethod)
+}
+
+// AccessMatrix holds the decisions of roles on permissions
+type AccessMatrix struct {
+	Header string
+	Roles  []constants.BusinessRole
+	Rows   []AccessMatrixRow
+}
+
+type AccessMatrixRow struct {
+	Permission string
+	Decisions  []Decision
+}
+
+func (m AccessMatrix) records() [][]string {
+	header := []string{m.Header}
+	for _, role := range m.Roles {
+		header = append(header, string(role))
+	}
+
+	records := [][]string{header}
+	for _, row := range m.Rows {
+		record := []string{row.Permission}
+		for _, d := range row.Decisions {
+			record = append(record, string(d))
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// WriteTable writes the matrix as an aligned table
+func (m AccessMatrix) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	for _, record := range m.records() {
+		_, err := fmt.Fprintln(tw, strings.Join(record, "\t"))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
+}
+
+// WriteCSV writes the matrix as CSV
+func (m AccessMatrix) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	err := cw.WriteAll(m.records())
+	if err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
+
+// PolicyTestCase is the expected decision of the API authorization for a
+// request of a role, or of the role of a tenant group
+type PolicyTestCase struct {
+	Role     constants.BusinessRole `yaml:"role"`
+	Group    string                 `yaml:"group"`
+	Method   string                 `yaml:"method"`
+	Path     string                 `yaml:"path"`
+	Expected Decision               `yaml:"expected"`
+}
+
+type PolicyTestMatrix struct {
+	Cases []PolicyTestCase `yaml:"cases"`
+}
+
+// ParsePolicyTestMatrix parses and validates a YAML policy test matrix
+func ParsePolicyTestMatrix(data []byte) (*PolicyTestMatrix, error) {
+	matrix := &PolicyTestMatrix{}
+
+	err := yaml.Unmarshal(data, matrix)
+	if err != nil {
+		return nil, errs.Wrap(ErrInvalidPolicyTestCase, err)
+	}
+
+	for i := range matrix.Cases {
+		c := &matrix.Cases[i]
+		c.Expected = Decision(strings.ToUpper(string(c.Expected)))
+
+		if (c.Role == "") == (c.Group == "") {
+			return nil, errs.Wrapf(ErrInvalidPolicyTestCase,
+				fmt.Sprintf("case %d: exactly one of role and group is required", i+1))
+		}
+
+		if c.Method == "" || c.Path == "" {
+			return nil, errs.Wrapf(ErrInvalidPolicyTestCase,
+				fmt.Sprintf("case %d: method and path are required", i+1))
+		}
+
+		if c.Expected != DecisionAllow && c.Expected != DecisionDeny {
+			return nil, errs.Wrapf(ErrInvalidPolicyTestCase,
+				fmt.Sprintf("case %d: expected must be %s or %s", i+1, DecisionAllow, DecisionDeny))
+		}
+	}
+
+	return matrix, nil
+}
+
+type PolicyTestResult struct {
+	PolicyTestCase
+
+	Endpoint string
+	Actual   Decision
+	Err      error
+}
+
+func (r PolicyTestResult) Passed() bool {
+	return r.Err == nil && r.Actual == r.Expected
+}
+
+// RunPolicyTests evaluates the cases of the matrix
+func (p EffectivePolicies) RunPolicyTests(matrix *PolicyTestMatrix) []PolicyTestResult {
+	results := make([]PolicyTestResult, 0, len(matrix.Cases))
+
+	for _, c := range matrix.Cases {
+		result := PolicyTestResult{PolicyTestCase: c}
+
+		role := c.Role
+		if c.Group != "" {
+			groupRole, ok := p.Groups[c.Group]
+			if !ok {
+				result.Err = errs.Wrapf(ErrUnknownGroup, c.Group)
+				results = append(results, result)
+
+				continue
+			}
+
+			role = groupRole
+		}
+
+		result.Actual, result.Endpoint, result.Err = p.DecideAPIAccess(role, c.Method, c.Path)
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,156 @@
+package authz_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/constants"
+)
+
+func TestDecideAPIAccess(t *testing.T) {
+	policies := authz.BuiltInPolicies()
+
+	tests := []struct {
+		name     string
+		role     constants.BusinessRole
+		method   string
+		path     string
+		expected authz.Decision
+		endpoint string
+	}{
+		{"Granted", constants.TenantAuditorRole, "GET", "/keys/123", authz.DecisionAllow, "GET /keys/{keyID}"},
+		{"Not granted", constants.TenantAuditorRole, "DELETE", "/keys/123", authz.DecisionDeny, "DELETE /keys/{keyID}"},
+		{"Allow listed", constants.TenantAuditorRole, "GET", "/userInfo", authz.DecisionAllow, "GET /userInfo"},
+		{"Token created by key admin", constants.KeyAdminRole, "POST", "/apiTokens", authz.DecisionAllow,
+			"POST /apiTokens"},
+		{"Token revoked by key admin", constants.KeyAdminRole, "DELETE", "/apiTokens/123", authz.DecisionDeny,
+			"DELETE /apiTokens/{tokenID}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision, endpoint, err := policies.DecideAPIAccess(tt.role, tt.method, tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, decision)
+			assert.Equal(t, tt.endpoint, endpoint)
+		})
+	}
+
+	t.Run("Should fail on unknown role", func(t *testing.T) {
+		_, _, err := policies.DecideAPIAccess("UNKNOWN", "GET", "/keys")
+		assert.ErrorIs(t, err, authz.ErrUnknownRole)
+	})
+
+	t.Run("Should fail on unknown endpoint", func(t *testing.T) {
+		_, _, err := policies.DecideAPIAccess(constants.TenantAuditorRole, "GET", "/unknown")
+		assert.ErrorIs(t, err, authz.ErrUnknownEndpoint)
+	})
+}
+
+func TestEffectivePoliciesCustomRole(t *testing.T) {
+	policies := authz.BuiltInPolicies()
+	policies.AddCustomRole(authz.CustomRole{
+		Name:     "KEY_READER",
+		BaseRole: constants.KeyAdminRole,
+		Permissions: []authz.Resource[authz.APIResourceType, authz.APIAction]{
+			{Type: authz.APIResourceTypeKey, Actions: []authz.APIAction{authz.APIActionRead}},
+		},
+	})
+	policies.Groups["readers"] = "KEY_READER"
+
+	assert.Contains(t, policies.Roles(), constants.BusinessRole("KEY_READER"))
+	assert.NotContains(t, authz.APIPolicies, constants.BusinessRole("KEY_READER"))
+
+	results := policies.RunPolicyTests(&authz.PolicyTestMatrix{Cases: []authz.PolicyTestCase{
+		{Group: "readers", Method: "GET", Path: "/keys", Expected: authz.DecisionAllow},
+		{Group: "readers", Method: "DELETE", Path: "/keys/123", Expected: authz.DecisionDeny},
+		{Group: "readers", Method: "POST", Path: "/keys", Expected: authz.DecisionAllow},
+		{Group: "writers", Method: "GET", Path: "/keys", Expected: authz.DecisionAllow},
+	}})
+
+	assert.True(t, results[0].Passed())
+	assert.True(t, results[1].Passed())
+	assert.False(t, results[2].Passed())
+	assert.Equal(t, authz.DecisionDeny, results[2].Actual)
+	assert.ErrorIs(t, results[3].Err, authz.ErrUnknownGroup)
+}
+
+func TestAccessMatrix(t *testing.T) {
+	policies := authz.BuiltInPolicies()
+
+	t.Run("Should have a row per API endpoint", func(t *testing.T) {
+		matrix := policies.APIMatrix()
+		assert.Len(t, matrix.Rows, len(authz.RestrictionsByAPI)+len(authz.AllowListByAPI))
+		assert.Equal(t, policies.Roles(), matrix.Roles)
+	})
+
+	t.Run("Should have a row per repository action", func(t *testing.T) {
+		count := 0
+		for _, actions := range authz.RepoResourceTypeActions {
+			count += len(actions)
+		}
+
+		assert.Len(t, policies.RepoMatrix().Rows, count)
+	})
+
+	t.Run("Should write CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		err := policies.APIMatrix().WriteCSV(&buf)
+		assert.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.Equal(t, "ENDPOINT,KEY_ADMINISTRATOR,TENANT_ADMINISTRATOR,TENANT_AUDITOR", lines[0])
+		assert.Contains(t, lines, "GET /userInfo,ALLOW,ALLOW,ALLOW")
+	})
+
+	t.Run("Should write table", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		err := policies.RepoMatrix().WriteTable(&buf)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(buf.String(), "RESOURCE ACTION"))
+	})
+}
+
+func TestParsePolicyTestMatrix(t *testing.T) {
+	t.Run("Should parse matrix", func(t *testing.T) {
+		matrix, err := authz.ParsePolicyTestMatrix([]byte(`
+cases:
+  - role: TENANT_AUDITOR
+    method: GET
+    path: /keys
+    expected: allow
+  - group: key-admins
+    method: DELETE
+    path: /keys/123
+    expected: DENY
+`))
+		assert.NoError(t, err)
+		assert.Len(t, matrix.Cases, 2)
+		assert.Equal(t, authz.DecisionAllow, matrix.Cases[0].Expected)
+		assert.Equal(t, "key-admins", matrix.Cases[1].Group)
+	})
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"invalid yaml", "cases: [:"},
+		{"role and group", "cases: [{role: A, group: B, method: GET, path: /keys, expected: ALLOW}]"},
+		{"no role", "cases: [{method: GET, path: /keys, expected: ALLOW}]"},
+		{"no path", "cases: [{role: A, method: GET, expected: ALLOW}]"},
+		{"invalid decision", "cases: [{role: A, method: GET, path: /keys, expected: MAYBE}]"},
+	}
+
+	for _, tt := range tests {
+		t.Run("Should fail on "+tt.name, func(t *testing.T) {
+			_, err := authz.ParsePolicyTestMatrix([]byte(tt.data))
+			assert.ErrorIs(t, err, authz.ErrInvalidPolicyTestCase)
+		})
+	}
+}
@@ -35,7 +35,10 @@ type (
 // These are linked to table names, so will require a migration if changed.
 // Having this linkage ensures that tables are more coupled to the authz resource identifiers
 const (
+	RepoResourceTypeAPIToken         RepoResourceType = RepoResourceType(constants.APITokenTable)
+	RepoResourceTypeAuditEvent       RepoResourceType = RepoResourceType(constants.AuditEventTable)
 	RepoResourceTypeCertificate      RepoResourceType = RepoResourceType(constants.CertificateTable)
+	RepoResourceTypeCustomRole       RepoResourceType = RepoResourceType(constants.CustomRoleTable)
 	RepoResourceTypeEvent            RepoResourceType = RepoResourceType(constants.EventTable)
 	RepoResourceTypeGroup            RepoResourceType = RepoResourceType(constants.GroupTable)
 	RepoResourceTypeImportparam      RepoResourceType = RepoResourceType(constants.ImportparamTable)
@@ -44,8 +47,11 @@ const (
 	RepoResourceTypeKeystore         RepoResourceType = RepoResourceType(constants.KeystoreTable)
 	RepoResourceTypeKeyversion       RepoResourceType = RepoResourceType(constants.KeyVersionTable)
 	RepoResourceTypeKeyLabel         RepoResourceType = RepoResourceType(constants.KeyLabelTable)
+	RepoResourceTypeResourceGrant    RepoResourceType = RepoResourceType(constants.ResourceGrantTable)
+	RepoResourceTypeRoleElevation    RepoResourceType = RepoResourceType(constants.RoleElevationTable)
 	RepoResourceTypeSystem           RepoResourceType = RepoResourceType(constants.SystemTable)
 	RepoResourceTypeSystemProperty   RepoResourceType = RepoResourceType(constants.SystemPropertyTable)
+	RepoResourceTypeSystemGroup      RepoResourceType = RepoResourceType(constants.SystemGroupTable)
 	RepoResourceTypeTag              RepoResourceType = RepoResourceType(constants.TagTable)
 	RepoResourceTypeTenant           RepoResourceType = RepoResourceType(constants.TenantTable)
 	RepoResourceTypeTenantconfig     RepoResourceType = RepoResourceType(constants.TenantconfigTable)
@@ -62,6 +68,7 @@ const (
 	APIResourceTypeKeyConfiguration APIResourceType = "KeyConfiguration"
 	APIResourceTypeKey              APIResourceType = "Key"
 	APIResourceTypeSystem           APIResourceType = "System"
+	APIResourceTypeSystemGroup      APIResourceType = "SystemGroup"
 	APIResourceTypeWorkFlow         APIResourceType = "Workflow"
 	APIResourceTypeUserGroup        APIResourceType = "UserGroup"
 	APIResourceTypeTenant           APIResourceType = "Tenant"
@@ -69,6 +76,9 @@ const (
 	APIResourceTypeEvent            APIResourceType = "Event"
 	APIResourceTypeImportParams     APIResourceType = "ImportParams"
 	APIResourceTypeKeyStoreConfig   APIResourceType = "KeyStoreConfig"
+	APIResourceTypeAuditEvent       APIResourceType = "AuditEvent"
+	APIResourceTypeRole             APIResourceType = "Role"
+	APIResourceTypeAPIToken         APIResourceType = "APIToken"
 
 	APIActionRead             APIAction = "read"
 	APIActionCreate           APIAction = "create"
@@ -88,7 +98,10 @@ var repoActionList = []RepoAction{
 }
 
 var RepoResourceTypeActions = map[RepoResourceType][]RepoAction{
+	RepoResourceTypeAPIToken:         repoActionList,
+	RepoResourceTypeAuditEvent:       repoActionList,
 	RepoResourceTypeCertificate:      repoActionList,
+	RepoResourceTypeCustomRole:       repoActionList,
 	RepoResourceTypeEvent:            repoActionList,
 	RepoResourceTypeGroup:            repoActionList,
 	RepoResourceTypeImportparam:      repoActionList,
@@ -97,8 +110,11 @@ var RepoResourceTypeActions = map[RepoResourceType][]RepoAction{
 	RepoResourceTypeKeystore:         repoActionList,
 	RepoResourceTypeKeyversion:       repoActionList,
 	RepoResourceTypeKeyLabel:         repoActionList,
+	RepoResourceTypeResourceGrant:    repoActionList,
+	RepoResourceTypeRoleElevation:    repoActionList,
 	RepoResourceTypeSystem:           repoActionList,
 	RepoResourceTypeSystemProperty:   repoActionList,
+	RepoResourceTypeSystemGroup:      repoActionList,
 	RepoResourceTypeTag:              repoActionList,
 	RepoResourceTypeTenant:           repoActionList,
 	RepoResourceTypeTenantconfig:     repoActionList,
@@ -124,6 +140,13 @@ var APIResourceTypeActions = map[APIResourceType][]APIAction{
 		APIActionRead,
 		APIActionSystemModifyLink,
 	},
+	APIResourceTypeSystemGroup: {
+		APIActionRead,
+		APIActionCreate,
+		APIActionDelete,
+		APIActionUpdate,
+		APIActionSystemModifyLink,
+	},
 	APIResourceTypeWorkFlow: {
 		APIActionRead,
 		APIActionCreate,
@@ -144,4 +167,18 @@ var APIResourceTypeActions = map[APIResourceType][]APIAction{
 		APIActionRead,
 		APIActionUpdate,
 	},
+	APIResourceTypeAuditEvent: {
+		APIActionRead,
+	},
+	APIResourceTypeRole: {
+		APIActionRead,
+		APIActionCreate,
+		APIActionDelete,
+		APIActionUpdate,
+	},
+	APIResourceTypeAPIToken: {
+		APIActionRead,
+		APIActionCreate,
+		APIActionDelete,
+	},
 }
@@ -1,17 +1,21 @@
 package authz_policy_test
 
 import (
+	"encoding/json"
 	"log/slog"
 	"testing"
 
 	"github.com/openkcm/common-sdk/pkg/auth"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/openkcm/cmk/internal/auditor"
 	"github.com/openkcm/cmk/internal/authz"
 	authz_loader "github.com/openkcm/cmk/internal/authz/loader"
 	authz_repo "github.com/openkcm/cmk/internal/authz/repo"
 	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/constants"
 	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
 	"github.com/openkcm/cmk/internal/repo/sql"
 	"github.com/openkcm/cmk/internal/testutils"
 	cmkcontext "github.com/openkcm/cmk/utils/context"
@@ -61,4 +65,55 @@ func TestBusinessAuthz_AuthzPolicy(t *testing.T) {
 		assert.NotContains(t, buf.String(), `"allowed":false`,
 			"authz denial in log — policy is missing a required permission: %s", buf.String())
 	})
+
+	t.Run("InternalBusinessAuthzRole allows First on CustomRole", func(t *testing.T) {
+		logger, buf := testutils.NewLogBuffer()
+		slog.SetDefault(logger)
+
+		role := testutils.NewCustomRole(func(c *model.CustomRole) {
+			c.BaseRole = constants.TenantAuditorRole
+			c.Permissions = json.RawMessage(`[{"resourceType":"Key","actions":["read"]}]`)
+		})
+		group := testutils.NewGroup(func(g *model.Group) {
+			g.Role = role.Name
+			g.IAMIdentifier = "custom-iam-group-id"
+		})
+		testutils.CreateTestEntities(cmkcontext.CreateTenantContext(t.Context(), tenant), t, r, role, group)
+
+		customCtx := cmkcontext.CreateTenantContext(t.Context(), tenant)
+		customCtx = cmkcontext.InjectBusinessUserData(customCtx, &auth.ClientData{
+			Groups: []string{group.IAMIdentifier},
+		}, nil)
+
+		// The custom role resolves to its base role TENANT_AUDITOR,
+		// which reads keys without group filtering
+		needs, err := userManager.NeedsGroupFiltering(customCtx, authz.APIActionRead, authz.APIResourceTypeKey)
+		assert.NoError(t, err)
+		assert.False(t, needs)
+		assert.NotContains(t, buf.String(), `"allowed":false`,
+			"authz denial in log — policy is missing a required permission: %s", buf.String())
+	})
+
+	t.Run("InternalBusinessAuthzRole allows First and Update on APIToken", func(t *testing.T) {
+		logger, buf := testutils.NewLogBuffer()
+		slog.SetDefault(logger)
+
+		tenantCtx := cmkcontext.CreateTenantContext(t.Context(), tenant)
+		secret := constants.APITokenPrefix + "policy-test"
+		group := testutils.NewGroup(func(_ *model.Group) {})
+		token := testutils.NewAPIToken(func(tk *model.APIToken) {
+			tk.GroupID = group.ID
+			tk.TokenHash = manager.HashAPIToken(secret)
+		})
+		testutils.CreateTestEntities(tenantCtx, t, r, group, token)
+
+		// Authenticate runs before any client data is in the context and
+		// switches to InternalBusinessAuthzRole for the lookup and last use
+		authenticated, err := manager.NewAPITokenManager(authzRepo, auditor.New(t.Context(), &config.Config{})).
+			Authenticate(tenantCtx, secret, "GET /keys")
+		assert.NoError(t, err)
+		assert.Equal(t, token.ID, authenticated.ID)
+		assert.NotContains(t, buf.String(), `"allowed":false`,
+			"authz denial in log — policy is missing a required permission: %s", buf.String())
+	})
 }
@@ -48,7 +48,7 @@ func TestCertRotation_AuthzPolicy(t *testing.T) {
 		Database: dbCfg,
 	}
 
-	certManager := manager.NewCertificateManager(t.Context(), authzRepo, ps, cfg)
+	certManager := manager.NewCertificateManager(t.Context(), authzRepo, ps, cfg, nil)
 	rotator := tasks.NewCertRotator(certManager, authzRepo)
 	task := asynq.NewTask(config.TypeCertificateTask, nil)
 
@@ -57,9 +57,9 @@ func TestHYOKSync_AuthzPolicy(t *testing.T) {
 	assert.NoError(t, err)
 
 	cmkAuditor := auditor.New(t.Context(), cfg)
-	certManager := manager.NewCertificateManager(t.Context(), authzRepo, ps, cfg)
-	tenantConfigManager := manager.NewTenantConfigManager(authzRepo, ps, cfg, certManager)
-	tagManager := manager.NewTagManager(authzRepo)
+	certManager := manager.NewCertificateManager(t.Context(), authzRepo, ps, cfg, cmkAuditor)
+	tenantConfigManager := manager.NewTenantConfigManager(authzRepo, ps, cfg, certManager, cmkAuditor)
+	tagManager := manager.NewTagManager(authzRepo, cmkAuditor)
 	userManager := manager.NewUserManager(authzRepo, cmkAuditor)
 	keyConfigManager := manager.NewKeyConfigManager(authzRepo, certManager, userManager, tagManager, cmkAuditor, eventFactory, cfg)
 
@@ -58,9 +58,9 @@ func TestKeystorePool_AuthzPolicy(t *testing.T) {
 
 	cmkAuditor := auditor.New(t.Context(), cfg)
 	userManager := manager.NewUserManager(authzRepo, cmkAuditor)
-	certManager := manager.NewCertificateManager(t.Context(), authzRepo, ps, cfg)
-	tagManager := manager.NewTagManager(authzRepo)
-	tenantConfigManager := manager.NewTenantConfigManager(authzRepo, ps, cfg, certManager)
+	certManager := manager.NewCertificateManager(t.Context(), authzRepo, ps, cfg, cmkAuditor)
+	tagManager := manager.NewTagManager(authzRepo, cmkAuditor)
+	tenantConfigManager := manager.NewTenantConfigManager(authzRepo, ps, cfg, certManager, cmkAuditor)
 	keyConfigManager := manager.NewKeyConfigManager(authzRepo, certManager, userManager, tagManager, cmkAuditor, eventFactory, cfg)
 	keyManager := manager.NewKeyManager(
 		authzRepo,
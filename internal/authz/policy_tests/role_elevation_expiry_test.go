@@ -0,0 +1,78 @@
+package authz_policy_test
+
+import (
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+
+	tasks "github.com/openkcm/cmk/internal/async/tasks/tenant"
+	"github.com/openkcm/cmk/internal/auditor"
+	authz_loader "github.com/openkcm/cmk/internal/authz/loader"
+	authz_repo "github.com/openkcm/cmk/internal/authz/repo"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+// TestRoleElevationExpiry_AuthzPolicy verifies that the InternalTaskWorkflowExpirationRole
+// policy grants the repo access that RoleElevationManager.ExpireRoleElevations requires
+// (Count, List and Update on RoleElevation).
+//
+// One role elevation past its expiry is seeded so the expiry patches it.
+func TestRoleElevationExpiry_AuthzPolicy(t *testing.T) {
+	db, tenants, dbCfg := testutils.NewTestDB(t, testutils.TestDBConfig{
+		CreateDatabase: true,
+	})
+	tenant := tenants[0]
+	tenantCtx := cmkcontext.CreateTenantContext(t.Context(), tenant)
+	ctx, err := cmkcontext.InjectInternalUserData(tenantCtx, constants.InternalTaskWorkflowExpirationRole)
+	assert.NoError(t, err)
+
+	r := sql.NewRepository(db)
+
+	keyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {})
+	elevation := &model.RoleElevation{
+		ID:                 uuid.New(),
+		UserID:             uuid.NewString(),
+		KeyConfigurationID: keyConfig.ID,
+		Role:               constants.KeyAdminRole,
+		WorkflowID:         uuid.New(),
+		ExpiresAt:          time.Now().Add(-time.Hour),
+	}
+	testutils.CreateTestEntities(tenantCtx, t, r, keyConfig, elevation)
+
+	authzRepoLoader := authz_loader.NewRepoAuthzLoader(t.Context(), r, &config.Config{})
+	authzRepo := authz_repo.NewAuthzRepo(r, authzRepoLoader)
+
+	cmkAuditor := auditor.New(t.Context(), &config.Config{Database: dbCfg})
+	processor := tasks.NewRoleElevationExpiryProcessor(
+		manager.NewRoleElevationManager(authzRepo, cmkAuditor),
+		authzRepo,
+	)
+	task := asynq.NewTask(config.TypeRoleElevationExpire, nil)
+
+	t.Run("InternalTaskWorkflowExpirationRole allows Count, List and Update on RoleElevation", func(t *testing.T) {
+		logger, buf := testutils.NewLogBuffer()
+		slog.SetDefault(logger)
+
+		err := processor.ProcessTask(ctx, task)
+		assert.NoError(t, err)
+		assert.NotContains(t, strings.ToLower(buf.String()), "error",
+			"unexpected error log: %s", buf.String())
+
+		expired := &model.RoleElevation{ID: elevation.ID}
+		_, err = r.First(tenantCtx, expired, *repo.NewQuery())
+		assert.NoError(t, err)
+		assert.True(t, expired.Expired)
+	})
+}
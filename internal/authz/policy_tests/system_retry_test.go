@@ -0,0 +1,106 @@
+package authz_policy_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/openkcm/orbital"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	tasks "github.com/openkcm/cmk/internal/async/tasks/tenant"
+	authz_loader "github.com/openkcm/cmk/internal/authz/loader"
+	authz_repo "github.com/openkcm/cmk/internal/authz/repo"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/constants"
+	eventprocessor "github.com/openkcm/cmk/internal/event-processor"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/multitenancy"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+// TestSystemRetry_AuthzPolicy verifies that the InternalTaskSystemRetryRole
+// policy grants exactly the repo access that SystemManager.RetryFailedSystems
+// requires, without the manager being mocked out.
+//
+// A failed system with a retriable link event is seeded, so the task lists the
+// failed systems, reads and updates the event and sets the system to PROCESSING.
+func TestSystemRetry_AuthzPolicy(t *testing.T) {
+	db, tenants, dbCfg := testutils.NewTestDB(t, testutils.TestDBConfig{
+		WithOrbital:    true,
+		CreateDatabase: true,
+	})
+	tenant := tenants[0]
+	ctx := cmkcontext.CreateTenantContext(t.Context(), tenant)
+
+	r := sql.NewRepository(db)
+
+	authzRepoLoader := authz_loader.NewRepoAuthzLoader(t.Context(), r, &config.Config{})
+	authzRepo := authz_repo.NewAuthzRepo(r, authzRepoLoader)
+
+	cfg := &config.Config{
+		Database: dbCfg,
+	}
+
+	eventFactory, err := eventprocessor.NewEventFactory(t.Context(), cfg, authzRepo)
+	require.NoError(t, err)
+
+	systemManager := manager.NewSystemManager(ctx, authzRepo, authzRepoLoader, nil, eventFactory,
+		testutils.NewTestPlugins(), cfg, nil, nil)
+
+	system := testutils.NewSystem(func(s *model.System) {
+		s.Status = cmkapi.SystemStatusFAILED
+	})
+	testutils.CreateTestEntities(ctx, t, r, system, &model.Event{
+		Identifier: system.ID.String(),
+		Type:       eventprocessor.JobTypeSystemLink.String(),
+		Data:       []byte("{}"),
+		ErrorCode:  constants.DefaultErrorCode,
+	})
+
+	err = r.WithTenant(ctx, &model.Event{}, func(tx *multitenancy.DB) error {
+		return tx.Model(&model.Event{}).
+			Where("identifier = ?", system.ID.String()).
+			UpdateColumn("updated_at", time.Now().Add(-24*time.Hour)).Error
+	})
+	require.NoError(t, err)
+
+	err = db.WithTenant(ctx, "orbital", func(tx *multitenancy.DB) error {
+		return tx.Table("jobs").Create(&orbital.Job{
+			ID:         uuid.New(),
+			ExternalID: system.ID.String(),
+			Data:       []byte("{}"),
+			Type:       eventprocessor.JobTypeSystemLink.String(),
+			Status:     orbital.JobStatusFailed,
+		}).Error
+	})
+	require.NoError(t, err)
+
+	processor := tasks.NewSystemRetryProcessor(systemManager, authzRepo)
+	task := asynq.NewTask(config.TypeSystemRetry, nil)
+
+	t.Run("InternalTaskSystemRetryRole allows retrying failed systems", func(t *testing.T) {
+		logger, buf := testutils.NewLogBuffer()
+		slog.SetDefault(logger)
+
+		taskCtx, err := cmkcontext.InjectInternalUserData(ctx, constants.InternalTaskSystemRetryRole)
+		assert.NoError(t, err)
+
+		err = processor.ProcessTask(taskCtx, task)
+		assert.NoError(t, err)
+		assert.NotContains(t, buf.String(), `"allowed":false`,
+			"unexpected authz denial: %s", buf.String())
+
+		_, err = r.First(ctx, system, *repo.NewQuery())
+		assert.NoError(t, err)
+		assert.Equal(t, cmkapi.SystemStatusPROCESSING, system.Status)
+	})
+}
@@ -69,9 +69,9 @@ func TestWorkflowAutoAssign_AuthzPolicy(t *testing.T) {
 
 	cmkAuditor := auditor.New(t.Context(), cfg)
 	userManager := manager.NewUserManager(authzRepo, cmkAuditor)
-	certManager := manager.NewCertificateManager(t.Context(), authzRepo, ps, cfg)
-	tagManager := manager.NewTagManager(authzRepo)
-	tenantConfigManager := manager.NewTenantConfigManager(authzRepo, ps, cfg, certManager)
+	certManager := manager.NewCertificateManager(t.Context(), authzRepo, ps, cfg, cmkAuditor)
+	tagManager := manager.NewTagManager(authzRepo, cmkAuditor)
+	tenantConfigManager := manager.NewTenantConfigManager(authzRepo, ps, cfg, certManager, cmkAuditor)
 	keyConfigManager := manager.NewKeyConfigManager(authzRepo, certManager, userManager, tagManager, cmkAuditor, eventFactory, cfg)
 	keyManager := manager.NewKeyManager(
 		authzRepo,
@@ -84,7 +84,7 @@ func TestWorkflowAutoAssign_AuthzPolicy(t *testing.T) {
 		cmkAuditor,
 		nil,
 	)
-	groupManager := manager.NewGroupManager(authzRepo, ps, userManager)
+	groupManager := manager.NewGroupManager(authzRepo, ps, userManager, cmkAuditor)
 	wfManager := manager.NewWorkflowManager(
 		authzRepo,
 		ps,
@@ -96,6 +96,7 @@ func TestWorkflowAutoAssign_AuthzPolicy(t *testing.T) {
 		nil, // asyncClient
 		tenantConfigManager,
 		cfg,
+		cmkAuditor,
 	)
 
 	// Seed a key configuration and a key referencing it, plus a workflow with that key
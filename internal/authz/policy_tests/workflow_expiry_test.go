@@ -48,7 +48,7 @@ func TestWorkflowExpiry_AuthzPolicy(t *testing.T) {
 	}
 
 	cmkAuditor := auditor.New(t.Context(), cfg)
-	tenantConfigManager := manager.NewTenantConfigManager(authzRepo, ps, cfg, nil)
+	tenantConfigManager := manager.NewTenantConfigManager(authzRepo, ps, cfg, nil, cmkAuditor)
 	userManager := manager.NewUserManager(authzRepo, cmkAuditor)
 
 	wfManager := manager.NewWorkflowManager(
@@ -62,6 +62,7 @@ func TestWorkflowExpiry_AuthzPolicy(t *testing.T) {
 		nil, // asyncClient
 		tenantConfigManager,
 		cfg,
+		cmkAuditor,
 	)
 
 	processor := tasks.NewWorkflowExpiryProcessor(wfManager, authzRepo)
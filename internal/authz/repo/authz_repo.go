@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 
+	"github.com/google/uuid"
+
 	"github.com/openkcm/cmk/internal/authz"
 	authz_loader "github.com/openkcm/cmk/internal/authz/loader"
+	"github.com/openkcm/cmk/internal/model"
 	"github.com/openkcm/cmk/internal/repo"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
 )
 
 var ErrUnauthorized = errors.New("action on resource unauthorized")
@@ -138,6 +142,14 @@ func (r *AuthzRepo) Transaction(ctx context.Context, txFunc repo.TransactionFunc
 	return r.repo.Transaction(ctx, txFunc)
 }
 
+func (r *AuthzRepo) NewTransaction(ctx context.Context, txFunc repo.TransactionFunc) error {
+	return r.repo.NewTransaction(ctx, txFunc)
+}
+
+func (r *AuthzRepo) LockXact(ctx context.Context, key string) error {
+	return r.repo.LockXact(ctx, key)
+}
+
 func (r *AuthzRepo) GetFilterOptions(
 	ctx context.Context,
 	resource repo.Resource,
@@ -166,6 +178,16 @@ func (r *AuthzRepo) checkResourceAuthZ(
 	}
 
 	isAllowed, err := resource.CheckAuthz(ctx, r.authzLoader.AuthzHandler, action)
+	if errors.Is(err, authz.ErrAuthorizationDenied) {
+		// Role elevations may have been granted after the tenant was loaded
+		err = r.authzLoader.LoadUserRoleElevations(ctx)
+		if err != nil {
+			return err
+		}
+
+		isAllowed, err = resource.CheckAuthz(keyConfigurationScope(ctx, resource), r.authzLoader.AuthzHandler, action)
+	}
+
 	if err != nil {
 		return err
 	}
@@ -196,3 +218,27 @@ func (r *AuthzRepo) checkQueryAuthZ(
 	}
 	return nil
 }
+
+// keyConfigurationScope scopes the context to the key configuration of the
+// resource if it has one, so role elevations apply within it only. Otherwise
+// the scope of the request is kept.
+func keyConfigurationScope(ctx context.Context, resource repo.Resource) context.Context {
+	var keyConfigID uuid.UUID
+
+	switch r := resource.(type) {
+	case *model.KeyConfiguration:
+		keyConfigID = r.ID
+	case *model.Key:
+		keyConfigID = r.KeyConfigurationID
+	case *model.System:
+		if r.KeyConfigurationID != nil {
+			keyConfigID = *r.KeyConfigurationID
+		}
+	}
+
+	if keyConfigID == uuid.Nil {
+		return ctx
+	}
+
+	return cmkcontext.InjectKeyConfigurationScope(ctx, keyConfigID)
+}
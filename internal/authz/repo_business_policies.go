@@ -7,6 +7,22 @@ var RepoBusinessPolicies = RolePolicies[constants.BusinessRole, RepoResourceType
 		{
 			ID: constants.AuditorPolicy,
 			ResourceTypes: []Resource[RepoResourceType, RepoAction]{
+				{
+					Type: RepoResourceTypeAPIToken,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+						RepoActionCount,
+					},
+				},
+				{
+					Type: RepoResourceTypeAuditEvent,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+						RepoActionCount,
+					},
+				},
 				{
 					Type: RepoResourceTypeCertificate,
 					Actions: []RepoAction{
@@ -15,6 +31,14 @@ var RepoBusinessPolicies = RolePolicies[constants.BusinessRole, RepoResourceType
 						RepoActionCount,
 					},
 				},
+				{
+					Type: RepoResourceTypeCustomRole,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+						RepoActionCount,
+					},
+				},
 				{
 					Type: RepoResourceTypeEvent,
 					Actions: []RepoAction{
@@ -79,6 +103,22 @@ var RepoBusinessPolicies = RolePolicies[constants.BusinessRole, RepoResourceType
 						RepoActionCount,
 					},
 				},
+				{
+					Type: RepoResourceTypeResourceGrant,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+						RepoActionCount,
+					},
+				},
+				{
+					Type: RepoResourceTypeRoleElevation,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+						RepoActionCount,
+					},
+				},
 				{
 					Type: RepoResourceTypeSystem,
 					Actions: []RepoAction{
@@ -95,6 +135,14 @@ var RepoBusinessPolicies = RolePolicies[constants.BusinessRole, RepoResourceType
 						RepoActionCount,
 					},
 				},
+				{
+					Type: RepoResourceTypeSystemGroup,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+						RepoActionCount,
+					},
+				},
 				{
 					Type: RepoResourceTypeTag,
 					Actions: []RepoAction{
@@ -125,6 +173,7 @@ var RepoBusinessPolicies = RolePolicies[constants.BusinessRole, RepoResourceType
 						RepoActionList,
 						RepoActionFirst,
 						RepoActionCount,
+						RepoActionCreate, // For requesting role elevations
 					},
 				},
 				{
@@ -142,6 +191,15 @@ var RepoBusinessPolicies = RolePolicies[constants.BusinessRole, RepoResourceType
 		{
 			ID: constants.KeyAdminPolicy,
 			ResourceTypes: []Resource[RepoResourceType, RepoAction]{
+				{
+					Type: RepoResourceTypeAPIToken,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+						RepoActionCount,
+						RepoActionCreate,
+					},
+				},
 				{
 					Type: RepoResourceTypeCertificate,
 					Actions: []RepoAction{
@@ -153,6 +211,15 @@ var RepoBusinessPolicies = RolePolicies[constants.BusinessRole, RepoResourceType
 						RepoActionDelete,
 					},
 				},
+				{
+					// To resolve the base role of key administrator groups
+					Type: RepoResourceTypeCustomRole,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+						RepoActionCount,
+					},
+				},
 				{
 					Type: RepoResourceTypeEvent,
 					Actions: []RepoAction{
@@ -238,6 +305,24 @@ var RepoBusinessPolicies = RolePolicies[constants.BusinessRole, RepoResourceType
 						RepoActionDelete,
 					},
 				},
+				{
+					Type: RepoResourceTypeResourceGrant,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+						RepoActionCount,
+						RepoActionDelete, // When deleting a key configuration
+					},
+				},
+				{
+					Type: RepoResourceTypeRoleElevation,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+						RepoActionCount,
+						RepoActionCreate, // When executing a role elevation workflow
+					},
+				},
 				{
 					Type: RepoResourceTypeSystem,
 					Actions: []RepoAction{
@@ -260,6 +345,17 @@ var RepoBusinessPolicies = RolePolicies[constants.BusinessRole, RepoResourceType
 						RepoActionDelete,
 					},
 				},
+				{
+					Type: RepoResourceTypeSystemGroup,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+						RepoActionCount,
+						RepoActionCreate,
+						RepoActionUpdate,
+						RepoActionDelete,
+					},
+				},
 				{
 					Type: RepoResourceTypeTag,
 					Actions: []RepoAction{
@@ -318,6 +414,27 @@ var RepoBusinessPolicies = RolePolicies[constants.BusinessRole, RepoResourceType
 		{
 			ID: constants.TenantAdminPolicy,
 			ResourceTypes: []Resource[RepoResourceType, RepoAction]{
+				{
+					Type: RepoResourceTypeAPIToken,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+						RepoActionCount,
+						RepoActionCreate,
+						RepoActionUpdate,
+					},
+				},
+				{
+					Type: RepoResourceTypeCustomRole,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+						RepoActionCount,
+						RepoActionCreate,
+						RepoActionUpdate,
+						RepoActionDelete,
+					},
+				},
 				{
 					Type: RepoResourceTypeGroup,
 					Actions: []RepoAction{
@@ -335,6 +452,17 @@ var RepoBusinessPolicies = RolePolicies[constants.BusinessRole, RepoResourceType
 						RepoActionFirst, // When deleting a group
 					},
 				},
+				{
+					Type: RepoResourceTypeResourceGrant,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+						RepoActionCount,
+						RepoActionCreate,
+						RepoActionUpdate,
+						RepoActionDelete,
+					},
+				},
 				{
 					Type: RepoResourceTypeTenant,
 					Actions: []RepoAction{
@@ -7,6 +7,22 @@ var RepoInternalPolicies = RolePolicies[constants.InternalRole, RepoResourceType
 		{
 			ID: constants.InternalBusinessAuthzPolicy,
 			ResourceTypes: []Resource[RepoResourceType, RepoAction]{
+				{
+					// To authenticate API tokens and record their last use
+					Type: RepoResourceTypeAPIToken,
+					Actions: []RepoAction{
+						RepoActionFirst,
+						RepoActionUpdate,
+					},
+				},
+				{
+					// To resolve the base role of custom roles
+					Type: RepoResourceTypeCustomRole,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionFirst,
+					},
+				},
 				{
 					Type: RepoResourceTypeGroup,
 					Actions: []RepoAction{
@@ -15,8 +31,42 @@ var RepoInternalPolicies = RolePolicies[constants.InternalRole, RepoResourceType
 					},
 				},
 				{
+					// To find the key configuration of a key when explaining decisions
+					Type: RepoResourceTypeKey,
+					Actions: []RepoAction{
+						RepoActionFirst,
+					},
+				},
+				{
+					// To read the target key configuration of a role elevation request
 					Type: RepoResourceTypeKeyconfiguration,
 					Actions: []RepoAction{
+						RepoActionFirst,
+						RepoActionCount,
+					},
+				},
+				{
+					// To evaluate resource grants of the user groups
+					Type: RepoResourceTypeResourceGrant,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionCount,
+					},
+				},
+				{
+					// To evaluate the active role elevations of the user
+					Type: RepoResourceTypeRoleElevation,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionCount,
+					},
+				},
+				{
+					// To check the resource of a resource grant exists and
+					// to find the key configuration of a system when explaining decisions
+					Type: RepoResourceTypeSystem,
+					Actions: []RepoAction{
+						RepoActionFirst,
 						RepoActionCount,
 					},
 				},
@@ -41,6 +91,21 @@ var RepoInternalPolicies = RolePolicies[constants.InternalRole, RepoResourceType
 					Type: RepoResourceTypeGroup,
 					Actions: []RepoAction{
 						RepoActionCreate,
+						RepoActionList,
+					},
+				},
+				{
+					// To evaluate the custom roles of a tenant in the authorization policy harness
+					Type: RepoResourceTypeCustomRole,
+					Actions: []RepoAction{
+						RepoActionList,
+					},
+				},
+				{
+					// To verify the audit event hash chain
+					Type: RepoResourceTypeAuditEvent,
+					Actions: []RepoAction{
+						RepoActionList,
 					},
 				},
 			},
@@ -427,6 +492,41 @@ var RepoInternalPolicies = RolePolicies[constants.InternalRole, RepoResourceType
 			},
 		},
 	},
+	constants.InternalTaskSystemRetryRole: {
+		{
+			ID: constants.InternalTaskSystemRetryPolicy,
+			ResourceTypes: []Resource[RepoResourceType, RepoAction]{
+				{
+					Type: RepoResourceTypeSystem,
+					Actions: []RepoAction{
+						RepoActionCount,
+						RepoActionList,
+						RepoActionUpdate,
+					},
+				},
+				{
+					Type: RepoResourceTypeEvent,
+					Actions: []RepoAction{
+						RepoActionFirst,
+						RepoActionUpdate,
+					},
+				},
+			},
+		},
+	},
+	constants.InternalTaskSystemKeyDriftRole: {
+		{
+			ID: constants.InternalTaskSystemKeyDriftPolicy,
+			ResourceTypes: []Resource[RepoResourceType, RepoAction]{
+				{
+					Type: RepoResourceTypeSystem,
+					Actions: []RepoAction{
+						RepoActionCount,
+					},
+				},
+			},
+		},
+	},
 	constants.InternalTaskTenantRefreshRole: {
 		{
 			ID: constants.InternalTaskTenantRefreshPolicy,
@@ -491,6 +591,15 @@ var RepoInternalPolicies = RolePolicies[constants.InternalRole, RepoResourceType
 						RepoActionUpdate,
 					},
 				},
+				{
+					// To expire role elevations
+					Type: RepoResourceTypeRoleElevation,
+					Actions: []RepoAction{
+						RepoActionList,
+						RepoActionCount,
+						RepoActionUpdate,
+					},
+				},
 			},
 		},
 	},
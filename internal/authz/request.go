@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
+
 	"github.com/openkcm/cmk/internal/constants"
 	"github.com/openkcm/cmk/internal/errs"
 	cmkcontext "github.com/openkcm/cmk/utils/context"
@@ -45,6 +47,9 @@ type BusinessUserRequest struct {
 	TenantID TenantID
 	UserName string
 	Groups   []string
+	// KeyConfigurationID is the key configuration the request acts on,
+	// role elevations of the user only apply within it
+	KeyConfigurationID uuid.UUID
 }
 
 func (u BusinessUserRequest) IsEmpty() bool {
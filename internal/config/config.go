@@ -50,6 +50,17 @@ type Config struct {
 	KeystorePool KeystorePool `yaml:"keystorePool"`
 	Landscape    Landscape    `yaml:"landscape"`
 	Workflow     Workflow     `yaml:"workflow"`
+	SystemRetry  SystemRetry  `yaml:"systemRetry"`
+	KeyDrift     KeyDrift     `yaml:"keyDrift"`
+	AuditStore   AuditStore   `yaml:"auditStore"`
+	AuditExport  AuditExport  `yaml:"auditExport"`
+
+	IdentityCache IdentityCache `yaml:"identityCache"`
+	PluginHealth  PluginHealth  `yaml:"pluginHealth"`
+
+	// KeystoreCapabilities are the capabilities of the KeyManagement plugins
+	// by plugin name. Plugins without capabilities support everything.
+	KeystoreCapabilities map[string]KeystoreCapabilities `yaml:"keystoreCapabilities"`
 }
 
 type ContextModels struct {
@@ -72,6 +83,16 @@ func (c *Config) Validate() error {
 		return errs.Wrap(ErrConfigurationValuesError, err)
 	}
 
+	err = c.AuditExport.Validate()
+	if err != nil {
+		return errs.Wrap(ErrConfigurationValuesError, err)
+	}
+
+	err = c.IdentityCache.Validate()
+	if err != nil {
+		return errs.Wrap(ErrConfigurationValuesError, err)
+	}
+
 	return nil
 }
 
@@ -373,6 +394,197 @@ type KeystorePool struct {
 	SupportedRegions []Region      `yaml:"supportedRegions" json:"supportedRegions"`
 }
 
+// SystemRetry holds the automatic retry policy for failed system jobs.
+// Unset values fall back to the defaults in constants.
+type SystemRetry struct {
+	// MaxAttempts is the number of automatic retries before manual recovery is required
+	MaxAttempts int `yaml:"maxAttempts"`
+	// InitialBackoff is the delay before the first retry, doubled on every further attempt
+	InitialBackoff time.Duration `yaml:"initialBackoff"`
+	// MaxBackoff caps the delay between retries
+	MaxBackoff time.Duration `yaml:"maxBackoff"`
+	// RetriableErrorCodes are the orbital error codes considered transient
+	RetriableErrorCodes []string `yaml:"retriableErrorCodes"`
+}
+
+// KeyDrift holds the settings of the key state check of connected systems
+type KeyDrift struct {
+	// AutoRepair resends the key switch or rotation to systems found drifted
+	AutoRepair bool `yaml:"autoRepair"`
+}
+
+// AuditStore holds the settings of the tenant local copy of audit events
+type AuditStore struct {
+	// Enabled writes every sent audit event to the tenant schema as well.
+	// The store holds the head of the tenant hash chain, so audit events
+	// are only hash chained while the store is enabled.
+	Enabled bool `yaml:"enabled"`
+}
+
+type AuditExportFormat string
+
+const (
+	AuditExportFormatCEF   AuditExportFormat = "cef"
+	AuditExportFormatLEEF  AuditExportFormat = "leef"
+	AuditExportFormatJSONL AuditExportFormat = "jsonl"
+)
+
+type AuditExportSink string
+
+const (
+	AuditExportSinkSyslog  AuditExportSink = "syslog"
+	AuditExportSinkWebhook AuditExportSink = "webhook"
+)
+
+// AuditExport holds the settings of the export of audit events to a SIEM,
+// in addition to the OTLP audit log
+type AuditExport struct {
+	Enabled bool               `yaml:"enabled"`
+	Format  AuditExportFormat  `yaml:"format" default:"jsonl"`
+	Sink    AuditExportSink    `yaml:"sink"`
+	Syslog  AuditExportSyslog  `yaml:"syslog"`
+	Webhook AuditExportWebhook `yaml:"webhook"`
+	// BufferSize is the number of events held while the sink is unavailable.
+	// Events are dropped once the buffer is full.
+	BufferSize int `yaml:"bufferSize" default:"1000"`
+	// MaxRetries is the number of retries of a failed delivery before the event is dropped
+	MaxRetries int `yaml:"maxRetries" default:"5"`
+	// RetryBackoff is the delay before the first retry, doubled on every further retry
+	RetryBackoff time.Duration `yaml:"retryBackoff" default:"1s"`
+	// ShutdownTimeout bounds the flush of the buffered events on shutdown
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout" default:"5s"`
+}
+
+// AuditExportSyslog holds the settings of a syslog sink receiving RFC 5424
+// messages over TCP. A mtls secret enables TLS.
+type AuditExportSyslog struct {
+	Address   string              `yaml:"address"`
+	SecretRef commoncfg.SecretRef `yaml:"secretRef"`
+	AppName   string              `yaml:"appName" default:"cmk"`
+}
+
+// AuditExportWebhook holds the settings of a HTTP sink receiving events as POST requests
+type AuditExportWebhook struct {
+	URL    string               `yaml:"url"`
+	Client commoncfg.HTTPClient `yaml:"client"`
+}
+
+// Validate checks the AuditExport configuration values
+func (a *AuditExport) Validate() error {
+	if !a.Enabled {
+		return nil
+	}
+
+	switch a.Format {
+	case AuditExportFormatCEF, AuditExportFormatLEEF, AuditExportFormatJSONL:
+	default:
+		return errs.Wrapf(ErrConfigurationValuesError, "audit export format must be cef, leef or jsonl")
+	}
+
+	switch a.Sink {
+	case AuditExportSinkSyslog:
+		if a.Syslog.Address == "" {
+			return errs.Wrapf(ErrConfigurationValuesError, "audit export syslog address is required")
+		}
+
+		if a.Syslog.SecretRef.Type != commoncfg.MTLSSecretType &&
+			a.Syslog.SecretRef.Type != commoncfg.InsecureSecretType {
+			return errs.Wrapf(
+				ErrConfigurationValuesError, "only insecure or mtls secrets are supported for audit export syslog",
+			)
+		}
+	case AuditExportSinkWebhook:
+		if a.Webhook.URL == "" {
+			return errs.Wrapf(ErrConfigurationValuesError, "audit export webhook url is required")
+		}
+	default:
+		return errs.Wrapf(ErrConfigurationValuesError, "audit export sink must be syslog or webhook")
+	}
+
+	if a.BufferSize <= 0 || a.MaxRetries < 0 || a.RetryBackoff < 0 {
+		return errs.Wrapf(
+			ErrConfigurationValuesError, "audit export buffer size must be positive and retries not negative",
+		)
+	}
+
+	return nil
+}
+
+type IdentityCacheBackend string
+
+const (
+	IdentityCacheBackendNone   IdentityCacheBackend = "none"
+	IdentityCacheBackendMemory IdentityCacheBackend = "memory"
+	IdentityCacheBackendRedis  IdentityCacheBackend = "redis"
+)
+
+// IdentityCache holds the settings of the cache of identity management
+// lookups. The memory backend caches per pod, the redis backend shares the
+// cache across pods.
+type IdentityCache struct {
+	Backend IdentityCacheBackend `yaml:"backend" default:"memory"`
+	TTL     IdentityCacheTTL     `yaml:"ttl"`
+	Redis   Redis                `yaml:"redis"`
+	// KeyPrefix is prepended to the keys of the redis backend
+	KeyPrefix string `yaml:"keyPrefix" default:"cmk:identity"`
+}
+
+// IdentityCacheTTL holds how long lookups are cached
+type IdentityCacheTTL struct {
+	Users  time.Duration `yaml:"users" default:"1h"`
+	Groups time.Duration `yaml:"groups" default:"15m"`
+	// Memberships applies to the users of a group and the groups of a user.
+	// Removed members keep their access to workflows up to this long.
+	Memberships time.Duration `yaml:"memberships" default:"2m"`
+	// NotFound applies to users and groups missing in the identity provider
+	NotFound time.Duration `yaml:"notFound" default:"30s"`
+}
+
+// Validate checks the IdentityCache configuration values
+func (c *IdentityCache) Validate() error {
+	switch c.Backend {
+	case "", IdentityCacheBackendNone, IdentityCacheBackendMemory:
+	case IdentityCacheBackendRedis:
+		if c.Redis.Port == "" {
+			return errs.Wrapf(ErrConfigurationValuesError, "identity cache redis port is required")
+		}
+	default:
+		return errs.Wrapf(ErrConfigurationValuesError, "identity cache backend must be none, memory or redis")
+	}
+
+	if c.TTL.Users < 0 || c.TTL.Groups < 0 || c.TTL.Memberships < 0 || c.TTL.NotFound < 0 {
+		return errs.Wrapf(ErrConfigurationValuesError, "identity cache ttls must not be negative")
+	}
+
+	return nil
+}
+
+// PluginHealth holds the settings of the plugin health checks reported by
+// the status server
+type PluginHealth struct {
+	// FailReadiness fails the readiness probe while a plugin of the required
+	// types failed its last health check
+	FailReadiness bool `yaml:"failReadiness"`
+	// RequiredTypes are the plugin types failing readiness. Defaults to the
+	// key management and identity management plugins. A type covers its
+	// alias with the Service suffix, like IdentityManagementService.
+	RequiredTypes []string `yaml:"requiredTypes"`
+}
+
+// KeystoreCapabilities declares the operations a KeyManagement plugin supports
+type KeystoreCapabilities struct {
+	// Algorithms supported, like AES256. Empty if not restricted.
+	Algorithms []string `yaml:"algorithms"`
+	// KeyTypes supported, one of SYSTEM_MANAGED, BYOK and HYOK. Empty if not restricted.
+	KeyTypes []string `yaml:"keyTypes"`
+	// Import of key material into BYOK keys
+	Import bool `yaml:"import"`
+	// Rotation of the keys by the plugin
+	Rotation bool `yaml:"rotation"`
+	// Regions served. Empty if not restricted.
+	Regions []string `yaml:"regions"`
+}
+
 type Landscape struct {
 	Name      string `yaml:"name"`
 	UIBaseUrl string `yaml:"uiBaseUrl"`
@@ -3,6 +3,7 @@ package config_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/openkcm/common-sdk/pkg/commoncfg"
 	"github.com/stretchr/testify/assert"
@@ -166,3 +167,164 @@ func TestValidateTenantManager(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAuditExport(t *testing.T) {
+	mutator := testutils.NewMutator(func() config.AuditExport {
+		return config.AuditExport{
+			Enabled: true,
+			Format:  config.AuditExportFormatCEF,
+			Sink:    config.AuditExportSinkSyslog,
+			Syslog: config.AuditExportSyslog{
+				Address:   "siem:6514",
+				SecretRef: commoncfg.SecretRef{Type: commoncfg.MTLSSecretType},
+			},
+			BufferSize: 10,
+		}
+	})
+
+	tests := []struct {
+		name   string
+		config config.AuditExport
+		expErr error
+	}{
+		{
+			name:   "Valid syslog configuration",
+			config: mutator(),
+		},
+		{
+			name: "Valid webhook configuration",
+			config: mutator(func(a *config.AuditExport) {
+				a.Sink = config.AuditExportSinkWebhook
+				a.Webhook.URL = "https://siem/events"
+			}),
+		},
+		{
+			name: "Disabled",
+			config: config.AuditExport{
+				Format: "unknown",
+			},
+		},
+		{
+			name: "Invalid format",
+			config: mutator(func(a *config.AuditExport) {
+				a.Format = "otlp"
+			}),
+			expErr: config.ErrConfigurationValuesError,
+		},
+		{
+			name: "Invalid sink",
+			config: mutator(func(a *config.AuditExport) {
+				a.Sink = "kafka"
+			}),
+			expErr: config.ErrConfigurationValuesError,
+		},
+		{
+			name: "Missing syslog address",
+			config: mutator(func(a *config.AuditExport) {
+				a.Syslog.Address = ""
+			}),
+			expErr: config.ErrConfigurationValuesError,
+		},
+		{
+			name: "Invalid syslog secret type",
+			config: mutator(func(a *config.AuditExport) {
+				a.Syslog.SecretRef.Type = commoncfg.BasicSecretType
+			}),
+			expErr: config.ErrConfigurationValuesError,
+		},
+		{
+			name: "Missing webhook url",
+			config: mutator(func(a *config.AuditExport) {
+				a.Sink = config.AuditExportSinkWebhook
+			}),
+			expErr: config.ErrConfigurationValuesError,
+		},
+		{
+			name: "Invalid buffer size",
+			config: mutator(func(a *config.AuditExport) {
+				a.BufferSize = 0
+			}),
+			expErr: config.ErrConfigurationValuesError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateIdentityCache(t *testing.T) {
+	mutator := testutils.NewMutator(func() config.IdentityCache {
+		return config.IdentityCache{
+			Backend: config.IdentityCacheBackendRedis,
+			TTL: config.IdentityCacheTTL{
+				Users:       time.Hour,
+				Groups:      time.Minute,
+				Memberships: time.Minute,
+				NotFound:    time.Second,
+			},
+			Redis: config.Redis{Port: "6379"},
+		}
+	})
+
+	tests := []struct {
+		name   string
+		config config.IdentityCache
+		expErr error
+	}{
+		{
+			name:   "Valid redis configuration",
+			config: mutator(),
+		},
+		{
+			name: "Valid memory configuration",
+			config: mutator(func(c *config.IdentityCache) {
+				c.Backend = config.IdentityCacheBackendMemory
+				c.Redis = config.Redis{}
+			}),
+		},
+		{
+			name: "Invalid backend",
+			config: mutator(func(c *config.IdentityCache) {
+				c.Backend = "memcached"
+			}),
+			expErr: config.ErrConfigurationValuesError,
+		},
+		{
+			name: "Missing redis port",
+			config: mutator(func(c *config.IdentityCache) {
+				c.Redis.Port = ""
+			}),
+			expErr: config.ErrConfigurationValuesError,
+		},
+		{
+			name: "Negative ttl",
+			config: mutator(func(c *config.IdentityCache) {
+				c.TTL.NotFound = -time.Second
+			}),
+			expErr: config.ErrConfigurationValuesError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
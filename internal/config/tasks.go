@@ -5,16 +5,19 @@ import (
 )
 
 const (
-	TypeSystemsTask        = "sys:refresh"
-	TypeCertificateTask    = "cert:rotate"
-	TypeHYOKSync           = "key:sync"
-	TypePendingStateSync   = "key:pending-state-sync"
-	TypeKeystorePool       = "keystore:fill"
-	TypeSendNotifications  = "notify:send"
-	TypeWorkflowAutoAssign = "workflow:auto-assign"
-	TypeWorkflowCleanup    = "workflow:cleanup"
-	TypeWorkflowExpire     = "workflow:expire"
-	TypeTenantRefreshName  = "tenant:refresh-name"
+	TypeSystemsTask         = "sys:refresh"
+	TypeCertificateTask     = "cert:rotate"
+	TypeHYOKSync            = "key:sync"
+	TypePendingStateSync    = "key:pending-state-sync"
+	TypeKeystorePool        = "keystore:fill"
+	TypeSendNotifications   = "notify:send"
+	TypeWorkflowAutoAssign  = "workflow:auto-assign"
+	TypeWorkflowCleanup     = "workflow:cleanup"
+	TypeWorkflowExpire      = "workflow:expire"
+	TypeRoleElevationExpire = "role-elevation:expire"
+	TypeTenantRefreshName   = "tenant:refresh-name"
+	TypeSystemRetry         = "sys:retry-failed"
+	TypeSystemKeyDrift      = "sys:check-key-drift"
 )
 
 const defaultRetryCount = 3
@@ -37,6 +40,16 @@ var PeriodicTasks = map[string]Task{
 			TimeOut: 5 * time.Minute,
 		},
 	},
+	TypeSystemRetry: {
+		Enabled:  new(true),
+		Cronspec: "*/5 * * * *", // Every 5 minutes
+		Retries:  new(defaultRetryCount),
+	},
+	TypeSystemKeyDrift: {
+		Enabled:  new(true),
+		Cronspec: "30 * * * *", // Hourly at minute 30
+		Retries:  new(defaultRetryCount),
+	},
 	TypeKeystorePool: {
 		Enabled:  new(true),
 		Cronspec: "0 * * * *", // Hourly
@@ -57,6 +70,11 @@ var PeriodicTasks = map[string]Task{
 		Cronspec: "0 2 * * *", // At 02:00 AM daily
 		Retries:  new(defaultRetryCount),
 	},
+	TypeRoleElevationExpire: {
+		Enabled:  new(true),
+		Cronspec: "*/15 * * * *", // Every 15 minutes
+		Retries:  new(defaultRetryCount),
+	},
 	// The TenantRefreshName was added to sync old tenants to have a tenant name
 	// This should be deleted on next release
 	TypeTenantRefreshName: {
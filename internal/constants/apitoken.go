@@ -0,0 +1,15 @@
+package constants
+
+import "time"
+
+const (
+	// APITokenPrefix starts every API token, so tokens can be told apart
+	// from other bearer credentials
+	APITokenPrefix = "cmk_"
+	// APITokenClientDataType is the client data type of requests
+	// authenticated with an API token
+	APITokenClientDataType = "API_TOKEN"
+
+	DefaultAPITokenValidity = 90 * 24 * time.Hour
+	MaxAPITokenValidity     = 365 * 24 * time.Hour
+)
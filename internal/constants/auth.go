@@ -28,10 +28,13 @@ const (
 	InternalTaskSystemRefreshRole      InternalRole = "INTERNAL_TASK_SYSTEM_REFRESH"
 	InternalTaskTenantRefreshRole      InternalRole = "INTERNAL_TASK_TENANT_REFRESH"
 	InternalTaskSendNotificationRole   InternalRole = "INTERNAL_TASK_SEND_NOTIFICATION"
+	InternalTaskSystemRetryRole        InternalRole = "INTERNAL_TASK_SYSTEM_RETRY"
+	InternalTaskSystemKeyDriftRole     InternalRole = "INTERNAL_TASK_SYSTEM_KEY_DRIFT"
 
 	AuditorPolicy     PolicyID = "AuditorPolicy"
 	KeyAdminPolicy    PolicyID = "KeyAdminPolicy"
 	TenantAdminPolicy PolicyID = "TenantAdminPolicy"
+	CustomRolePolicy  PolicyID = "CustomRolePolicy"
 
 	InternalTenantCLIPolicy              PolicyID = "InternalTenantCLI"
 	InternalBusinessAuthzPolicy          PolicyID = "InternalBusinessAuthz"
@@ -47,6 +50,8 @@ const (
 	InternalTaskTenantRefreshPolicy      PolicyID = "InternalTaskTenantRefresh"
 	InternalTaskWorkflowCleanupPolicy    PolicyID = "InternalTaskWorkflowCleanup"
 	InternalTaskWorkflowExpirationPolicy PolicyID = "InternalTaskWorkflowExpiration"
+	InternalTaskSystemRetryPolicy        PolicyID = "InternalTaskSystemRetry"
+	InternalTaskSystemKeyDriftPolicy     PolicyID = "InternalTaskSystemKeyDrift"
 )
 
 type (
@@ -0,0 +1,17 @@
+package constants
+
+import "time"
+
+const (
+	SystemAutoLinkConfigKey = "SYSTEM_AUTO_LINK_CONFIG"
+)
+
+const (
+	DefaultSystemRetryMaxAttempts    = 3
+	DefaultSystemRetryInitialBackoff = 5 * time.Minute
+	DefaultSystemRetryMaxBackoff     = time.Hour
+)
+
+// DefaultSystemRetryErrorCodes are retried when no codes are configured.
+// Unclassified errors such as transport or crypto timeouts are reported with the default code.
+var DefaultSystemRetryErrorCodes = []string{DefaultErrorCode}
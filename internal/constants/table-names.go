@@ -3,7 +3,10 @@ package constants
 const (
 	publicTablePreFix = "public."
 
+	APITokenTable         = "api_tokens"
+	AuditEventTable       = "audit_events"
 	CertificateTable      = "certificates"
+	CustomRoleTable       = "custom_roles"
 	EventTable            = "events"
 	GroupTable            = "groups"
 	ImportparamTable      = "import_params"
@@ -12,8 +15,11 @@ const (
 	KeystoreTable         = publicTablePreFix + "keystore_pool"
 	KeyVersionTable       = "key_versions"
 	KeyLabelTable         = "key_labels"
+	ResourceGrantTable    = "resource_grants"
+	RoleElevationTable    = "role_elevations"
 	SystemTable           = "systems"
 	SystemPropertyTable   = "systems_properties"
+	SystemGroupTable      = "system_groups"
 	TagTable              = "tags"
 	TenantTable           = publicTablePreFix + "tenants"
 	TenantconfigTable     = "tenant_configs"
@@ -9,4 +9,6 @@ const (
 	DefaultExpiryPeriodDays = 7
 
 	DefaultMaxExpiryPeriodDays = 30
+
+	MaxRoleElevationHours = 24
 )
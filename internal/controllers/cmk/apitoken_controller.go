@@ -0,0 +1,93 @@
+package cmk
+
+import (
+	"context"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform"
+	"github.com/openkcm/cmk/internal/api/transform/apitoken"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/utils/ptr"
+)
+
+func (c *APIController) GetAPITokens(
+	ctx context.Context,
+	request cmkapi.GetAPITokensRequestObject,
+) (cmkapi.GetAPITokensResponseObject, error) {
+	pagination := repo.Pagination{
+		Skip:  ptr.GetPtrOrDefault(request.Params.Skip, constants.DefaultSkip),
+		Top:   ptr.GetPtrOrDefault(request.Params.Top, constants.DefaultTop),
+		Count: ptr.GetSafeDeref(request.Params.Count),
+	}
+
+	tokens, total, err := c.Manager.APITokens.GetAPITokens(ctx, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := transform.ToList(tokens, apitoken.ToAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	response := cmkapi.APITokenList{
+		Value: values,
+	}
+
+	if pagination.Count {
+		response.Count = new(total)
+	}
+
+	return cmkapi.GetAPITokens200JSONResponse(response), nil
+}
+
+// CreateAPIToken returns the secret of the token, which is only available
+// in this response
+func (c *APIController) CreateAPIToken(
+	ctx context.Context,
+	request cmkapi.CreateAPITokenRequestObject,
+) (cmkapi.CreateAPITokenResponseObject, error) {
+	token, secret, err := c.Manager.APITokens.CreateAPIToken(ctx, apitoken.FromAPI(*request.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	apiToken, err := apitoken.ToAPI(*token)
+	if err != nil {
+		return nil, err
+	}
+
+	apiToken.Token = &secret
+
+	return cmkapi.CreateAPIToken201JSONResponse(*apiToken), nil
+}
+
+func (c *APIController) GetAPITokenByID(
+	ctx context.Context,
+	request cmkapi.GetAPITokenByIDRequestObject,
+) (cmkapi.GetAPITokenByIDResponseObject, error) {
+	token, err := c.Manager.APITokens.GetAPITokenByID(ctx, request.TokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiToken, err := apitoken.ToAPI(*token)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.GetAPITokenByID200JSONResponse(*apiToken), nil
+}
+
+func (c *APIController) RevokeAPIToken(
+	ctx context.Context,
+	request cmkapi.RevokeAPITokenRequestObject,
+) (cmkapi.RevokeAPITokenResponseObject, error) {
+	err := c.Manager.APITokens.RevokeAPIToken(ctx, request.TokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.RevokeAPIToken204Response(struct{}{}), nil
+}
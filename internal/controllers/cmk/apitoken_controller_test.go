@@ -0,0 +1,196 @@
+package cmk_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+)
+
+func TestAPITokens(t *testing.T) {
+	db, sv, tenant, keyStorage := startAPIGroups(t)
+	r := sql.NewRepository(db)
+	ctx := testutils.CreateCtxWithTenant(tenant)
+
+	authClient := testutils.NewAuthClient(ctx, t, r, testutils.WithTenantAdminRole())
+	headers := testutils.WithBusinessUserData(t, keyStorage, authClient)
+
+	group := authClient.Group
+	otherGroup := testutils.NewGroup(func(_ *model.Group) {})
+	testutils.CreateTestEntities(ctx, t, r, otherGroup)
+
+	endpoint := "/apiTokens"
+
+	var tokenID uuid.UUID
+
+	t.Run("Should 201 on API token creation with secret", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: endpoint,
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.APIToken{
+				Name:    "ci-pipeline",
+				GroupID: group.ID,
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.APIToken](t, w)
+		assert.Equal(t, group.ID, response.GroupID)
+		assert.Equal(t, cmkapi.APITokenStatusACTIVE, *response.Status)
+		assert.True(t, strings.HasPrefix(*response.Token, constants.APITokenPrefix))
+		assert.True(t, strings.HasPrefix(*response.Token, *response.Prefix))
+		assert.WithinDuration(t, time.Now().Add(constants.DefaultAPITokenValidity), *response.ExpiresAt, time.Minute)
+
+		tokenID = *response.Id
+	})
+
+	t.Run("Should 400 on expiry beyond maximum validity", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: endpoint,
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.APIToken{
+				Name:      "ci-pipeline",
+				GroupID:   group.ID,
+				ExpiresAt: new(time.Now().Add(2 * constants.MaxAPITokenValidity)),
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Should 403 on group the caller is not member of", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: endpoint,
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.APIToken{
+				Name:    "ci-pipeline",
+				GroupID: otherGroup.ID,
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.ErrorMessage](t, w)
+		assert.Equal(t, "API_TOKEN_GROUP_NOT_ALLOWED", response.Error.Code)
+	})
+
+	t.Run("Should 404 on unknown group", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: endpoint,
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.APIToken{
+				Name:    "ci-pipeline",
+				GroupID: uuid.New(),
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Should 200 on list API tokens without secrets", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodGet,
+			Endpoint: endpoint + "?$count=true",
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.APITokenList](t, w)
+		assert.Equal(t, 1, *response.Count)
+		assert.Equal(t, tokenID, *response.Value[0].Id)
+		assert.Nil(t, response.Value[0].Token)
+	})
+
+	t.Run("Should 204 on API token revocation", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodDelete,
+			Endpoint: endpoint + "/" + tokenID.String(),
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("Should 200 on get revoked API token", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodGet,
+			Endpoint: endpoint + "/" + tokenID.String(),
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.APIToken](t, w)
+		assert.Equal(t, cmkapi.APITokenStatusREVOKED, *response.Status)
+		assert.NotNil(t, response.RevokedAt)
+	})
+
+	t.Run("Should 404 on unknown API token", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodDelete,
+			Endpoint: endpoint + "/" + uuid.NewString(),
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	keyAdmin := testutils.NewAuthClient(ctx, t, r, testutils.WithKeyAdminRole())
+	keyAdminHeaders := testutils.WithBusinessUserData(t, keyStorage, keyAdmin)
+
+	var keyAdminTokenID uuid.UUID
+
+	t.Run("Should 201 on API token creation of key admin for own group", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: endpoint,
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.APIToken{
+				Name:    "ci-pipeline",
+				GroupID: keyAdmin.Group.ID,
+			}),
+			Headers: keyAdminHeaders,
+		})
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.APIToken](t, w)
+		assert.Equal(t, keyAdmin.Group.ID, response.GroupID)
+
+		keyAdminTokenID = *response.Id
+	})
+
+	t.Run("Should 403 on API token revocation of key admin", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodDelete,
+			Endpoint: endpoint + "/" + keyAdminTokenID.String(),
+			Tenant:   tenant,
+			Headers:  keyAdminHeaders,
+		})
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
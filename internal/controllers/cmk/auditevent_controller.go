@@ -0,0 +1,109 @@
+package cmk
+
+import (
+	"context"
+	"time"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform"
+	"github.com/openkcm/cmk/internal/api/transform/auditevent"
+	"github.com/openkcm/cmk/internal/apierrors"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/utils/odata"
+)
+
+var getAuditEventsSchema = odata.FilterSchema{
+	Entries: []odata.FilterSchemaEntry{
+		{
+			FilterName:     "actor",
+			FilterType:     odata.String,
+			DBName:         repo.ActorIDField,
+			ValueValidator: odata.MaxLengthValidator(constants.QueryMaxLengthName),
+		},
+		{
+			FilterName:     "resource",
+			FilterType:     odata.String,
+			DBName:         repo.ObjectIDField,
+			ValueValidator: odata.MaxLengthValidator(constants.QueryMaxLengthName),
+		},
+		{
+			FilterName:     "eventType",
+			FilterType:     odata.String,
+			DBName:         repo.EventTypeField,
+			ValueValidator: odata.MaxLengthValidator(constants.QueryMaxLengthName),
+		},
+		{
+			FilterName:     "createdAfter",
+			FilterType:     odata.String,
+			DBName:         repo.CreatedAfterField,
+			ValueValidator: isRFC3339,
+			DBQuery: func(query *repo.Query, value any) *repo.Query {
+				return createdAtQuery(query, value, repo.Gt)
+			},
+		},
+		{
+			FilterName:     "createdBefore",
+			FilterType:     odata.String,
+			DBName:         repo.CreatedBeforeField,
+			ValueValidator: isRFC3339,
+			DBQuery: func(query *repo.Query, value any) *repo.Query {
+				return createdAtQuery(query, value, repo.Lt)
+			},
+		},
+	},
+}
+
+func isRFC3339(s string) bool {
+	_, err := time.Parse(time.RFC3339, s)
+	return err == nil
+}
+
+// createdAtQuery bounds the creation time with the given comparison.
+// The value has already been validated by isRFC3339
+func createdAtQuery(query *repo.Query, value any, op func(v any) repo.Key) *repo.Query {
+	s, _ := value.(string)
+	createdAt, _ := time.Parse(time.RFC3339, s)
+
+	return query.Where(
+		repo.NewCompositeKeyGroup(
+			repo.NewCompositeKey().Where(repo.CreatedField, createdAt, op),
+		),
+	)
+}
+
+// GetAuditEvents returns the audit events recorded for the tenant
+func (c *APIController) GetAuditEvents(
+	ctx context.Context,
+	request cmkapi.GetAuditEventsRequestObject,
+) (cmkapi.GetAuditEventsResponseObject, error) {
+	odataQueryMapper := odata.NewQueryOdataMapper(getAuditEventsSchema)
+
+	err := odataQueryMapper.ParseFilter(request.Params.Filter)
+	if err != nil {
+		return nil, errs.Wrap(apierrors.ErrBadOdataFilter, err)
+	}
+
+	odataQueryMapper.SetPaging(request.Params.Skip, request.Params.Top, request.Params.Count)
+
+	events, total, err := c.Manager.AuditEvents.GetAuditEvents(ctx, odataQueryMapper)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := transform.ToList(events, auditevent.ToAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	response := cmkapi.AuditEventList{
+		Value: values,
+	}
+
+	if odataQueryMapper.GetPagination().Count {
+		response.Count = new(total)
+	}
+
+	return cmkapi.GetAuditEvents200JSONResponse(response), nil
+}
@@ -0,0 +1,134 @@
+package cmk_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/multitenancy"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+)
+
+func startAPIAuditEvents(t *testing.T) (*multitenancy.DB, cmkapi.ServeMux, string, *testutils.TestSigningKeyStorage) {
+	t.Helper()
+
+	db, tenants, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
+
+	keyStorage := testutils.NewTestSigningKeyStorage(t)
+
+	r := testutils.NewAPIServer(
+		t, db, testutils.TestAPIServerConfig{
+			EnableBusinessUserDataMW: true,
+			SigningKeyStorage:        keyStorage,
+		},
+	)
+
+	return db, r, tenants[0], keyStorage
+}
+
+func TestGetAuditEvents(t *testing.T) {
+	db, r, tenant, keyStorage := startAPIAuditEvents(t)
+	rep := sql.NewRepository(db)
+	ctx := testutils.CreateCtxWithTenant(tenant)
+
+	authClient := testutils.NewAuthClient(ctx, t, rep, testutils.WithAuditorRole())
+	headers := testutils.WithBusinessUserData(t, keyStorage, authClient)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	keyID := uuid.NewString()
+
+	older := &model.AuditEvent{
+		ID:        uuid.New(),
+		EventType: "keyDisable",
+		ObjectID:  keyID,
+		ActorID:   "alice",
+		CreatedAt: now.Add(-2 * time.Hour),
+	}
+	newer := &model.AuditEvent{
+		ID:        uuid.New(),
+		EventType: "keyDelete",
+		ObjectID:  keyID,
+		ActorID:   "bob",
+		CreatedAt: now,
+	}
+
+	testutils.CreateTestEntities(ctx, t, rep, older, newer)
+
+	get := func(t *testing.T, filter string) cmkapi.AuditEventList {
+		t.Helper()
+
+		endpoint := "/auditEvents?$count=true"
+		if filter != "" {
+			endpoint += "&$filter=" + url.QueryEscape(filter)
+		}
+
+		w := testutils.MakeHTTPRequest(t, r, testutils.RequestOptions{
+			Method:   http.MethodGet,
+			Endpoint: endpoint,
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		return testutils.GetJSONBody[cmkapi.AuditEventList](t, w)
+	}
+
+	t.Run("Should 200 with newest events first", func(t *testing.T) {
+		response := get(t, "")
+		assert.Equal(t, 2, *response.Count)
+		assert.Equal(t, newer.ID, *response.Value[0].Id)
+		assert.Equal(t, older.ID, *response.Value[1].Id)
+	})
+
+	t.Run("Should filter by actor", func(t *testing.T) {
+		response := get(t, "actor eq 'alice'")
+		assert.Equal(t, 1, *response.Count)
+		assert.Equal(t, older.ID, *response.Value[0].Id)
+	})
+
+	t.Run("Should filter by resource and event type", func(t *testing.T) {
+		response := get(t, "resource eq '"+keyID+"' and eventType eq 'keyDelete'")
+		assert.Equal(t, 1, *response.Count)
+		assert.Equal(t, newer.ID, *response.Value[0].Id)
+	})
+
+	t.Run("Should filter by time range", func(t *testing.T) {
+		response := get(t, "createdAfter eq '"+now.Add(-3*time.Hour).Format(time.RFC3339)+
+			"' and createdBefore eq '"+now.Add(-time.Hour).Format(time.RFC3339)+"'")
+		assert.Equal(t, 1, *response.Count)
+		assert.Equal(t, older.ID, *response.Value[0].Id)
+	})
+
+	t.Run("Should 400 on invalid time filter", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, r, testutils.RequestOptions{
+			Method:   http.MethodGet,
+			Endpoint: "/auditEvents?$filter=" + url.QueryEscape("createdAfter eq 'yesterday'"),
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Should 403 for key admin", func(t *testing.T) {
+		keyAdmin := testutils.NewAuthClient(ctx, t, rep, testutils.WithKeyAdminRole())
+
+		w := testutils.MakeHTTPRequest(t, r, testutils.RequestOptions{
+			Method:   http.MethodGet,
+			Endpoint: "/auditEvents",
+			Tenant:   tenant,
+			Headers:  testutils.WithBusinessUserData(t, keyStorage, keyAdmin),
+		})
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
@@ -45,6 +45,10 @@ func authzEndpoints() []testutils.AuthzTestEndpoint {
 	systemID := uuid.New().String()
 	workflowID := uuid.New().String()
 	groupID := uuid.New().String()
+	systemGroupID := uuid.New().String()
+	roleID := uuid.New().String()
+	grantID := uuid.New().String()
+	tokenID := uuid.New().String()
 
 	return []testutils.AuthzTestEndpoint{
 		// --- Keys ---
@@ -167,6 +171,11 @@ func authzEndpoints() []testutils.AuthzTestEndpoint {
 			Method:   http.MethodDelete,
 			Endpoint: "/systems/" + systemID + "/link",
 		},
+		{
+			Method:   http.MethodPost,
+			Endpoint: "/systems/" + systemID + "/link:validate",
+			Body:     `{"keyConfigurationID": "` + keyConfigID + `"}`,
+		},
 		{
 			Method:   http.MethodPost,
 			Endpoint: "/systems/" + systemID + "/recoveryActions",
@@ -177,6 +186,49 @@ func authzEndpoints() []testutils.AuthzTestEndpoint {
 			Endpoint: "/systems/" + systemID + "/recoveryActions",
 		},
 
+		// --- System Groups ---
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/systemGroups",
+		},
+		{
+			Method:   http.MethodPost,
+			Endpoint: "/systemGroups",
+			Body:     `{"name": "test-landscape", "systemIDs": ["` + systemID + `"]}`,
+		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/systemGroups/" + systemGroupID,
+		},
+		{
+			Method:   http.MethodPatch,
+			Endpoint: "/systemGroups/" + systemGroupID,
+			Body:     `{"description": "updated"}`,
+		},
+		{
+			Method:   http.MethodDelete,
+			Endpoint: "/systemGroups/" + systemGroupID,
+		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/systemGroups/" + systemGroupID + "/systems",
+		},
+		{
+			Method:   http.MethodPatch,
+			Endpoint: "/systemGroups/" + systemGroupID + "/link",
+			Body:     `{"keyConfigurationID": "` + keyConfigID + `"}`,
+		},
+		{
+			Method:   http.MethodDelete,
+			Endpoint: "/systemGroups/" + systemGroupID + "/link",
+		},
+
+		// --- Audit Events ---
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/auditEvents",
+		},
+
 		// --- Workflows ---
 		{
 			Method:   http.MethodPost,
@@ -244,6 +296,77 @@ func authzEndpoints() []testutils.AuthzTestEndpoint {
 			Method:   http.MethodDelete,
 			Endpoint: "/groups/" + groupID,
 		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/groups/" + groupID + "/grants",
+		},
+		{
+			Method:   http.MethodPost,
+			Endpoint: "/groups/" + groupID + "/grants",
+			Body: `{
+				"resourceType": "KEY_CONFIGURATION",
+				"resourceID": "` + keyConfigID + `",
+				"access": "READ"
+			}`,
+		},
+		{
+			Method:   http.MethodDelete,
+			Endpoint: "/groups/" + groupID + "/grants/" + grantID,
+		},
+
+		// --- API tokens ---
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/apiTokens",
+		},
+		{
+			Method:   http.MethodPost,
+			Endpoint: "/apiTokens",
+			Body:     `{"name": "ci-pipeline", "groupID": "` + groupID + `"}`,
+		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/apiTokens/" + tokenID,
+		},
+		{
+			Method:   http.MethodDelete,
+			Endpoint: "/apiTokens/" + tokenID,
+		},
+
+		// --- Roles ---
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/roles",
+		},
+		{
+			Method:   http.MethodPost,
+			Endpoint: "/roles",
+			Body: `{
+				"name": "KEY_OPERATOR",
+				"baseRole": "KEY_ADMINISTRATOR",
+				"permissions": [{"resourceType": "Key", "actions": ["read"]}]
+			}`,
+		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/roles/" + roleID,
+		},
+		{
+			Method:   http.MethodPatch,
+			Endpoint: "/roles/" + roleID,
+			Body:     `{"description": "updated"}`,
+		},
+		{
+			Method:   http.MethodDelete,
+			Endpoint: "/roles/" + roleID,
+		},
+
+		// --- Authorization ---
+		{
+			Method:   http.MethodPost,
+			Endpoint: "/authz/explain",
+			Body:     `{"groups": ["KMS_001"], "method": "GET", "path": "/keys"}`,
+		},
 
 		// --- Tenant Configurations ---
 		{
@@ -259,6 +382,19 @@ func authzEndpoints() []testutils.AuthzTestEndpoint {
 			Endpoint: "/tenantConfigurations/workflow",
 			Body:     `{"enabled": true}`,
 		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/tenantConfigurations/systemAutoLink",
+		},
+		{
+			Method:   http.MethodPut,
+			Endpoint: "/tenantConfigurations/systemAutoLink",
+			Body:     `{"rules": []}`,
+		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/capabilities",
+		},
 
 		// --- Tenant Info ---
 		{
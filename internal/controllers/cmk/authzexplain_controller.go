@@ -0,0 +1,20 @@
+package cmk
+
+import (
+	"context"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform/authzexplain"
+)
+
+func (c *APIController) ExplainAuthz(
+	ctx context.Context,
+	request cmkapi.ExplainAuthzRequestObject,
+) (cmkapi.ExplainAuthzResponseObject, error) {
+	explanation, err := c.Manager.AuthzExplain.Explain(ctx, authzexplain.FromAPI(*request.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.ExplainAuthz200JSONResponse(*authzexplain.ToAPI(*explanation)), nil
+}
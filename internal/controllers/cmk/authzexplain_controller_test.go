@@ -0,0 +1,82 @@
+package cmk_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+)
+
+func TestExplainAuthz(t *testing.T) {
+	db, sv, tenant, keyStorage := startAPIGroups(t)
+	r := sql.NewRepository(db)
+	ctx := testutils.CreateCtxWithTenant(tenant)
+
+	authClient := testutils.NewAuthClient(ctx, t, r, testutils.WithTenantAdminRole())
+	headers := testutils.WithBusinessUserData(t, keyStorage, authClient)
+
+	keyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {})
+	testutils.CreateTestEntities(ctx, t, r, keyConfig)
+
+	t.Run("Should 200 on explained decision", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: "/authz/explain",
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.AuthzExplainRequest{
+				Groups:     []string{keyConfig.AdminGroup.IAMIdentifier},
+				Method:     cmkapi.AuthzExplainRequestMethodGET,
+				Path:       "/keyConfigurations/" + keyConfig.ID.String(),
+				ResourceID: &keyConfig.ID,
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.AuthzExplanation](t, w)
+		assert.True(t, response.Allowed)
+		assert.Equal(t, "GET /keyConfigurations/{keyConfigurationID}", *response.Endpoint)
+		assert.Equal(t, string(constants.KeyAdminRole), *response.Groups[0].Role)
+		assert.True(t, *response.GroupFilter.ResourceAllowed)
+	})
+
+	t.Run("Should 200 on denied decision", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: "/authz/explain",
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.AuthzExplainRequest{
+				Groups: []string{"unknown"},
+				Method: cmkapi.AuthzExplainRequestMethodDELETE,
+				Path:   "/keys/" + keyConfig.ID.String(),
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.AuthzExplanation](t, w)
+		assert.False(t, response.Allowed)
+		assert.False(t, response.Groups[0].Found)
+		assert.Nil(t, response.GroupFilter)
+	})
+
+	t.Run("Should 400 on missing path", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: "/authz/explain",
+			Tenant:   tenant,
+			Body:     testutils.WithString(t, `{"groups": [], "method": "GET"}`),
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
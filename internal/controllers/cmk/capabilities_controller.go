@@ -0,0 +1,28 @@
+package cmk
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform/capabilities"
+)
+
+// GetCapabilities returns the capabilities of the keystore providers
+func (c *APIController) GetCapabilities(
+	ctx context.Context,
+	_ cmkapi.GetCapabilitiesRequestObject,
+) (cmkapi.GetCapabilitiesResponseObject, error) {
+	providers, err := c.Manager.Capabilities.GetKeystoreCapabilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]cmkapi.KeystoreCapabilities, 0, len(providers))
+	for _, name := range slices.Sorted(maps.Keys(providers)) {
+		values = append(values, capabilities.ToAPI(name, providers[name]))
+	}
+
+	return cmkapi.GetCapabilities200JSONResponse{Value: values}, nil
+}
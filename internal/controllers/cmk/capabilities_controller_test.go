@@ -0,0 +1,60 @@
+package cmk_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/keymanagement"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+	"github.com/openkcm/cmk/internal/testutils/testplugins"
+)
+
+func TestGetCapabilities(t *testing.T) {
+	db, tenants, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
+	tenant := tenants[0]
+	keyStorage := testutils.NewTestSigningKeyStorage(t)
+
+	r := testutils.NewAPIServer(t, db, testutils.TestAPIServerConfig{
+		Registry: testutils.NewTestPlugins(testplugins.WithKeyManagement(testplugins.Name,
+			testplugins.WithCapabilities(testplugins.NewTestKeyManagement(false, true), keymanagement.Capabilities{
+				Version:    1,
+				Algorithms: []keymanagement.KeyAlgorithm{keymanagement.AES256},
+				KeyTypes:   []keymanagement.KeyType{keymanagement.BYOK},
+				Import:     true,
+				Regions:    []string{"eu10"},
+			}),
+		)),
+		EnableBusinessUserDataMW: true,
+		SigningKeyStorage:        keyStorage,
+	})
+
+	ctx := testutils.CreateCtxWithTenant(tenant)
+	authClient := testutils.NewAuthClient(ctx, t, sql.NewRepository(db), testutils.WithAuditorRole())
+
+	w := testutils.MakeHTTPRequest(t, r, testutils.RequestOptions{
+		Method:   http.MethodGet,
+		Endpoint: "/capabilities",
+		Tenant:   tenant,
+		Headers:  testutils.WithBusinessUserData(t, keyStorage, authClient),
+	})
+	require.Equal(t, http.StatusOK, w.Code)
+
+	response := testutils.GetJSONBody[cmkapi.KeystoreCapabilitiesList](t, w)
+	assert.Equal(t, []cmkapi.KeystoreCapabilities{
+		{
+			Provider:   testplugins.Name,
+			Declared:   true,
+			Version:    1,
+			Algorithms: []cmkapi.KeyAlgorithm{cmkapi.KeyAlgorithmAES256},
+			KeyTypes:   []cmkapi.KeyType{cmkapi.KeyTypeBYOK},
+			Import:     true,
+			Rotation:   false,
+			Regions:    []cmkapi.KeyRegion{"eu10"},
+		},
+	}, response.Value)
+}
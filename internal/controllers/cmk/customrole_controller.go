@@ -0,0 +1,111 @@
+package cmk
+
+import (
+	"context"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform"
+	"github.com/openkcm/cmk/internal/api/transform/customrole"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/utils/ptr"
+)
+
+func (c *APIController) GetRoles(
+	ctx context.Context,
+	request cmkapi.GetRolesRequestObject,
+) (cmkapi.GetRolesResponseObject, error) {
+	pagination := repo.Pagination{
+		Skip:  ptr.GetPtrOrDefault(request.Params.Skip, constants.DefaultSkip),
+		Top:   ptr.GetPtrOrDefault(request.Params.Top, constants.DefaultTop),
+		Count: ptr.GetSafeDeref(request.Params.Count),
+	}
+
+	roles, total, err := c.Manager.CustomRoles.GetCustomRoles(ctx, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := transform.ToList(roles, customrole.ToAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	response := cmkapi.CustomRoleList{
+		Value: values,
+	}
+
+	if pagination.Count {
+		response.Count = new(total)
+	}
+
+	return cmkapi.GetRoles200JSONResponse(response), nil
+}
+
+func (c *APIController) CreateRole(
+	ctx context.Context,
+	request cmkapi.CreateRoleRequestObject,
+) (cmkapi.CreateRoleResponseObject, error) {
+	role, err := customrole.FromAPI(*request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err = c.Manager.CustomRoles.CreateCustomRole(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	apiRole, err := customrole.ToAPI(*role)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.CreateRole201JSONResponse(*apiRole), nil
+}
+
+func (c *APIController) GetRoleByID(
+	ctx context.Context,
+	request cmkapi.GetRoleByIDRequestObject,
+) (cmkapi.GetRoleByIDResponseObject, error) {
+	role, err := c.Manager.CustomRoles.GetCustomRoleByID(ctx, request.RoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiRole, err := customrole.ToAPI(*role)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.GetRoleByID200JSONResponse(*apiRole), nil
+}
+
+func (c *APIController) UpdateRole(
+	ctx context.Context,
+	request cmkapi.UpdateRoleRequestObject,
+) (cmkapi.UpdateRoleResponseObject, error) {
+	role, err := c.Manager.CustomRoles.UpdateCustomRole(ctx, request.RoleID, *request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	apiRole, err := customrole.ToAPI(*role)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.UpdateRole200JSONResponse(*apiRole), nil
+}
+
+func (c *APIController) DeleteRoleByID(
+	ctx context.Context,
+	request cmkapi.DeleteRoleByIDRequestObject,
+) (cmkapi.DeleteRoleByIDResponseObject, error) {
+	err := c.Manager.CustomRoles.DeleteCustomRole(ctx, request.RoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.DeleteRoleByID204Response(struct{}{}), nil
+}
@@ -0,0 +1,170 @@
+package cmk_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+)
+
+func TestCustomRoles(t *testing.T) {
+	db, sv, tenant, keyStorage := startAPIGroups(t)
+	r := sql.NewRepository(db)
+	ctx := testutils.CreateCtxWithTenant(tenant)
+
+	authClient := testutils.NewAuthClient(ctx, t, r, testutils.WithTenantAdminRole())
+	headers := testutils.WithBusinessUserData(t, keyStorage, authClient)
+
+	var roleID uuid.UUID
+
+	t.Run("Should 201 on custom role creation", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: "/roles",
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.CustomRole{
+				Name:     "KEY_OPERATOR",
+				BaseRole: cmkapi.CustomRoleBaseRoleKEYADMINISTRATOR,
+				Permissions: []cmkapi.CustomRolePermission{
+					{ResourceType: "KeyConfiguration", Actions: []string{"read"}},
+					{ResourceType: "Key", Actions: []string{"read", "update"}},
+				},
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.CustomRole](t, w)
+		assert.Equal(t, "KEY_OPERATOR", response.Name)
+
+		roleID = *response.Id
+	})
+
+	t.Run("Should 409 on duplicated name", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: "/roles",
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.CustomRole{
+				Name:     "KEY_OPERATOR",
+				BaseRole: cmkapi.CustomRoleBaseRoleKEYADMINISTRATOR,
+				Permissions: []cmkapi.CustomRolePermission{
+					{ResourceType: "Key", Actions: []string{"read"}},
+				},
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("Should 400 on permission not granted by base role", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: "/roles",
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.CustomRole{
+				Name:     "KEY_AUDITOR",
+				BaseRole: cmkapi.CustomRoleBaseRoleTENANTAUDITOR,
+				Permissions: []cmkapi.CustomRolePermission{
+					{ResourceType: "Key", Actions: []string{"delete"}},
+				},
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.ErrorMessage](t, w)
+		assert.Equal(t, "INVALID_ROLE_PERMISSIONS", response.Error.Code)
+	})
+
+	t.Run("Should 200 on list custom roles", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodGet,
+			Endpoint: "/roles?$count=true",
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.CustomRoleList](t, w)
+		assert.Equal(t, 1, *response.Count)
+	})
+
+	t.Run("Should 200 on update custom role", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPatch,
+			Endpoint: "/roles/" + roleID.String(),
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.CustomRolePatch{
+				Description: new("Can enable and disable keys"),
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.CustomRole](t, w)
+		assert.Equal(t, "Can enable and disable keys", *response.Description)
+	})
+
+	t.Run("Should 201 on group with custom role", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: "/groups",
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.Group{
+				Name: "operators",
+				Role: "KEY_OPERATOR",
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("Should 400 on delete custom role referenced by a group", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodDelete,
+			Endpoint: "/roles/" + roleID.String(),
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Should 204 on delete custom role", func(t *testing.T) {
+		role := testutils.NewCustomRole(func(_ *model.CustomRole) {})
+		testutils.CreateTestEntities(ctx, t, r, role)
+
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodDelete,
+			Endpoint: "/roles/" + role.ID.String(),
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("Should 404 on unknown custom role", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodGet,
+			Endpoint: "/roles/" + uuid.NewString(),
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/openkcm/cmk/internal/api/cmkapi"
 	"github.com/openkcm/cmk/internal/api/transform"
 	tfGroup "github.com/openkcm/cmk/internal/api/transform/group"
+	"github.com/openkcm/cmk/internal/authz"
 	"github.com/openkcm/cmk/internal/constants"
 	"github.com/openkcm/cmk/internal/manager"
 	"github.com/openkcm/cmk/internal/model"
@@ -54,7 +55,9 @@ func (c *APIController) CreateGroup(
 	request cmkapi.CreateGroupRequestObject,
 ) (cmkapi.CreateGroupResponseObject, error) {
 	// This should only be checked if request comes from UI
-	if request.Body.Role != cmkapi.GroupRoleKEYADMINISTRATOR {
+	// Custom roles are checked for existence by the group manager
+	role := constants.BusinessRole(request.Body.Role)
+	if authz.IsBuiltInRole(role) && role != constants.KeyAdminRole {
 		return nil, manager.ErrGroupRole
 	}
 
@@ -103,7 +103,7 @@ func TestPostGroups(t *testing.T) {
 		"Should code 201 on successful group creation", func(t *testing.T) {
 			group := cmkapi.Group{
 				Name: "test",
-				Role: cmkapi.GroupRoleKEYADMINISTRATOR,
+				Role: string(constants.KeyAdminRole),
 			}
 
 			w := testutils.MakeHTTPRequest(
@@ -124,7 +124,7 @@ func TestPostGroups(t *testing.T) {
 		"Should code 400 on group with a non applicable role", func(t *testing.T) {
 			group := cmkapi.Group{
 				Name: "test",
-				Role: cmkapi.GroupRoleTENANTAUDITOR,
+				Role: string(constants.TenantAuditorRole),
 			}
 
 			w := testutils.MakeHTTPRequest(
@@ -166,7 +166,7 @@ func TestPostGroups(t *testing.T) {
 		"Should code 400 on group with invalid name", func(t *testing.T) {
 			group := cmkapi.Group{
 				Name: "$",
-				Role: cmkapi.GroupRoleKEYADMINISTRATOR,
+				Role: string(constants.KeyAdminRole),
 			}
 
 			w := testutils.MakeHTTPRequest(
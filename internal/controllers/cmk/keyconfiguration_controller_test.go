@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/openkcm/common-sdk/pkg/auth"
@@ -683,6 +684,53 @@ func TestKeyConfigurationController_UpdateByID(t *testing.T) {
 	}
 }
 
+func TestKeyConfigurationController_UpdateByIDWithRoleElevation(t *testing.T) {
+	idmPlugin := testplugins.NewTestIdentityManagement()
+	sv, tenant, ctx, r, keyStorage := startAPIKeyConfig(t, idmPlugin)
+
+	keyAdmin := testutils.NewAuthClient(ctx, t, r, testutils.WithKeyAdminRole())
+	auditor := testutils.NewAuthClient(ctx, t, r, testutils.WithAuditorRole())
+	headers := signedHeadersFromClientMap(t, keyStorage, auditor.GetClientMap())
+
+	keyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {},
+		testutils.WithAuthBusinessUserDataKC(keyAdmin), testutils.WithIDMPluginKC(idmPlugin))
+	otherKeyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {},
+		testutils.WithAuthBusinessUserDataKC(keyAdmin), testutils.WithIDMPluginKC(idmPlugin))
+	testutils.CreateTestEntities(ctx, t, r, keyConfig, otherKeyConfig, &model.RoleElevation{
+		ID:                 uuid.New(),
+		UserID:             auditor.Identifier,
+		KeyConfigurationID: keyConfig.ID,
+		Role:               constants.KeyAdminRole,
+		WorkflowID:         uuid.New(),
+		ExpiresAt:          time.Now().Add(time.Hour),
+	})
+
+	patch := func(t *testing.T, keyConfigID uuid.UUID, name string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		return testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPatch,
+			Endpoint: "/keyConfigurations/" + keyConfigID.String(),
+			Tenant:   tenant,
+			Body:     testutils.WithString(t, `{"name": "`+name+`"}`),
+			Headers:  headers,
+		})
+	}
+
+	t.Run("Should 403 on key configuration without role elevation", func(t *testing.T) {
+		w := patch(t, otherKeyConfig.ID, "not-elevated")
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Should 200 on key configuration with approved role elevation", func(t *testing.T) {
+		w := patch(t, keyConfig.ID, "elevated")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.KeyConfiguration](t, w)
+		assert.Equal(t, "elevated", response.Name)
+	})
+}
+
 func TestKeyConfigurationController_DeleteByID(t *testing.T) {
 	idmPlugin := testplugins.NewTestIdentityManagement()
 	sv, tenant, ctx, r, keyStorage := startAPIKeyConfig(t, idmPlugin)
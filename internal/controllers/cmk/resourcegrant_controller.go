@@ -0,0 +1,75 @@
+package cmk
+
+import (
+	"context"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform"
+	"github.com/openkcm/cmk/internal/api/transform/resourcegrant"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/utils/ptr"
+)
+
+func (c *APIController) GetGroupGrants(
+	ctx context.Context,
+	request cmkapi.GetGroupGrantsRequestObject,
+) (cmkapi.GetGroupGrantsResponseObject, error) {
+	pagination := repo.Pagination{
+		Skip:  ptr.GetPtrOrDefault(request.Params.Skip, constants.DefaultSkip),
+		Top:   ptr.GetPtrOrDefault(request.Params.Top, constants.DefaultTop),
+		Count: ptr.GetSafeDeref(request.Params.Count),
+	}
+
+	grants, total, err := c.Manager.Grants.GetResourceGrants(ctx, request.GroupID, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := transform.ToList(grants, resourcegrant.ToAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	response := cmkapi.ResourceGrantList{
+		Value: values,
+	}
+
+	if pagination.Count {
+		response.Count = new(total)
+	}
+
+	return cmkapi.GetGroupGrants200JSONResponse(response), nil
+}
+
+func (c *APIController) CreateGroupGrant(
+	ctx context.Context,
+	request cmkapi.CreateGroupGrantRequestObject,
+) (cmkapi.CreateGroupGrantResponseObject, error) {
+	grant, err := c.Manager.Grants.CreateResourceGrant(
+		ctx,
+		resourcegrant.FromAPI(*request.Body, request.GroupID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	apiGrant, err := resourcegrant.ToAPI(*grant)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.CreateGroupGrant201JSONResponse(*apiGrant), nil
+}
+
+func (c *APIController) DeleteGroupGrant(
+	ctx context.Context,
+	request cmkapi.DeleteGroupGrantRequestObject,
+) (cmkapi.DeleteGroupGrantResponseObject, error) {
+	err := c.Manager.Grants.DeleteResourceGrant(ctx, request.GroupID, request.GrantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.DeleteGroupGrant204Response(struct{}{}), nil
+}
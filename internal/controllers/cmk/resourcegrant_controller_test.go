@@ -0,0 +1,148 @@
+package cmk_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+)
+
+func TestResourceGrants(t *testing.T) {
+	db, sv, tenant, keyStorage := startAPIGroups(t)
+	r := sql.NewRepository(db)
+	ctx := testutils.CreateCtxWithTenant(tenant)
+
+	authClient := testutils.NewAuthClient(ctx, t, r, testutils.WithTenantAdminRole())
+	headers := testutils.WithBusinessUserData(t, keyStorage, authClient)
+
+	group := testutils.NewGroup(func(_ *model.Group) {})
+	keyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {})
+	testutils.CreateTestEntities(ctx, t, r, group, keyConfig)
+
+	endpoint := "/groups/" + group.ID.String() + "/grants"
+
+	var grantID uuid.UUID
+
+	t.Run("Should 201 on resource grant creation", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: endpoint,
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.ResourceGrant{
+				ResourceType: cmkapi.ResourceGrantTypeKEYCONFIGURATION,
+				ResourceID:   keyConfig.ID,
+				Access:       cmkapi.ResourceGrantAccessOPERATE,
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.ResourceGrant](t, w)
+		assert.Equal(t, keyConfig.ID, response.ResourceID)
+
+		grantID = *response.Id
+	})
+
+	t.Run("Should 409 on duplicated grant", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: endpoint,
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.ResourceGrant{
+				ResourceType: cmkapi.ResourceGrantTypeKEYCONFIGURATION,
+				ResourceID:   keyConfig.ID,
+				Access:       cmkapi.ResourceGrantAccessREAD,
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("Should 404 on unknown resource", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: endpoint,
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.ResourceGrant{
+				ResourceType: cmkapi.ResourceGrantTypeSYSTEM,
+				ResourceID:   uuid.New(),
+				Access:       cmkapi.ResourceGrantAccessREAD,
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Should 400 on invalid access", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: endpoint,
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.ResourceGrant{
+				ResourceType: cmkapi.ResourceGrantTypeKEYCONFIGURATION,
+				ResourceID:   keyConfig.ID,
+				Access:       "WRITE",
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Should 200 on list resource grants", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodGet,
+			Endpoint: endpoint + "?$count=true",
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.ResourceGrantList](t, w)
+		assert.Equal(t, 1, *response.Count)
+		assert.Equal(t, grantID, *response.Value[0].Id)
+	})
+
+	t.Run("Should 404 on unknown group", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodGet,
+			Endpoint: "/groups/" + uuid.NewString() + "/grants",
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Should 204 on resource grant deletion", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodDelete,
+			Endpoint: endpoint + "/" + grantID.String(),
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("Should 404 on deleted resource grant", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodDelete,
+			Endpoint: endpoint + "/" + grantID.String(),
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/google/uuid"
+
 	"github.com/openkcm/cmk/internal/api/cmkapi"
 	"github.com/openkcm/cmk/internal/api/transform/system"
 	wfWorkflow "github.com/openkcm/cmk/internal/api/transform/workflow"
@@ -161,6 +163,22 @@ func (c *APIController) LinkSystemAction(
 	return cmkapi.LinkSystemAction200JSONResponse(*systemResponse), nil
 }
 
+func (c *APIController) ValidateSystemLink(
+	ctx context.Context,
+	request cmkapi.ValidateSystemLinkRequestObject,
+) (cmkapi.ValidateSystemLinkResponseObject, error) {
+	if request.Body.KeyConfigurationID == uuid.Nil {
+		return nil, apierrors.ErrKeyConfigurationIDRequired
+	}
+
+	validation, err := c.Manager.System.ValidateLinkSystem(ctx, request.SystemID, request.Body.KeyConfigurationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.ValidateSystemLink200JSONResponse(validation), nil
+}
+
 func (c *APIController) GetRecoveryActions(
 	ctx context.Context,
 	request cmkapi.GetRecoveryActionsRequestObject,
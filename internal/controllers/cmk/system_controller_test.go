@@ -18,6 +18,7 @@ import (
 	"github.com/openkcm/cmk/internal/apierrors"
 	"github.com/openkcm/cmk/internal/clients/registry/systems"
 	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/manager"
 	"github.com/openkcm/cmk/internal/model"
 	"github.com/openkcm/cmk/internal/multitenancy"
 	"github.com/openkcm/cmk/internal/pluginregistry/service/api/identitymanagement"
@@ -1064,6 +1065,98 @@ func TestLinkSystemAction(t *testing.T) {
 	}
 }
 
+func TestValidateSystemLink(t *testing.T) {
+	db, sv, tenant, keyStorage := startAPISystems(t, testutils.TestAPIServerConfig{})
+	ctx := cmkcontext.CreateTenantContext(t.Context(), tenant)
+	r := sql.NewRepository(db)
+
+	authClient := testutils.NewAuthClient(ctx, t, r, testutils.WithKeyAdminRole())
+
+	key := testutils.NewKey(func(_ *model.Key) {})
+	keyConfig := testutils.NewKeyConfig(func(k *model.KeyConfiguration) {
+		k.PrimaryKeyID = new(key.ID)
+	}, testutils.WithAuthBusinessUserDataKC(authClient))
+	keyConfigNoPrimary := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {},
+		testutils.WithAuthBusinessUserDataKC(authClient))
+	system := testutils.NewSystem(func(_ *model.System) {})
+
+	testutils.CreateTestEntities(ctx, t, r, key, keyConfig, keyConfigNoPrimary, system)
+
+	clientData := &auth.ClientData{
+		Identifier: authClient.Identifier,
+		Groups:     []string{authClient.Group.IAMIdentifier},
+	}
+
+	privateKey, ok := keyStorage.GetPrivateKey(0)
+	assert.True(t, ok, "test key should exist")
+	headers := testutils.NewSignedBusinessUserDataHeaders(t, clientData, privateKey, 0)
+
+	t.Run("Should 200 with valid result", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: fmt.Sprintf("/systems/%s/link:validate", system.ID),
+			Tenant:   tenant,
+			Body:     testutils.WithJSON(t, cmkapi.SystemPatch{KeyConfigurationID: keyConfig.ID}),
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.SystemLinkValidation](t, w)
+		assert.True(t, response.Valid)
+		assert.Empty(t, response.Blocking)
+	})
+
+	t.Run("Should 200 with blocking reasons", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: fmt.Sprintf("/systems/%s/link:validate", system.ID),
+			Tenant:   tenant,
+			Body:     testutils.WithJSON(t, cmkapi.SystemPatch{KeyConfigurationID: keyConfigNoPrimary.ID}),
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.SystemLinkValidation](t, w)
+		assert.False(t, response.Valid)
+		assert.Len(t, response.Blocking, 1)
+		assert.Equal(t, manager.SystemLinkReasonNoPrimaryKey, response.Blocking[0].Code)
+
+		_, err := r.First(ctx, system, *repo.NewQuery())
+		assert.NoError(t, err)
+		assert.Nil(t, system.KeyConfigurationID)
+		assert.Equal(t, cmkapi.SystemStatusDISCONNECTED, system.Status)
+	})
+
+	t.Run("Should 404 on non-existing key configuration", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: fmt.Sprintf("/systems/%s/link:validate", system.ID),
+			Tenant:   tenant,
+			Body:     testutils.WithJSON(t, cmkapi.SystemPatch{KeyConfigurationID: uuid.New()}),
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.ErrorMessage](t, w)
+		assert.Equal(t, "KEY_CONFIGURATION_NOT_FOUND", response.Error.Code)
+	})
+
+	t.Run("Should 404 on non-existing system", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: fmt.Sprintf("/systems/%s/link:validate", uuid.New()),
+			Tenant:   tenant,
+			Body:     testutils.WithJSON(t, cmkapi.SystemPatch{KeyConfigurationID: keyConfig.ID}),
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
 func TestUnlinkSystemAction(t *testing.T) {
 	db, sv, tenant, keyStorage := startAPISystems(t, testutils.TestAPIServerConfig{})
 	ctx := cmkcontext.CreateTenantContext(t.Context(), tenant)
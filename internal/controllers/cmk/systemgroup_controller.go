@@ -0,0 +1,225 @@
+package cmk
+
+import (
+	"context"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform"
+	"github.com/openkcm/cmk/internal/api/transform/system"
+	"github.com/openkcm/cmk/internal/api/transform/systemgroup"
+	"github.com/openkcm/cmk/internal/apierrors"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/utils/ptr"
+)
+
+func (c *APIController) GetSystemGroups(
+	ctx context.Context,
+	request cmkapi.GetSystemGroupsRequestObject,
+) (cmkapi.GetSystemGroupsResponseObject, error) {
+	pagination := repo.Pagination{
+		Skip:  ptr.GetPtrOrDefault(request.Params.Skip, constants.DefaultSkip),
+		Top:   ptr.GetPtrOrDefault(request.Params.Top, constants.DefaultTop),
+		Count: ptr.GetSafeDeref(request.Params.Count),
+	}
+
+	groups, total, err := c.Manager.SystemGroups.GetSystemGroups(ctx, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := transform.ToList(groups, systemgroup.ToAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	response := cmkapi.SystemGroupList{
+		Value: values,
+	}
+
+	if pagination.Count {
+		response.Count = new(total)
+	}
+
+	return cmkapi.GetSystemGroups200JSONResponse(response), nil
+}
+
+func (c *APIController) CreateSystemGroup(
+	ctx context.Context,
+	request cmkapi.CreateSystemGroupRequestObject,
+) (cmkapi.CreateSystemGroupResponseObject, error) {
+	group, err := systemgroup.FromAPI(*request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err = c.Manager.SystemGroups.CreateSystemGroup(ctx, group, ptr.GetSafeDeref(request.Body.SystemIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	apiGroup, err := systemgroup.ToAPI(*group)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.CreateSystemGroup201JSONResponse(*apiGroup), nil
+}
+
+func (c *APIController) GetSystemGroupByID(
+	ctx context.Context,
+	request cmkapi.GetSystemGroupByIDRequestObject,
+) (cmkapi.GetSystemGroupByIDResponseObject, error) {
+	group, err := c.Manager.SystemGroups.GetSystemGroupByID(ctx, request.SystemGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiGroup, err := systemgroup.ToAPI(*group)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.GetSystemGroupByID200JSONResponse(*apiGroup), nil
+}
+
+func (c *APIController) UpdateSystemGroup(
+	ctx context.Context,
+	request cmkapi.UpdateSystemGroupRequestObject,
+) (cmkapi.UpdateSystemGroupResponseObject, error) {
+	group, err := c.Manager.SystemGroups.UpdateSystemGroup(ctx, request.SystemGroupID, *request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	apiGroup, err := systemgroup.ToAPI(*group)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.UpdateSystemGroup200JSONResponse(*apiGroup), nil
+}
+
+func (c *APIController) DeleteSystemGroup(
+	ctx context.Context,
+	request cmkapi.DeleteSystemGroupRequestObject,
+) (cmkapi.DeleteSystemGroupResponseObject, error) {
+	err := c.Manager.SystemGroups.DeleteSystemGroup(ctx, request.SystemGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.DeleteSystemGroup204Response(struct{}{}), nil
+}
+
+func (c *APIController) GetSystemGroupSystems(
+	ctx context.Context,
+	request cmkapi.GetSystemGroupSystemsRequestObject,
+) (cmkapi.GetSystemGroupSystemsResponseObject, error) {
+	pagination := repo.Pagination{
+		Skip:  ptr.GetPtrOrDefault(request.Params.Skip, constants.DefaultSkip),
+		Top:   ptr.GetPtrOrDefault(request.Params.Top, constants.DefaultTop),
+		Count: ptr.GetSafeDeref(request.Params.Count),
+	}
+
+	systems, total, err := c.Manager.SystemGroups.GetSystemGroupSystems(ctx, request.SystemGroupID, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := transform.ToList(systems, func(sys model.System) (*cmkapi.System, error) {
+		return system.ToAPI(sys, &c.config.ContextModels.System)
+	})
+	if err != nil {
+		return nil, errs.Wrap(apierrors.ErrTransformSystemList, err)
+	}
+
+	response := cmkapi.SystemList{
+		Value: values,
+	}
+
+	if pagination.Count {
+		response.Count = new(total)
+	}
+
+	return cmkapi.GetSystemGroupSystems200JSONResponse(response), nil
+}
+
+func (c *APIController) LinkSystemGroupAction(
+	ctx context.Context,
+	request cmkapi.LinkSystemGroupActionRequestObject,
+) (cmkapi.LinkSystemGroupActionResponseObject, error) {
+	required, err := c.Manager.Workflow.IsWorkflowRequired(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if required {
+		return nil, apierrors.ErrActionRequireWorkflow
+	}
+
+	results, err := c.Manager.SystemGroups.LinkSystemGroup(
+		ctx,
+		request.SystemGroupID,
+		request.Body.KeyConfigurationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.LinkSystemGroupAction200JSONResponse(systemGroupActionResultToAPI(ctx, results)), nil
+}
+
+func (c *APIController) UnlinkSystemGroupAction(
+	ctx context.Context,
+	request cmkapi.UnlinkSystemGroupActionRequestObject,
+) (cmkapi.UnlinkSystemGroupActionResponseObject, error) {
+	required, err := c.Manager.Workflow.IsWorkflowRequired(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if required {
+		return nil, apierrors.ErrActionRequireWorkflow
+	}
+
+	results, err := c.Manager.SystemGroups.UnlinkSystemGroup(ctx, request.SystemGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmkapi.UnlinkSystemGroupAction200JSONResponse(systemGroupActionResultToAPI(ctx, results)), nil
+}
+
+// systemGroupActionResultToAPI exposes the per system errors
+// the same way they would be on the single system endpoints
+func systemGroupActionResultToAPI(
+	ctx context.Context,
+	results []manager.SystemGroupActionResult,
+) cmkapi.SystemGroupActionResult {
+	values := make([]cmkapi.SystemGroupSystemResult, len(results))
+
+	for i, r := range results {
+		values[i] = cmkapi.SystemGroupSystemResult{
+			SystemID: r.SystemID,
+			Result:   cmkapi.SystemGroupSystemResultResultTRIGGERED,
+		}
+
+		switch {
+		case r.Err != nil:
+			apiErr := apierrors.APIErrorMapper.Transform(ctx, r.Err)
+			values[i].Result = cmkapi.SystemGroupSystemResultResultFAILED
+			values[i].ErrorCode = &apiErr.Code
+			values[i].ErrorMessage = &apiErr.Message
+		case r.Skipped:
+			values[i].Result = cmkapi.SystemGroupSystemResultResultSKIPPED
+		}
+	}
+
+	return cmkapi.SystemGroupActionResult{
+		Value: values,
+	}
+}
@@ -0,0 +1,272 @@
+package cmk_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/openkcm/common-sdk/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/clients/registry/systems"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+func TestSystemGroups(t *testing.T) {
+	db, sv, tenant, keyStorage := startAPISystems(t, testutils.TestAPIServerConfig{})
+	ctx := cmkcontext.CreateTenantContext(t.Context(), tenant)
+	r := sql.NewRepository(db)
+
+	authClient := testutils.NewAuthClient(ctx, t, r, testutils.WithKeyAdminRole())
+
+	eu := testutils.NewSystem(func(s *model.System) {
+		s.Region = "eu10"
+		s.Status = cmkapi.SystemStatusCONNECTED
+	})
+	us := testutils.NewSystem(func(s *model.System) {
+		s.Region = "us10"
+	})
+
+	testutils.CreateTestEntities(ctx, t, r, eu, us)
+
+	privateKey, ok := keyStorage.GetPrivateKey(0)
+	assert.True(t, ok, "test key should exist")
+	headers := testutils.NewSignedBusinessUserDataHeaders(t, &auth.ClientData{
+		Identifier: authClient.Identifier,
+		Groups:     []string{authClient.Group.IAMIdentifier},
+	}, privateKey, 0)
+
+	var groupID uuid.UUID
+
+	t.Run("Should 201 on explicit group creation", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: "/systemGroups",
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.SystemGroup{
+				Name:      "landscape",
+				SystemIDs: &[]uuid.UUID{eu.ID, us.ID},
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.SystemGroup](t, w)
+		assert.Equal(t, "landscape", response.Name)
+		assert.Equal(t, cmkapi.SystemGroupStatus{Total: 2, Connected: 1, Disconnected: 1}, *response.Status)
+
+		groupID = *response.Id
+	})
+
+	t.Run("Should 409 on duplicated name", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: "/systemGroups",
+			Tenant:   tenant,
+			Body:     testutils.WithJSON(t, cmkapi.SystemGroup{Name: "landscape"}),
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("Should 400 on selector and systems", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: "/systemGroups",
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.SystemGroup{
+				Name:      "mixed",
+				Selector:  &cmkapi.SystemSelector{Region: new("eu10")},
+				SystemIDs: &[]uuid.UUID{eu.ID},
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.ErrorMessage](t, w)
+		assert.Equal(t, "INVALID_SYSTEM_GROUP_MEMBERSHIP", response.Error.Code)
+	})
+
+	t.Run("Should 200 on selector group systems", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPost,
+			Endpoint: "/systemGroups",
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.SystemGroup{
+				Name:     "europe",
+				Selector: &cmkapi.SystemSelector{Region: new("eu10")},
+			}),
+			Headers: headers,
+		})
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		group := testutils.GetJSONBody[cmkapi.SystemGroup](t, w)
+
+		w = testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodGet,
+			Endpoint: fmt.Sprintf("/systemGroups/%s/systems?$count=true", group.Id.String()),
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.SystemList](t, w)
+		assert.Equal(t, 1, *response.Count)
+		assert.Equal(t, eu.ID, *response.Value[0].ID)
+	})
+
+	t.Run("Should 200 on groups list", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodGet,
+			Endpoint: "/systemGroups?$count=true",
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.SystemGroupList](t, w)
+		assert.Equal(t, 2, *response.Count)
+	})
+
+	t.Run("Should 200 on group update", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPatch,
+			Endpoint: "/systemGroups/" + groupID.String(),
+			Tenant:   tenant,
+			Body: testutils.WithJSON(t, cmkapi.SystemGroupPatch{
+				SystemIDs: &[]uuid.UUID{us.ID},
+			}),
+			Headers: headers,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.SystemGroup](t, w)
+		assert.Equal(t, 1, response.Status.Total)
+	})
+
+	t.Run("Should 400 on group link requiring workflow", func(t *testing.T) {
+		_, err := manager.NewTenantConfigManager(r, nil, nil, nil, nil).
+			SetWorkflowConfig(ctx, testutils.NewDefaultWorkflowConfig(true))
+		assert.NoError(t, err)
+
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPatch,
+			Endpoint: fmt.Sprintf("/systemGroups/%s/link", groupID),
+			Tenant:   tenant,
+			Body:     testutils.WithString(t, fmt.Sprintf(`{"keyConfigurationID": "%s"}`, uuid.New())),
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.ErrorMessage](t, w)
+		assert.Equal(t, "ACTION_REQUIRE_WORKFLOW", response.Error.Code)
+	})
+
+	t.Run("Should 204 on group delete", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodDelete,
+			Endpoint: "/systemGroups/" + groupID.String(),
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+		assert.Equal(t, http.StatusNoContent, w.Code)
+
+		w = testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodGet,
+			Endpoint: "/systemGroups/" + groupID.String(),
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestSystemGroupLinkAction(t *testing.T) {
+	systemService := systems.NewFakeService(testutils.SetupLoggerWithBuffer())
+
+	_, grpcCon := testutils.NewGRPCSuite(
+		t,
+		func(s *grpc.Server) {
+			systemgrpc.RegisterServiceServer(s, systemService)
+		},
+	)
+
+	db, sv, tenant, keyStorage := startAPISystems(t, testutils.TestAPIServerConfig{
+		GRPCCon: grpcCon,
+	})
+	ctx := cmkcontext.CreateTenantContext(t.Context(), tenant)
+	r := sql.NewRepository(db)
+
+	disableWorkflow(t, ctx, r)
+
+	authClient := testutils.NewAuthClient(ctx, t, r, testutils.WithKeyAdminRole())
+
+	key := testutils.NewKey(func(_ *model.Key) {})
+	keyConfig := testutils.NewKeyConfig(func(k *model.KeyConfiguration) {
+		k.PrimaryKeyID = new(key.ID)
+	}, testutils.WithAuthBusinessUserDataKC(authClient))
+
+	linked := testutils.NewSystem(func(s *model.System) {
+		s.KeyConfigurationID = new(keyConfig.ID)
+		s.Status = cmkapi.SystemStatusCONNECTED
+	})
+	unlinked := testutils.NewSystem(func(_ *model.System) {})
+	failed := testutils.NewSystem(func(s *model.System) {
+		s.Status = cmkapi.SystemStatusFAILED
+	})
+	group := testutils.NewSystemGroup(func(_ *model.SystemGroup) {})
+
+	testutils.CreateTestEntities(ctx, t, r, key, keyConfig, linked, unlinked, failed, group)
+
+	for _, s := range []*model.System{linked, unlinked, failed} {
+		testutils.CreateTestEntities(ctx, t, r, &model.SystemGroupSystem{
+			SystemGroupID: group.ID,
+			SystemID:      s.ID,
+		})
+	}
+
+	privateKey, ok := keyStorage.GetPrivateKey(0)
+	assert.True(t, ok, "test key should exist")
+	headers := testutils.NewSignedBusinessUserDataHeaders(t, &auth.ClientData{
+		Identifier: authClient.Identifier,
+		Groups:     []string{authClient.Group.IAMIdentifier},
+	}, privateKey, 0)
+
+	w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+		Method:   http.MethodPatch,
+		Endpoint: fmt.Sprintf("/systemGroups/%s/link", group.ID),
+		Tenant:   tenant,
+		Body:     testutils.WithString(t, fmt.Sprintf(`{"keyConfigurationID": "%s"}`, keyConfig.ID)),
+		Headers:  headers,
+	})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	response := testutils.GetJSONBody[cmkapi.SystemGroupActionResult](t, w)
+	assert.Len(t, response.Value, 3)
+
+	results := make(map[uuid.UUID]cmkapi.SystemGroupSystemResult, len(response.Value))
+	for _, v := range response.Value {
+		results[v.SystemID] = v
+	}
+
+	assert.Equal(t, cmkapi.SystemGroupSystemResultResultSKIPPED, results[linked.ID].Result)
+	assert.Equal(t, cmkapi.SystemGroupSystemResultResultTRIGGERED, results[unlinked.ID].Result)
+	assert.Equal(t, cmkapi.SystemGroupSystemResultResultFAILED, results[failed.ID].Result)
+	assert.NotNil(t, results[failed.ID].ErrorCode)
+}
@@ -51,3 +51,31 @@ func (c *APIController) UpdateTenantWorkflowConfiguration(
 	apiConfig := tenantconfigs.WorkflowConfigToAPI(savedConfig)
 	return cmkapi.UpdateTenantWorkflowConfiguration200JSONResponse(*apiConfig), nil
 }
+
+func (c *APIController) GetTenantSystemAutoLinkConfiguration(
+	ctx context.Context,
+	_ cmkapi.GetTenantSystemAutoLinkConfigurationRequestObject,
+) (cmkapi.GetTenantSystemAutoLinkConfigurationResponseObject, error) {
+	autoLinkConfig, err := c.Manager.TenantConfigs.GetSystemAutoLinkConfig(ctx)
+	if err != nil {
+		return nil, errs.Wrap(apierrors.ErrGetSystemAutoLinkConfig, err)
+	}
+
+	apiConfig := tenantconfigs.SystemAutoLinkConfigToAPI(autoLinkConfig)
+	return cmkapi.GetTenantSystemAutoLinkConfiguration200JSONResponse(*apiConfig), nil
+}
+
+func (c *APIController) UpdateTenantSystemAutoLinkConfiguration(
+	ctx context.Context,
+	request cmkapi.UpdateTenantSystemAutoLinkConfigurationRequestObject,
+) (cmkapi.UpdateTenantSystemAutoLinkConfigurationResponseObject, error) {
+	autoLinkConfig := tenantconfigs.SystemAutoLinkConfigFromAPI(*request.Body)
+
+	savedConfig, err := c.Manager.TenantConfigs.SetSystemAutoLinkConfig(ctx, autoLinkConfig)
+	if err != nil {
+		return nil, errs.Wrap(apierrors.ErrSetSystemAutoLinkConfig, err)
+	}
+
+	apiConfig := tenantconfigs.SystemAutoLinkConfigToAPI(savedConfig)
+	return cmkapi.UpdateTenantSystemAutoLinkConfiguration200JSONResponse(*apiConfig), nil
+}
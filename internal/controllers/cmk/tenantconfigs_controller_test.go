@@ -426,3 +426,114 @@ func setupDefaultWorkflowConfig(t *testing.T, r *sql.ResourceRepository, ctx con
 	err = r.Set(ctx, tenantConfig, *repo.NewQuery())
 	require.NoError(t, err)
 }
+
+func TestAPIController_TenantSystemAutoLinkConfiguration(t *testing.T) {
+	db, sv, tenant, keyStorage := startAPIServerTenantConfig(t, testutils.TestAPIServerConfig{})
+	ctx := testutils.CreateCtxWithTenant(tenant)
+	r := sql.NewRepository(db)
+
+	authClient := testutils.NewAuthClient(ctx, t, r, testutils.WithTenantAdminRole())
+
+	keyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {},
+		testutils.WithAuthBusinessUserDataKC(authClient))
+	testutils.CreateTestEntities(ctx, t, r, keyConfig)
+
+	businessUserData := &auth.ClientData{
+		Identifier: authClient.Identifier,
+		Groups:     []string{authClient.Group.IAMIdentifier},
+	}
+	privateKey, ok := keyStorage.GetPrivateKey(0)
+	assert.True(t, ok, "test key should exist")
+	headers := testutils.NewSignedBusinessUserDataHeaders(t, businessUserData, privateKey, 0)
+
+	t.Run("Should 200 getting empty auto-link config", func(t *testing.T) {
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodGet,
+			Endpoint: "/tenantConfigurations/systemAutoLink",
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.TenantSystemAutoLinkConfiguration](t, w)
+		assert.Empty(t, response.Rules)
+	})
+
+	t.Run("Should 200 updating auto-link config", func(t *testing.T) {
+		updateRequest := cmkapi.TenantSystemAutoLinkConfiguration{
+			Rules: []cmkapi.SystemAutoLinkRule{
+				{
+					Name:               new("eu-subaccounts"),
+					Type:               new(string(model.SystemTypeSUBACCOUNT)),
+					Region:             new("eu10"),
+					KeyConfigurationID: keyConfig.ID,
+				},
+			},
+		}
+
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPut,
+			Endpoint: "/tenantConfigurations/systemAutoLink",
+			Tenant:   tenant,
+			Body:     testutils.WithJSON(t, updateRequest),
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodGet,
+			Endpoint: "/tenantConfigurations/systemAutoLink",
+			Tenant:   tenant,
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response := testutils.GetJSONBody[cmkapi.TenantSystemAutoLinkConfiguration](t, w)
+		assert.Equal(t, updateRequest, response)
+	})
+
+	t.Run("Should 400 INVALID_SETTING with unknown key configuration", func(t *testing.T) {
+		updateRequest := cmkapi.TenantSystemAutoLinkConfiguration{
+			Rules: []cmkapi.SystemAutoLinkRule{
+				{KeyConfigurationID: uuid.New()},
+			},
+		}
+
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPut,
+			Endpoint: "/tenantConfigurations/systemAutoLink",
+			Tenant:   tenant,
+			Body:     testutils.WithJSON(t, updateRequest),
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errResp cmkapi.ErrorMessage
+		err := json.Unmarshal(w.Body.Bytes(), &errResp)
+		require.NoError(t, err)
+		assert.Equal(t, "INVALID_SETTING", errResp.Error.Code)
+		assert.Equal(t, "keyConfigurationID", (*errResp.Error.Context)["setting"])
+	})
+
+	t.Run("Should 400 INVALID_SETTING with invalid system type", func(t *testing.T) {
+		updateRequest := cmkapi.TenantSystemAutoLinkConfiguration{
+			Rules: []cmkapi.SystemAutoLinkRule{
+				{Type: new("UNKNOWN"), KeyConfigurationID: keyConfig.ID},
+			},
+		}
+
+		w := testutils.MakeHTTPRequest(t, sv, testutils.RequestOptions{
+			Method:   http.MethodPut,
+			Endpoint: "/tenantConfigurations/systemAutoLink",
+			Tenant:   tenant,
+			Body:     testutils.WithJSON(t, updateRequest),
+			Headers:  headers,
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -198,6 +198,7 @@ func createHTTPServer(
 			Middlewares: []cmkapi.MiddlewareFunc{ // Middlewares are applied from last to first
 				middleware.AuthzMiddleware(ctr),
 				middleware.BusinessUserDataMiddleware(signingKeyStorage, cfg.ClientData.AuthContextFields, ctr.Manager.User),
+				middleware.APITokenMiddleware(ctr.Manager.APITokens),
 				middleware.OAPIMiddleware(swagger),
 				middleware.LoggingMiddleware(),
 				middleware.PanicRecoveryMiddleware(),
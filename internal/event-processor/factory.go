@@ -235,6 +235,29 @@ func (f *EventFactory) SystemKeyRotate(
 	return job, nil
 }
 
+// SystemKeyCheck creates a job comparing the key state of the tenant connected systems
+// with the key state reported by their regions. Make sure the ctx provided has the tenant set.
+// Only one check per tenant can be active, otherwise orbital.ErrJobAlreadyExists is returned.
+func (f *EventFactory) SystemKeyCheck(ctx context.Context) (orbital.Job, error) {
+	tenantID, err := cmkcontext.ExtractTenantID(ctx)
+	if err != nil {
+		return orbital.Job{}, err
+	}
+
+	jobData, err := json.Marshal(TenantActionJobData{TenantID: tenantID})
+	if err != nil {
+		return orbital.Job{}, err
+	}
+
+	event := &model.Event{
+		Identifier: tenantID,
+		Type:       JobTypeSystemKeyCheck.String(),
+		Data:       jobData,
+	}
+
+	return f.CreateJob(ctx, event)
+}
+
 // KeyEnable creates a job to enable a key make sure the ctx provided has the tenant set.
 func (f *EventFactory) KeyEnable(ctx context.Context, keyID string) (orbital.Job, error) {
 	return f.createKeyEventJob(ctx, keyID, JobTypeKeyEnable)
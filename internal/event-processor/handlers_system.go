@@ -8,6 +8,8 @@ import (
 
 	"github.com/openkcm/orbital"
 
+	protoPkg "google.golang.org/protobuf/proto"
+
 	typesv1 "github.com/openkcm/api-sdk/proto/kms/api/cmk/types/v1"
 
 	"github.com/openkcm/cmk/internal/api/cmkapi"
@@ -15,6 +17,7 @@ import (
 	"github.com/openkcm/cmk/internal/clients/registry"
 	"github.com/openkcm/cmk/internal/clients/registry/systems"
 	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/event-processor/proto"
 	"github.com/openkcm/cmk/internal/log"
 	"github.com/openkcm/cmk/internal/model"
 	"github.com/openkcm/cmk/internal/repo"
@@ -525,6 +528,15 @@ func (h *SystemKeyRotateJobHandler) HandleJobDoneEvent(ctx context.Context, job
 		slog.String("systemID", system.Identifier),
 		slog.String("keyID", data.KeyIDTo))
 
+	// Rotations sent to repair a drifted system bring it back to connected
+	if system.Status == cmkapi.SystemStatusDRIFTED {
+		system.Status = cmkapi.SystemStatusCONNECTED
+		err = updateSystem(ctx, h.repo, system)
+		if err != nil {
+			return err
+		}
+	}
+
 	//nolint:godox
 	// TODO: Add audit log when common-sdk provides CMK system key rotation event
 	// err = h.cmkAuditor.SendCmkSystemKeyRotationAuditLog(ctx, system.Identifier, data.KeyIDTo)
@@ -591,6 +603,246 @@ func (h *SystemKeyRotateJobHandler) HandleJobCanceledEvent(ctx context.Context,
 	return cleanUpEvent(ctx, h.repo, job)
 }
 
+// SystemKeyCheckJobHandler handles SYSTEM_KEY_CHECK events.
+// It compares the key state reported by the regions with the key configurations of the systems
+// and sets the systems not matching to DRIFTED. If auto repair is enabled,
+// the key switch or rotation is sent again to the drifted systems.
+type SystemKeyCheckJobHandler struct {
+	repo           repo.Repo
+	orbitalManager *orbital.Manager
+	taskResolver   *SystemKeyCheckTaskInfoResolver
+	eventFactory   *EventFactory
+	autoRepair     bool
+}
+
+func NewSystemKeyCheckJobHandler(
+	repo repo.Repo,
+	orbitalManager *orbital.Manager,
+	taskResolver *SystemKeyCheckTaskInfoResolver,
+	eventFactory *EventFactory,
+	autoRepair bool,
+) *SystemKeyCheckJobHandler {
+	return &SystemKeyCheckJobHandler{
+		repo:           repo,
+		orbitalManager: orbitalManager,
+		taskResolver:   taskResolver,
+		eventFactory:   eventFactory,
+		autoRepair:     autoRepair,
+	}
+}
+
+func (h *SystemKeyCheckJobHandler) ResolveTasks(
+	ctx context.Context,
+	job orbital.Job,
+) ([]orbital.TaskInfo, error) {
+	return h.taskResolver.Resolve(ctx, job)
+}
+
+func (h *SystemKeyCheckJobHandler) HandleJobConfirm(
+	_ context.Context,
+	_ orbital.Job,
+) (orbital.JobConfirmerResult, error) {
+	// The check is read only on the regions, systems are validated when the results are handled
+	return orbital.CompleteJobConfirmer(), nil
+}
+
+func (h *SystemKeyCheckJobHandler) HandleJobDoneEvent(ctx context.Context, job orbital.Job) error {
+	return h.handleResults(ctx, job)
+}
+
+// HandleJobFailedEvent still handles the results of the regions which completed the check
+func (h *SystemKeyCheckJobHandler) HandleJobFailedEvent(ctx context.Context, job orbital.Job) error {
+	errorMessage, err := mergeOrbitalTaskErrors(ctx, h.orbitalManager, job)
+	if err != nil {
+		errorMessage = job.ErrorMessage
+	}
+
+	log.Warn(ctx, "System key check failed for some regions",
+		slog.String("jobID", job.ID.String()),
+		slog.String("error", errorMessage))
+
+	return h.handleResults(ctx, job)
+}
+
+func (h *SystemKeyCheckJobHandler) HandleJobCanceledEvent(ctx context.Context, job orbital.Job) error {
+	log.Warn(ctx, "SYSTEM_KEY_CHECK job canceled - system state unchanged",
+		slog.String("jobID", job.ID.String()),
+		slog.String("error", job.ErrorMessage))
+
+	return nil
+}
+
+func (h *SystemKeyCheckJobHandler) handleResults(ctx context.Context, job orbital.Job) error {
+	ctx = log.InjectSystemEvent(ctx, job.Type)
+
+	data, err := unmarshalTenantJobData(job)
+	if err != nil {
+		return err
+	}
+
+	ctx = cmkcontext.CreateTenantContext(ctx, data.TenantID)
+
+	tasks, err := h.orbitalManager.ListTasks(ctx, orbital.ListTasksQuery{
+		JobID:  job.ID,
+		Status: orbital.TaskStatusDone,
+	})
+	if err != nil {
+		return err
+	}
+
+	loader := newSystemKeyStateLoader(h.repo)
+
+	for _, task := range tasks {
+		err := h.checkRegion(ctx, loader, task)
+		if err != nil {
+			log.Error(ctx, "Failed to check system key state of region", err, slog.String("region", task.Target))
+		}
+	}
+
+	return nil
+}
+
+func (h *SystemKeyCheckJobHandler) checkRegion(
+	ctx context.Context,
+	loader *systemKeyStateLoader,
+	task orbital.Task,
+) error {
+	requested := &proto.Data{}
+
+	err := protoPkg.Unmarshal(task.Data, requested)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal task data: %w", err)
+	}
+
+	result := &proto.SystemKeyCheckResult{}
+
+	err = protoPkg.Unmarshal(task.WorkingState, result)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal task working state: %w", err)
+	}
+
+	reported := make(map[string]*proto.SystemKeyState, len(result.GetSystems()))
+	for _, state := range result.GetSystems() {
+		reported[state.GetSystemId()] = state
+	}
+
+	for _, requestedState := range requested.GetSystemKeyCheck().GetSystems() {
+		system, err := getSystemByIdentifier(ctx, h.repo, requestedState.GetSystemId(), task.Target)
+		if errors.Is(err, repo.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		ctx := model.LogInjectSystem(ctx, system)
+
+		// Systems with an action in progress since the check was sent are left alone
+		if !system.IsConnected() {
+			continue
+		}
+
+		expected, err := loader.load(ctx, system)
+		if err != nil {
+			log.Error(ctx, "Failed to load expected system key state", err)
+			continue
+		}
+
+		err = h.checkSystem(ctx, system, expected, reported[system.Identifier])
+		if err != nil {
+			log.Error(ctx, "Failed to handle system key state", err)
+		}
+	}
+
+	return nil
+}
+
+func (h *SystemKeyCheckJobHandler) checkSystem(
+	ctx context.Context,
+	system *model.System,
+	expected *proto.SystemKeyState,
+	reported *proto.SystemKeyState,
+) error {
+	if !isKeyStateDrifted(expected, reported) {
+		if system.Status != cmkapi.SystemStatusDRIFTED {
+			return nil
+		}
+
+		log.Info(ctx, "System key state matches the key configuration again")
+
+		system.Status = cmkapi.SystemStatusCONNECTED
+
+		return updateSystem(ctx, h.repo, system)
+	}
+
+	log.Warn(ctx, "System key state drifted from the key configuration",
+		slog.String("expectedKeyID", expected.GetKeyId()),
+		slog.String("expectedKeyVersion", expected.GetKeyVersion()),
+		slog.String("reportedKeyID", reported.GetKeyId()),
+		slog.String("reportedKeyVersion", reported.GetKeyVersion()))
+
+	system.Status = cmkapi.SystemStatusDRIFTED
+
+	err := updateSystem(ctx, h.repo, system)
+	if err != nil {
+		return err
+	}
+
+	if !h.autoRepair {
+		return nil
+	}
+
+	// Rotations keep the system drifted until they are done, so the repair
+	// of an earlier check may still be in progress
+	pending, err := h.hasPendingEvent(ctx, system)
+	if err != nil {
+		return err
+	}
+
+	if pending {
+		log.Info(ctx, "Event of drifted system still in progress, skipping repair")
+		return nil
+	}
+
+	switch reported.GetKeyId() {
+	case "":
+		log.Warn(ctx, "No key reported for drifted system, manual repair required")
+		return nil
+	case expected.GetKeyId():
+		_, err = h.eventFactory.SystemKeyRotate(ctx, system, expected.GetKeyId())
+	default:
+		_, err = h.eventFactory.SystemSwitchNewPrimaryKey(ctx, system, expected.GetKeyId(), reported.GetKeyId())
+	}
+
+	return err
+}
+
+// hasPendingEvent checks if an event sent to the system has not terminated yet.
+// Events are removed once done, terminated events keep their error for retries.
+func (h *SystemKeyCheckJobHandler) hasPendingEvent(ctx context.Context, system *model.System) (bool, error) {
+	event, err := h.eventFactory.GetLastEvent(ctx, system.ID.String())
+	if errors.Is(err, ErrNoPreviousEvent) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return event.ErrorCode == "" && event.ErrorMessage == "", nil
+}
+
+// isKeyStateDrifted compares the reported key state with the expected one.
+// Versions are only compared if known on both sides.
+func isKeyStateDrifted(expected, reported *proto.SystemKeyState) bool {
+	if reported.GetKeyId() != expected.GetKeyId() {
+		return true
+	}
+
+	return expected.GetKeyVersion() != "" && reported.GetKeyVersion() != "" &&
+		expected.GetKeyVersion() != reported.GetKeyVersion()
+}
+
 func handleSystemJobConfirm(
 	ctx context.Context,
 	r repo.Repo,
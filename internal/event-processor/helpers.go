@@ -86,3 +86,14 @@ func mergeOrbitalTaskErrors(
 
 	return message, nil
 }
+
+func unmarshalTenantJobData(job orbital.Job) (TenantActionJobData, error) {
+	var data TenantActionJobData
+
+	err := json.Unmarshal(job.Data, &data)
+	if err != nil {
+		return TenantActionJobData{}, fmt.Errorf("failed to unmarshal job data: %w", err)
+	}
+
+	return data, nil
+}
@@ -33,6 +33,7 @@ const (
 	TaskType_SYSTEM_UNLINK     TaskType = 5
 	TaskType_SYSTEM_SWITCH     TaskType = 6
 	TaskType_KEY_DETACH        TaskType = 7
+	TaskType_SYSTEM_KEY_CHECK  TaskType = 8
 )
 
 // Enum value maps for TaskType.
@@ -46,6 +47,7 @@ var (
 		5: "SYSTEM_UNLINK",
 		6: "SYSTEM_SWITCH",
 		7: "KEY_DETACH",
+		8: "SYSTEM_KEY_CHECK",
 	}
 	TaskType_value = map[string]int32{
 		"KEY_ENABLE":        0,
@@ -56,6 +58,7 @@ var (
 		"SYSTEM_UNLINK":     5,
 		"SYSTEM_SWITCH":     6,
 		"KEY_DETACH":        7,
+		"SYSTEM_KEY_CHECK":  8,
 	}
 )
 
@@ -94,6 +97,7 @@ type Data struct {
 	//
 	//	*Data_KeyAction
 	//	*Data_SystemAction
+	//	*Data_SystemKeyCheck
 	Data          isData_Data `protobuf_oneof:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -161,6 +165,15 @@ func (x *Data) GetSystemAction() *SystemAction {
 	return nil
 }
 
+func (x *Data) GetSystemKeyCheck() *SystemKeyCheck {
+	if x != nil {
+		if x, ok := x.Data.(*Data_SystemKeyCheck); ok {
+			return x.SystemKeyCheck
+		}
+	}
+	return nil
+}
+
 type isData_Data interface {
 	isData_Data()
 }
@@ -173,10 +186,16 @@ type Data_SystemAction struct {
 	SystemAction *SystemAction `protobuf:"bytes,20,opt,name=system_action,json=systemAction,proto3,oneof"`
 }
 
+type Data_SystemKeyCheck struct {
+	SystemKeyCheck *SystemKeyCheck `protobuf:"bytes,30,opt,name=system_key_check,json=systemKeyCheck,proto3,oneof"`
+}
+
 func (*Data_KeyAction) isData_Data() {}
 
 func (*Data_SystemAction) isData_Data() {}
 
+func (*Data_SystemKeyCheck) isData_Data() {}
+
 // TaskType KEY_ENABLE, KEY_DISABLE, KEY_DELETE
 type KeyAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -363,17 +382,193 @@ func (x *SystemAction) GetCmkRegion() string {
 	return ""
 }
 
+// TaskType SYSTEM_KEY_CHECK
+// Lists the key state CMK expects for the connected systems of a region.
+type SystemKeyCheck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Systems       []*SystemKeyState      `protobuf:"bytes,10,rep,name=systems,proto3" json:"systems,omitempty"`
+	TenantId      string                 `protobuf:"bytes,20,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CmkRegion     string                 `protobuf:"bytes,30,opt,name=cmk_region,json=cmkRegion,proto3" json:"cmk_region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemKeyCheck) Reset() {
+	*x = SystemKeyCheck{}
+	mi := &file_internal_event_processor_proto_task_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemKeyCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemKeyCheck) ProtoMessage() {}
+
+func (x *SystemKeyCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_event_processor_proto_task_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemKeyCheck.ProtoReflect.Descriptor instead.
+func (*SystemKeyCheck) Descriptor() ([]byte, []int) {
+	return file_internal_event_processor_proto_task_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SystemKeyCheck) GetSystems() []*SystemKeyState {
+	if x != nil {
+		return x.Systems
+	}
+	return nil
+}
+
+func (x *SystemKeyCheck) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SystemKeyCheck) GetCmkRegion() string {
+	if x != nil {
+		return x.CmkRegion
+	}
+	return ""
+}
+
+// Reported by the region as the task working state of SYSTEM_KEY_CHECK.
+type SystemKeyCheckResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Systems       []*SystemKeyState      `protobuf:"bytes,10,rep,name=systems,proto3" json:"systems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemKeyCheckResult) Reset() {
+	*x = SystemKeyCheckResult{}
+	mi := &file_internal_event_processor_proto_task_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemKeyCheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemKeyCheckResult) ProtoMessage() {}
+
+func (x *SystemKeyCheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_event_processor_proto_task_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemKeyCheckResult.ProtoReflect.Descriptor instead.
+func (*SystemKeyCheckResult) Descriptor() ([]byte, []int) {
+	return file_internal_event_processor_proto_task_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SystemKeyCheckResult) GetSystems() []*SystemKeyState {
+	if x != nil {
+		return x.Systems
+	}
+	return nil
+}
+
+type SystemKeyState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemId      string                 `protobuf:"bytes,10,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	SystemType    string                 `protobuf:"bytes,20,opt,name=system_type,json=systemType,proto3" json:"system_type,omitempty"`
+	KeyId         string                 `protobuf:"bytes,30,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	KeyVersion    string                 `protobuf:"bytes,40,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemKeyState) Reset() {
+	*x = SystemKeyState{}
+	mi := &file_internal_event_processor_proto_task_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemKeyState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemKeyState) ProtoMessage() {}
+
+func (x *SystemKeyState) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_event_processor_proto_task_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemKeyState.ProtoReflect.Descriptor instead.
+func (*SystemKeyState) Descriptor() ([]byte, []int) {
+	return file_internal_event_processor_proto_task_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SystemKeyState) GetSystemId() string {
+	if x != nil {
+		return x.SystemId
+	}
+	return ""
+}
+
+func (x *SystemKeyState) GetSystemType() string {
+	if x != nil {
+		return x.SystemType
+	}
+	return ""
+}
+
+func (x *SystemKeyState) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *SystemKeyState) GetKeyVersion() string {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return ""
+}
+
 var File_internal_event_processor_proto_task_proto protoreflect.FileDescriptor
 
 const file_internal_event_processor_proto_task_proto_rawDesc = "" +
 	"\n" +
-	")internal/event-processor/proto/task.proto\x12\x05proto\"\xab\x01\n" +
+	")internal/event-processor/proto/task.proto\x12\x05proto\"\xee\x01\n" +
 	"\x04Data\x12,\n" +
 	"\ttask_type\x18\x05 \x01(\x0e2\x0f.proto.TaskTypeR\btaskType\x121\n" +
 	"\n" +
 	"key_action\x18\n" +
 	" \x01(\v2\x10.proto.KeyActionH\x00R\tkeyAction\x12:\n" +
-	"\rsystem_action\x18\x14 \x01(\v2\x13.proto.SystemActionH\x00R\fsystemActionB\x06\n" +
+	"\rsystem_action\x18\x14 \x01(\v2\x13.proto.SystemActionH\x00R\fsystemAction\x12A\n" +
+	"\x10system_key_check\x18\x1e \x01(\v2\x15.proto.SystemKeyCheckH\x00R\x0esystemKeyCheckB\x06\n" +
 	"\x04data\"^\n" +
 	"\tKeyAction\x12\x15\n" +
 	"\x06key_id\x18\n" +
@@ -395,7 +590,24 @@ const file_internal_event_processor_proto_task_proto_rawDesc = "" +
 	"\x0ftenant_owner_id\x183 \x01(\tR\rtenantOwnerId\x12*\n" +
 	"\x11tenant_owner_type\x184 \x01(\tR\x0ftenantOwnerType\x12\x1d\n" +
 	"\n" +
-	"cmk_region\x18< \x01(\tR\tcmkRegion*\x99\x01\n" +
+	"cmk_region\x18< \x01(\tR\tcmkRegion\"}\n" +
+	"\x0eSystemKeyCheck\x12/\n" +
+	"\asystems\x18\n" +
+	" \x03(\v2\x15.proto.SystemKeyStateR\asystems\x12\x1b\n" +
+	"\ttenant_id\x18\x14 \x01(\tR\btenantId\x12\x1d\n" +
+	"\n" +
+	"cmk_region\x18\x1e \x01(\tR\tcmkRegion\"G\n" +
+	"\x14SystemKeyCheckResult\x12/\n" +
+	"\asystems\x18\n" +
+	" \x03(\v2\x15.proto.SystemKeyStateR\asystems\"\x86\x01\n" +
+	"\x0eSystemKeyState\x12\x1b\n" +
+	"\tsystem_id\x18\n" +
+	" \x01(\tR\bsystemId\x12\x1f\n" +
+	"\vsystem_type\x18\x14 \x01(\tR\n" +
+	"systemType\x12\x15\n" +
+	"\x06key_id\x18\x1e \x01(\tR\x05keyId\x12\x1f\n" +
+	"\vkey_version\x18( \x01(\tR\n" +
+	"keyVersion*\xaf\x01\n" +
 	"\bTaskType\x12\x0e\n" +
 	"\n" +
 	"KEY_ENABLE\x10\x00\x12\x0f\n" +
@@ -407,7 +619,8 @@ const file_internal_event_processor_proto_task_proto_rawDesc = "" +
 	"\rSYSTEM_UNLINK\x10\x05\x12\x11\n" +
 	"\rSYSTEM_SWITCH\x10\x06\x12\x0e\n" +
 	"\n" +
-	"KEY_DETACH\x10\aB=Z;github.com/openkcm/cmk/internal/event-processor/proto;protob\x06proto3"
+	"KEY_DETACH\x10\a\x12\x14\n" +
+	"\x10SYSTEM_KEY_CHECK\x10\bB=Z;github.com/openkcm/cmk/internal/event-processor/proto;protob\x06proto3"
 
 var (
 	file_internal_event_processor_proto_task_proto_rawDescOnce sync.Once
@@ -422,22 +635,28 @@ func file_internal_event_processor_proto_task_proto_rawDescGZIP() []byte {
 }
 
 var file_internal_event_processor_proto_task_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_internal_event_processor_proto_task_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_internal_event_processor_proto_task_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_internal_event_processor_proto_task_proto_goTypes = []any{
-	(TaskType)(0),        // 0: proto.TaskType
-	(*Data)(nil),         // 1: proto.Data
-	(*KeyAction)(nil),    // 2: proto.KeyAction
-	(*SystemAction)(nil), // 3: proto.SystemAction
+	(TaskType)(0),                // 0: proto.TaskType
+	(*Data)(nil),                 // 1: proto.Data
+	(*KeyAction)(nil),            // 2: proto.KeyAction
+	(*SystemAction)(nil),         // 3: proto.SystemAction
+	(*SystemKeyCheck)(nil),       // 4: proto.SystemKeyCheck
+	(*SystemKeyCheckResult)(nil), // 5: proto.SystemKeyCheckResult
+	(*SystemKeyState)(nil),       // 6: proto.SystemKeyState
 }
 var file_internal_event_processor_proto_task_proto_depIdxs = []int32{
 	0, // 0: proto.Data.task_type:type_name -> proto.TaskType
 	2, // 1: proto.Data.key_action:type_name -> proto.KeyAction
 	3, // 2: proto.Data.system_action:type_name -> proto.SystemAction
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: proto.Data.system_key_check:type_name -> proto.SystemKeyCheck
+	6, // 4: proto.SystemKeyCheck.systems:type_name -> proto.SystemKeyState
+	6, // 5: proto.SystemKeyCheckResult.systems:type_name -> proto.SystemKeyState
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_internal_event_processor_proto_task_proto_init() }
@@ -448,6 +667,7 @@ func file_internal_event_processor_proto_task_proto_init() {
 	file_internal_event_processor_proto_task_proto_msgTypes[0].OneofWrappers = []any{
 		(*Data_KeyAction)(nil),
 		(*Data_SystemAction)(nil),
+		(*Data_SystemKeyCheck)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -455,7 +675,7 @@ func file_internal_event_processor_proto_task_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_event_processor_proto_task_proto_rawDesc), len(file_internal_event_processor_proto_task_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
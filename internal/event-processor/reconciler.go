@@ -18,6 +18,7 @@ import (
 	otelAttr "go.opentelemetry.io/otel/attribute"
 
 	"github.com/openkcm/cmk/internal/auditor"
+	audit_store "github.com/openkcm/cmk/internal/auditor/store"
 	"github.com/openkcm/cmk/internal/clients"
 	"github.com/openkcm/cmk/internal/clients/registry"
 	"github.com/openkcm/cmk/internal/config"
@@ -49,6 +50,8 @@ var (
 	ErrNoConnectedRegionsForKey  = errors.New("no connected regions found for key")
 	ErrNoTasksResolvedForJob     = errors.New("no tasks resolved for the job")
 	ErrKeyRotateMismatchedKeyIDs = errors.New("system key rotate requires identical key IDs")
+	ErrSystemNotLinked           = errors.New("system is not linked to a key configuration")
+	ErrNoPrimaryKey              = errors.New("key configuration has no primary key")
 )
 
 type Option func(manager *orbital.Manager)
@@ -114,7 +117,7 @@ func NewCryptoReconciler(
 		initiators = append(initiators, targets[region].Client)
 	}
 
-	cmkAuditor := auditor.New(ctx, cfg)
+	cmkAuditor := auditor.New(ctx, cfg, auditor.WithEventStore(audit_store.New(repository)))
 	tracer := getTracer(cfg)
 
 	reconciler := &CryptoReconciler{
@@ -165,6 +168,17 @@ func NewCryptoReconciler(
 		cfg:     cfg,
 	}
 
+	keyCheckResolver := &SystemKeyCheckTaskInfoResolver{
+		repo:    repository,
+		targets: targetMap,
+		cfg:     cfg,
+	}
+
+	eventFactory := &EventFactory{
+		repo:    repository,
+		manager: manager,
+	}
+
 	jobHandlerMap := map[JobType]JobHandler{
 		JobTypeSystemLink:   NewSystemLinkJobHandler(repository, registry, cmkAuditor, manager, systemResolver),
 		JobTypeSystemUnlink: NewSystemUnlinkJobHandler(repository, registry, cmkAuditor, manager, systemResolver),
@@ -177,6 +191,8 @@ func NewCryptoReconciler(
 		JobTypeKeyDisable:        NewKeyJobHandler(keyResolver),
 		JobTypeKeyDelete:         NewKeyJobHandler(keyResolver),
 		JobTypeKeyDetach:         NewKeyDetachJobHandler(repository, cmkAuditor, manager, keyResolver),
+		JobTypeSystemKeyCheck: NewSystemKeyCheckJobHandler(
+			repository, manager, keyCheckResolver, eventFactory, cfg.KeyDrift.AutoRepair),
 	}
 	reconciler.jobHandlerMap = jobHandlerMap
 
@@ -198,6 +198,14 @@ func TestResolveKeyTasks(t *testing.T) {
 		KeyConfigurationID: &keyConfigID,
 		Status:             cmkapi.SystemStatusDISCONNECTED,
 	}
+	driftedSystem := &model.System{
+		ID:                 uuid.New(),
+		Identifier:         "system-drifted",
+		Region:             "region-drifted",
+		Type:               model.SystemTypeSYSTEM,
+		KeyConfigurationID: &keyConfigID,
+		Status:             cmkapi.SystemStatusDRIFTED,
+	}
 	targetlessSystem := &model.System{
 		ID:                 uuid.New(),
 		Identifier:         "system-targetless",
@@ -219,6 +227,7 @@ func TestResolveKeyTasks(t *testing.T) {
 	instance := setupTestInstance(t, []string{
 		connectedSystem.Region,
 		disconnectedSystem.Region,
+		driftedSystem.Region,
 		keylessSystem.Region,
 		systemlessTarget,
 	})
@@ -227,12 +236,15 @@ func TestResolveKeyTasks(t *testing.T) {
 	tenant := instance.tenant
 
 	ctx := cmkcontext.CreateTenantContext(t.Context(), tenant)
-	for _, sys := range []*model.System{connectedSystem, disconnectedSystem, targetlessSystem, keylessSystem} {
+	systems := []*model.System{connectedSystem, disconnectedSystem, driftedSystem, targetlessSystem, keylessSystem}
+	for _, sys := range systems {
 		err := r.Create(cmkcontext.CreateTenantContext(t.Context(), tenant), sys)
 		assert.NoError(t, err)
 	}
 
-	allTargets := []string{connectedSystem.Region, disconnectedSystem.Region, keylessSystem.Region, systemlessTarget}
+	allTargets := []string{
+		connectedSystem.Region, disconnectedSystem.Region, driftedSystem.Region, keylessSystem.Region, systemlessTarget,
+	}
 
 	t.Run("should resolve targets for", func(t *testing.T) {
 		tests := []struct {
@@ -245,13 +257,13 @@ func TestResolveKeyTasks(t *testing.T) {
 				name:       "KEY_ENABLE task",
 				jobType:    eventprocessor.JobTypeKeyEnable.String(),
 				taskType:   eventProto.TaskType_KEY_ENABLE.String(),
-				expTargets: []string{connectedSystem.Region},
+				expTargets: []string{connectedSystem.Region, driftedSystem.Region},
 			},
 			{
 				name:       "KEY_DISABLE task",
 				jobType:    eventprocessor.JobTypeKeyDisable.String(),
 				taskType:   eventProto.TaskType_KEY_DISABLE.String(),
-				expTargets: []string{connectedSystem.Region},
+				expTargets: []string{connectedSystem.Region, driftedSystem.Region},
 			},
 			{
 				name:       "KEY_DETACH task",
@@ -1751,6 +1763,153 @@ func TestSystemKeyRotateJobHandler(t *testing.T) {
 	})
 }
 
+func TestSystemKeyCheckJobHandler(t *testing.T) {
+	region := "test-region"
+	instance := setupTestInstance(t, []string{region})
+	r := instance.repo
+	tenant := instance.tenant
+
+	ctx := cmkcontext.CreateTenantContext(t.Context(), tenant)
+
+	keyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {})
+	key := testutils.NewKey(func(k *model.Key) {
+		k.KeyConfigurationID = keyConfig.ID
+	})
+	version := testutils.NewKeyVersion(func(kv *model.KeyVersion) {
+		kv.KeyID = key.ID
+	})
+	keyConfig.PrimaryKeyID = &key.ID
+
+	newSystem := func(status cmkapi.SystemStatus) *model.System {
+		return testutils.NewSystem(func(s *model.System) {
+			s.Region = region
+			s.Status = status
+			s.KeyConfigurationID = &keyConfig.ID
+		})
+	}
+
+	matching := newSystem(cmkapi.SystemStatusCONNECTED)
+	otherKey := newSystem(cmkapi.SystemStatusCONNECTED)
+	otherVersion := newSystem(cmkapi.SystemStatusCONNECTED)
+	missing := newSystem(cmkapi.SystemStatusCONNECTED)
+	recovered := newSystem(cmkapi.SystemStatusDRIFTED)
+	processing := newSystem(cmkapi.SystemStatusPROCESSING)
+	unsupported := testutils.NewSystem(func(s *model.System) {
+		s.Status = cmkapi.SystemStatusCONNECTED
+		s.KeyConfigurationID = &keyConfig.ID
+	})
+
+	testutils.CreateTestEntities(ctx, t, r, keyConfig, key, version,
+		matching, otherKey, otherVersion, missing, recovered, processing, unsupported)
+
+	jobData, err := json.Marshal(eventprocessor.TenantActionJobData{TenantID: tenant})
+	assert.NoError(t, err)
+
+	job := orbital.NewJob(eventprocessor.JobTypeSystemKeyCheck.String(), jobData).WithExternalID(tenant)
+	job.ID = uuid.New()
+
+	handler, err := instance.reconciler.GetHandlerByJobType(eventprocessor.JobTypeSystemKeyCheck.String())
+	assert.NoError(t, err)
+
+	var taskData []byte
+
+	t.Run("ResolveTasks should list expected key state per region", func(t *testing.T) {
+		tasks, err := handler.ResolveTasks(ctx, job)
+		assert.NoError(t, err)
+
+		if assert.Len(t, tasks, 1) {
+			assert.Equal(t, region, tasks[0].Target)
+			assert.Equal(t, eventProto.TaskType_SYSTEM_KEY_CHECK.String(), tasks[0].Type)
+
+			var data eventProto.Data
+			assert.NoError(t, proto.Unmarshal(tasks[0].Data, &data))
+
+			check := data.GetSystemKeyCheck()
+			assert.Equal(t, tenant, check.GetTenantId())
+			assert.Len(t, check.GetSystems(), 5)
+
+			for _, state := range check.GetSystems() {
+				assert.Equal(t, key.ID.String(), state.GetKeyId())
+				assert.Equal(t, version.NativeID, state.GetKeyVersion())
+			}
+
+			taskData = tasks[0].Data
+		}
+	})
+
+	t.Run("HandleJobDoneEvent should set drifted systems", func(t *testing.T) {
+		reported := func(system *model.System, keyID, keyVersion string) *eventProto.SystemKeyState {
+			return &eventProto.SystemKeyState{
+				SystemId:   system.Identifier,
+				KeyId:      keyID,
+				KeyVersion: keyVersion,
+			}
+		}
+
+		workingState, err := proto.Marshal(&eventProto.SystemKeyCheckResult{
+			Systems: []*eventProto.SystemKeyState{
+				reported(matching, key.ID.String(), version.NativeID),
+				reported(otherKey, uuid.NewString(), version.NativeID),
+				reported(otherVersion, key.ID.String(), uuid.NewString()),
+				reported(recovered, key.ID.String(), ""),
+			},
+		})
+		assert.NoError(t, err)
+
+		// The processing system changed since the check was sent, so it is part of the request
+		request := &eventProto.Data{}
+		assert.NoError(t, proto.Unmarshal(taskData, request))
+		request.GetSystemKeyCheck().Systems = append(request.GetSystemKeyCheck().Systems,
+			reported(processing, uuid.NewString(), ""))
+		requestData, err := proto.Marshal(request)
+		assert.NoError(t, err)
+
+		now := time.Now().Unix()
+		err = instance.db.WithTenant(ctx, "orbital", func(tx *multitenancy.DB) error {
+			return tx.Table("tasks").Create(map[string]any{
+				"id":                   uuid.New(),
+				"job_id":               job.ID,
+				"type":                 eventProto.TaskType_SYSTEM_KEY_CHECK.String(),
+				"data":                 requestData,
+				"working_state":        workingState,
+				"status":               orbital.TaskStatusDone,
+				"target":               region,
+				"last_reconciled_at":   0,
+				"reconcile_count":      0,
+				"reconcile_after_sec":  0,
+				"total_sent_count":     0,
+				"total_received_count": 0,
+				"created_at":           now,
+				"updated_at":           now,
+			}).Error
+		})
+		require.NoError(t, err)
+
+		err = handler.HandleJobDoneEvent(ctx, job)
+		assert.NoError(t, err)
+
+		expected := map[*model.System]cmkapi.SystemStatus{
+			matching:     cmkapi.SystemStatusCONNECTED,
+			otherKey:     cmkapi.SystemStatusDRIFTED,
+			otherVersion: cmkapi.SystemStatusDRIFTED,
+			missing:      cmkapi.SystemStatusDRIFTED,
+			recovered:    cmkapi.SystemStatusCONNECTED,
+			processing:   cmkapi.SystemStatusPROCESSING,
+			unsupported:  cmkapi.SystemStatusCONNECTED,
+		}
+		for system, status := range expected {
+			_, err := r.First(ctx, system, *repo.NewQuery())
+			assert.NoError(t, err)
+			assert.Equal(t, status, system.Status, system.Identifier)
+		}
+	})
+
+	t.Run("HandleJobCanceledEvent should not change systems", func(t *testing.T) {
+		err := handler.HandleJobCanceledEvent(ctx, job)
+		assert.NoError(t, err)
+	})
+}
+
 func TestWithOptions(t *testing.T) {
 	t.Run("WithMaxReconcileCount", func(t *testing.T) {
 		var m orbital.Manager
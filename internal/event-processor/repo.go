@@ -31,6 +31,24 @@ func getSystemByID(ctx context.Context, r repo.Repo, systemID string) (*model.Sy
 	return &system, nil
 }
 
+func getSystemByIdentifier(ctx context.Context, r repo.Repo, identifier, region string) (*model.System, error) {
+	var system model.System
+
+	ck := repo.NewCompositeKey().
+		Where(repo.IdentifierField, identifier).
+		Where(repo.RegionField, region)
+	query := repo.NewQuery().Where(
+		repo.NewCompositeKeyGroup(ck),
+	)
+
+	_, err := r.First(ctx, &system, *query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &system, nil
+}
+
 func getKeyByKeyID(ctx context.Context, r repo.Repo, keyID string) (*model.Key, error) {
 	var key model.Key
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/openkcm/orbital"
 
 	protoPkg "google.golang.org/protobuf/proto"
@@ -317,6 +318,149 @@ func (r *SystemTaskInfoResolver) getKeyAccessMetadata(
 	return keyAccessMetadata, nil
 }
 
+// SystemKeyCheckTaskInfoResolver is responsible for resolving a task per region with connected systems,
+// listing the key state CMK expects for each of them.
+type SystemKeyCheckTaskInfoResolver struct {
+	repo    repo.Repo
+	targets map[string]struct{}
+	cfg     *config.Config
+}
+
+func (r *SystemKeyCheckTaskInfoResolver) Resolve(
+	ctx context.Context,
+	job orbital.Job,
+) ([]orbital.TaskInfo, error) {
+	data, err := unmarshalTenantJobData(job)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = cmkcontext.CreateTenantContext(ctx, data.TenantID)
+	loader := newSystemKeyStateLoader(r.repo)
+	checks := make(map[string]*proto.SystemKeyCheck)
+
+	// Drifted systems are checked again so they recover once the region reports the expected key
+	query := repo.NewQuery().Where(
+		repo.NewCompositeKeyGroup(
+			repo.NewCompositeKey().Where(repo.StatusField, []cmkapi.SystemStatus{
+				cmkapi.SystemStatusCONNECTED,
+				cmkapi.SystemStatusDRIFTED,
+			}),
+		),
+	)
+	err = repo.ProcessInBatch(ctx, r.repo, query, repo.DefaultLimit, func(systems []*model.System) error {
+		for _, system := range systems {
+			ctx := model.LogInjectSystem(ctx, system)
+
+			if _, ok := r.targets[system.Region]; !ok {
+				log.Error(ctx, "skipping key check for system as target is not configured", ErrUnsupportedRegion)
+				continue
+			}
+
+			state, err := loader.load(ctx, system)
+			if err != nil {
+				log.Error(ctx, "skipping key check for system without expected key state", err)
+				continue
+			}
+
+			check, ok := checks[system.Region]
+			if !ok {
+				check = &proto.SystemKeyCheck{
+					TenantId:  data.TenantID,
+					CmkRegion: r.cfg.Landscape.Region,
+				}
+				checks[system.Region] = check
+			}
+
+			check.Systems = append(check.Systems, state)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve systems to check: %w", err)
+	}
+
+	result := make([]orbital.TaskInfo, 0, len(checks))
+
+	for region, check := range checks {
+		taskData := &proto.Data{
+			TaskType: proto.TaskType_SYSTEM_KEY_CHECK,
+			Data: &proto.Data_SystemKeyCheck{
+				SystemKeyCheck: check,
+			},
+		}
+
+		taskDataBytes, err := protoPkg.Marshal(taskData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal task data: %w", err)
+		}
+
+		result = append(result, orbital.TaskInfo{
+			Target: region,
+			Data:   taskDataBytes,
+			Type:   proto.TaskType_SYSTEM_KEY_CHECK.String(),
+		})
+	}
+
+	return result, nil
+}
+
+// systemKeyStateLoader loads the key state CMK expects for linked systems.
+// The primary key and its newest version are cached per key configuration.
+type systemKeyStateLoader struct {
+	repo  repo.Repo
+	cache map[uuid.UUID]*proto.SystemKeyState
+}
+
+func newSystemKeyStateLoader(r repo.Repo) *systemKeyStateLoader {
+	return &systemKeyStateLoader{
+		repo:  r,
+		cache: make(map[uuid.UUID]*proto.SystemKeyState),
+	}
+}
+
+func (l *systemKeyStateLoader) load(ctx context.Context, system *model.System) (*proto.SystemKeyState, error) {
+	if system.KeyConfigurationID == nil {
+		return nil, ErrSystemNotLinked
+	}
+
+	keyState, ok := l.cache[*system.KeyConfigurationID]
+	if !ok {
+		keyConfig := &model.KeyConfiguration{ID: *system.KeyConfigurationID}
+
+		_, err := l.repo.First(ctx, keyConfig, *repo.NewQuery())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get key configuration %s: %w", keyConfig.ID, err)
+		}
+
+		if keyConfig.PrimaryKeyID == nil {
+			return nil, ErrNoPrimaryKey
+		}
+
+		keyID := keyConfig.PrimaryKeyID.String()
+
+		// Keys without versions are compared on the key only
+		version, err := getNewestKeyVersionNativeID(ctx, l.repo, keyID)
+		if err != nil && !errors.Is(err, repo.ErrNotFound) && !errors.Is(err, ErrVersionHasNoNativeID) {
+			return nil, err
+		}
+
+		keyState = &proto.SystemKeyState{
+			KeyId:      keyID,
+			KeyVersion: version,
+		}
+		l.cache[keyConfig.ID] = keyState
+	}
+
+	return &proto.SystemKeyState{
+		SystemId:   system.Identifier,
+		SystemType: strings.ToLower(string(system.Type)),
+		KeyId:      keyState.GetKeyId(),
+		KeyVersion: keyState.GetKeyVersion(),
+	}, nil
+}
+
 // KeyTaskInfoResolver is responsible for resolving the necessary information to create a TaskInfo
 // for key-related tasks such as enabling, disabling, detaching.
 type KeyTaskInfoResolver struct {
@@ -433,7 +577,7 @@ func (r *KeyTaskInfoResolver) getRegionsByKeyID(ctx context.Context, keyID strin
 	)
 	err = repo.ProcessInBatch(ctx, r.repo, query, repo.DefaultLimit, func(systems []*model.System) error {
 		for _, system := range systems {
-			if system.Status == cmkapi.SystemStatusCONNECTED {
+			if system.IsConnected() {
 				if _, ok := r.targets[system.Region]; !ok {
 					ctx := model.LogInjectSystem(ctx, system)
 					log.Error(ctx,
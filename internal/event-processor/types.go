@@ -19,6 +19,7 @@ const (
 	JobTypeSystemSwitch             JobType = "SYSTEM_SWITCH"
 	JobTypeSystemSwitchNewPK        JobType = "SYSTEM_SWITCH_NEW_PK"
 	JobTypeSystemKeyRotate          JobType = "SYSTEM_KEY_ROTATE"
+	JobTypeSystemKeyCheck           JobType = "SYSTEM_KEY_CHECK"
 	JobTypeKeyEnable                JobType = "KEY_ENABLE"
 	JobTypeKeyDisable               JobType = "KEY_DISABLE"
 	JobTypeKeyDetach                JobType = "KEY_DETACH"
@@ -53,3 +54,8 @@ type KeyConfigActionJobData struct {
 	TenantID    string `json:"tenantID"`
 	KeyConfigID string `json:"keyConfigID"`
 }
+
+// TenantActionJobData contains the data needed for a tenant wide orbital job.
+type TenantActionJobData struct {
+	TenantID string `json:"tenantID"`
+}
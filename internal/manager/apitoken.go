@@ -0,0 +1,277 @@
+package manager
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openkcm/common-sdk/pkg/auth"
+
+	otlpaudit "github.com/openkcm/common-sdk/pkg/otlp/audit"
+
+	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/log"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+const (
+	apiTokenSecretBytes = 32
+	// apiTokenPrefixLength is the length of the token prefix kept in clear text
+	apiTokenPrefixLength = len(constants.APITokenPrefix) + 8
+	// apiTokenLastUseInterval limits the writes of the last use of a token
+	apiTokenLastUseInterval = time.Minute
+	// apiTokenIdentifierPrefix starts the identifier of API token clients
+	apiTokenIdentifierPrefix = "api-token/"
+)
+
+// APITokenManager manages the API tokens authenticating machine clients
+// of a tenant with the role of a group
+type APITokenManager struct {
+	repo       repo.Repo
+	cmkAuditor *auditor.Auditor
+}
+
+func NewAPITokenManager(repository repo.Repo, cmkAuditor *auditor.Auditor) *APITokenManager {
+	return &APITokenManager{
+		repo:       repository,
+		cmkAuditor: cmkAuditor,
+	}
+}
+
+// HashAPIToken returns the stored hash of an API token. Tokens are random
+// with 256 bits of entropy, so an unsalted hash is not open to guessing.
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// APITokenClientData returns the client identity of requests authenticated
+// with the API token. The token acts with the role of its group.
+func APITokenClientData(token *model.APIToken) *auth.ClientData {
+	return &auth.ClientData{
+		Identifier: apiTokenIdentifierPrefix + token.ID.String(),
+		GivenName:  token.Name,
+		Groups:     []string{token.Group.IAMIdentifier},
+		Type:       constants.APITokenClientDataType,
+	}
+}
+
+// IsAPITokenClient returns true if the request in context is authenticated
+// with an API token
+func IsAPITokenClient(ctx context.Context) bool {
+	clientData, err := cmkcontext.ExtractBusinessUserData(ctx)
+	return err == nil && clientData.Type == constants.APITokenClientDataType
+}
+
+func (m *APITokenManager) GetAPITokens(
+	ctx context.Context,
+	pagination repo.Pagination,
+) ([]*model.APIToken, int, error) {
+	query := repo.NewQuery().Order(repo.OrderField{
+		Field:     repo.CreatedField,
+		Direction: repo.Asc,
+	})
+
+	tokens, count, err := repo.ListAndCount(ctx, m.repo, pagination, model.APIToken{}, query)
+	if err != nil {
+		return nil, 0, errs.Wrap(ErrListAPITokens, err)
+	}
+
+	return tokens, count, nil
+}
+
+func (m *APITokenManager) GetAPITokenByID(ctx context.Context, tokenID uuid.UUID) (*model.APIToken, error) {
+	token := &model.APIToken{ID: tokenID}
+
+	_, err := m.repo.First(ctx, token, *repo.NewQuery())
+	if err != nil {
+		return nil, errs.Wrap(ErrGetAPIToken, err)
+	}
+
+	return token, nil
+}
+
+// CreateAPIToken creates an API token bound to a group of the caller and returns
+// it with its secret. Only the hash of the secret is stored, so the secret can't
+// be read again.
+func (m *APITokenManager) CreateAPIToken(
+	ctx context.Context,
+	token *model.APIToken,
+) (*model.APIToken, string, error) {
+	if IsAPITokenClient(ctx) {
+		return nil, "", ErrAPITokenNotAllowed
+	}
+
+	now := time.Now()
+	if token.ExpiresAt.IsZero() {
+		token.ExpiresAt = now.Add(constants.DefaultAPITokenValidity)
+	}
+
+	if !token.ExpiresAt.After(now) || token.ExpiresAt.After(now.Add(constants.MaxAPITokenValidity)) {
+		return nil, "", ErrInvalidAPITokenExpiry
+	}
+
+	group := &model.Group{ID: token.GroupID}
+
+	_, err := m.repo.First(ctx, group, *repo.NewQuery())
+	if err != nil {
+		return nil, "", errs.Wrap(ErrGetGroups, err)
+	}
+
+	// The token acts with the role of its group, so binding it to another
+	// group would let the creator act with a role it doesn't hold
+	groups, err := cmkcontext.ExtractBusinessUserDataGroups(ctx)
+	if err != nil {
+		return nil, "", errs.Wrap(ErrCreateAPIToken, err)
+	}
+
+	if !slices.Contains(groups, group.IAMIdentifier) {
+		return nil, "", ErrAPITokenGroupNotOwn
+	}
+
+	createdBy, err := cmkcontext.ExtractUserIdentifier(ctx)
+	if err != nil {
+		return nil, "", errs.Wrap(ErrCreateAPIToken, err)
+	}
+
+	secret, err := newAPITokenSecret()
+	if err != nil {
+		return nil, "", errs.Wrap(ErrCreateAPIToken, err)
+	}
+
+	token.ID = uuid.New()
+	token.Prefix = secret[:apiTokenPrefixLength]
+	token.TokenHash = HashAPIToken(secret)
+	token.CreatedBy = createdBy
+	token.RevokedAt = nil
+	token.LastUsedAt = nil
+
+	err = m.repo.Create(ctx, token)
+	if err != nil {
+		return nil, "", errs.Wrap(ErrCreateAPIToken, err)
+	}
+
+	err = m.cmkAuditor.SendAPITokenCreateAuditLog(ctx, token.ID.String())
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for API token create", err)
+	}
+
+	return token, secret, nil
+}
+
+// RevokeAPIToken revokes an API token. The token is kept for auditing.
+func (m *APITokenManager) RevokeAPIToken(ctx context.Context, tokenID uuid.UUID) error {
+	token, err := m.GetAPITokenByID(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+
+	if token.RevokedAt != nil {
+		return nil
+	}
+
+	_, err = m.repo.Patch(ctx, &model.APIToken{ID: tokenID, RevokedAt: new(time.Now())}, *repo.NewQuery())
+	if err != nil {
+		return errs.Wrap(ErrRevokeAPIToken, err)
+	}
+
+	err = m.cmkAuditor.SendAPITokenRevokeAuditLog(ctx, tokenID.String())
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for API token revoke", err)
+	}
+
+	return nil
+}
+
+// Authenticate returns the active API token of the tenant in context with
+// its group. Every use and every rejection of a known token is audited,
+// request describes the request for the audit log.
+func (m *APITokenManager) Authenticate(ctx context.Context, secret, request string) (*model.APIToken, error) {
+	authCtx, err := cmkcontext.BusinessToInternalContext(ctx, constants.InternalBusinessAuthzRole)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(secret, constants.APITokenPrefix) {
+		return nil, ErrInvalidAPIToken
+	}
+
+	token := &model.APIToken{}
+
+	_, err = m.repo.First(
+		authCtx, token,
+		*repo.NewQuery().
+			Where(repo.NewCompositeKeyGroup(
+				repo.NewCompositeKey().Where(repo.TokenHashField, HashAPIToken(secret)),
+			)).
+			Preload(repo.Preload{"Group"}),
+	)
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil, ErrInvalidAPIToken
+	}
+
+	if err != nil {
+		return nil, errs.Wrap(ErrGetAPIToken, err)
+	}
+
+	now := time.Now()
+	tokenCtx := cmkcontext.InjectBusinessUserData(ctx, APITokenClientData(token), nil)
+
+	switch token.Status(now) {
+	case model.APITokenStatusRevoked:
+		m.auditRejectedToken(tokenCtx, token, otlpaudit.FAILREASON_TOKENREVOKED, request)
+		return nil, ErrAPITokenRevoked
+	case model.APITokenStatusExpired:
+		m.auditRejectedToken(tokenCtx, token, otlpaudit.FAILREASON_TOKENEXPIRED, request)
+		return nil, ErrAPITokenExpired
+	case model.APITokenStatusActive:
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) > apiTokenLastUseInterval {
+		_, err = m.repo.Patch(authCtx, &model.APIToken{ID: token.ID, LastUsedAt: &now}, *repo.NewQuery())
+		if err != nil {
+			log.Error(ctx, "Failed to record last use of API token", err)
+		}
+	}
+
+	err = m.cmkAuditor.SendAPITokenUseAuditLog(tokenCtx, token.ID.String(), request)
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for API token use", err)
+	}
+
+	return token, nil
+}
+
+func (m *APITokenManager) auditRejectedToken(
+	ctx context.Context,
+	token *model.APIToken,
+	reason otlpaudit.FailReason,
+	request string,
+) {
+	err := m.cmkAuditor.SendAPITokenRejectAuditLog(ctx, token.ID.String(), reason, request)
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for rejected API token", err)
+	}
+}
+
+func newAPITokenSecret() (string, error) {
+	b := make([]byte, apiTokenSecretBytes)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return constants.APITokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
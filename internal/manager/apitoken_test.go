@@ -0,0 +1,146 @@
+package manager_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+func TestAPITokenManager(t *testing.T) {
+	db, tenants, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
+	r := sql.NewRepository(db)
+	m := manager.NewAPITokenManager(r, auditor.New(t.Context(), &config.Config{}))
+	ctx := testutils.CreateCtxWithTenant(tenants[0])
+	group := testutils.NewGroup(func(_ *model.Group) {})
+	otherGroup := testutils.NewGroup(func(_ *model.Group) {})
+	testutils.CreateTestEntities(ctx, t, r, group, otherGroup)
+
+	userCtx := testutils.InjectBusinessUserDataIntoContext(ctx, "admin", []string{group.IAMIdentifier})
+
+	var (
+		token  *model.APIToken
+		secret string
+	)
+
+	t.Run("Should create API token and store only its hash", func(t *testing.T) {
+		var err error
+
+		token, secret, err = m.CreateAPIToken(userCtx, &model.APIToken{Name: "ci-pipeline", GroupID: group.ID})
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(secret, token.Prefix))
+		assert.Equal(t, "admin", token.CreatedBy)
+		assert.WithinDuration(t, time.Now().Add(constants.DefaultAPITokenValidity), token.ExpiresAt, time.Minute)
+
+		stored, err := m.GetAPITokenByID(ctx, token.ID)
+		require.NoError(t, err)
+		assert.Equal(t, manager.HashAPIToken(secret), stored.TokenHash)
+		assert.NotContains(t, stored.TokenHash, secret)
+	})
+
+	t.Run("Should fail to create API token on invalid expiry", func(t *testing.T) {
+		for _, expiresAt := range []time.Time{
+			time.Now().Add(-time.Hour),
+			time.Now().Add(constants.MaxAPITokenValidity + time.Hour),
+		} {
+			_, _, err := m.CreateAPIToken(userCtx, &model.APIToken{
+				Name:      "ci-pipeline",
+				GroupID:   group.ID,
+				ExpiresAt: expiresAt,
+			})
+			assert.ErrorIs(t, err, manager.ErrInvalidAPITokenExpiry)
+		}
+	})
+
+	t.Run("Should fail to create API token on unknown group", func(t *testing.T) {
+		_, _, err := m.CreateAPIToken(userCtx, &model.APIToken{Name: "ci-pipeline", GroupID: uuid.New()})
+		assert.ErrorIs(t, err, manager.ErrGetGroups)
+		assert.ErrorIs(t, err, repo.ErrNotFound)
+	})
+
+	t.Run("Should not create API token for a group of others", func(t *testing.T) {
+		_, _, err := m.CreateAPIToken(userCtx, &model.APIToken{Name: "ci-pipeline", GroupID: otherGroup.ID})
+		assert.ErrorIs(t, err, manager.ErrAPITokenGroupNotOwn)
+	})
+
+	t.Run("Should not create API token with API token", func(t *testing.T) {
+		tokenCtx := cmkcontext.InjectBusinessUserData(ctx, manager.APITokenClientData(token), nil)
+
+		_, _, err := m.CreateAPIToken(tokenCtx, &model.APIToken{Name: "ci-pipeline", GroupID: group.ID})
+		assert.ErrorIs(t, err, manager.ErrAPITokenNotAllowed)
+	})
+
+	t.Run("Should authenticate API token with its group", func(t *testing.T) {
+		authenticated, err := m.Authenticate(ctx, secret, "GET /keys")
+		require.NoError(t, err)
+		assert.Equal(t, token.ID, authenticated.ID)
+		assert.Equal(t, group.IAMIdentifier, authenticated.Group.IAMIdentifier)
+
+		stored, err := m.GetAPITokenByID(ctx, token.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, stored.LastUsedAt)
+
+		clientData := manager.APITokenClientData(authenticated)
+		assert.Equal(t, constants.APITokenClientDataType, clientData.Type)
+		assert.Equal(t, []string{group.IAMIdentifier}, clientData.Groups)
+	})
+
+	t.Run("Should reject unknown API token", func(t *testing.T) {
+		_, err := m.Authenticate(ctx, secret+"x", "GET /keys")
+		assert.ErrorIs(t, err, manager.ErrInvalidAPIToken)
+
+		_, err = m.Authenticate(ctx, "not-a-token", "GET /keys")
+		assert.ErrorIs(t, err, manager.ErrInvalidAPIToken)
+	})
+
+	t.Run("Should reject expired API token", func(t *testing.T) {
+		expiredSecret := constants.APITokenPrefix + "expired"
+		expired := testutils.NewAPIToken(func(tk *model.APIToken) {
+			tk.GroupID = group.ID
+			tk.TokenHash = manager.HashAPIToken(expiredSecret)
+			tk.ExpiresAt = time.Now().Add(-time.Hour)
+		})
+		testutils.CreateTestEntities(ctx, t, r, expired)
+
+		_, err := m.Authenticate(ctx, expiredSecret, "GET /keys")
+		assert.ErrorIs(t, err, manager.ErrAPITokenExpired)
+	})
+
+	t.Run("Should reject revoked API token", func(t *testing.T) {
+		err := m.RevokeAPIToken(userCtx, token.ID)
+		require.NoError(t, err)
+
+		// Revoking twice keeps the first revocation
+		err = m.RevokeAPIToken(userCtx, token.ID)
+		assert.NoError(t, err)
+
+		_, err = m.Authenticate(ctx, secret, "GET /keys")
+		assert.ErrorIs(t, err, manager.ErrAPITokenRevoked)
+	})
+
+	t.Run("Should list API tokens", func(t *testing.T) {
+		tokens, count, err := m.GetAPITokens(ctx, repo.Pagination{Count: true})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.Len(t, tokens, 2)
+	})
+
+	t.Run("Should fail to revoke unknown API token", func(t *testing.T) {
+		err := m.RevokeAPIToken(userCtx, uuid.New())
+		assert.ErrorIs(t, err, manager.ErrGetAPIToken)
+		assert.ErrorIs(t, err, repo.ErrNotFound)
+	})
+}
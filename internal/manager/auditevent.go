@@ -0,0 +1,37 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+)
+
+type AuditEventManager struct {
+	repo repo.Repo
+}
+
+func NewAuditEventManager(repository repo.Repo) *AuditEventManager {
+	return &AuditEventManager{
+		repo: repository,
+	}
+}
+
+// GetAuditEvents returns the audit events stored for the tenant, newest first
+func (m *AuditEventManager) GetAuditEvents(
+	ctx context.Context,
+	params repo.QueryMapper,
+) ([]*model.AuditEvent, int, error) {
+	query := params.GetQuery(ctx).Order(repo.OrderField{
+		Field:     repo.CreatedField,
+		Direction: repo.Desc,
+	})
+
+	events, count, err := repo.ListAndCount(ctx, m.repo, params.GetPagination(), model.AuditEvent{}, query)
+	if err != nil {
+		return nil, 0, errs.Wrap(ErrListAuditEvents, err)
+	}
+
+	return events, count, nil
+}
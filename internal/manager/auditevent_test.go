@@ -0,0 +1,61 @@
+package manager_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+	"github.com/openkcm/cmk/utils/odata"
+)
+
+func TestGetAuditEvents(t *testing.T) {
+	db, tenants, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
+	r := sql.NewRepository(db)
+	m := manager.NewAuditEventManager(r)
+	ctx := cmkcontext.CreateTenantContext(t.Context(), tenants[0])
+
+	now := time.Now().UTC()
+	events := make([]repo.Resource, 0, 3)
+
+	for i := range 3 {
+		events = append(events, &model.AuditEvent{
+			ID:        uuid.New(),
+			EventType: "keyUpdate",
+			ObjectID:  uuid.NewString(),
+			ActorID:   "user",
+			CreatedAt: now.Add(time.Duration(i) * time.Minute),
+		})
+	}
+
+	testutils.CreateTestEntities(ctx, t, r, events...)
+
+	t.Run("Should list newest events first", func(t *testing.T) {
+		mapper := odata.NewQueryOdataMapper(odata.FilterSchema{})
+		mapper.SetPaging(nil, new(2), new(true))
+
+		res, count, err := m.GetAuditEvents(ctx, mapper)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+		assert.Len(t, res, 2)
+		assert.Equal(t, events[2].(*model.AuditEvent).ID, res[0].ID)
+		assert.Equal(t, events[1].(*model.AuditEvent).ID, res[1].ID)
+	})
+
+	t.Run("Should error on list failure", func(t *testing.T) {
+		forced := testutils.NewDBErrorForced(db, ErrForced)
+
+		forced.Register()
+		defer forced.Unregister()
+
+		_, _, err := m.GetAuditEvents(ctx, odata.NewQueryOdataMapper(odata.FilterSchema{}))
+		assert.ErrorIs(t, err, manager.ErrListAuditEvents)
+	})
+}
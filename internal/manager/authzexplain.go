@@ -0,0 +1,353 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/openkcm/common-sdk/pkg/auth"
+
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+// AuthzExplainRequest describes an API request of a user to explain.
+// Without a user identifier no role elevations are evaluated.
+type AuthzExplainRequest struct {
+	UserIdentifier string
+	Groups         []string
+	Method         string
+	Path           string
+	ResourceID     *uuid.UUID
+}
+
+// ExplainedGroup is a group of the user and the role it holds
+type ExplainedGroup struct {
+	IAMIdentifier string
+	Found         bool
+	Role          constants.BusinessRole
+	BaseRole      constants.BusinessRole
+}
+
+// ExplainedPolicy is a policy of a role of the user groups, or of a role
+// elevation of the user if RoleElevationID is set
+type ExplainedPolicy struct {
+	Role            constants.BusinessRole
+	PolicyID        constants.PolicyID
+	GrantsAccess    bool
+	RoleElevationID *uuid.UUID
+}
+
+// AuthzExplanation holds the steps of an authorization decision
+type AuthzExplanation struct {
+	Endpoint    string
+	AllowListed bool
+	Restriction *authz.Restricted
+	Groups      []ExplainedGroup
+	Policies    []ExplainedPolicy
+	Allowed     bool
+	Reason      string
+	GroupFilter *AccessDecision
+}
+
+// AuthzExplainManager explains the authorization decisions of the API
+// for a user of the tenant. It evaluates the stored groups and roles,
+// so changes not yet picked up by the authorization handlers are reflected.
+type AuthzExplainManager struct {
+	repo repo.Repo
+	user User
+}
+
+func NewAuthzExplainManager(repository repo.Repo, userManager User) *AuthzExplainManager {
+	return &AuthzExplainManager{
+		repo: repository,
+		user: userManager,
+	}
+}
+
+func (m *AuthzExplainManager) Explain(
+	ctx context.Context,
+	request AuthzExplainRequest,
+) (*AuthzExplanation, error) {
+	explanation := &AuthzExplanation{
+		Groups:   []ExplainedGroup{},
+		Policies: []ExplainedPolicy{},
+	}
+
+	endpoint, found := authz.MatchAPIPattern(request.Method, request.Path)
+	if !found {
+		explanation.Reason = "No API endpoint matches the method and path"
+		return explanation, nil
+	}
+
+	explanation.Endpoint = endpoint
+
+	if _, ok := authz.AllowListByAPI[endpoint]; ok {
+		explanation.AllowListed = true
+		explanation.Allowed = true
+		explanation.Reason = "API endpoint is on the allow list"
+
+		return explanation, nil
+	}
+
+	restriction := authz.RestrictionsByAPI[endpoint]
+	explanation.Restriction = &restriction
+
+	err := m.explainGroups(ctx, explanation, request.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.explainPolicies(ctx, explanation)
+	if err != nil {
+		return nil, err
+	}
+
+	if !explanation.Allowed {
+		err = m.explainRoleElevations(ctx, explanation, request)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !explanation.Allowed {
+		return explanation, nil
+	}
+
+	decision, err := m.explainGroupFilter(ctx, request, restriction)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation.GroupFilter = decision
+
+	return explanation, nil
+}
+
+func (m *AuthzExplainManager) explainGroups(
+	ctx context.Context,
+	explanation *AuthzExplanation,
+	iamIdentifiers []string,
+) error {
+	var groups []model.Group
+
+	err := m.repo.List(
+		ctx, &model.Group{}, &groups,
+		*repo.NewQuery().Where(
+			repo.NewCompositeKeyGroup(
+				repo.NewCompositeKey().Where(repo.IAMIdField, iamIdentifiers),
+			),
+		),
+	)
+	if err != nil {
+		return errs.Wrap(ErrExplainAuthz, err)
+	}
+
+	roles := make(map[string]constants.BusinessRole, len(groups))
+	for _, group := range groups {
+		roles[group.IAMIdentifier] = group.Role
+	}
+
+	for _, iamIdentifier := range iamIdentifiers {
+		role, ok := roles[iamIdentifier]
+
+		explanation.Groups = append(explanation.Groups, ExplainedGroup{
+			IAMIdentifier: iamIdentifier,
+			Found:         ok,
+			Role:          role,
+		})
+	}
+
+	return nil
+}
+
+// explainPolicies evaluates the policies of the group roles. Like the
+// authorization handler, a request is allowed if any group role grants it.
+func (m *AuthzExplainManager) explainPolicies(ctx context.Context, explanation *AuthzExplanation) error {
+	restriction := *explanation.Restriction
+	evaluated := make(map[constants.BusinessRole]constants.BusinessRole)
+
+	for i, group := range explanation.Groups {
+		if !group.Found {
+			continue
+		}
+
+		baseRole, ok := evaluated[group.Role]
+		if !ok {
+			policies, base, err := rolePolicies(ctx, m.repo, group.Role)
+			if err != nil {
+				return errs.Wrap(ErrExplainAuthz, err)
+			}
+
+			baseRole = base
+			evaluated[group.Role] = base
+
+			for _, policy := range policies {
+				grants := authz.IsGranted(
+					[]authz.Policy[authz.APIResourceType, authz.APIAction]{policy},
+					restriction.APIResourceTypeName, restriction.APIAction,
+				)
+
+				explanation.Policies = append(explanation.Policies, ExplainedPolicy{
+					Role:         group.Role,
+					PolicyID:     policy.ID,
+					GrantsAccess: grants,
+				})
+
+				if grants && !explanation.Allowed {
+					explanation.Allowed = true
+					explanation.Reason = fmt.Sprintf("Granted by policy %s of role %s", policy.ID, group.Role)
+				}
+			}
+		}
+
+		explanation.Groups[i].BaseRole = baseRole
+	}
+
+	switch {
+	case explanation.Allowed:
+	case len(evaluated) == 0:
+		explanation.Reason = "None of the groups exist in the tenant"
+	default:
+		explanation.Reason = fmt.Sprintf("No policy of the group roles grants %s on %s",
+			restriction.APIAction, restriction.APIResourceTypeName)
+	}
+
+	return nil
+}
+
+// explainRoleElevations evaluates the policies of the active role elevations
+// of the user. Like the authorization handler, elevations only apply within
+// the key configuration of the resource.
+func (m *AuthzExplainManager) explainRoleElevations(
+	ctx context.Context,
+	explanation *AuthzExplanation,
+	request AuthzExplainRequest,
+) error {
+	restriction := *explanation.Restriction
+
+	keyConfigID, err := m.keyConfigurationScope(ctx, restriction.APIResourceTypeName, request.ResourceID)
+	if err != nil || keyConfigID == uuid.Nil {
+		return err
+	}
+
+	elevations, err := activeRoleElevations(ctx, m.repo, request.UserIdentifier, &keyConfigID)
+	if err != nil {
+		return errs.Wrap(ErrExplainAuthz, err)
+	}
+
+	for _, elevation := range elevations {
+		policies, _, err := rolePolicies(ctx, m.repo, elevation.Role)
+		if err != nil {
+			return errs.Wrap(ErrExplainAuthz, err)
+		}
+
+		for _, policy := range policies {
+			grants := authz.IsGranted(
+				[]authz.Policy[authz.APIResourceType, authz.APIAction]{policy},
+				restriction.APIResourceTypeName, restriction.APIAction,
+			)
+
+			explanation.Policies = append(explanation.Policies, ExplainedPolicy{
+				Role:            elevation.Role,
+				PolicyID:        policy.ID,
+				GrantsAccess:    grants,
+				RoleElevationID: &elevation.ID,
+			})
+
+			if grants && !explanation.Allowed {
+				explanation.Allowed = true
+				explanation.Reason = fmt.Sprintf("Granted by policy %s of role %s elevated on key configuration %s",
+					policy.ID, elevation.Role, elevation.KeyConfigurationID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// keyConfigurationScope returns the key configuration of the resource, or
+// uuid.Nil if the resource is not scoped to one
+func (m *AuthzExplainManager) keyConfigurationScope(
+	ctx context.Context,
+	resource authz.APIResourceType,
+	resourceID *uuid.UUID,
+) (uuid.UUID, error) {
+	if resourceID == nil {
+		return uuid.Nil, nil
+	}
+
+	authCtx, err := cmkcontext.BusinessToInternalContext(ctx,
+		constants.InternalBusinessAuthzRole)
+	if err != nil {
+		return uuid.Nil, errs.Wrap(ErrExplainAuthz, err)
+	}
+
+	switch resource {
+	case authz.APIResourceTypeKeyConfiguration:
+		return *resourceID, nil
+	case authz.APIResourceTypeKey:
+		key := &model.Key{ID: *resourceID}
+
+		_, err = m.repo.First(authCtx, key, *repo.NewQuery())
+		if err != nil {
+			return uuid.Nil, ignoreNotFound(err)
+		}
+
+		return key.KeyConfigurationID, nil
+	case authz.APIResourceTypeSystem:
+		system := &model.System{ID: *resourceID}
+
+		_, err = m.repo.First(authCtx, system, *repo.NewQuery())
+		if err != nil || system.KeyConfigurationID == nil {
+			return uuid.Nil, ignoreNotFound(err)
+		}
+
+		return *system.KeyConfigurationID, nil
+	default:
+		return uuid.Nil, nil
+	}
+}
+
+// ignoreNotFound drops not found errors, the group filter explains missing resources
+func ignoreNotFound(err error) error {
+	if err == nil || errors.Is(err, repo.ErrNotFound) {
+		return nil
+	}
+
+	return errs.Wrap(ErrExplainAuthz, err)
+}
+
+// explainGroupFilter evaluates the group filter and resource access decisions
+// as the explained user, never with the identity of the caller
+func (m *AuthzExplainManager) explainGroupFilter(
+	ctx context.Context,
+	request AuthzExplainRequest,
+	restriction authz.Restricted,
+) (*AccessDecision, error) {
+	userCtx := cmkcontext.InjectBusinessUserData(ctx, &auth.ClientData{
+		Identifier: request.UserIdentifier,
+		Groups:     request.Groups,
+	}, nil)
+
+	decision, err := m.user.ExplainAccess(
+		userCtx, restriction.APIAction, restriction.APIResourceTypeName, request.ResourceID,
+	)
+
+	switch {
+	case err == nil:
+	case errors.Is(err, repo.ErrNotFound):
+		decision.Reason = "Resource does not exist"
+	case errors.Is(err, ErrMultipleRolesInGroups), errors.Is(err, ErrZeroRolesInGroups):
+		decision.Reason = err.Error()
+	default:
+		return nil, errs.Wrap(ErrExplainAuthz, err)
+	}
+
+	return &decision, nil
+}
@@ -0,0 +1,211 @@
+package manager_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+)
+
+func TestAuthzExplainManager(t *testing.T) {
+	userManager, db, tenant := SetupUserManager(t)
+	r := sql.NewRepository(db)
+	m := manager.NewAuthzExplainManager(r, userManager)
+	ctx := testutils.CreateCtxWithTenant(tenant)
+	ctx = testutils.InjectBusinessUserDataIntoContext(ctx, "admin", []string{"tenant-admins"})
+
+	keyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {})
+	otherKeyAdmins := testutils.NewGroup(func(_ *model.Group) {})
+	tenantAdmins := testutils.NewGroup(func(g *model.Group) {
+		g.Role = constants.TenantAdminRole
+	})
+	customRole := testutils.NewCustomRole(func(_ *model.CustomRole) {})
+	operators := testutils.NewGroup(func(g *model.Group) {
+		g.Role = customRole.Name
+	})
+	testutils.CreateTestEntities(ctx, t, r, keyConfig, otherKeyAdmins, tenantAdmins, customRole, operators)
+
+	t.Run("Should allow allow listed endpoint", func(t *testing.T) {
+		explanation, err := m.Explain(ctx, manager.AuthzExplainRequest{
+			Method: "GET",
+			Path:   "/userInfo",
+		})
+		assert.NoError(t, err)
+		assert.True(t, explanation.AllowListed)
+		assert.True(t, explanation.Allowed)
+		assert.Nil(t, explanation.Restriction)
+	})
+
+	t.Run("Should not allow unknown endpoint", func(t *testing.T) {
+		explanation, err := m.Explain(ctx, manager.AuthzExplainRequest{
+			Groups: []string{tenantAdmins.IAMIdentifier},
+			Method: "GET",
+			Path:   "/unknown",
+		})
+		assert.NoError(t, err)
+		assert.False(t, explanation.Allowed)
+		assert.Empty(t, explanation.Endpoint)
+	})
+
+	t.Run("Should explain built-in role decisions", func(t *testing.T) {
+		explanation, err := m.Explain(ctx, manager.AuthzExplainRequest{
+			Groups: []string{tenantAdmins.IAMIdentifier},
+			Method: "GET",
+			Path:   "/keys/" + uuid.NewString(),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "GET /keys/{keyID}", explanation.Endpoint)
+		assert.Equal(t, &authz.Restricted{
+			APIResourceTypeName: authz.APIResourceTypeKey,
+			APIAction:           authz.APIActionRead,
+		}, explanation.Restriction)
+		assert.False(t, explanation.Allowed)
+		assert.Nil(t, explanation.GroupFilter)
+		assert.Equal(t, []manager.ExplainedPolicy{{
+			Role:     constants.TenantAdminRole,
+			PolicyID: constants.TenantAdminPolicy,
+		}}, explanation.Policies)
+
+		explanation, err = m.Explain(ctx, manager.AuthzExplainRequest{
+			Groups: []string{tenantAdmins.IAMIdentifier, otherKeyAdmins.IAMIdentifier},
+			Method: "get",
+			Path:   "/keys?$top=10",
+		})
+		assert.NoError(t, err)
+		assert.True(t, explanation.Allowed)
+		assert.Len(t, explanation.Groups, 2)
+		assert.NotNil(t, explanation.GroupFilter)
+	})
+
+	t.Run("Should explain unknown groups", func(t *testing.T) {
+		explanation, err := m.Explain(ctx, manager.AuthzExplainRequest{
+			Groups: []string{"unknown"},
+			Method: "GET",
+			Path:   "/keys",
+		})
+		assert.NoError(t, err)
+		assert.False(t, explanation.Allowed)
+		assert.Equal(t, []manager.ExplainedGroup{{IAMIdentifier: "unknown"}}, explanation.Groups)
+		assert.Empty(t, explanation.Policies)
+	})
+
+	t.Run("Should explain custom role decisions", func(t *testing.T) {
+		explanation, err := m.Explain(ctx, manager.AuthzExplainRequest{
+			Groups: []string{operators.IAMIdentifier},
+			Method: "PATCH",
+			Path:   "/keys/" + uuid.NewString(),
+		})
+		assert.NoError(t, err)
+		assert.True(t, explanation.Allowed)
+		assert.Equal(t, constants.KeyAdminRole, explanation.Groups[0].BaseRole)
+
+		explanation, err = m.Explain(ctx, manager.AuthzExplainRequest{
+			Groups: []string{operators.IAMIdentifier},
+			Method: "DELETE",
+			Path:   "/keys/" + uuid.NewString(),
+		})
+		assert.NoError(t, err)
+		assert.False(t, explanation.Allowed)
+	})
+
+	t.Run("Should explain group filter decisions", func(t *testing.T) {
+		path := "/keyConfigurations/" + keyConfig.ID.String()
+
+		explanation, err := m.Explain(ctx, manager.AuthzExplainRequest{
+			Groups:     []string{keyConfig.AdminGroup.IAMIdentifier},
+			Method:     "GET",
+			Path:       path,
+			ResourceID: &keyConfig.ID,
+		})
+		assert.NoError(t, err)
+		assert.True(t, explanation.Allowed)
+		assert.True(t, explanation.GroupFilter.GroupFiltered)
+		assert.True(t, explanation.GroupFilter.ResourceChecked)
+		assert.True(t, explanation.GroupFilter.ResourceAllowed)
+
+		explanation, err = m.Explain(ctx, manager.AuthzExplainRequest{
+			Groups:     []string{otherKeyAdmins.IAMIdentifier},
+			Method:     "GET",
+			Path:       path,
+			ResourceID: &keyConfig.ID,
+		})
+		assert.NoError(t, err)
+		assert.True(t, explanation.Allowed)
+		assert.True(t, explanation.GroupFilter.ResourceChecked)
+		assert.False(t, explanation.GroupFilter.ResourceAllowed)
+		assert.NotEmpty(t, explanation.GroupFilter.Reason)
+	})
+
+	t.Run("Should explain with the role elevations of the explained user", func(t *testing.T) {
+		elevation := &model.RoleElevation{
+			ID:                 uuid.New(),
+			UserID:             "admin",
+			KeyConfigurationID: keyConfig.ID,
+			Role:               constants.KeyAdminRole,
+			WorkflowID:         uuid.New(),
+			ExpiresAt:          time.Now().Add(time.Hour),
+		}
+		testutils.CreateTestEntities(ctx, t, r, elevation)
+
+		request := manager.AuthzExplainRequest{
+			Groups:     []string{otherKeyAdmins.IAMIdentifier},
+			Method:     "PATCH",
+			Path:       "/keyConfigurations/" + keyConfig.ID.String(),
+			ResourceID: &keyConfig.ID,
+		}
+
+		// The elevation of the caller does not apply to the explained user
+		explanation, err := m.Explain(ctx, request)
+		assert.NoError(t, err)
+		assert.False(t, explanation.GroupFilter.ResourceAllowed)
+
+		request.UserIdentifier = elevation.UserID
+
+		explanation, err = m.Explain(ctx, request)
+		assert.NoError(t, err)
+		assert.True(t, explanation.GroupFilter.ResourceAllowed)
+
+		// The elevated role grants the endpoint to groups whose roles do not
+		request.Groups = []string{tenantAdmins.IAMIdentifier}
+
+		explanation, err = m.Explain(ctx, request)
+		assert.NoError(t, err)
+		assert.True(t, explanation.Allowed)
+		assert.Contains(t, explanation.Reason, "elevated on key configuration")
+		assert.True(t, slices.ContainsFunc(explanation.Policies, func(policy manager.ExplainedPolicy) bool {
+			return policy.GrantsAccess && policy.RoleElevationID != nil && *policy.RoleElevationID == elevation.ID
+		}))
+
+		// Elevations only apply within their key configuration
+		otherKeyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {})
+		testutils.CreateTestEntities(ctx, t, r, otherKeyConfig)
+
+		request.Path = "/keyConfigurations/" + otherKeyConfig.ID.String()
+		request.ResourceID = &otherKeyConfig.ID
+
+		explanation, err = m.Explain(ctx, request)
+		assert.NoError(t, err)
+		assert.False(t, explanation.Allowed)
+	})
+
+	t.Run("Should explain unknown resource", func(t *testing.T) {
+		explanation, err := m.Explain(ctx, manager.AuthzExplainRequest{
+			Groups:     []string{otherKeyAdmins.IAMIdentifier},
+			Method:     "GET",
+			Path:       "/keys/" + uuid.NewString(),
+			ResourceID: new(uuid.New()),
+		})
+		assert.NoError(t, err)
+		assert.False(t, explanation.GroupFilter.ResourceAllowed)
+		assert.Equal(t, "Resource does not exist", explanation.GroupFilter.Reason)
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/openkcm/cmk/internal/async"
 	"github.com/openkcm/cmk/internal/auditor"
+	audit_store "github.com/openkcm/cmk/internal/auditor/store"
 	"github.com/openkcm/cmk/internal/authz"
 	authz_loader "github.com/openkcm/cmk/internal/authz/loader"
 	"github.com/openkcm/cmk/internal/clients"
@@ -20,13 +21,21 @@ type Manager struct {
 	KeyVersions   *KeyVersionManager
 	TenantConfigs *TenantConfigManager
 	System        System
+	SystemGroups  *SystemGroupManager
 	KeyConfig     KeyConfigurationAPI
 	Tags          Tags
 	Labels        Label
 	Workflow      Workflow
 	Certificates  *CertificateManager
 	Group         *GroupManager
+	CustomRoles   *CustomRoleManager
+	Grants        *ResourceGrantManager
+	AuthzExplain  *AuthzExplainManager
+	RoleElevation *RoleElevationManager
 	User          User
+	AuditEvents   *AuditEventManager
+	Capabilities  *CapabilityManager
+	APITokens     *APITokenManager
 
 	Tenant Tenant
 
@@ -48,11 +57,11 @@ func New(
 	asyncClient async.Client,
 	migrator db.Migrator,
 ) *Manager {
-	cmkAuditor := auditor.New(ctx, config)
-	certManager := NewCertificateManager(ctx, repo, svcRegistry, config)
-	tenantConfigManager := NewTenantConfigManager(repo, svcRegistry, config, certManager)
+	cmkAuditor := auditor.New(ctx, config, auditor.WithEventStore(audit_store.New(repo)))
+	certManager := NewCertificateManager(ctx, repo, svcRegistry, config, cmkAuditor)
+	tenantConfigManager := NewTenantConfigManager(repo, svcRegistry, config, certManager, cmkAuditor)
 	userManager := NewUserManager(repo, cmkAuditor)
-	tagManager := NewTagManager(repo)
+	tagManager := NewTagManager(repo, cmkAuditor)
 	keyConfigManager := NewKeyConfigManager(repo, certManager, userManager, tagManager, cmkAuditor, eventFactory, config)
 	keyManager := NewKeyManager(
 		repo,
@@ -76,31 +85,42 @@ func New(
 		keyConfigManager,
 		userManager,
 	)
-	groupManager := NewGroupManager(repo, svcRegistry, userManager)
+	groupManager := NewGroupManager(repo, svcRegistry, userManager, cmkAuditor)
+	workflowManager := NewWorkflowManager(
+		repo,
+		svcRegistry,
+		keyManager,
+		keyConfigManager,
+		systemManager,
+		groupManager,
+		userManager,
+		asyncClient,
+		tenantConfigManager,
+		config,
+		cmkAuditor,
+	)
+	systemManager.workflow = workflowManager
 
 	return &Manager{
 		Keys:          keyManager,
 		KeyVersions:   NewKeyVersionManager(repo, svcRegistry, tenantConfigManager, certManager, cmkAuditor),
 		TenantConfigs: tenantConfigManager,
 		System:        systemManager,
+		SystemGroups:  NewSystemGroupManager(repo, systemManager),
+		AuditEvents:   NewAuditEventManager(repo),
+		Capabilities:  NewCapabilityManager(svcRegistry),
 		KeyConfig:     keyConfigManager,
-		Tags:          NewTagManager(repo),
-		Labels:        NewLabelManager(repo),
-		Workflow: NewWorkflowManager(
-			repo,
-			svcRegistry,
-			keyManager,
-			keyConfigManager,
-			systemManager,
-			groupManager,
-			userManager,
-			asyncClient,
-			tenantConfigManager,
-			config,
-		),
-		Certificates: certManager,
-		Group:        groupManager,
-		User:         userManager,
+		Tags:          NewTagManager(repo, cmkAuditor),
+		Labels:        NewLabelManager(repo, cmkAuditor),
+		Workflow:      workflowManager,
+		Certificates:  certManager,
+		Group:         groupManager,
+		CustomRoles:   NewCustomRoleManager(repo, cmkAuditor),
+		Grants:        NewResourceGrantManager(repo, cmkAuditor),
+		AuthzExplain:  NewAuthzExplainManager(repo, userManager),
+		RoleElevation: NewRoleElevationManager(repo, cmkAuditor),
+		APITokens:     NewAPITokenManager(repo, cmkAuditor),
+		User:          userManager,
 
 		Tenant: NewTenantManager(repo, systemManager, keyManager, userManager, cmkAuditor, migrator),
 
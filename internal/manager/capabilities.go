@@ -0,0 +1,31 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/openkcm/cmk/internal/errs"
+	serviceapi "github.com/openkcm/cmk/internal/pluginregistry/service/api"
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/keymanagement"
+)
+
+type CapabilityManager struct {
+	svcRegistry serviceapi.Registry
+}
+
+func NewCapabilityManager(svcRegistry serviceapi.Registry) *CapabilityManager {
+	return &CapabilityManager{
+		svcRegistry: svcRegistry,
+	}
+}
+
+// GetKeystoreCapabilities returns the capabilities of the keystore providers by name
+func (m *CapabilityManager) GetKeystoreCapabilities(
+	_ context.Context,
+) (map[string]keymanagement.Capabilities, error) {
+	capabilities, err := serviceapi.KeyManagementCapabilities(m.svcRegistry)
+	if err != nil {
+		return nil, errs.Wrap(ErrGetCapabilities, err)
+	}
+
+	return capabilities, nil
+}
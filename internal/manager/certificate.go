@@ -14,6 +14,7 @@ import (
 	"github.com/openkcm/common-sdk/pkg/commoncfg"
 	"gopkg.in/yaml.v3"
 
+	"github.com/openkcm/cmk/internal/auditor"
 	"github.com/openkcm/cmk/internal/config"
 	"github.com/openkcm/cmk/internal/errs"
 	"github.com/openkcm/cmk/internal/log"
@@ -47,6 +48,7 @@ type CertificateManager struct {
 	certIssuer          certificateissuer.CertificateIssuer
 	cfg                 *config.Config
 	privateKeyGenerator func() (*rsa.PrivateKey, error)
+	cmkAuditor          *auditor.Auditor
 }
 
 func NewCertificateManager(
@@ -54,6 +56,7 @@ func NewCertificateManager(
 	repo repo.Repo,
 	svcRegistry serviceapi.Registry,
 	cfg *config.Config,
+	cmkAuditor *auditor.Auditor,
 ) *CertificateManager {
 	certIssuer, err := svcRegistry.CertificateIssuer()
 	if err != nil {
@@ -64,6 +67,7 @@ func NewCertificateManager(
 		repo:       repo,
 		certIssuer: certIssuer,
 		cfg:        cfg,
+		cmkAuditor: cmkAuditor,
 	}
 }
 
@@ -175,6 +179,11 @@ func (m *CertificateManager) RotateCertificate(ctx context.Context,
 		return nil, nil, errs.Wrap(ErrCertificateManager, err)
 	}
 
+	err = m.cmkAuditor.SendCertificateCreateAuditLog(ctx, cert.ID.String())
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for certificate rotation", err)
+	}
+
 	return cert, pk, nil
 }
 
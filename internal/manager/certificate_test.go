@@ -62,6 +62,7 @@ func SetupCertificateManager(
 		dbRepository,
 		svcRegistry,
 		cfg,
+		nil,
 	)
 
 	return m, db, tenants[0]
@@ -0,0 +1,234 @@
+package manager
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform/customrole"
+	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/log"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+// CustomRoleManager manages the business roles defined by a tenant.
+// The authorization handlers pick up changes on their next reload.
+type CustomRoleManager struct {
+	repo       repo.Repo
+	cmkAuditor *auditor.Auditor
+}
+
+func NewCustomRoleManager(repository repo.Repo, cmkAuditor *auditor.Auditor) *CustomRoleManager {
+	return &CustomRoleManager{
+		repo:       repository,
+		cmkAuditor: cmkAuditor,
+	}
+}
+
+func (m *CustomRoleManager) GetCustomRoles(
+	ctx context.Context,
+	pagination repo.Pagination,
+) ([]*model.CustomRole, int, error) {
+	query := repo.NewQuery().Order(repo.OrderField{
+		Field:     repo.NameField,
+		Direction: repo.Asc,
+	})
+
+	roles, count, err := repo.ListAndCount(ctx, m.repo, pagination, model.CustomRole{}, query)
+	if err != nil {
+		return nil, 0, errs.Wrap(ErrListCustomRoles, err)
+	}
+
+	return roles, count, nil
+}
+
+func (m *CustomRoleManager) GetCustomRoleByID(ctx context.Context, id uuid.UUID) (*model.CustomRole, error) {
+	role := &model.CustomRole{ID: id}
+
+	_, err := m.repo.First(ctx, role, *repo.NewQuery())
+	if err != nil {
+		return nil, errs.Wrap(ErrGetCustomRole, err)
+	}
+
+	return role, nil
+}
+
+func (m *CustomRoleManager) CreateCustomRole(ctx context.Context, role *model.CustomRole) (*model.CustomRole, error) {
+	err := m.repo.Create(ctx, role)
+	if err != nil {
+		return nil, errs.Wrap(ErrCreateCustomRole, err)
+	}
+
+	err = m.cmkAuditor.SendConfigCreateAuditLog(ctx, role.ID.String(), string(role.Name))
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for custom role create", err)
+	}
+
+	return role, nil
+}
+
+// UpdateCustomRole updates the description and permissions of a custom role.
+// The name and base role are immutable as groups reference the role by name.
+func (m *CustomRoleManager) UpdateCustomRole(
+	ctx context.Context,
+	id uuid.UUID,
+	patchRole cmkapi.CustomRolePatch,
+) (*model.CustomRole, error) {
+	role, err := m.GetCustomRoleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := *role
+
+	if patchRole.Description != nil {
+		role.Description = *patchRole.Description
+	}
+
+	if patchRole.Permissions != nil {
+		err = role.SetPermissions(customrole.PermissionsFromAPI(*patchRole.Permissions))
+		if err != nil {
+			return nil, errs.Wrap(ErrUpdateCustomRole, err)
+		}
+	}
+
+	_, err = m.repo.Patch(ctx, role, *repo.NewQuery())
+	if err != nil {
+		return nil, errs.Wrap(ErrUpdateCustomRole, err)
+	}
+
+	if string(previous.Permissions) != string(role.Permissions) {
+		err = m.cmkAuditor.SendConfigUpdateAuditLog(
+			ctx, role.ID.String(), string(previous.Permissions), string(role.Permissions),
+		)
+		if err != nil {
+			log.Error(ctx, "Failed to send audit log for custom role update", err)
+		}
+	}
+
+	return role, nil
+}
+
+// DeleteCustomRole deletes a custom role that is not referenced by any group
+func (m *CustomRoleManager) DeleteCustomRole(ctx context.Context, id uuid.UUID) error {
+	role, err := m.GetCustomRoleByID(ctx, id)
+	if err != nil {
+		return errs.Wrap(ErrDeleteCustomRole, err)
+	}
+
+	count, err := m.repo.Count(
+		ctx, &model.Group{},
+		*repo.NewQuery().Where(
+			repo.NewCompositeKeyGroup(
+				repo.NewCompositeKey().Where(repo.RoleField, role.Name),
+			),
+		).SetLimit(0),
+	)
+	if err != nil {
+		return errs.Wrap(ErrDeleteCustomRole, err)
+	}
+
+	if count > 0 {
+		return ErrCustomRoleInUse
+	}
+
+	_, err = m.repo.Delete(ctx, &model.CustomRole{ID: id}, *repo.NewQuery())
+	if err != nil {
+		return errs.Wrap(ErrDeleteCustomRole, err)
+	}
+
+	err = m.cmkAuditor.SendConfigDeleteAuditLog(ctx, role.ID.String(), string(role.Name))
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for custom role delete", err)
+	}
+
+	return nil
+}
+
+// resolveBaseRole returns the built-in role a business role acts as.
+// Built-in roles resolve to themselves and custom roles to their base role.
+func resolveBaseRole(
+	ctx context.Context,
+	r repo.Repo,
+	role constants.BusinessRole,
+) (constants.BusinessRole, error) {
+	if role == "" || authz.IsBuiltInRole(role) {
+		return role, nil
+	}
+
+	authCtx, err := cmkcontext.BusinessToInternalContext(ctx,
+		constants.InternalBusinessAuthzRole)
+	if err != nil {
+		return "", err
+	}
+
+	customRole := &model.CustomRole{}
+
+	_, err = r.First(
+		authCtx, customRole,
+		*repo.NewQuery().Where(
+			repo.NewCompositeKeyGroup(
+				repo.NewCompositeKey().Where(repo.NameField, role),
+			),
+		),
+	)
+	if err != nil {
+		return "", errs.Wrap(ErrGetCustomRole, err)
+	}
+
+	return customRole.BaseRole, nil
+}
+
+// rolePolicies returns the API policies and base role of a built-in or custom role.
+// Unknown and invalid custom roles have no policies, as for the authorization handler.
+func rolePolicies(
+	ctx context.Context,
+	r repo.Repo,
+	role constants.BusinessRole,
+) ([]authz.Policy[authz.APIResourceType, authz.APIAction], constants.BusinessRole, error) {
+	if authz.IsBuiltInRole(role) {
+		return authz.APIPolicies[role], role, nil
+	}
+
+	authCtx, err := cmkcontext.BusinessToInternalContext(ctx,
+		constants.InternalBusinessAuthzRole)
+	if err != nil {
+		return nil, "", err
+	}
+
+	customRole := &model.CustomRole{}
+
+	_, err = r.First(
+		authCtx, customRole,
+		*repo.NewQuery().Where(
+			repo.NewCompositeKeyGroup(
+				repo.NewCompositeKey().Where(repo.NameField, role),
+			),
+		),
+	)
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil, "", nil
+	}
+
+	if err != nil {
+		return nil, "", errs.Wrap(ErrGetCustomRole, err)
+	}
+
+	authzRole, err := customRole.ToAuthz()
+	if err == nil {
+		err = authzRole.Validate()
+	}
+
+	if err != nil {
+		return nil, customRole.BaseRole, nil
+	}
+
+	return authz.APICustomRolePolicies(authzRole), customRole.BaseRole, nil
+}
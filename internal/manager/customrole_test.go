@@ -0,0 +1,112 @@
+package manager_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+)
+
+func TestCustomRoleManager(t *testing.T) {
+	db, tenants, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
+	r := sql.NewRepository(db)
+	m := manager.NewCustomRoleManager(r, auditor.New(t.Context(), &config.Config{}))
+	ctx := testutils.CreateCtxWithTenant(tenants[0])
+
+	t.Run("Should create and get custom role", func(t *testing.T) {
+		role, err := m.CreateCustomRole(ctx, testutils.NewCustomRole(func(_ *model.CustomRole) {}))
+		assert.NoError(t, err)
+
+		res, err := m.GetCustomRoleByID(ctx, role.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, role.Name, res.Name)
+		assert.Equal(t, role.BaseRole, res.BaseRole)
+	})
+
+	t.Run("Should fail on duplicated name", func(t *testing.T) {
+		role := testutils.NewCustomRole(func(_ *model.CustomRole) {})
+		testutils.CreateTestEntities(ctx, t, r, role)
+
+		_, err := m.CreateCustomRole(ctx, testutils.NewCustomRole(func(c *model.CustomRole) {
+			c.Name = role.Name
+		}))
+		assert.ErrorIs(t, err, manager.ErrCreateCustomRole)
+		assert.ErrorIs(t, err, repo.ErrUniqueConstraint)
+	})
+
+	t.Run("Should list custom roles", func(t *testing.T) {
+		_, count, err := m.GetCustomRoles(ctx, repo.Pagination{Count: true})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("Should fail to get unknown custom role", func(t *testing.T) {
+		_, err := m.GetCustomRoleByID(ctx, uuid.New())
+		assert.ErrorIs(t, err, manager.ErrGetCustomRole)
+		assert.ErrorIs(t, err, repo.ErrNotFound)
+	})
+
+	t.Run("Should update permissions", func(t *testing.T) {
+		role := testutils.NewCustomRole(func(_ *model.CustomRole) {})
+		testutils.CreateTestEntities(ctx, t, r, role)
+
+		res, err := m.UpdateCustomRole(ctx, role.ID, cmkapi.CustomRolePatch{
+			Description: new("operates keys"),
+			Permissions: &[]cmkapi.CustomRolePermission{
+				{ResourceType: "Key", Actions: []string{"read"}},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "operates keys", res.Description)
+
+		permissions, err := res.GetPermissions()
+		assert.NoError(t, err)
+		assert.Equal(t, []model.CustomRolePermission{
+			{ResourceType: authz.APIResourceTypeKey, Actions: []authz.APIAction{authz.APIActionRead}},
+		}, permissions)
+	})
+
+	t.Run("Should fail to update permissions exceeding base role", func(t *testing.T) {
+		role := testutils.NewCustomRole(func(_ *model.CustomRole) {})
+		testutils.CreateTestEntities(ctx, t, r, role)
+
+		_, err := m.UpdateCustomRole(ctx, role.ID, cmkapi.CustomRolePatch{
+			Permissions: &[]cmkapi.CustomRolePermission{
+				{ResourceType: "UserGroup", Actions: []string{"create"}},
+			},
+		})
+		assert.ErrorIs(t, err, authz.ErrCustomRolePermission)
+	})
+
+	t.Run("Should delete custom role", func(t *testing.T) {
+		role := testutils.NewCustomRole(func(_ *model.CustomRole) {})
+		testutils.CreateTestEntities(ctx, t, r, role)
+
+		err := m.DeleteCustomRole(ctx, role.ID)
+		assert.NoError(t, err)
+
+		_, err = m.GetCustomRoleByID(ctx, role.ID)
+		assert.ErrorIs(t, err, repo.ErrNotFound)
+	})
+
+	t.Run("Should not delete custom role referenced by a group", func(t *testing.T) {
+		role := testutils.NewCustomRole(func(_ *model.CustomRole) {})
+		group := testutils.NewGroup(func(g *model.Group) {
+			g.Role = role.Name
+		})
+		testutils.CreateTestEntities(ctx, t, r, role, group)
+
+		err := m.DeleteCustomRole(ctx, role.ID)
+		assert.ErrorIs(t, err, manager.ErrCustomRoleInUse)
+	})
+}
@@ -15,6 +15,9 @@ var (
 	ErrKeyCreationFailed       = errors.New("failed to create key in provider")
 	ErrKeyRegistration         = errors.New("failed to register key from provider")
 	ErrUnsupportedKeyAlgorithm = errors.New("unsupported key algorithm")
+	ErrUnsupportedKeyType      = errors.New("key type not supported by the keystore provider")
+	ErrUnsupportedRegion       = errors.New("region not supported by the keystore provider")
+	ErrImportNotSupported      = errors.New("key material import not supported by the keystore provider")
 	ErrInvalidKeyState         = errors.New("invalid key state")
 	ErrHYOKKeyActionNotAllowed = errors.New("HYOK key action not allowed")
 	ErrNameCannotBeEmpty       = errors.New("name field cannot be empty")
@@ -117,6 +120,55 @@ var (
 	ErrCheckIAMExistenceOfGroups = errors.New("failed to check IAM existence of groups")
 	ErrCheckTenantHasIAMGroups   = errors.New("failed to check tenant has IAM groups")
 
+	ErrListSystemGroups          = errors.New("failed to list system groups from database")
+	ErrGetSystemGroup            = errors.New("failed to get system group from database")
+	ErrCreateSystemGroup         = errors.New("failed to create system group")
+	ErrUpdateSystemGroup         = errors.New("failed to update system group")
+	ErrDeleteSystemGroup         = errors.New("failed to delete system group")
+	ErrListSystemGroupSystems    = errors.New("failed to list systems of system group")
+	ErrSystemGroupStatus         = errors.New("failed to get system group status")
+	ErrSystemGroupAction         = errors.New("failed to apply action on system group systems")
+	ErrSystemGroupMembership     = errors.New("system group membership must be either selector or explicit systems")
+	ErrSystemGroupEmptySelector  = errors.New("system group selector must set at least one criteria")
+	ErrSystemGroupUnknownSystems = errors.New("system group references systems that do not exist")
+
+	ErrListAuditEvents = errors.New("failed to list audit events from database")
+
+	ErrGetCapabilities = errors.New("failed to get keystore capabilities")
+
+	ErrListCustomRoles  = errors.New("failed to list custom roles from database")
+	ErrGetCustomRole    = errors.New("failed to get custom role from database")
+	ErrCreateCustomRole = errors.New("failed to create custom role")
+	ErrUpdateCustomRole = errors.New("failed to update custom role")
+	ErrDeleteCustomRole = errors.New("failed to delete custom role")
+	ErrCustomRoleInUse  = errors.New("custom role is referenced by a group")
+
+	ErrListResourceGrants    = errors.New("failed to list resource grants from database")
+	ErrGetResourceGrant      = errors.New("failed to get resource grant from database")
+	ErrCreateResourceGrant   = errors.New("failed to create resource grant")
+	ErrDeleteResourceGrant   = errors.New("failed to delete resource grant")
+	ErrCheckResourceGrants   = errors.New("failed to check resource grants of groups")
+	ErrResourceGrantNoTarget = errors.New("resource of the grant does not exist")
+
+	ErrExplainAuthz = errors.New("failed to explain authorization decision")
+
+	ErrGrantRoleElevation       = errors.New("failed to grant role elevation")
+	ErrExpireRoleElevations     = errors.New("failed to expire role elevations")
+	ErrCheckRoleElevations      = errors.New("failed to check role elevations of user")
+	ErrInvalidRoleElevation     = errors.New("role elevation must be to a key administrator role")
+	ErrRoleElevationNotRequired = errors.New("user already administers the key configuration")
+
+	ErrListAPITokens         = errors.New("failed to list API tokens from database")
+	ErrGetAPIToken           = errors.New("failed to get API token from database")
+	ErrCreateAPIToken        = errors.New("failed to create API token")
+	ErrRevokeAPIToken        = errors.New("failed to revoke API token")
+	ErrInvalidAPIToken       = errors.New("invalid API token")
+	ErrAPITokenExpired       = errors.New("API token has expired")
+	ErrAPITokenRevoked       = errors.New("API token has been revoked")
+	ErrInvalidAPITokenExpiry = errors.New("API token expiry must be in the future and within the maximum validity")
+	ErrAPITokenNotAllowed    = errors.New("API tokens cannot create API tokens")
+	ErrAPITokenGroupNotOwn   = errors.New("API tokens can only be bound to a group of the creator")
+
 	ErrNoBodyForCustomerHeldDB = errors.New(
 		"body must be provided for customer held key rotation",
 	)
@@ -142,6 +194,7 @@ var (
 	ErrUnlinkSystemNoPrimaryKey  = errors.New("failed to unlink system without primary key")
 	ErrUpdateSystem              = errors.New("failed to update system")
 	ErrSystemNotLinked           = errors.New("system is not linked to a key configuration")
+	ErrValidateSystemLink        = errors.New("failed to validate system link")
 	ErrFailedToReencryptSystem   = errors.New("system reencrypt failed on new key")
 	ErrNotAllSystemsConnected    = errors.New("keyconfig contains systems not connected")
 	ErrUnsuportedWorkflow        = errors.New("workflow artifact type and action type set is not supported")
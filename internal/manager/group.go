@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/auditor"
 	"github.com/openkcm/cmk/internal/authz"
 	"github.com/openkcm/cmk/internal/constants"
 	"github.com/openkcm/cmk/internal/errs"
@@ -24,17 +25,20 @@ type GroupManager struct {
 	repo        repo.Repo
 	svcRegistry serviceapi.Registry
 	userManager User
+	cmkAuditor  *auditor.Auditor
 }
 
 func NewGroupManager(
 	repository repo.Repo,
 	svcRegistry serviceapi.Registry,
 	userManager User,
+	cmkAuditor *auditor.Auditor,
 ) *GroupManager {
 	return &GroupManager{
 		repo:        repository,
 		svcRegistry: svcRegistry,
 		userManager: userManager,
+		cmkAuditor:  cmkAuditor,
 	}
 }
 
@@ -59,15 +63,25 @@ func (m *GroupManager) GetGroups(ctx context.Context, pagination repo.Pagination
 }
 
 func (m *GroupManager) CreateGroup(ctx context.Context, group *model.Group) (*model.Group, error) {
-	if !m.isSupportedRole(group) {
+	supported, err := m.isSupportedRole(ctx, group)
+	if err != nil {
+		return nil, errs.Wrap(ErrCreateGroups, err)
+	}
+
+	if !supported {
 		return nil, ErrGroupRole
 	}
 
-	err := m.repo.Create(ctx, group)
+	err = m.repo.Create(ctx, group)
 	if err != nil {
 		return nil, errs.Wrap(ErrCreateGroups, err)
 	}
 
+	err = m.cmkAuditor.SendGroupCreateAuditLog(ctx, group.ID.String(), group.Name)
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for group create", err)
+	}
+
 	return group, nil
 }
 
@@ -106,6 +120,11 @@ func (m *GroupManager) DeleteGroupByID(ctx context.Context, id uuid.UUID) error
 		return errs.Wrap(ErrDeleteGroups, err)
 	}
 
+	err = m.cmkAuditor.SendGroupDeleteAuditLog(ctx, group.ID.String(), group.Name)
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for group delete", err)
+	}
+
 	return nil
 }
 
@@ -143,6 +162,8 @@ func (m *GroupManager) UpdateGroup(
 		return nil, ErrInvalidGroupUpdate
 	}
 
+	previous := *group
+
 	if patchGroup.Name != nil {
 		if *patchGroup.Name == "" {
 			return nil, errs.Wrap(ErrNameCannotBeEmpty, nil)
@@ -164,9 +185,34 @@ func (m *GroupManager) UpdateGroup(
 		return nil, errs.Wrap(ErrUpdateGroups, err)
 	}
 
+	m.sendUpdateAuditLogs(ctx, &previous, group)
+
 	return group, nil
 }
 
+// sendUpdateAuditLogs sends an audit log for each changed property of the group
+func (m *GroupManager) sendUpdateAuditLogs(ctx context.Context, previous, group *model.Group) {
+	changes := []struct {
+		property      string
+		before, after string
+	}{
+		{property: "name", before: previous.Name, after: group.Name},
+		{property: "description", before: previous.Description, after: group.Description},
+		{property: "iamIdentifier", before: previous.IAMIdentifier, after: group.IAMIdentifier},
+	}
+
+	for _, change := range changes {
+		if change.before == change.after {
+			continue
+		}
+
+		err := m.cmkAuditor.SendGroupUpdateAuditLog(ctx, group.ID.String(), change.property, change.before, change.after)
+		if err != nil {
+			log.Error(ctx, "Failed to send audit log for group update", err)
+		}
+	}
+}
+
 // CreateDefaultGroups creates the default admin and auditor groups for a tenant.
 func (m *GroupManager) CreateDefaultGroups(ctx context.Context) error {
 	tenantID, err := cmkcontext.ExtractTenantID(ctx)
@@ -254,6 +300,10 @@ func (m *GroupManager) CheckIAMExistenceOfGroups(
 		return nil, err
 	}
 
+	// Groups are checked while being set up in the identity provider,
+	// so cached lookups could be outdated
+	invalidator, _ := plugin.(identitymanagement.Invalidator)
+
 	result := make([]GroupIAMExistence, 0, len(iamIdentifiers))
 	for _, name := range iamIdentifiers {
 		request := &identitymanagement.GetGroupRequest{
@@ -261,6 +311,13 @@ func (m *GroupManager) CheckIAMExistenceOfGroups(
 			AuthContext: identitymanagement.AuthContext{Data: authCtx},
 		}
 
+		if invalidator != nil {
+			err := invalidator.InvalidateGroup(ctx, request.AuthContext, name)
+			if err != nil {
+				log.Warn(ctx, "Failed to invalidate cached group", log.ErrorAttr(err))
+			}
+		}
+
 		_, err := plugin.GetGroup(ctx, request)
 		if err != nil {
 			st, ok := status.FromError(err)
@@ -301,13 +358,19 @@ func (m *GroupManager) isReservedName(patchGroup cmkapi.GroupPatch) bool {
 	return m.isMandatoryGroup(&model.Group{Name: *patchGroup.Name})
 }
 
-func (m *GroupManager) isSupportedRole(group *model.Group) bool {
-	switch group.Role {
-	case constants.TenantAdminRole, constants.TenantAuditorRole, constants.KeyAdminRole:
-		return true
-	default:
-		return false
+// isSupportedRole checks that the group role is a built-in role
+// or a custom role defined for the tenant
+func (m *GroupManager) isSupportedRole(ctx context.Context, group *model.Group) (bool, error) {
+	baseRole, err := resolveBaseRole(ctx, m.repo, group.Role)
+	if errors.Is(err, repo.ErrNotFound) {
+		return false, nil
 	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return baseRole != "", nil
 }
 
 // applyIAMGroupFilter adds IAM filtering to query if user is not TenantAdmin.
@@ -31,9 +31,9 @@ func SetupGroupManager(t *testing.T) (*manager.GroupManager, *multitenancy.DB, s
 
 	dbRepository := sql.NewRepository(db)
 
+	cmkAuditor := auditor.New(t.Context(), &config.Config{})
 	m := manager.NewGroupManager(dbRepository, svcRegistry,
-		manager.NewUserManager(dbRepository,
-			auditor.New(t.Context(), &config.Config{})))
+		manager.NewUserManager(dbRepository, cmkAuditor), cmkAuditor)
 
 	return m, db, tenants[0]
 }
@@ -118,6 +118,41 @@ func TestCreateGroup(t *testing.T) {
 		assert.ErrorIs(t, err, manager.ErrGroupRole)
 	})
 
+	t.Run("Should create group with custom role", func(t *testing.T) {
+		ctx := testutils.CreateCtxWithTenant(tenant)
+		ctx = testutils.InjectBusinessUserDataIntoContext(ctx, "test-user", []string{"test-group-custom"})
+
+		role := testutils.NewCustomRole(func(_ *model.CustomRole) {})
+		testutils.CreateTestEntities(ctx, t, sql.NewRepository(db), role)
+
+		res, err := groupManager.CreateGroup(
+			ctx,
+			testutils.NewGroup(
+				func(g *model.Group) {
+					g.Role = role.Name
+					g.IAMIdentifier = "test-group-custom"
+				},
+			),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, role.Name, res.Role)
+	})
+
+	t.Run("Should error on create group with unknown custom role", func(t *testing.T) {
+		ctx := testutils.CreateCtxWithTenant(tenant)
+		ctx = testutils.InjectBusinessUserDataIntoContext(ctx, "test-user", []string{"test-group-unknown"})
+		_, err := groupManager.CreateGroup(
+			ctx,
+			testutils.NewGroup(
+				func(g *model.Group) {
+					g.Role = "KEY_VIEWER"
+					g.IAMIdentifier = "test-group-unknown"
+				},
+			),
+		)
+		assert.ErrorIs(t, err, manager.ErrGroupRole)
+	})
+
 	t.Run("Should error on create group", func(t *testing.T) {
 		forced := testutils.NewDBErrorForced(db, ErrForced)
 
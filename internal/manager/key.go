@@ -133,6 +133,10 @@ func (km *KeyManager) Create(
 		return nil, err
 	}
 
+	if err := km.checkKeyCapabilities(key); err != nil {
+		return nil, err
+	}
+
 	// For BYOK keys, check if tenant provisioning is needed before attempting provider creation.
 	// If the default keystore has not yet had its management role provisioned (LocalityID == ""),
 	// persist the key in PENDING_CREATION state and return immediately. The sync worker will
@@ -350,6 +354,11 @@ func (km *KeyManager) GetImportParams(ctx context.Context, keyID uuid.UUID) (*mo
 		return nil, err
 	}
 
+	err = km.checkImportCapability(key)
+	if err != nil {
+		return nil, err
+	}
+
 	if key.ImportParams != nil {
 		if key.ImportParams.IsExpired() {
 			return km.fetchImportParams(ctx, key)
@@ -380,6 +389,11 @@ func (km *KeyManager) ImportKeyMaterial(
 		return nil, err
 	}
 
+	err = km.checkImportCapability(key)
+	if err != nil {
+		return nil, err
+	}
+
 	if key.ImportParams == nil || key.ImportParams.IsExpired() {
 		return nil, ErrMissingOrExpiredImportParams
 	}
@@ -712,6 +726,49 @@ func (km *KeyManager) validateKeyCreation(ctx context.Context, key *model.Key) e
 	return nil
 }
 
+// checkKeyCapabilities rejects keys the provider does not support before
+// anything is created. A provider not found is left to the provider init.
+func (km *KeyManager) checkKeyCapabilities(key *model.Key) error {
+	capabilities, err := km.GetProviderCapabilities(key)
+	if err != nil {
+		return nil //nolint:nilerr
+	}
+
+	if !capabilities.SupportsKeyType(convertToAPIKeyType(key.KeyType)) {
+		return errs.Wrapf(ErrUnsupportedKeyType, string(key.KeyType))
+	}
+
+	// The algorithm and region of HYOK keys are given by the registered key
+	if key.KeyType == cmkapi.KeyTypeHYOK {
+		return nil
+	}
+
+	if !capabilities.SupportsAlgorithm(convertToAPIKeyAlgorithm(key.Algorithm)) {
+		return errs.Wrapf(ErrUnsupportedKeyAlgorithm, string(key.Algorithm))
+	}
+
+	if !capabilities.SupportsRegion(key.Region) {
+		return errs.Wrapf(ErrUnsupportedRegion, key.Region)
+	}
+
+	return nil
+}
+
+// checkImportCapability rejects the import into keys of providers not
+// supporting it. A provider not found is left to the provider init.
+func (km *KeyManager) checkImportCapability(key *model.Key) error {
+	capabilities, err := km.GetProviderCapabilities(key)
+	if err != nil {
+		return nil //nolint:nilerr
+	}
+
+	if !capabilities.SupportsImport() {
+		return ErrImportNotSupported
+	}
+
+	return nil
+}
+
 // createOrRegisterProviderKey creates a managed key or registers a HYOK key based on key type
 func (km *KeyManager) createOrRegisterProviderKey(
 	ctx context.Context,
@@ -1394,7 +1451,7 @@ func (km *KeyManager) syncHYOKKeyState(ctx context.Context, key *model.Key) erro
 		key.State = cmkapi.KeyState(keyResp.Status)
 
 		// Check if a new version was detected from the keystore
-		err := km.syncKeyVersions(ctx, provider, key)
+		err := km.syncRotatedKeyVersions(ctx, provider, key)
 		if err != nil {
 			log.Warn(ctx, "Failed to sync key version", log.ErrorAttr(err))
 		}
@@ -1419,6 +1476,21 @@ func (km *KeyManager) syncHYOKKeyState(ctx context.Context, key *model.Key) erro
 	return nil
 }
 
+// syncRotatedKeyVersions syncs the versions of keys rotated by the provider.
+// Providers not declaring rotation keep the versions synced on creation.
+func (km *KeyManager) syncRotatedKeyVersions(
+	ctx context.Context,
+	provider *ProviderConfig,
+	key *model.Key,
+) error {
+	capabilities, err := km.GetProviderCapabilities(key)
+	if err == nil && !capabilities.SupportsRotation() {
+		return nil
+	}
+
+	return km.syncKeyVersions(ctx, provider, key)
+}
+
 // syncKeyVersions checks if the latest version from keystore matches the stored version.
 // If a new version is detected, it creates a new KeyVersion record.
 func (km *KeyManager) syncKeyVersions(
@@ -3,10 +3,13 @@ package manager
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 
+	"github.com/openkcm/cmk/internal/auditor"
 	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/log"
 	"github.com/openkcm/cmk/internal/model"
 	"github.com/openkcm/cmk/internal/repo"
 )
@@ -31,13 +34,16 @@ type Label interface {
 
 type LabelManager struct {
 	repository repo.Repo
+	cmkAuditor *auditor.Auditor
 }
 
 func NewLabelManager(
 	repository repo.Repo,
+	cmkAuditor *auditor.Auditor,
 ) *LabelManager {
 	return &LabelManager{
 		repository: repository,
+		cmkAuditor: cmkAuditor,
 	}
 }
 
@@ -62,17 +68,25 @@ func (m *LabelManager) DeleteLabel(
 	ck := repo.NewCompositeKey().
 		Where(repo.KeyField, labelName).
 		Where(repo.ResourceIDField, keyID)
+	query := *repo.NewQuery().Where(repo.NewCompositeKeyGroup(ck))
 
-	ok, err := m.repository.Delete(
-		ctx,
-		label,
-		*repo.NewQuery().
-			Where(repo.NewCompositeKeyGroup(ck)),
-	)
+	_, err = m.repository.First(ctx, label, query)
+	if err != nil && !errors.Is(err, repo.ErrNotFound) {
+		return false, errs.Wrap(ErrDeleteLabelDB, err)
+	}
+
+	ok, err := m.repository.Delete(ctx, &model.KeyLabel{}, query)
 	if err != nil {
 		return false, errs.Wrap(ErrDeleteLabelDB, err)
 	}
 
+	if ok {
+		err = m.cmkAuditor.SendConfigDeleteAuditLog(ctx, keyID.String(), labelAuditValue(label.Key, label.Value))
+		if err != nil {
+			log.Error(ctx, "Failed to send audit log for label delete", err)
+		}
+	}
+
 	return ok, nil
 }
 
@@ -90,8 +104,11 @@ func (m *LabelManager) CreateOrUpdateLabel(
 		return errs.Wrap(ErrGettingKeyByID, err)
 	}
 
+	// Previous values of the labels, empty for created labels
+	previous := make([]string, len(labels))
+
 	err = m.repository.Transaction(ctx, func(ctx context.Context) error {
-		for _, label := range labels {
+		for i, label := range labels {
 			l := &model.KeyLabel{}
 			ck = repo.NewCompositeKey().Where(repo.KeyField, label.Key).Where(repo.ResourceIDField, keyID)
 
@@ -111,6 +128,7 @@ func (m *LabelManager) CreateOrUpdateLabel(
 					return errs.Wrap(ErrInsertLabel, err)
 				}
 			} else {
+				previous[i] = labelAuditValue(l.Key, l.Value)
 				l.Value = label.Value
 
 				_, err := m.repository.Patch(
@@ -130,6 +148,15 @@ func (m *LabelManager) CreateOrUpdateLabel(
 		return errs.Wrap(ErrUpdateLabelDB, err)
 	}
 
+	for i, label := range labels {
+		err = m.cmkAuditor.SendConfigUpdateAuditLog(
+			ctx, keyID.String(), previous[i], labelAuditValue(label.Key, label.Value),
+		)
+		if err != nil {
+			log.Error(ctx, "Failed to send audit log for label update", err)
+		}
+	}
+
 	return nil
 }
 
@@ -152,3 +179,8 @@ func (m *LabelManager) GetKeyLabels(
 
 	return repo.ListAndCount(ctx, m.repository, pagination, model.KeyLabel{}, query)
 }
+
+// labelAuditValue formats a label as value of a configuration audit log
+func labelAuditValue(key, value string) string {
+	return fmt.Sprintf("%s=%s", key, value)
+}
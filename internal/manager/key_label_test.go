@@ -183,7 +183,7 @@ func setupTest(t *testing.T) (*multitenancy.DB, *manager.LabelManager, string) {
 	db, tenants, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
 
 	r := sql.NewRepository(db)
-	labelManager := manager.NewLabelManager(r)
+	labelManager := manager.NewLabelManager(r, nil)
 
 	return db, labelManager, tenants[0]
 }
@@ -89,10 +89,10 @@ func SetupKeyTest(t *testing.T, opts ...testplugins.RegistryOption) (
 	eventFactory, err := eventprocessor.NewEventFactory(ctx, cfg, r)
 	assert.NoError(t, err)
 
-	certManager := manager.NewCertificateManager(ctx, r, svcRegistry, cfg)
-	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, nil, certManager)
+	certManager := manager.NewCertificateManager(ctx, r, svcRegistry, cfg, cmkAuditor)
+	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, nil, certManager, cmkAuditor)
 	userManager := manager.NewUserManager(r, cmkAuditor)
-	tagManager := manager.NewTagManager(r)
+	tagManager := manager.NewTagManager(r, cmkAuditor)
 	keyConfigManager := manager.NewKeyConfigManager(r, certManager, userManager, tagManager, cmkAuditor, eventFactory, cfg)
 
 	km := manager.NewKeyManager(
@@ -470,6 +470,101 @@ func TestHYOKRegistrationCertificateSubject(t *testing.T) {
 	})
 }
 
+func TestCreateUnsupportedByProvider(t *testing.T) {
+	tests := []struct {
+		name         string
+		capabilities keymanagement.Capabilities
+		key          func(k *model.Key)
+		wantErr      error
+	}{
+		{
+			name: "Key type not declared",
+			capabilities: keymanagement.Capabilities{
+				KeyTypes: []keymanagement.KeyType{keymanagement.HYOK},
+				Import:   true,
+			},
+			key:     func(_ *model.Key) {},
+			wantErr: manager.ErrUnsupportedKeyType,
+		},
+		{
+			name: "Algorithm not declared",
+			capabilities: keymanagement.Capabilities{
+				Algorithms: []keymanagement.KeyAlgorithm{},
+				Import:     true,
+			},
+			key:     func(_ *model.Key) {},
+			wantErr: manager.ErrUnsupportedKeyAlgorithm,
+		},
+		{
+			name: "Region not declared",
+			capabilities: keymanagement.Capabilities{
+				Regions: []string{"eu10"},
+				Import:  true,
+			},
+			key:     func(k *model.Key) { k.Region = testRegionUSEast1 },
+			wantErr: manager.ErrUnsupportedRegion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyProviderPlugin := testplugins.NewTestKeyManagement(true, true)
+			km, r, ctx, keyConfig, _ := SetupKeyTest(t, testplugins.WithKeyManagement(testplugins.Name,
+				testplugins.WithCapabilities(keyProviderPlugin, tt.capabilities),
+			))
+			seedDefaultKeystore(t, r, ctx)
+
+			providerKeys := len(keyProviderPlugin.KeyStore)
+
+			_, err := km.Create(ctx, testutils.NewKey(func(k *model.Key) {
+				k.KeyConfigurationID = keyConfig.ID
+				tt.key(k)
+			}))
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Len(t, keyProviderPlugin.KeyStore, providerKeys, "provider should not be called")
+		})
+	}
+}
+
+func TestImportUnsupportedByProvider(t *testing.T) {
+	keyProviderPlugin := testplugins.NewTestKeyManagement(true, true)
+	km, r, ctx, keyConfig, _ := SetupKeyTest(t, testplugins.WithKeyManagement(testplugins.Name,
+		testplugins.WithCapabilities(keyProviderPlugin, keymanagement.Capabilities{
+			KeyTypes: []keymanagement.KeyType{keymanagement.BYOK},
+		}),
+	))
+	byokKey := createTestBYOKKey(t, r, ctx, keyConfig.ID, cmkapi.KeyStatePENDINGIMPORT, keyProviderPlugin)
+
+	_, err := km.GetImportParams(ctx, byokKey.ID)
+	assert.ErrorIs(t, err, manager.ErrImportNotSupported)
+
+	_, err = km.ImportKeyMaterial(ctx, byokKey.ID, "dGVzdC1rZXktbWF0ZXJpYWw=")
+	assert.ErrorIs(t, err, manager.ErrImportNotSupported)
+}
+
+func TestRotationUnsupportedByProvider(t *testing.T) {
+	keyProviderPlugin := testplugins.NewTestKeyManagement(true, true)
+	km, r, ctx, keyConfig, _ := SetupKeyTest(t, testplugins.WithKeyManagement(testplugins.Name,
+		testplugins.WithCapabilities(keyProviderPlugin, keymanagement.Capabilities{Import: true}),
+	))
+	hyokKey := createTestHYOKKey(t, km, ctx, keyConfig.ID, keyProviderPlugin)
+
+	require.NoError(t, keyProviderPlugin.RotateKey(*hyokKey.NativeID, "version-1", nil))
+
+	err := km.SyncHYOKKeys(ctx)
+	require.NoError(t, err)
+
+	_, count, err := repo.ListAndCount(
+		ctx, r, repo.Pagination{Count: true},
+		model.KeyVersion{},
+		repo.NewQuery().Where(repo.NewCompositeKeyGroup(
+			repo.NewCompositeKey().Where("key_id", hyokKey.ID),
+		)),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "rotation should not be synced for providers not rotating keys")
+}
+
 func TestSetFirstKeyPrimary(t *testing.T) {
 	km, r, ctx, keyConfig, _ := SetupKeyTest(t)
 
@@ -1396,10 +1491,10 @@ func TestKeyRotationTime(t *testing.T) {
 	assert.NoError(t, err)
 
 	cmkAuditor := auditor.New(ctx, cfg)
-	certManager := manager.NewCertificateManager(ctx, r, svcRegistry, cfg)
-	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, nil, certManager)
+	certManager := manager.NewCertificateManager(ctx, r, svcRegistry, cfg, cmkAuditor)
+	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, nil, certManager, cmkAuditor)
 	userManager := manager.NewUserManager(r, cmkAuditor)
-	tagManager := manager.NewTagManager(r)
+	tagManager := manager.NewTagManager(r, cmkAuditor)
 	keyConfigManager := manager.NewKeyConfigManager(r, certManager, userManager, tagManager, cmkAuditor, eventFactory, cfg)
 	km := manager.NewKeyManager(r, svcRegistry, tenantConfigManager, keyConfigManager, userManager, certManager, nil, cmkAuditor, nil)
 
@@ -2261,10 +2356,10 @@ func SetupKeyTestWithAsyncClient(
 	eventFactory, err := eventprocessor.NewEventFactory(ctx, cfg, r)
 	require.NoError(t, err)
 
-	certManager := manager.NewCertificateManager(ctx, r, svcRegistry, cfg)
-	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, nil, certManager)
+	certManager := manager.NewCertificateManager(ctx, r, svcRegistry, cfg, cmkAuditor)
+	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, nil, certManager, cmkAuditor)
 	userManager := manager.NewUserManager(r, cmkAuditor)
-	tagManager := manager.NewTagManager(r)
+	tagManager := manager.NewTagManager(r, cmkAuditor)
 	keyConfigManager := manager.NewKeyConfigManager(r, certManager, userManager, tagManager, cmkAuditor, eventFactory, cfg)
 
 	km := manager.NewKeyManager(
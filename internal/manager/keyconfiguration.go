@@ -146,7 +146,8 @@ func (m *KeyConfigManager) PostKeyConfigurations(
 		return nil, ErrInvalidKeyAdminGroup
 	}
 
-	if group.Role != constants.KeyAdminRole {
+	baseRole, err := resolveBaseRole(ctx, m.r, group.Role)
+	if err != nil || baseRole != constants.KeyAdminRole {
 		return nil, ErrInvalidKeyAdminGroup
 	}
 
@@ -202,6 +203,17 @@ func (m *KeyConfigManager) DeleteKeyConfigurationByID(
 			return errs.Wrap(ErrDeleteKeyConfiguration, err)
 		}
 
+		_, err = m.r.Delete(ctx, &model.ResourceGrant{}, *repo.NewQuery().Where(
+			repo.NewCompositeKeyGroup(
+				repo.NewCompositeKey().
+					Where(repo.ResourceTypeField, model.ResourceGrantTypeKeyConfiguration).
+					Where(repo.ResourceIDField, keyConfig.ID),
+			),
+		))
+		if err != nil {
+			return errs.Wrap(ErrDeleteKeyConfiguration, err)
+		}
+
 		return m.tagManager.DeleteTags(ctx, keyConfig.ID)
 	})
 }
@@ -408,11 +420,30 @@ func (m *KeyConfigManager) applyIAMGroupFilter(
 		JoinTable: &model.Group{},
 	}
 
+	grantedIDs, err := grantedResourceIDs(ctx, m.r, iamIdentifiers,
+		model.ResourceGrantTypeKeyConfiguration)
+	if err != nil {
+		return false, err
+	}
+
+	elevatedIDs, err := elevatedKeyConfigurationIDs(ctx, m.r)
+	if err != nil {
+		return false, err
+	}
+
+	grantedIDs = append(grantedIDs, elevatedIDs...)
+
 	groupTable := (&model.Group{}).TableName()
+	keyConfigTable := (&model.KeyConfiguration{}).TableName()
 
-	// Create query with IAM identifier filter
+	// Create query with IAM identifier filter, also matching the key configurations
+	// the IAM groups have a grant on and the user has a role elevation on
 	ck := repo.NewCompositeKey().
 		Where(fmt.Sprintf(`"%s".%s`, groupTable, repo.IAMIdField), iamIdentifiers)
+	if len(grantedIDs) > 0 {
+		ck = ck.Where(fmt.Sprintf(`"%s".%s`, keyConfigTable, repo.IDField), grantedIDs)
+		ck.IsStrict = false
+	}
 
 	*query = *query.
 		Join(repo.InnerJoin, joinCond).
@@ -91,7 +91,7 @@ func SetupKeyConfigManager(t *testing.T) (*manager.KeyConfigManager, *multitenan
 	svcRegistry, err := cmkpluginregistry.New(t.Context(), cfg)
 	assert.NoError(t, err)
 
-	certManager := manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg)
+	certManager := manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg, cmkAuditor)
 
 	authzRepoLoader := authz_loader.NewRepoAuthzLoader(t.Context(),
 		r, &config.Config{})
@@ -99,7 +99,7 @@ func SetupKeyConfigManager(t *testing.T) (*manager.KeyConfigManager, *multitenan
 	authzRepo := authz_repo.NewAuthzRepo(r, authzRepoLoader)
 
 	userManager := manager.NewUserManager(authzRepo, cmkAuditor)
-	tagManager := manager.NewTagManager(authzRepo)
+	tagManager := manager.NewTagManager(authzRepo, cmkAuditor)
 
 	eventFactory, err := eventprocessor.NewEventFactory(t.Context(), cfg, r)
 	assert.NoError(t, err)
@@ -253,6 +253,30 @@ func TestGetKeyConfigurations(t *testing.T) {
 		assert.Equal(t, 1, total)
 	})
 
+	t.Run("Should get granted key configuration - resource grant", func(t *testing.T) {
+		grantGroup := testutils.NewGroup(func(_ *model.Group) {})
+		grant := testutils.NewResourceGrant(func(g *model.ResourceGrant) {
+			g.GroupID = grantGroup.ID
+			g.ResourceID = expected[0].ID
+		})
+		testutils.CreateTestEntities(ctx, t, r, grantGroup, grant)
+
+		ctxWithGroups := testutils.InjectBusinessUserDataIntoContext(
+			ctx,
+			"example-user",
+			[]string{grantGroup.IAMIdentifier},
+		)
+		pagination := repo.Pagination{
+			Skip:  constants.DefaultSkip,
+			Top:   constants.DefaultTop,
+			Count: true,
+		}
+		actual, total, err := m.GetKeyConfigurations(ctxWithGroups, manager.KeyConfigFilter{Pagination: pagination})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, expected[0].ID, actual[0].ID)
+	})
+
 	t.Run("Should err getting key configuration", func(t *testing.T) {
 		forced := testutils.NewDBErrorForced(db, ErrForced)
 
@@ -1027,7 +1051,12 @@ func TestDeleteKeyConfiguration(t *testing.T) {
 		t.Values = bytes
 	})
 
-	testutils.CreateTestEntities(ctx, t, r, expected, tags, adminGroup, keyConfigWithAdminGroup)
+	grant := testutils.NewResourceGrant(func(g *model.ResourceGrant) {
+		g.GroupID = adminGroup.ID
+		g.ResourceID = expected.ID
+	})
+
+	testutils.CreateTestEntities(ctx, t, r, expected, tags, adminGroup, keyConfigWithAdminGroup, grant)
 
 	t.Run("Should delete key configuration and it's tags", func(t *testing.T) {
 		err := m.DeleteKeyConfigurationByID(ctx, expected.ID)
@@ -1050,6 +1079,10 @@ func TestDeleteKeyConfiguration(t *testing.T) {
 		count, err = r.Count(ctx, &model.Tag{ID: expected.ID}, *repo.NewQuery())
 		assert.Equal(t, 0, count)
 		assert.NoError(t, err)
+
+		count, err = r.Count(ctx, &model.ResourceGrant{ID: grant.ID}, *repo.NewQuery())
+		assert.Equal(t, 0, count)
+		assert.NoError(t, err)
 	})
 
 	t.Run("Should error on delete key configuration on connected systems", func(t *testing.T) {
@@ -1136,6 +1169,7 @@ func TestTenantConfigManager_GetCertificates(t *testing.T) {
 			sql.NewRepository(db),
 			svcRegistry,
 			&cfg,
+			nil,
 		)
 
 		ctx := testutils.CreateCtxWithTenant(tenant)
@@ -35,8 +35,8 @@ func setupKeyVersionManager(t *testing.T) (*manager.KeyVersionManager, repo.Repo
 		ctx, r, svcRegistry,
 		&config.Config{
 			Certificates: config.Certificates{ValidityDays: config.MinCertificateValidityDays},
-		})
-	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, nil, nil)
+		}, nil)
+	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, nil, nil, nil)
 	cmkAuditor := auditor.New(ctx, &cfg)
 
 	kvm := manager.NewKeyVersionManager(
@@ -188,6 +188,31 @@ func (pmc *ProviderConfigManager) GetOrInitProvider(ctx context.Context, key *mo
 	return providerCfg, nil
 }
 
+// GetProviderCapabilities returns the capabilities of the plugin serving the key
+func (pmc *ProviderConfigManager) GetProviderCapabilities(key *model.Key) (keymanagement.Capabilities, error) {
+	provider := key.Provider
+	if key.KeyType != cmkapi.KeyTypeHYOK {
+		var err error
+
+		provider, err = pmc.GetDefaultKeystoreFromCatalog()
+		if err != nil {
+			return keymanagement.Capabilities{}, err
+		}
+	}
+
+	keyManagements, err := pmc.svcRegistry.KeyManagements()
+	if err != nil {
+		return keymanagement.Capabilities{}, errs.Wrapf(ErrPluginNotFound, provider)
+	}
+
+	client, ok := keyManagements[provider]
+	if !ok {
+		return keymanagement.Capabilities{}, errs.Wrapf(ErrPluginNotFound, provider)
+	}
+
+	return keymanagement.GetCapabilities(client), nil
+}
+
 func (pmc *ProviderConfigManager) FillKeystorePool(ctx context.Context, size int) error {
 	count, err := pmc.keystorePool.Count(ctx)
 	if err != nil {
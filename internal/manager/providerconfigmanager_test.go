@@ -29,8 +29,8 @@ func SetupProviderManager(t *testing.T) (*manager.ProviderConfigManager, string,
 	m := manager.NewProviderConfigManager(
 		svcRegistry,
 		make(map[manager.ProviderCachedKey]*manager.ProviderConfig),
-		manager.NewTenantConfigManager(r, svcRegistry, cfg, manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg)),
-		manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg),
+		manager.NewTenantConfigManager(r, svcRegistry, cfg, manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg, nil), nil),
+		manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg, nil),
 		manager.NewPool(r),
 		r,
 	)
@@ -172,8 +172,8 @@ func TestGetOrInitProvider_ExpiredEntryIsReinitialized(t *testing.T) {
 		map[manager.ProviderCachedKey]*manager.ProviderConfig{
 			compositeKey: expiredCfg,
 		},
-		manager.NewTenantConfigManager(r, svcRegistry, cfg, manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg)),
-		manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg),
+		manager.NewTenantConfigManager(r, svcRegistry, cfg, manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg, nil), nil),
+		manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg, nil),
 		manager.NewPool(r),
 		r,
 	)
@@ -0,0 +1,270 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/log"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+// ResourceGrantManager manages the grants giving a group access on a single
+// key configuration or system
+type ResourceGrantManager struct {
+	repo       repo.Repo
+	cmkAuditor *auditor.Auditor
+}
+
+func NewResourceGrantManager(repository repo.Repo, cmkAuditor *auditor.Auditor) *ResourceGrantManager {
+	return &ResourceGrantManager{
+		repo:       repository,
+		cmkAuditor: cmkAuditor,
+	}
+}
+
+func (m *ResourceGrantManager) GetResourceGrants(
+	ctx context.Context,
+	groupID uuid.UUID,
+	pagination repo.Pagination,
+) ([]*model.ResourceGrant, int, error) {
+	err := m.ensureGroupExists(ctx, groupID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := repo.NewQuery().
+		Where(
+			repo.NewCompositeKeyGroup(
+				repo.NewCompositeKey().Where(repo.GroupIDField, groupID),
+			),
+		).
+		Order(repo.OrderField{
+			Field:     repo.CreatedField,
+			Direction: repo.Asc,
+		})
+
+	grants, count, err := repo.ListAndCount(ctx, m.repo, pagination, model.ResourceGrant{}, query)
+	if err != nil {
+		return nil, 0, errs.Wrap(ErrListResourceGrants, err)
+	}
+
+	return grants, count, nil
+}
+
+func (m *ResourceGrantManager) CreateResourceGrant(
+	ctx context.Context,
+	grant *model.ResourceGrant,
+) (*model.ResourceGrant, error) {
+	if !grant.ResourceType.Valid() {
+		return nil, errs.Wrap(ErrCreateResourceGrant, model.ErrInvalidResourceGrantType)
+	}
+
+	if !grant.Access.Valid() {
+		return nil, errs.Wrap(ErrCreateResourceGrant, model.ErrInvalidResourceGrantAccess)
+	}
+
+	err := m.ensureGroupExists(ctx, grant.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.ensureResourceExists(ctx, grant)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.repo.Create(ctx, grant)
+	if err != nil {
+		return nil, errs.Wrap(ErrCreateResourceGrant, err)
+	}
+
+	err = m.cmkAuditor.SendConfigCreateAuditLog(ctx, grant.ID.String(), grantAuditValue(grant))
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for resource grant create", err)
+	}
+
+	return grant, nil
+}
+
+func (m *ResourceGrantManager) DeleteResourceGrant(ctx context.Context, groupID, grantID uuid.UUID) error {
+	grant := &model.ResourceGrant{}
+
+	_, err := m.repo.First(
+		ctx, grant,
+		*repo.NewQuery().Where(
+			repo.NewCompositeKeyGroup(
+				repo.NewCompositeKey().
+					Where(repo.IDField, grantID).
+					Where(repo.GroupIDField, groupID),
+			),
+		),
+	)
+	if err != nil {
+		return errs.Wrap(ErrGetResourceGrant, err)
+	}
+
+	_, err = m.repo.Delete(ctx, &model.ResourceGrant{ID: grantID}, *repo.NewQuery())
+	if err != nil {
+		return errs.Wrap(ErrDeleteResourceGrant, err)
+	}
+
+	err = m.cmkAuditor.SendConfigDeleteAuditLog(ctx, grant.ID.String(), grantAuditValue(grant))
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for resource grant delete", err)
+	}
+
+	return nil
+}
+
+func (m *ResourceGrantManager) ensureGroupExists(ctx context.Context, groupID uuid.UUID) error {
+	_, err := m.repo.First(ctx, &model.Group{ID: groupID}, *repo.NewQuery())
+	if err != nil {
+		return errs.Wrap(ErrGetGroups, err)
+	}
+
+	return nil
+}
+
+// ensureResourceExists checks the key configuration or system of the grant exists.
+// The lookup runs with the internal role as the tenant admin can't read systems.
+func (m *ResourceGrantManager) ensureResourceExists(ctx context.Context, grant *model.ResourceGrant) error {
+	authCtx, err := cmkcontext.BusinessToInternalContext(ctx,
+		constants.InternalBusinessAuthzRole)
+	if err != nil {
+		return err
+	}
+
+	var resource repo.Resource
+
+	switch grant.ResourceType {
+	case model.ResourceGrantTypeKeyConfiguration:
+		resource = &model.KeyConfiguration{}
+	case model.ResourceGrantTypeSystem:
+		resource = &model.System{}
+	}
+
+	count, err := m.repo.Count(
+		authCtx, resource,
+		*repo.NewQuery().Where(
+			repo.NewCompositeKeyGroup(
+				repo.NewCompositeKey().Where(repo.IDField, grant.ResourceID),
+			),
+		).SetLimit(0),
+	)
+	if err != nil {
+		return errs.Wrap(ErrCreateResourceGrant, err)
+	}
+
+	if count == 0 {
+		return ErrResourceGrantNoTarget
+	}
+
+	return nil
+}
+
+func grantAuditValue(grant *model.ResourceGrant) string {
+	return fmt.Sprintf("%s %s %s", grant.ResourceType, grant.ResourceID, grant.Access)
+}
+
+// hasResourceGrant checks if any of the IAM groups has a grant on the resource
+// allowing the action
+func hasResourceGrant(
+	ctx context.Context,
+	r repo.Repo,
+	iamIdentifiers []string,
+	grantType model.ResourceGrantType,
+	resourceID uuid.UUID,
+	action authz.APIAction,
+) (bool, error) {
+	if len(iamIdentifiers) == 0 {
+		return false, nil
+	}
+
+	authCtx, err := cmkcontext.BusinessToInternalContext(ctx,
+		constants.InternalBusinessAuthzRole)
+	if err != nil {
+		return false, err
+	}
+
+	grantTable := (&model.ResourceGrant{}).TableName()
+
+	ck := grantsOfGroupsKey(iamIdentifiers, grantType).
+		Where(fmt.Sprintf(`"%s".%s`, grantTable, repo.ResourceIDField), resourceID).
+		Where(fmt.Sprintf(`"%s".%s`, grantTable, repo.AccessField), model.ResourceGrantAccessesFor(action))
+
+	count, err := r.Count(
+		authCtx, &model.ResourceGrant{},
+		*repo.NewQuery().
+			Join(repo.InnerJoin, grantGroupJoin()).
+			Where(repo.NewCompositeKeyGroup(ck)).
+			SetLimit(0),
+	)
+	if err != nil {
+		return false, errs.Wrap(ErrCheckResourceGrants, err)
+	}
+
+	return count > 0, nil
+}
+
+// grantedResourceIDs returns the IDs of the resources of a type the IAM groups
+// have a grant on
+func grantedResourceIDs(
+	ctx context.Context,
+	r repo.Repo,
+	iamIdentifiers []string,
+	grantType model.ResourceGrantType,
+) ([]uuid.UUID, error) {
+	if len(iamIdentifiers) == 0 {
+		return nil, nil
+	}
+
+	authCtx, err := cmkcontext.BusinessToInternalContext(ctx,
+		constants.InternalBusinessAuthzRole)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uuid.UUID
+
+	query := repo.NewQuery().
+		Join(repo.InnerJoin, grantGroupJoin()).
+		Where(repo.NewCompositeKeyGroup(grantsOfGroupsKey(iamIdentifiers, grantType)))
+
+	err = repo.ProcessInBatch(authCtx, r, query, repo.DefaultLimit, func(grants []*model.ResourceGrant) error {
+		for _, grant := range grants {
+			ids = append(ids, grant.ResourceID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errs.Wrap(ErrCheckResourceGrants, err)
+	}
+
+	return ids, nil
+}
+
+func grantGroupJoin() repo.JoinCondition {
+	return repo.JoinCondition{
+		Table:     &model.ResourceGrant{},
+		Field:     repo.GroupIDField,
+		JoinField: repo.IDField,
+		JoinTable: &model.Group{},
+	}
+}
+
+func grantsOfGroupsKey(iamIdentifiers []string, grantType model.ResourceGrantType) repo.CompositeKey {
+	grantTable := (&model.ResourceGrant{}).TableName()
+	groupTable := (&model.Group{}).TableName()
+
+	return repo.NewCompositeKey().
+		Where(fmt.Sprintf(`"%s".%s`, grantTable, repo.ResourceTypeField), grantType).
+		Where(fmt.Sprintf(`"%s".%s`, groupTable, repo.IAMIdField), iamIdentifiers)
+}
@@ -0,0 +1,108 @@
+package manager_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+)
+
+func TestResourceGrantManager(t *testing.T) {
+	db, tenants, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
+	r := sql.NewRepository(db)
+	m := manager.NewResourceGrantManager(r, auditor.New(t.Context(), &config.Config{}))
+	ctx := testutils.CreateCtxWithTenant(tenants[0])
+
+	group := testutils.NewGroup(func(_ *model.Group) {})
+	keyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {})
+	system := testutils.NewSystem(func(_ *model.System) {})
+	testutils.CreateTestEntities(ctx, t, r, group, keyConfig, system)
+
+	t.Run("Should create and list resource grants", func(t *testing.T) {
+		_, err := m.CreateResourceGrant(ctx, testutils.NewResourceGrant(func(g *model.ResourceGrant) {
+			g.GroupID = group.ID
+			g.ResourceID = keyConfig.ID
+		}))
+		assert.NoError(t, err)
+
+		_, err = m.CreateResourceGrant(ctx, testutils.NewResourceGrant(func(g *model.ResourceGrant) {
+			g.GroupID = group.ID
+			g.ResourceType = model.ResourceGrantTypeSystem
+			g.ResourceID = system.ID
+			g.Access = model.ResourceGrantAccessOperate
+		}))
+		assert.NoError(t, err)
+
+		grants, count, err := m.GetResourceGrants(ctx, group.ID, repo.Pagination{Count: true})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.Len(t, grants, 2)
+	})
+
+	t.Run("Should fail on duplicated grant", func(t *testing.T) {
+		_, err := m.CreateResourceGrant(ctx, testutils.NewResourceGrant(func(g *model.ResourceGrant) {
+			g.GroupID = group.ID
+			g.ResourceID = keyConfig.ID
+			g.Access = model.ResourceGrantAccessOperate
+		}))
+		assert.ErrorIs(t, err, manager.ErrCreateResourceGrant)
+		assert.ErrorIs(t, err, repo.ErrUniqueConstraint)
+	})
+
+	t.Run("Should fail on unknown group", func(t *testing.T) {
+		_, err := m.CreateResourceGrant(ctx, testutils.NewResourceGrant(func(g *model.ResourceGrant) {
+			g.ResourceID = keyConfig.ID
+		}))
+		assert.ErrorIs(t, err, manager.ErrGetGroups)
+		assert.ErrorIs(t, err, repo.ErrNotFound)
+
+		_, _, err = m.GetResourceGrants(ctx, uuid.New(), repo.Pagination{})
+		assert.ErrorIs(t, err, repo.ErrNotFound)
+	})
+
+	t.Run("Should fail on unknown resource", func(t *testing.T) {
+		_, err := m.CreateResourceGrant(ctx, testutils.NewResourceGrant(func(g *model.ResourceGrant) {
+			g.GroupID = group.ID
+			g.ResourceType = model.ResourceGrantTypeSystem
+			g.ResourceID = keyConfig.ID
+		}))
+		assert.ErrorIs(t, err, manager.ErrResourceGrantNoTarget)
+	})
+
+	t.Run("Should fail on invalid access", func(t *testing.T) {
+		_, err := m.CreateResourceGrant(ctx, testutils.NewResourceGrant(func(g *model.ResourceGrant) {
+			g.GroupID = group.ID
+			g.ResourceID = keyConfig.ID
+			g.Access = "WRITE"
+		}))
+		assert.ErrorIs(t, err, model.ErrInvalidResourceGrantAccess)
+	})
+
+	t.Run("Should delete resource grant", func(t *testing.T) {
+		otherGroup := testutils.NewGroup(func(_ *model.Group) {})
+		grant := testutils.NewResourceGrant(func(g *model.ResourceGrant) {
+			g.GroupID = otherGroup.ID
+			g.ResourceID = keyConfig.ID
+		})
+		testutils.CreateTestEntities(ctx, t, r, otherGroup, grant)
+
+		err := m.DeleteResourceGrant(ctx, group.ID, grant.ID)
+		assert.ErrorIs(t, err, manager.ErrGetResourceGrant)
+		assert.ErrorIs(t, err, repo.ErrNotFound)
+
+		err = m.DeleteResourceGrant(ctx, otherGroup.ID, grant.ID)
+		assert.NoError(t, err)
+
+		_, count, err := m.GetResourceGrants(ctx, otherGroup.ID, repo.Pagination{Count: true})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+}
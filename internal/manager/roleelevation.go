@@ -0,0 +1,173 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/log"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+// RoleElevationManager grants users a role on a key configuration for a limited
+// time once their role elevation workflow is approved, and expires the grants
+type RoleElevationManager struct {
+	repo       repo.Repo
+	cmkAuditor *auditor.Auditor
+}
+
+func NewRoleElevationManager(repository repo.Repo, cmkAuditor *auditor.Auditor) *RoleElevationManager {
+	return &RoleElevationManager{
+		repo:       repository,
+		cmkAuditor: cmkAuditor,
+	}
+}
+
+// GrantRoleElevation grants the initiator of the workflow the requested role on the
+// key configuration of the workflow. The elevation starts with the execution of the workflow.
+func (m *RoleElevationManager) GrantRoleElevation(
+	ctx context.Context,
+	workflow *model.Workflow,
+) (*model.RoleElevation, error) {
+	params, err := model.ParseRoleElevationParameters(workflow.Parameters)
+	if err != nil {
+		return nil, errs.Wrap(ErrGrantRoleElevation, err)
+	}
+
+	elevation := &model.RoleElevation{
+		ID:                 uuid.New(),
+		UserID:             workflow.InitiatorID,
+		KeyConfigurationID: workflow.ArtifactID,
+		Role:               params.Role,
+		WorkflowID:         workflow.ID,
+		ExpiresAt:          time.Now().Add(time.Duration(params.DurationHours) * time.Hour),
+	}
+
+	err = m.repo.Create(ctx, elevation)
+	if err != nil {
+		return nil, errs.Wrap(ErrGrantRoleElevation, err)
+	}
+
+	err = m.cmkAuditor.SendRoleElevationGrantAuditLog(ctx, elevation.ID.String(), roleElevationAuditValue(elevation))
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for role elevation grant", err)
+	}
+
+	return elevation, nil
+}
+
+// ExpireRoleElevations marks the role elevations past their expiry as expired.
+// Elevations stop granting their role at expiry, this records and audits the expiry.
+func (m *RoleElevationManager) ExpireRoleElevations(ctx context.Context) error {
+	query := repo.NewQuery().Where(
+		repo.NewCompositeKeyGroup(
+			repo.NewCompositeKey().
+				Where(repo.ExpiredField, false).
+				Where(repo.ExpiresAtField, time.Now(), repo.Lt),
+		),
+	)
+
+	// Expired elevations no longer match the query, so the offset is kept at 0
+	err := repo.ProcessInBatchWithOptions(
+		ctx, m.repo, query, repo.DefaultLimit,
+		repo.BatchProcessOptions{DeleteMode: true},
+		func(elevations []*model.RoleElevation) error {
+			for _, elevation := range elevations {
+				elevation.Expired = true
+
+				_, err := m.repo.Patch(ctx, elevation, *repo.NewQuery())
+				if err != nil {
+					return err
+				}
+
+				err = m.cmkAuditor.SendRoleElevationExpireAuditLog(
+					ctx, elevation.ID.String(), roleElevationAuditValue(elevation),
+				)
+				if err != nil {
+					log.Error(ctx, "Failed to send audit log for role elevation expiry", err)
+				}
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return errs.Wrap(ErrExpireRoleElevations, err)
+	}
+
+	return nil
+}
+
+func roleElevationAuditValue(elevation *model.RoleElevation) string {
+	return fmt.Sprintf("%s %s %s until %s", elevation.UserID, elevation.Role,
+		elevation.KeyConfigurationID, elevation.ExpiresAt.UTC().Format(time.RFC3339))
+}
+
+// activeRoleElevations returns the role elevations of the user which have
+// not expired, on the given key configuration or all if nil
+func activeRoleElevations(
+	ctx context.Context,
+	r repo.Repo,
+	userID string,
+	keyConfigID *uuid.UUID,
+) ([]*model.RoleElevation, error) {
+	// Elevations are granted to identified users only
+	if userID == "" {
+		return nil, nil
+	}
+
+	authCtx, err := cmkcontext.BusinessToInternalContext(ctx,
+		constants.InternalBusinessAuthzRole)
+	if err != nil {
+		return nil, err
+	}
+
+	ck := repo.NewCompositeKey().
+		Where(repo.UserIDField, userID).
+		Where(repo.ExpiredField, false).
+		Where(repo.ExpiresAtField, time.Now(), repo.Gt)
+	if keyConfigID != nil {
+		ck = ck.Where(repo.KeyConfigIDField, *keyConfigID)
+	}
+
+	var elevations []*model.RoleElevation
+
+	err = repo.ProcessInBatch(authCtx, r, repo.NewQuery().Where(repo.NewCompositeKeyGroup(ck)), repo.DefaultLimit,
+		func(batch []*model.RoleElevation) error {
+			elevations = append(elevations, batch...)
+			return nil
+		})
+	if err != nil {
+		return nil, errs.Wrap(ErrCheckRoleElevations, err)
+	}
+
+	return elevations, nil
+}
+
+// elevatedKeyConfigurationIDs returns the IDs of the key configurations
+// the business user has an active role elevation on
+func elevatedKeyConfigurationIDs(ctx context.Context, r repo.Repo) ([]uuid.UUID, error) {
+	identifier, err := cmkcontext.ExtractBusinessUserDataIdentifier(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	elevations, err := activeRoleElevations(ctx, r, identifier, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(elevations))
+	for _, elevation := range elevations {
+		ids = append(ids, elevation.KeyConfigurationID)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,127 @@
+package manager_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+func TestRoleElevation(t *testing.T) {
+	u, db, tenant := SetupUserManager(t)
+	r := sql.NewRepository(db)
+	m := manager.NewRoleElevationManager(r, auditor.New(t.Context(), &config.Config{}))
+	ctx := testutils.CreateCtxWithTenant(tenant)
+
+	keyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {})
+	otherKeyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {})
+	testutils.CreateTestEntities(ctx, t, r, keyConfig, otherKeyConfig)
+
+	userID := uuid.NewString()
+	userCtx := testutils.InjectBusinessUserDataIntoContext(ctx, userID, []string{})
+
+	t.Run("Should deny access without elevation", func(t *testing.T) {
+		_, err := u.HasKeyConfigAccess(userCtx, authz.APIActionUpdate, keyConfig)
+		assert.ErrorIs(t, err, manager.ErrKeyConfigurationNotAllowed)
+	})
+
+	t.Run("Should fail to grant on invalid parameters", func(t *testing.T) {
+		_, err := m.GrantRoleElevation(ctx, testutils.NewWorkflow(func(wf *model.Workflow) {
+			wf.InitiatorID = userID
+			wf.ArtifactID = keyConfig.ID
+			wf.Parameters = `{"role":"KEY_ADMINISTRATOR"}`
+		}))
+		assert.ErrorIs(t, err, manager.ErrGrantRoleElevation)
+		assert.ErrorIs(t, err, model.ErrInvalidRoleElevationParameters)
+	})
+
+	t.Run("Should grant role elevation", func(t *testing.T) {
+		elevation, err := m.GrantRoleElevation(ctx, testutils.NewWorkflow(func(wf *model.Workflow) {
+			wf.InitiatorID = userID
+			wf.ArtifactType = model.WorkflowArtifactTypeKeyConfiguration
+			wf.ActionType = model.WorkflowActionTypeElevate
+			wf.ArtifactID = keyConfig.ID
+			wf.Parameters = `{"role":"KEY_ADMINISTRATOR","durationHours":2}`
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, userID, elevation.UserID)
+		assert.Equal(t, constants.KeyAdminRole, elevation.Role)
+		assert.WithinDuration(t, time.Now().Add(2*time.Hour), elevation.ExpiresAt, time.Minute)
+	})
+
+	t.Run("Should honor elevation on the key configuration only", func(t *testing.T) {
+		_, err := u.HasKeyConfigAccess(userCtx, authz.APIActionUpdate, keyConfig)
+		assert.NoError(t, err)
+
+		_, err = u.HasKeyAccess(userCtx, authz.APIActionUpdate, keyConfig.ID)
+		assert.NoError(t, err)
+
+		_, err = u.HasKeyConfigAccess(userCtx, authz.APIActionUpdate, otherKeyConfig)
+		assert.ErrorIs(t, err, manager.ErrKeyConfigurationNotAllowed)
+	})
+
+	t.Run("Should return elevated role without groups on the key configuration only", func(t *testing.T) {
+		role, err := u.GetRoleFromIAM(
+			cmkcontext.InjectKeyConfigurationScope(userCtx, keyConfig.ID), []string{})
+		assert.NoError(t, err)
+		assert.Equal(t, constants.KeyAdminRole, role)
+
+		role, err = u.GetRoleFromIAM(
+			cmkcontext.InjectKeyConfigurationScope(userCtx, otherKeyConfig.ID), []string{})
+		assert.NoError(t, err)
+		assert.Empty(t, role)
+
+		role, err = u.GetRoleFromIAM(userCtx, []string{})
+		assert.NoError(t, err)
+		assert.Empty(t, role)
+	})
+
+	t.Run("Should not honor elevation past expiry", func(t *testing.T) {
+		expiredUserID := uuid.NewString()
+		elevation := &model.RoleElevation{
+			ID:                 uuid.New(),
+			UserID:             expiredUserID,
+			KeyConfigurationID: keyConfig.ID,
+			Role:               constants.KeyAdminRole,
+			WorkflowID:         uuid.New(),
+			ExpiresAt:          time.Now().Add(-time.Minute),
+		}
+		testutils.CreateTestEntities(ctx, t, r, elevation)
+
+		expiredCtx := testutils.InjectBusinessUserDataIntoContext(ctx, expiredUserID, []string{})
+
+		_, err := u.HasKeyConfigAccess(expiredCtx, authz.APIActionUpdate, keyConfig)
+		assert.ErrorIs(t, err, manager.ErrKeyConfigurationNotAllowed)
+
+		role, err := u.GetRoleFromIAM(
+			cmkcontext.InjectKeyConfigurationScope(expiredCtx, keyConfig.ID), []string{})
+		assert.NoError(t, err)
+		assert.Empty(t, role)
+
+		err = m.ExpireRoleElevations(ctx)
+		assert.NoError(t, err)
+
+		expired := &model.RoleElevation{ID: elevation.ID}
+		_, err = r.First(ctx, expired, *repo.NewQuery())
+		assert.NoError(t, err)
+		assert.True(t, expired.Expired)
+
+		active := &model.RoleElevation{}
+		_, err = r.First(ctx, active, *repo.NewQuery().Where(repo.NewCompositeKeyGroup(
+			repo.NewCompositeKey().Where(repo.UserIDField, userID))))
+		assert.NoError(t, err)
+		assert.False(t, active.Expired)
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/openkcm/orbital"
@@ -39,6 +41,7 @@ type System interface {
 	RefreshSystemsData(ctx context.Context) bool
 	UnmapSystemFromRegistry(ctx context.Context, system *model.System) error
 	LinkSystemAction(ctx context.Context, systemID uuid.UUID, patchSystem cmkapi.SystemPatch) (*model.System, error)
+	ValidateLinkSystem(ctx context.Context, systemID, keyConfigID uuid.UUID) (cmkapi.SystemLinkValidation, error)
 	UnlinkSystemAction(ctx context.Context, systemID uuid.UUID, trigger string) error
 	GetRecoveryActions(ctx context.Context, sytemID uuid.UUID) (cmkapi.SystemRecoveryAction, error)
 	SendRecoveryActions(
@@ -57,8 +60,28 @@ type SystemManager struct {
 	KeyConfigManager *KeyConfigManager
 	ContextModelsCfg config.System
 	user             User
+	retryPolicy      config.SystemRetry
+
+	// workflow is used to open link workflows for auto-linked systems
+	// when workflows are required by the tenant
+	workflow Workflow
 }
 
+// Reason codes returned by a system link validation
+const (
+	SystemLinkReasonNoPrimaryKey             = "NO_PRIMARY_KEY"
+	SystemLinkReasonPrimaryKeyNotEnabled     = "PRIMARY_KEY_NOT_ENABLED"
+	SystemLinkReasonPrimaryKeyUnderWorkflow  = "PRIMARY_KEY_UNDER_WORKFLOW"
+	SystemLinkReasonRegionNotSupported       = "REGION_NOT_SUPPORTED"
+	SystemLinkReasonSystemProcessingOrFailed = "SYSTEM_PROCESSING_OR_FAILED"
+	SystemLinkReasonSystemUnderWorkflow      = "SYSTEM_UNDER_WORKFLOW"
+	SystemLinkReasonOngoingWorkflow          = "ONGOING_WORKFLOW"
+	SystemLinkReasonAlreadyLinked            = "ALREADY_LINKED"
+	SystemLinkReasonSystemNotMapped          = "SYSTEM_NOT_MAPPED"
+	SystemLinkReasonRegistryUnavailable      = "REGISTRY_UNAVAILABLE"
+	SystemLinkReasonWorkflowRequired         = "WORKFLOW_REQUIRED"
+)
+
 type SystemFilter struct {
 	KeyConfigID uuid.UUID
 	Region      string
@@ -160,6 +183,7 @@ func NewSystemManager(
 	}
 
 	manager.ContextModelsCfg = cfg.ContextModels.System
+	manager.retryPolicy = systemRetryPolicy(cfg.SystemRetry)
 
 	sisClient, err := NewSystemInformationManager(repository, authzLoader,
 		svcRegistry, &cfg.ContextModels.System)
@@ -225,14 +249,22 @@ func (m *SystemManager) RefreshSystemsData(ctx context.Context) bool {
 		return false
 	}
 
+	var newSystems []*model.System
+
 	for _, fetchedSystem := range fetchedSystems {
-		err := m.createSystemIfNotExists(ctx, fetchedSystem)
+		created, err := m.createSystemIfNotExists(ctx, fetchedSystem)
 		if err != nil {
 			log.Error(ctx, "Could not save systems", err)
 			return false
 		}
+
+		if created {
+			newSystems = append(newSystems, fetchedSystem)
+		}
 	}
 
+	m.autoLinkSystems(ctx, newSystems)
+
 	// Remove systems that no longer exist in registry
 	err = m.removeSystemsNotInRegistry(ctx, fetchedSystems)
 	if err != nil {
@@ -317,7 +349,6 @@ func (m *SystemManager) GetSystemByID(ctx context.Context, systemID uuid.UUID) (
 	return system, nil
 }
 
-//nolint:cyclop
 func (m *SystemManager) LinkSystemAction(
 	ctx context.Context,
 	systemID uuid.UUID,
@@ -333,7 +364,6 @@ func (m *SystemManager) LinkSystemAction(
 			}
 
 			updatedSystem = system
-			keyConfig := &model.KeyConfiguration{ID: patchSystem.KeyConfigurationID}
 
 			// Check authorization for the TARGET key configuration
 			if patchSystem.KeyConfigurationID != uuid.Nil {
@@ -343,38 +373,68 @@ func (m *SystemManager) LinkSystemAction(
 				}
 			}
 
-			_, err = m.repo.First(ctx, keyConfig, *repo.NewQuery())
-			if err != nil {
-				return errs.Wrap(ErrGettingKeyConfigByID, err)
-			}
+			return m.linkSystem(ctx, system, patchSystem.KeyConfigurationID)
+		},
+	)
+	if err != nil {
+		return nil, errs.Wrap(ErrUpdateSystem, err)
+	}
 
-			_, err = m.KeyConfigManager.CanConnectSystems(ctx, keyConfig)
-			if err != nil {
-				return err
-			}
+	return updatedSystem, nil
+}
 
-			if system.Status == cmkapi.SystemStatusPROCESSING || system.Status == cmkapi.SystemStatusFAILED {
-				return ErrLinkSystemProcessingOrFailed
-			}
+// ValidateLinkSystem runs the preconditions of linking a system to a key configuration
+// without making any changes. Unmet preconditions are returned as blocking reasons,
+// conditions that only affect how the link is processed are returned as warnings.
+func (m *SystemManager) ValidateLinkSystem(
+	ctx context.Context,
+	systemID, keyConfigID uuid.UUID,
+) (cmkapi.SystemLinkValidation, error) {
+	system, err := m.GetSystemByID(ctx, systemID)
+	if err != nil {
+		return cmkapi.SystemLinkValidation{}, err
+	}
 
-			event, err := m.selectEvent(ctx, system, keyConfig)
-			if err != nil {
-				return err
-			}
+	_, err = m.user.HasSystemAccess(ctx, authz.APIActionSystemModifyLink, system)
+	if err != nil {
+		return cmkapi.SystemLinkValidation{}, err
+	}
 
-			err = m.eventFactory.SendEvent(ctx, event)
-			if err != nil {
-				return err
-			}
+	keyConfig := &model.KeyConfiguration{ID: keyConfigID}
 
-			return nil
-		},
-	)
+	_, err = m.repo.First(ctx, keyConfig, *repo.NewQuery())
 	if err != nil {
-		return nil, errs.Wrap(ErrUpdateSystem, err)
+		return cmkapi.SystemLinkValidation{},
+			errs.Wrap(ErrValidateSystemLink, errs.Wrap(ErrGettingKeyConfigByID, err))
 	}
 
-	return updatedSystem, nil
+	validation := &systemLinkValidation{}
+
+	err = m.validateLinkSystemState(ctx, validation, system, keyConfig)
+	if err != nil {
+		return cmkapi.SystemLinkValidation{}, errs.Wrap(ErrValidateSystemLink, err)
+	}
+
+	err = m.validateLinkPrimaryKey(ctx, validation, system, keyConfig)
+	if err != nil {
+		return cmkapi.SystemLinkValidation{}, errs.Wrap(ErrValidateSystemLink, err)
+	}
+
+	m.validateLinkRegistryMapping(ctx, validation, system)
+
+	if m.workflow != nil {
+		required, err := m.workflow.IsWorkflowRequired(ctx)
+		if err != nil {
+			return cmkapi.SystemLinkValidation{}, errs.Wrap(ErrValidateSystemLink, err)
+		}
+
+		if required {
+			validation.warn(SystemLinkReasonWorkflowRequired,
+				"the link must be requested through a workflow")
+		}
+	}
+
+	return validation.result(), nil
 }
 
 // UnlinkSystemAction unlinks a system.
@@ -480,6 +540,152 @@ func (m *SystemManager) UnmapSystemFromRegistry(ctx context.Context, system *mod
 	return err
 }
 
+// RetryFailedSystems automatically retries the last job of failed systems.
+// Only link, switch and rotate jobs failing with a retriable error code are retried,
+// with an exponential backoff and up to the maximum attempts of the retry policy.
+// Other failures are left for manual recovery.
+func (m *SystemManager) RetryFailedSystems(ctx context.Context) error {
+	query := repo.NewQuery().Where(
+		repo.NewCompositeKeyGroup(
+			repo.NewCompositeKey().Where(repo.StatusField, cmkapi.SystemStatusFAILED),
+		),
+	)
+
+	return repo.ProcessInBatch(ctx, m.repo, query, repo.DefaultLimit, func(systems []*model.System) error {
+		for _, system := range systems {
+			ctx := model.LogInjectSystem(ctx, system)
+
+			err := m.retryFailedSystem(ctx, system)
+			if err != nil {
+				log.Warn(ctx, "Failed to automatically retry system action", log.ErrorAttr(err))
+			}
+		}
+
+		return nil
+	})
+}
+
+// CheckKeyDrift triggers a check of the key state of the connected systems against their regions.
+// Systems whose reported key state doesn't match their key configuration are set to DRIFTED.
+// A check still in progress for the tenant is left to finish.
+func (m *SystemManager) CheckKeyDrift(ctx context.Context) error {
+	query := repo.NewQuery().Where(
+		repo.NewCompositeKeyGroup(
+			repo.NewCompositeKey().Where(repo.StatusField, []cmkapi.SystemStatus{
+				cmkapi.SystemStatusCONNECTED,
+				cmkapi.SystemStatusDRIFTED,
+			}),
+		),
+	)
+
+	count, err := m.repo.Count(ctx, &model.System{}, *query)
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	job, err := m.eventFactory.SystemKeyCheck(ctx)
+	if errors.Is(err, orbital.ErrJobAlreadyExists) {
+		log.Info(ctx, "System key check still in progress")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Info(ctx, "System key check triggered", slog.String("jobId", job.ID.String()))
+
+	return nil
+}
+
+func (m *SystemManager) retryFailedSystem(ctx context.Context, system *model.System) error {
+	event, err := m.eventFactory.GetLastEvent(ctx, system.ID.String())
+	if errors.Is(err, eventprocessor.ErrNoPreviousEvent) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !m.isRetriable(event) {
+		return nil
+	}
+
+	if time.Now().Before(event.UpdatedAt.Add(m.retryBackoff(event.RetryCount))) {
+		return nil
+	}
+
+	// The attempt is counted before sending so a failing send can't retry endlessly
+	_, err = m.repo.Patch(ctx, &model.Event{
+		Identifier: event.Identifier,
+		RetryCount: event.RetryCount + 1,
+	}, *repo.NewQuery())
+	if err != nil {
+		return err
+	}
+
+	log.Info(ctx, "Automatically retrying system action",
+		slog.String("eventType", event.Type),
+		slog.String("errorCode", event.ErrorCode),
+		slog.Int("attempt", event.RetryCount+1))
+
+	return m.retrySystemAction(ctx, system)
+}
+
+func (m *SystemManager) isRetriable(event *model.Event) bool {
+	switch event.Type {
+	case eventprocessor.JobTypeSystemLink.String(),
+		eventprocessor.JobTypeSystemSwitch.String(),
+		eventprocessor.JobTypeSystemSwitchNewPK.String(),
+		eventprocessor.JobTypeSystemKeyRotate.String():
+	default:
+		return false
+	}
+
+	if event.RetryCount >= m.retryPolicy.MaxAttempts {
+		return false
+	}
+
+	return slices.Contains(m.retryPolicy.RetriableErrorCodes, event.ErrorCode)
+}
+
+// retryBackoff returns the delay before the next attempt, doubling on every attempt
+func (m *SystemManager) retryBackoff(attempt int) time.Duration {
+	backoff := m.retryPolicy.InitialBackoff
+	for range attempt {
+		backoff *= 2
+		if backoff >= m.retryPolicy.MaxBackoff {
+			return m.retryPolicy.MaxBackoff
+		}
+	}
+
+	return min(backoff, m.retryPolicy.MaxBackoff)
+}
+
+// systemRetryPolicy fills unset values of the configured retry policy with defaults
+func systemRetryPolicy(cfg config.SystemRetry) config.SystemRetry {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = constants.DefaultSystemRetryMaxAttempts
+	}
+
+	if cfg.InitialBackoff <= 0 {
+		cfg.InitialBackoff = constants.DefaultSystemRetryInitialBackoff
+	}
+
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = constants.DefaultSystemRetryMaxBackoff
+	}
+
+	if len(cfg.RetriableErrorCodes) == 0 {
+		cfg.RetriableErrorCodes = constants.DefaultSystemRetryErrorCodes
+	}
+
+	return cfg
+}
+
 func (m *SystemManager) cancelSystemAction(ctx context.Context, systemID uuid.UUID) error {
 	event, err := m.eventFactory.GetLastEvent(ctx, systemID.String())
 	if err != nil {
@@ -536,6 +742,179 @@ func (m *SystemManager) retrySystemAction(ctx context.Context, system *model.Sys
 	return m.eventFactory.SendEvent(ctx, event)
 }
 
+// linkSystem sends the link or switch event of a system to the given key configuration
+func (m *SystemManager) linkSystem(ctx context.Context, system *model.System, keyConfigID uuid.UUID) error {
+	keyConfig := &model.KeyConfiguration{ID: keyConfigID}
+
+	_, err := m.repo.First(ctx, keyConfig, *repo.NewQuery())
+	if err != nil {
+		return errs.Wrap(ErrGettingKeyConfigByID, err)
+	}
+
+	_, err = m.KeyConfigManager.CanConnectSystems(ctx, keyConfig)
+	if err != nil {
+		return err
+	}
+
+	err = checkLinkSystemState(system)
+	if err != nil {
+		return err
+	}
+
+	event, err := m.selectEvent(ctx, system, keyConfig)
+	if err != nil {
+		return err
+	}
+
+	return m.eventFactory.SendEvent(ctx, event)
+}
+
+// checkLinkSystemState checks the state of the system allows linking it
+func checkLinkSystemState(system *model.System) error {
+	if system.Status == cmkapi.SystemStatusPROCESSING || system.Status == cmkapi.SystemStatusFAILED {
+		return ErrLinkSystemProcessingOrFailed
+	}
+
+	return nil
+}
+
+// systemLinkValidation collects the reasons found while validating a system link
+type systemLinkValidation struct {
+	blocking []cmkapi.SystemLinkValidationReason
+	warnings []cmkapi.SystemLinkValidationReason
+}
+
+func (v *systemLinkValidation) block(code, message string) {
+	v.blocking = append(v.blocking, cmkapi.SystemLinkValidationReason{Code: code, Message: message})
+}
+
+func (v *systemLinkValidation) warn(code, message string) {
+	v.warnings = append(v.warnings, cmkapi.SystemLinkValidationReason{Code: code, Message: message})
+}
+
+func (v *systemLinkValidation) result() cmkapi.SystemLinkValidation {
+	return cmkapi.SystemLinkValidation{
+		Valid:    len(v.blocking) == 0,
+		Blocking: append([]cmkapi.SystemLinkValidationReason{}, v.blocking...),
+		Warnings: append([]cmkapi.SystemLinkValidationReason{}, v.warnings...),
+	}
+}
+
+// validateLinkSystemState checks the system state and the workflows ongoing on the system
+func (m *SystemManager) validateLinkSystemState(
+	ctx context.Context,
+	validation *systemLinkValidation,
+	system *model.System,
+	keyConfig *model.KeyConfiguration,
+) error {
+	err := checkLinkSystemState(system)
+	if err != nil {
+		validation.block(SystemLinkReasonSystemProcessingOrFailed, err.Error())
+	}
+
+	if system.UnderWorkflow {
+		validation.block(SystemLinkReasonSystemUnderWorkflow, "system is locked by a workflow")
+	}
+
+	ck := repo.NewCompositeKey().
+		Where(fmt.Sprintf("%s_%s", repo.ArtifactField, repo.TypeField), model.WorkflowArtifactTypeSystem).
+		Where(fmt.Sprintf("%s_%s", repo.ArtifactField, repo.IDField), system.ID).
+		Where(repo.StateField, model.WorkflowNonTerminalStates)
+
+	count, err := m.repo.Count(ctx, &model.Workflow{}, *repo.NewQuery().Where(repo.NewCompositeKeyGroup(ck)))
+	if err != nil {
+		return errs.Wrap(ErrCheckOngoingWorkflow, err)
+	}
+
+	if count > 0 {
+		validation.block(SystemLinkReasonOngoingWorkflow, ErrOngoingWorkflowExist.Error())
+	}
+
+	if ptr.GetSafeDeref(system.KeyConfigurationID) == keyConfig.ID {
+		validation.warn(SystemLinkReasonAlreadyLinked,
+			"system is already linked to the key configuration, the link event is sent again")
+	}
+
+	return nil
+}
+
+// validateLinkPrimaryKey checks the primary key of the key configuration can serve the system.
+// Whether systems can be connected is decided by CanConnectSystems like on the link.
+func (m *SystemManager) validateLinkPrimaryKey(
+	ctx context.Context,
+	validation *systemLinkValidation,
+	system *model.System,
+	keyConfig *model.KeyConfiguration,
+) error {
+	_, err := m.KeyConfigManager.CanConnectSystems(ctx, keyConfig)
+	if errors.Is(err, ErrConnectSystemNoPrimaryKey) {
+		if !ptr.IsNotNilUUID(keyConfig.PrimaryKeyID) {
+			validation.block(SystemLinkReasonNoPrimaryKey, err.Error())
+			return nil
+		}
+
+		validation.block(SystemLinkReasonPrimaryKeyNotEnabled, err.Error())
+	} else if err != nil {
+		return err
+	}
+
+	key := &model.Key{ID: *keyConfig.PrimaryKeyID}
+
+	_, err = m.repo.First(ctx, key, *repo.NewQuery())
+	if err != nil {
+		return errs.Wrap(ErrGettingKeyByID, err)
+	}
+
+	cryptoData := key.GetCryptoAccessData()
+	if len(cryptoData) > 0 {
+		if _, ok := cryptoData[system.Region]; !ok {
+			validation.block(SystemLinkReasonRegionNotSupported,
+				fmt.Sprintf("primary key has no crypto access data for region %s", system.Region))
+		}
+	}
+
+	if key.UnderWorkflow {
+		validation.warn(SystemLinkReasonPrimaryKeyUnderWorkflow, "primary key is locked by a workflow")
+	}
+
+	return nil
+}
+
+// validateLinkRegistryMapping checks the system is mapped to the tenant in the registry.
+// Failing to reach the registry is only a warning as the link event is processed asynchronously.
+func (m *SystemManager) validateLinkRegistryMapping(
+	ctx context.Context,
+	validation *systemLinkValidation,
+	system *model.System,
+) {
+	if m.registry == nil {
+		validation.warn(SystemLinkReasonRegistryUnavailable, "registry mapping could not be checked")
+		return
+	}
+
+	tenant, err := cmkcontext.ExtractTenantID(ctx)
+	if err != nil {
+		validation.warn(SystemLinkReasonRegistryUnavailable, "registry mapping could not be checked")
+		return
+	}
+
+	registrySystems, err := m.registry.System().GetSystemsWithFilter(ctx, systems.SystemFilter{
+		ExternalID: system.Identifier,
+		Region:     system.Region,
+		TenantID:   tenant,
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		log.Warn(ctx, "Could not check system mapping in registry", log.ErrorAttr(err))
+		validation.warn(SystemLinkReasonRegistryUnavailable, "registry mapping could not be checked")
+
+		return
+	}
+
+	if len(registrySystems) == 0 {
+		validation.block(SystemLinkReasonSystemNotMapped, "system is not mapped to the tenant in the registry")
+	}
+}
+
 func (m *SystemManager) selectEvent(
 	ctx context.Context,
 	system *model.System,
@@ -591,7 +970,8 @@ func (m *SystemManager) selectEvent(
 	}, nil
 }
 
-func (m *SystemManager) createSystemIfNotExists(ctx context.Context, newSystem *model.System) error {
+// createSystemIfNotExists stores a system found in the registry and reports whether it was created
+func (m *SystemManager) createSystemIfNotExists(ctx context.Context, newSystem *model.System) (bool, error) {
 	// Systems are identified by their ExternalID and Region - those fields can not be updated
 	system := &model.System{}
 	query := *repo.NewQuery().Where(
@@ -608,7 +988,7 @@ func (m *SystemManager) createSystemIfNotExists(ctx context.Context, newSystem *
 
 	count, _ := m.repo.Count(ctx, system, query)
 	if count > 0 {
-		return nil
+		return false, nil
 	}
 
 	ctx = model.LogInjectSystem(ctx, newSystem)
@@ -616,7 +996,7 @@ func (m *SystemManager) createSystemIfNotExists(ctx context.Context, newSystem *
 
 	err := m.repo.Create(ctx, newSystem)
 	if err != nil {
-		return errs.Wrap(ErrCreatingSystem, err)
+		return false, errs.Wrap(ErrCreatingSystem, err)
 	}
 
 	err = m.sisClient.updateSystem(ctx, newSystem)
@@ -624,7 +1004,95 @@ func (m *SystemManager) createSystemIfNotExists(ctx context.Context, newSystem *
 		log.Warn(ctx, "SIS Update Failed", log.ErrorAttr(err))
 	}
 
-	return nil
+	return true, nil
+}
+
+// autoLinkSystems links new systems matching a tenant auto-link rule.
+// If workflows are required a link workflow is opened instead.
+// Failures are logged and do not fail the refresh.
+func (m *SystemManager) autoLinkSystems(ctx context.Context, newSystems []*model.System) {
+	if len(newSystems) == 0 {
+		return
+	}
+
+	autoLinkConfig, err := getSystemAutoLinkConfig(ctx, m.repo)
+	if err != nil {
+		log.Warn(ctx, "Could not get system auto-link config", log.ErrorAttr(err))
+		return
+	}
+
+	if len(autoLinkConfig.Rules) == 0 {
+		return
+	}
+
+	for _, newSystem := range newSystems {
+		// Properties are filled in by SIS after the system is created
+		system, err := m.GetSystemByID(ctx, newSystem.ID)
+		if err != nil {
+			log.Warn(ctx, "Could not get system for auto-link", log.ErrorAttr(err))
+			continue
+		}
+
+		rule := autoLinkConfig.Match(system)
+		if rule == nil {
+			continue
+		}
+
+		ctx := model.LogInjectSystem(ctx, system)
+
+		err = m.autoLinkSystem(ctx, system, rule.KeyConfigurationID)
+		if err != nil {
+			log.Warn(ctx, "Could not auto-link system", log.ErrorAttr(err),
+				slog.String("rule", rule.Name))
+			continue
+		}
+
+		log.Info(ctx, "Auto-linked system", slog.String("rule", rule.Name),
+			slog.String("keyConfigurationID", rule.KeyConfigurationID.String()))
+	}
+}
+
+func (m *SystemManager) autoLinkSystem(ctx context.Context, system *model.System, keyConfigID uuid.UUID) error {
+	if m.workflow != nil {
+		workflowConfig, err := m.workflow.WorkflowConfig(ctx)
+		if err != nil {
+			return err
+		}
+
+		if workflowConfig.Enabled {
+			return m.createLinkWorkflow(ctx, system, keyConfigID, workflowConfig.DefaultExpiryPeriodDays)
+		}
+	}
+
+	return m.repo.Transaction(ctx, func(ctx context.Context) error {
+		return m.linkSystem(ctx, system, keyConfigID)
+	})
+}
+
+func (m *SystemManager) createLinkWorkflow(
+	ctx context.Context,
+	system *model.System,
+	keyConfigID uuid.UUID,
+	expiryPeriodDays int,
+) error {
+	businessUserData, err := cmkcontext.ExtractBusinessUserData(ctx)
+	if err != nil {
+		return err
+	}
+
+	expiryDate := time.Now().AddDate(0, 0, expiryPeriodDays)
+
+	_, err = m.workflow.CreateWorkflow(ctx, &model.Workflow{
+		ID:           uuid.New(),
+		ActionType:   model.WorkflowActionTypeLink,
+		ArtifactType: model.WorkflowArtifactTypeSystem,
+		ArtifactID:   system.ID,
+		InitiatorID:  businessUserData.Identifier,
+		Parameters:   keyConfigID.String(),
+		ExpiryDate:   &expiryDate,
+	})
+
+	return err
 }
 
 func (m *SystemManager) removeSystemsNotInRegistry(ctx context.Context, registrySystems []*model.System) error {
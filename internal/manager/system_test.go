@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/openkcm/common-sdk/pkg/commoncfg"
@@ -72,9 +73,10 @@ func SetupSystemManager(t *testing.T, clientsFactory clients.Factory) (
 		&config.Config{
 			Certificates: config.Certificates{ValidityDays: config.MinCertificateValidityDays},
 		},
+		nil,
 	)
 	userManager := manager.NewUserManager(r, auditor.New(t.Context(), &cfg))
-	tagManager := manager.NewTagManager(r)
+	tagManager := manager.NewTagManager(r, nil)
 	keyConfigManager := manager.NewKeyConfigManager(r, certManager, userManager, tagManager, nil, nil, &cfg)
 
 	eventFactory, err := eventprocessor.NewEventFactory(t.Context(), &cfg, r)
@@ -954,6 +956,181 @@ func TestSendRecoveryAction(t *testing.T) {
 	}
 }
 
+func TestRetryFailedSystems(t *testing.T) {
+	m, db, tenant := SetupSystemManager(t, nil)
+	ctx := testutils.CreateCtxWithTenant(tenant)
+	r := sql.NewRepository(db)
+
+	createFailedSystem := func(t *testing.T, eventType eventprocessor.JobType, errorCode string,
+		retryCount int, failedAt time.Time,
+	) *model.System {
+		t.Helper()
+
+		system := testutils.NewSystem(
+			func(s *model.System) {
+				s.Status = cmkapi.SystemStatusFAILED
+			},
+		)
+		testutils.CreateTestEntities(ctx, t, r, system, &model.Event{
+			Identifier: system.ID.String(),
+			Type:       eventType.String(),
+			Data:       []byte("{}"),
+			ErrorCode:  errorCode,
+			RetryCount: retryCount,
+		})
+
+		// Creating the event always sets UpdatedAt to now, so the failure time is set directly
+		err := r.WithTenant(ctx, &model.Event{}, func(tx *multitenancy.DB) error {
+			return tx.Model(&model.Event{}).
+				Where("identifier = ?", system.ID.String()).
+				UpdateColumn("updated_at", failedAt).Error
+		})
+		require.NoError(t, err)
+
+		err = db.WithTenant(
+			ctx, "orbital", func(tx *multitenancy.DB) error {
+				job := orbital.Job{
+					ID:         uuid.New(),
+					ExternalID: system.ID.String(),
+					Data:       []byte("{}"),
+					Type:       eventType.String(),
+					Status:     orbital.JobStatusFailed,
+				}
+
+				return tx.Table("jobs").Create(&job).Error
+			},
+		)
+		require.NoError(t, err)
+
+		return system
+	}
+
+	longAgo := time.Now().Add(-24 * time.Hour)
+
+	tests := []struct {
+		name           string
+		eventType      eventprocessor.JobType
+		errorCode      string
+		retryCount     int
+		failedAt       time.Time
+		expectedStatus cmkapi.SystemStatus
+		expectedCount  int
+	}{
+		{
+			name:           "Should retry link with retriable error",
+			eventType:      eventprocessor.JobTypeSystemLink,
+			errorCode:      constants.DefaultErrorCode,
+			failedAt:       longAgo,
+			expectedStatus: cmkapi.SystemStatusPROCESSING,
+			expectedCount:  1,
+		},
+		{
+			name:           "Should retry switch after previous attempts",
+			eventType:      eventprocessor.JobTypeSystemSwitch,
+			errorCode:      constants.DefaultErrorCode,
+			retryCount:     2,
+			failedAt:       longAgo,
+			expectedStatus: cmkapi.SystemStatusPROCESSING,
+			expectedCount:  3,
+		},
+		{
+			name:           "Should not retry before backoff elapsed",
+			eventType:      eventprocessor.JobTypeSystemLink,
+			errorCode:      constants.DefaultErrorCode,
+			retryCount:     1,
+			failedAt:       time.Now().Add(-constants.DefaultSystemRetryInitialBackoff),
+			expectedStatus: cmkapi.SystemStatusFAILED,
+			expectedCount:  1,
+		},
+		{
+			name:           "Should not retry non retriable error",
+			eventType:      eventprocessor.JobTypeSystemLink,
+			errorCode:      "INVALID_KEY",
+			failedAt:       longAgo,
+			expectedStatus: cmkapi.SystemStatusFAILED,
+			expectedCount:  0,
+		},
+		{
+			name:           "Should not retry after max attempts",
+			eventType:      eventprocessor.JobTypeSystemLink,
+			errorCode:      constants.DefaultErrorCode,
+			retryCount:     constants.DefaultSystemRetryMaxAttempts,
+			failedAt:       longAgo,
+			expectedStatus: cmkapi.SystemStatusFAILED,
+			expectedCount:  constants.DefaultSystemRetryMaxAttempts,
+		},
+		{
+			name:           "Should not retry unlink",
+			eventType:      eventprocessor.JobTypeSystemUnlink,
+			errorCode:      constants.DefaultErrorCode,
+			failedAt:       longAgo,
+			expectedStatus: cmkapi.SystemStatusFAILED,
+			expectedCount:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			system := createFailedSystem(t, tt.eventType, tt.errorCode, tt.retryCount, tt.failedAt)
+
+			err := m.RetryFailedSystems(ctx)
+			assert.NoError(t, err)
+
+			_, err = r.First(ctx, system, *repo.NewQuery())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, system.Status)
+
+			event := &model.Event{Identifier: system.ID.String()}
+			_, err = r.First(ctx, event, *repo.NewQuery())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCount, event.RetryCount)
+		})
+	}
+}
+
+func TestCheckKeyDrift(t *testing.T) {
+	m, db, tenant := SetupSystemManager(t, nil)
+	ctx := testutils.CreateCtxWithTenant(tenant)
+	r := sql.NewRepository(db)
+
+	countCheckJobs := func(t *testing.T) int64 {
+		t.Helper()
+
+		var count int64
+		err := db.WithTenant(ctx, "orbital", func(tx *multitenancy.DB) error {
+			return tx.Table("jobs").
+				Where("external_id = ? AND type = ?", tenant, eventprocessor.JobTypeSystemKeyCheck.String()).
+				Count(&count).Error
+		})
+		require.NoError(t, err)
+
+		return count
+	}
+
+	t.Run("Should not check without connected systems", func(t *testing.T) {
+		testutils.CreateTestEntities(ctx, t, r, testutils.NewSystem(func(_ *model.System) {}))
+
+		err := m.CheckKeyDrift(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), countCheckJobs(t))
+	})
+
+	t.Run("Should trigger a single check for connected systems", func(t *testing.T) {
+		testutils.CreateTestEntities(ctx, t, r, testutils.NewSystem(func(s *model.System) {
+			s.Status = cmkapi.SystemStatusCONNECTED
+		}))
+
+		err := m.CheckKeyDrift(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), countCheckJobs(t))
+
+		// The previous check is still in progress
+		err = m.CheckKeyDrift(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), countCheckJobs(t))
+	})
+}
+
 func TestSelectEvent(t *testing.T) {
 	m, db, tenant := SetupSystemManager(t, nil)
 	ctx := testutils.CreateCtxWithTenant(tenant)
@@ -1233,6 +1410,210 @@ func TestLinkSystemAction(t *testing.T) {
 	}
 }
 
+func TestValidateLinkSystem(t *testing.T) {
+	systemService := systems.NewFakeService(testutils.SetupLoggerWithBuffer())
+	_, grpcClient := testutils.NewGRPCSuite(
+		t,
+		func(s *grpc.Server) {
+			systemgrpc.RegisterServiceServer(s, systemService)
+		},
+	)
+
+	clientsFactory, err := clients.NewFactory(
+		config.Services{
+			Registry: &commoncfg.GRPCClient{
+				Enabled: true,
+				Address: grpcClient.Target(),
+				SecretRef: &commoncfg.SecretRef{
+					Type: commoncfg.InsecureSecretType,
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	m, db, tenant := SetupSystemManager(t, clientsFactory)
+	ctx := testutils.CreateCtxWithTenant(tenant)
+	ctx = testutils.InjectBusinessUserDataIntoContext(ctx, "test-user", []string{"test-group"})
+	r := sql.NewRepository(db)
+
+	region := regionpb.Region_REGION_EU.String()
+
+	newMappedSystem := func(mut func(*model.System)) *model.System {
+		system := testutils.NewSystem(func(s *model.System) {
+			s.Region = region
+			mut(s)
+		})
+		registerSystem(ctx, t, systemService, system.Identifier, region, string(system.Type),
+			func(req *systemgrpc.RegisterSystemRequest) {
+				req.TenantId = tenant
+			},
+		)
+		testutils.CreateTestEntities(ctx, t, r, system)
+
+		return system
+	}
+
+	testGroup := testutils.NewGroup(func(g *model.Group) {
+		g.IAMIdentifier = "test-group"
+	})
+	key := testutils.NewKey(func(k *model.Key) {
+		k.CryptoAccessData = json.RawMessage(`{"` + region + `": {}}`)
+	})
+	keyConfig := testutils.NewKeyConfig(func(k *model.KeyConfiguration) {
+		k.PrimaryKeyID = &key.ID
+		k.AdminGroupID = testGroup.ID
+		k.AdminGroup = *testGroup
+	})
+	keyConfigNoPrimary := testutils.NewKeyConfig(func(k *model.KeyConfiguration) {
+		k.AdminGroupID = testGroup.ID
+		k.AdminGroup = *testGroup
+	})
+	disabledKey := testutils.NewKey(func(k *model.Key) {
+		k.State = cmkapi.KeyStateDISABLED
+		k.UnderWorkflow = true
+		k.CryptoAccessData = json.RawMessage(`{"other-region": {}}`)
+	})
+	keyConfigDisabled := testutils.NewKeyConfig(func(k *model.KeyConfiguration) {
+		k.PrimaryKeyID = &disabledKey.ID
+		k.AdminGroupID = testGroup.ID
+		k.AdminGroup = *testGroup
+	})
+	testutils.CreateTestEntities(ctx, t, r, key, disabledKey, keyConfig, keyConfigNoPrimary, keyConfigDisabled)
+
+	reasonCodes := func(reasons []cmkapi.SystemLinkValidationReason) []string {
+		result := make([]string, 0, len(reasons))
+		for _, reason := range reasons {
+			result = append(result, reason.Code)
+		}
+
+		return result
+	}
+
+	t.Run("Should be valid for a linkable system", func(t *testing.T) {
+		system := newMappedSystem(func(_ *model.System) {})
+
+		validation, err := m.ValidateLinkSystem(ctx, system.ID, keyConfig.ID)
+		assert.NoError(t, err)
+		assert.True(t, validation.Valid)
+		assert.Empty(t, validation.Blocking)
+		assert.Empty(t, validation.Warnings)
+	})
+
+	t.Run("Should block without primary key", func(t *testing.T) {
+		system := newMappedSystem(func(_ *model.System) {})
+
+		validation, err := m.ValidateLinkSystem(ctx, system.ID, keyConfigNoPrimary.ID)
+		assert.NoError(t, err)
+		assert.False(t, validation.Valid)
+		assert.Equal(t, []string{manager.SystemLinkReasonNoPrimaryKey}, reasonCodes(validation.Blocking))
+	})
+
+	t.Run("Should block disabled primary key without access to system region", func(t *testing.T) {
+		system := newMappedSystem(func(_ *model.System) {})
+
+		validation, err := m.ValidateLinkSystem(ctx, system.ID, keyConfigDisabled.ID)
+		assert.NoError(t, err)
+		assert.False(t, validation.Valid)
+		assert.Equal(t, []string{
+			manager.SystemLinkReasonPrimaryKeyNotEnabled,
+			manager.SystemLinkReasonRegionNotSupported,
+		}, reasonCodes(validation.Blocking))
+		assert.Equal(t, []string{manager.SystemLinkReasonPrimaryKeyUnderWorkflow}, reasonCodes(validation.Warnings))
+	})
+
+	t.Run("Should block the key configurations the link rejects", func(t *testing.T) {
+		for _, kc := range []*model.KeyConfiguration{keyConfigNoPrimary, keyConfigDisabled} {
+			system := newMappedSystem(func(_ *model.System) {})
+
+			validation, err := m.ValidateLinkSystem(ctx, system.ID, kc.ID)
+			assert.NoError(t, err)
+			assert.False(t, validation.Valid)
+
+			_, err = m.LinkSystemAction(ctx, system.ID, cmkapi.SystemPatch{KeyConfigurationID: kc.ID})
+			assert.ErrorIs(t, err, manager.ErrConnectSystemNoPrimaryKey)
+		}
+	})
+
+	t.Run("Should block system in processing state with ongoing workflow", func(t *testing.T) {
+		system := newMappedSystem(func(s *model.System) {
+			s.Status = cmkapi.SystemStatusPROCESSING
+			s.UnderWorkflow = true
+		})
+		workflow := testutils.NewWorkflow(func(w *model.Workflow) {
+			w.ArtifactType = model.WorkflowArtifactTypeSystem
+			w.ArtifactID = system.ID
+			w.ActionType = model.WorkflowActionTypeLink
+			w.State = model.WorkflowStateWaitApproval
+		})
+		testutils.CreateTestEntities(ctx, t, r, workflow)
+
+		validation, err := m.ValidateLinkSystem(ctx, system.ID, keyConfig.ID)
+		assert.NoError(t, err)
+		assert.False(t, validation.Valid)
+		assert.Equal(t, []string{
+			manager.SystemLinkReasonSystemProcessingOrFailed,
+			manager.SystemLinkReasonSystemUnderWorkflow,
+			manager.SystemLinkReasonOngoingWorkflow,
+		}, reasonCodes(validation.Blocking))
+	})
+
+	t.Run("Should warn if system is already linked", func(t *testing.T) {
+		system := newMappedSystem(func(s *model.System) {
+			s.KeyConfigurationID = &keyConfig.ID
+			s.Status = cmkapi.SystemStatusCONNECTED
+		})
+
+		validation, err := m.ValidateLinkSystem(ctx, system.ID, keyConfig.ID)
+		assert.NoError(t, err)
+		assert.True(t, validation.Valid)
+		assert.Equal(t, []string{manager.SystemLinkReasonAlreadyLinked}, reasonCodes(validation.Warnings))
+	})
+
+	t.Run("Should block system not mapped to the tenant", func(t *testing.T) {
+		system := testutils.NewSystem(func(s *model.System) {
+			s.Region = region
+		})
+		testutils.CreateTestEntities(ctx, t, r, system)
+
+		validation, err := m.ValidateLinkSystem(ctx, system.ID, keyConfig.ID)
+		assert.NoError(t, err)
+		assert.False(t, validation.Valid)
+		assert.Equal(t, []string{manager.SystemLinkReasonSystemNotMapped}, reasonCodes(validation.Blocking))
+	})
+
+	t.Run("Should warn if registry is not available", func(t *testing.T) {
+		mNoRegistry, dbNoRegistry, tenantNoRegistry := SetupSystemManager(t, nil)
+		ctx := testutils.CreateCtxWithTenant(tenantNoRegistry)
+		ctx = testutils.InjectBusinessUserDataIntoContext(ctx, "test-user", []string{"test-group"})
+		r := sql.NewRepository(dbNoRegistry)
+
+		system := testutils.NewSystem(func(_ *model.System) {})
+		keyConfig := testutils.NewKeyConfig(func(k *model.KeyConfiguration) {
+			k.PrimaryKeyID = &key.ID
+		})
+		testutils.CreateTestEntities(ctx, t, r, key, keyConfig, system)
+
+		validation, err := mNoRegistry.ValidateLinkSystem(ctx, system.ID, keyConfig.ID)
+		assert.NoError(t, err)
+		assert.True(t, validation.Valid)
+		assert.Equal(t, []string{manager.SystemLinkReasonRegistryUnavailable}, reasonCodes(validation.Warnings))
+	})
+
+	t.Run("Should fail on non-existing key configuration", func(t *testing.T) {
+		system := newMappedSystem(func(_ *model.System) {})
+
+		_, err := m.ValidateLinkSystem(ctx, system.ID, uuid.New())
+		assert.ErrorIs(t, err, manager.ErrValidateSystemLink)
+		assert.ErrorIs(t, err, manager.ErrGettingKeyConfigByID)
+	})
+
+	t.Run("Should fail on non-existing system", func(t *testing.T) {
+		_, err := m.ValidateLinkSystem(ctx, uuid.New(), keyConfig.ID)
+		assert.ErrorIs(t, err, manager.ErrGettingSystemByID)
+	})
+}
+
 func TestUnlinkSystemAction(t *testing.T) {
 	logger := testutils.SetupLoggerWithBuffer()
 	systemService := systems.NewFakeService(logger)
@@ -1530,6 +1911,107 @@ func TestRefreshSystems(t *testing.T) {
 	})
 }
 
+func TestRefreshSystemsAutoLink(t *testing.T) {
+	logger := testutils.SetupLoggerWithBuffer()
+	systemService := systems.NewFakeService(logger)
+	_, grpcClient := testutils.NewGRPCSuite(
+		t,
+		func(s *grpc.Server) {
+			systemgrpc.RegisterServiceServer(s, systemService)
+		},
+	)
+
+	clientsFactory, err := clients.NewFactory(
+		config.Services{
+			Registry: &commoncfg.GRPCClient{
+				Enabled: true,
+				Address: grpcClient.Target(),
+				SecretRef: &commoncfg.SecretRef{
+					Type: commoncfg.InsecureSecretType,
+				},
+			},
+		},
+	)
+	assert.NoError(t, err)
+	assert.NoError(t, clientsFactory.Close())
+
+	m, db, tenant := SetupSystemManager(t, clientsFactory)
+	ctx := testutils.CreateCtxWithTenant(tenant)
+	ctx = testutils.InjectBusinessUserDataIntoContext(ctx, "test-user", []string{"test-group"})
+	r := sql.NewRepository(db)
+
+	key := testutils.NewKey(func(_ *model.Key) {})
+	keyConfig := testutils.NewKeyConfig(
+		func(k *model.KeyConfiguration) {
+			k.PrimaryKeyID = &key.ID
+		},
+	)
+	testutils.CreateTestEntities(ctx, t, r, keyConfig, key)
+
+	tenantConfigManager := manager.NewTenantConfigManager(r, nil, nil, nil, nil)
+	_, err = tenantConfigManager.SetSystemAutoLinkConfig(ctx, &model.SystemAutoLinkConfig{
+		Rules: []model.SystemAutoLinkRule{
+			{
+				Name:               "eu-subaccounts",
+				Type:               systems.SystemTypeSUBACCOUNT,
+				Region:             regionpb.Region_REGION_EU.String(),
+				KeyConfigurationID: keyConfig.ID,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	getSystem := func(t *testing.T, externalID string) *model.System {
+		t.Helper()
+
+		sys := &model.System{}
+		_, err := r.First(
+			ctx,
+			sys,
+			*repo.NewQuery().Where(
+				repo.NewCompositeKeyGroup(
+					repo.NewCompositeKey().
+						Where(repo.IdentifierField, externalID),
+				),
+			),
+		)
+		require.NoError(t, err)
+
+		return sys
+	}
+
+	matchingID := uuid.NewString()
+	registerSystem(
+		ctx, t, systemService, matchingID, regionpb.Region_REGION_EU.String(),
+		string(systems.SystemTypeSUBACCOUNT),
+		func(req *systemgrpc.RegisterSystemRequest) {
+			req.TenantId = tenant
+		},
+	)
+
+	otherID := uuid.NewString()
+	registerSystem(
+		ctx, t, systemService, otherID, regionpb.Region_REGION_US.String(),
+		string(systems.SystemTypeSUBACCOUNT),
+		func(req *systemgrpc.RegisterSystemRequest) {
+			req.TenantId = tenant
+		},
+	)
+
+	assert.True(t, m.RefreshSystemsData(ctx))
+
+	t.Run("Should link new system matching a rule", func(t *testing.T) {
+		sys := getSystem(t, matchingID)
+		assert.Equal(t, &keyConfig.ID, sys.TargetKeyConfigurationID)
+	})
+
+	t.Run("Should not link new system not matching any rule", func(t *testing.T) {
+		sys := getSystem(t, otherID)
+		assert.Nil(t, sys.TargetKeyConfigurationID)
+		assert.Nil(t, sys.KeyConfigurationID)
+	})
+}
+
 func TestGetFilters(t *testing.T) {
 	groupID := uuid.NewString()
 	m, db, tenant := SetupSystemManager(t, nil)
@@ -0,0 +1,532 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/api/transform/systemgroup"
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/utils/ptr"
+)
+
+type SystemGroupManager struct {
+	repo   repo.Repo
+	system System
+}
+
+func NewSystemGroupManager(repository repo.Repo, system System) *SystemGroupManager {
+	return &SystemGroupManager{
+		repo:   repository,
+		system: system,
+	}
+}
+
+// SystemGroupActionResult is the outcome of a group action on one of its systems.
+// Skipped is set if the system was already in the requested state
+// and Err is set if the action could not be triggered for the system
+type SystemGroupActionResult struct {
+	SystemID uuid.UUID
+	Skipped  bool
+	Err      error
+}
+
+func (m *SystemGroupManager) GetSystemGroups(
+	ctx context.Context,
+	pagination repo.Pagination,
+) ([]*model.SystemGroup, int, error) {
+	query := repo.NewQuery().Order(repo.OrderField{
+		Field:     repo.NameField,
+		Direction: repo.Asc,
+	})
+
+	groups, count, err := repo.ListAndCount(ctx, m.repo, pagination, model.SystemGroup{}, query)
+	if err != nil {
+		return nil, 0, errs.Wrap(ErrListSystemGroups, err)
+	}
+
+	err = m.loadStatuses(ctx, groups)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return groups, count, nil
+}
+
+func (m *SystemGroupManager) GetSystemGroupByID(ctx context.Context, id uuid.UUID) (*model.SystemGroup, error) {
+	group, err := m.getSystemGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.loadStatus(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// CreateSystemGroup creates a system group.
+// Groups with a selector derive their systems from it,
+// otherwise systemIDs are the explicit members of the group
+func (m *SystemGroupManager) CreateSystemGroup(
+	ctx context.Context,
+	group *model.SystemGroup,
+	systemIDs []uuid.UUID,
+) (*model.SystemGroup, error) {
+	err := validateSelector(group)
+	if err != nil {
+		return nil, errs.Wrap(ErrCreateSystemGroup, err)
+	}
+
+	if group.HasSelector() && len(systemIDs) > 0 {
+		return nil, errs.Wrap(ErrCreateSystemGroup, ErrSystemGroupMembership)
+	}
+
+	err = m.repo.Transaction(ctx, func(ctx context.Context) error {
+		err := m.repo.Create(ctx, group)
+		if err != nil {
+			return err
+		}
+
+		return m.addSystems(ctx, group.ID, systemIDs)
+	})
+	if err != nil {
+		return nil, errs.Wrap(ErrCreateSystemGroup, err)
+	}
+
+	err = m.loadStatus(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// UpdateSystemGroup updates a system group.
+// The selector can only be changed on selector groups and
+// the systems can only be replaced on explicit groups
+func (m *SystemGroupManager) UpdateSystemGroup(
+	ctx context.Context,
+	id uuid.UUID,
+	patchGroup cmkapi.SystemGroupPatch,
+) (*model.SystemGroup, error) {
+	group, err := m.getSystemGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if (patchGroup.Selector != nil && !group.HasSelector()) ||
+		(patchGroup.SystemIDs != nil && group.HasSelector()) {
+		return nil, errs.Wrap(ErrUpdateSystemGroup, ErrSystemGroupMembership)
+	}
+
+	if patchGroup.Name != nil {
+		if *patchGroup.Name == "" {
+			return nil, errs.Wrap(ErrUpdateSystemGroup, ErrNameCannotBeEmpty)
+		}
+
+		group.Name = *patchGroup.Name
+	}
+
+	if patchGroup.Description != nil {
+		group.Description = *patchGroup.Description
+	}
+
+	if patchGroup.Selector != nil {
+		err = group.SetSelector(systemgroup.SelectorFromAPI(patchGroup.Selector))
+		if err != nil {
+			return nil, errs.Wrap(ErrUpdateSystemGroup, err)
+		}
+
+		err = validateSelector(group)
+		if err != nil {
+			return nil, errs.Wrap(ErrUpdateSystemGroup, err)
+		}
+	}
+
+	err = m.repo.Transaction(ctx, func(ctx context.Context) error {
+		_, err := m.repo.Patch(ctx, group, *repo.NewQuery())
+		if err != nil {
+			return err
+		}
+
+		if patchGroup.SystemIDs == nil {
+			return nil
+		}
+
+		_, err = m.repo.Delete(ctx, &model.SystemGroupSystem{}, *repo.NewQuery().Where(
+			repo.NewCompositeKeyGroup(
+				repo.NewCompositeKey().Where(repo.SystemGroupIDField, group.ID),
+			),
+		))
+		if err != nil {
+			return err
+		}
+
+		return m.addSystems(ctx, group.ID, *patchGroup.SystemIDs)
+	})
+	if err != nil {
+		return nil, errs.Wrap(ErrUpdateSystemGroup, err)
+	}
+
+	err = m.loadStatus(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// DeleteSystemGroup deletes a system group.
+// Member systems are kept as they are
+func (m *SystemGroupManager) DeleteSystemGroup(ctx context.Context, id uuid.UUID) error {
+	_, err := m.getSystemGroup(ctx, id)
+	if err != nil {
+		return errs.Wrap(ErrDeleteSystemGroup, err)
+	}
+
+	_, err = m.repo.Delete(ctx, &model.SystemGroup{ID: id}, *repo.NewQuery())
+	if err != nil {
+		return errs.Wrap(ErrDeleteSystemGroup, err)
+	}
+
+	return nil
+}
+
+func (m *SystemGroupManager) GetSystemGroupSystems(
+	ctx context.Context,
+	id uuid.UUID,
+	pagination repo.Pagination,
+) ([]*model.System, int, error) {
+	group, err := m.getSystemGroup(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query, err := membersQuery(group)
+	if err != nil {
+		return nil, 0, errs.Wrap(ErrListSystemGroupSystems, err)
+	}
+
+	systems, count, err := repo.ListAndCountSystemWithProperties(ctx, m.repo, pagination, query)
+	if err != nil {
+		return nil, 0, errs.Wrap(ErrListSystemGroupSystems, err)
+	}
+
+	return systems, count, nil
+}
+
+// LinkSystemGroup links or switches all systems of the group to the key configuration.
+// Systems already connected to the key configuration are skipped
+func (m *SystemGroupManager) LinkSystemGroup(
+	ctx context.Context,
+	id uuid.UUID,
+	keyConfigID uuid.UUID,
+) ([]SystemGroupActionResult, error) {
+	return m.applyAction(ctx, id, func(ctx context.Context, system *model.System) (bool, error) {
+		if ptr.GetSafeDeref(system.KeyConfigurationID) == keyConfigID && system.IsConnected() {
+			return true, nil
+		}
+
+		_, err := m.system.LinkSystemAction(ctx, system.ID, cmkapi.SystemPatch{
+			KeyConfigurationID: keyConfigID,
+		})
+
+		return false, err
+	})
+}
+
+// UnlinkSystemGroup unlinks all systems of the group.
+// Systems that are not linked are skipped
+func (m *SystemGroupManager) UnlinkSystemGroup(
+	ctx context.Context,
+	id uuid.UUID,
+) ([]SystemGroupActionResult, error) {
+	return m.applyAction(ctx, id, func(ctx context.Context, system *model.System) (bool, error) {
+		if !ptr.IsNotNilUUID(system.KeyConfigurationID) {
+			return true, nil
+		}
+
+		return false, m.system.UnlinkSystemAction(ctx, system.ID, "")
+	})
+}
+
+// applyAction runs the action on each system of the group.
+// A failure on a system doesn't stop the action on the remaining systems
+// and is reported in its result instead
+func (m *SystemGroupManager) applyAction(
+	ctx context.Context,
+	id uuid.UUID,
+	action func(ctx context.Context, system *model.System) (bool, error),
+) ([]SystemGroupActionResult, error) {
+	group, err := m.getSystemGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := membersQuery(group)
+	if err != nil {
+		return nil, errs.Wrap(ErrSystemGroupAction, err)
+	}
+
+	var results []SystemGroupActionResult
+
+	err = repo.ProcessInBatch(ctx, m.repo, query, repo.DefaultLimit, func(systems []*model.System) error {
+		for _, system := range systems {
+			skipped, err := action(model.LogInjectSystem(ctx, system), system)
+			results = append(results, SystemGroupActionResult{
+				SystemID: system.ID,
+				Skipped:  skipped,
+				Err:      err,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errs.Wrap(ErrSystemGroupAction, err)
+	}
+
+	return results, nil
+}
+
+func (m *SystemGroupManager) getSystemGroup(ctx context.Context, id uuid.UUID) (*model.SystemGroup, error) {
+	group := &model.SystemGroup{ID: id}
+
+	_, err := m.repo.First(ctx, group, *repo.NewQuery())
+	if err != nil {
+		return nil, errs.Wrap(ErrGetSystemGroup, err)
+	}
+
+	return group, nil
+}
+
+// addSystems adds the systems as explicit members of the group
+// All systems must exist
+func (m *SystemGroupManager) addSystems(ctx context.Context, groupID uuid.UUID, systemIDs []uuid.UUID) error {
+	if len(systemIDs) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(systemIDs))
+	for _, id := range systemIDs {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	count, err := m.repo.Count(ctx, &model.System{}, *repo.NewQuery().Where(
+		repo.NewCompositeKeyGroup(
+			repo.NewCompositeKey().Where(repo.IDField, ids),
+		),
+	))
+	if err != nil {
+		return err
+	}
+
+	if count != len(ids) {
+		return ErrSystemGroupUnknownSystems
+	}
+
+	for _, id := range ids {
+		err = m.repo.Create(ctx, &model.SystemGroupSystem{
+			SystemGroupID: groupID,
+			SystemID:      id,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadStatus sets the number of group systems in each status
+func (m *SystemGroupManager) loadStatus(ctx context.Context, group *model.SystemGroup) error {
+	return m.loadStatuses(ctx, []*model.SystemGroup{group})
+}
+
+// systemGroupStatusCounts holds the number of members of a group per system status
+type systemGroupStatusCounts struct {
+	ID           uuid.UUID
+	StatusCounts json.RawMessage
+}
+
+func (systemGroupStatusCounts) TableName() string {
+	return model.SystemGroup{}.TableName()
+}
+
+// loadStatuses sets the number of group systems in each status
+// for all groups in a single query grouping the members by status
+func (m *SystemGroupManager) loadStatuses(ctx context.Context, groups []*model.SystemGroup) error {
+	if len(groups) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(groups))
+	for _, group := range groups {
+		ids = append(ids, group.ID)
+	}
+
+	groupTable := model.SystemGroup{}.TableName()
+
+	query := repo.NewQuery().Select(
+		repo.NewSelectField(fmt.Sprintf("%s.%s", groupTable, repo.IDField), repo.QueryFunction{}),
+		repo.NewSelectField(statusCountsSelect(), repo.QueryFunction{}).SetAlias(statusCountsAlias),
+	).Where(repo.NewCompositeKeyGroup(
+		repo.NewCompositeKey().Where(fmt.Sprintf("%s.%s", groupTable, repo.IDField), ids),
+	))
+
+	var rows []systemGroupStatusCounts
+
+	err := m.repo.List(ctx, model.SystemGroup{}, &rows, *query)
+	if err != nil {
+		return errs.Wrap(ErrSystemGroupStatus, err)
+	}
+
+	statuses := make(map[uuid.UUID]model.SystemGroupStatus, len(rows))
+
+	for _, row := range rows {
+		counts := map[cmkapi.SystemStatus]int{}
+
+		if len(row.StatusCounts) > 0 {
+			err = json.Unmarshal(row.StatusCounts, &counts)
+			if err != nil {
+				return errs.Wrap(ErrSystemGroupStatus, err)
+			}
+		}
+
+		status := model.SystemGroupStatus{
+			Connected:    counts[cmkapi.SystemStatusCONNECTED],
+			Disconnected: counts[cmkapi.SystemStatusDISCONNECTED],
+			Processing:   counts[cmkapi.SystemStatusPROCESSING],
+			Failed:       counts[cmkapi.SystemStatusFAILED],
+			Drifted:      counts[cmkapi.SystemStatusDRIFTED],
+		}
+
+		// Every member counts, whatever its status
+		for _, count := range counts {
+			status.Total += count
+		}
+
+		statuses[row.ID] = status
+	}
+
+	for _, group := range groups {
+		group.Status = statuses[group.ID]
+	}
+
+	return nil
+}
+
+const statusCountsAlias = "status_counts"
+
+// statusCountsSelect returns the subquery aggregating the members of the
+// system group row by status into a JSON object. Members are the systems
+// matching the selector of the group, or listed in system_group_systems
+// for groups with explicit membership, like in membersQuery.
+func statusCountsSelect() string {
+	group := model.SystemGroup{}.TableName()
+
+	member := fmt.Sprintf(`CASE WHEN %[1]s.selector IS NULL OR jsonb_typeof(%[1]s.selector) = 'null'
+	THEN EXISTS (SELECT 1 FROM %[2]s m WHERE m.system_group_id = %[1]s.id AND m.system_id = s.id)
+	ELSE s.type = COALESCE(NULLIF(%[1]s.selector->>'type', ''), s.type)
+		AND s.region = COALESCE(NULLIF(%[1]s.selector->>'region', ''), s.region)
+		AND NOT EXISTS (
+			SELECT 1 FROM jsonb_each_text(COALESCE(%[1]s.selector->'properties', jsonb_build_object())) p
+			WHERE NOT EXISTS (
+				SELECT 1 FROM %[3]s sp WHERE sp.id = s.id AND sp."key" = p.key AND sp."value" = p.value
+			)
+		)
+	END`,
+		group, model.SystemGroupSystem{}.TableName(), model.SystemProperty{}.TableName())
+
+	return fmt.Sprintf(`(SELECT jsonb_object_agg(c.status, c.total) FROM (
+	SELECT s.status, COUNT(*) AS total FROM %s s WHERE %s GROUP BY s.status
+) c)`, model.System{}.TableName(), member)
+}
+
+func validateSelector(group *model.SystemGroup) error {
+	selector, err := group.GetSelector()
+	if err != nil {
+		return err
+	}
+
+	if selector != nil && selector.IsEmpty() {
+		return ErrSystemGroupEmptySelector
+	}
+
+	return nil
+}
+
+// membersQuery returns the query on systems matching the members of the group
+func membersQuery(group *model.SystemGroup) (*repo.Query, error) {
+	query := repo.NewQuery().Order(repo.OrderField{
+		Field:     repo.IdentifierField,
+		Direction: repo.Asc,
+	})
+
+	selector, err := group.GetSelector()
+	if err != nil {
+		return nil, err
+	}
+
+	if selector == nil {
+		joinTable := model.SystemGroupSystem{}
+
+		return query.Join(repo.InnerJoin, repo.JoinCondition{
+			Table:     model.System{},
+			Field:     repo.IDField,
+			JoinTable: joinTable,
+			JoinField: repo.SystemIDField,
+		}).Where(repo.NewCompositeKeyGroup(
+			repo.NewCompositeKey().Where(
+				fmt.Sprintf("%s.%s", joinTable.TableName(), repo.SystemGroupIDField), group.ID,
+			),
+		)), nil
+	}
+
+	ck := repo.NewCompositeKey()
+
+	if selector.Type != "" {
+		ck = ck.Where(repo.TypeField, selector.Type)
+	}
+
+	if selector.Region != "" {
+		ck = ck.Where(repo.RegionField, selector.Region)
+	}
+
+	// Each property is matched on its own join so that all of them must match
+	keys := make([]string, 0, len(selector.Properties))
+	for k := range selector.Properties {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	for i, k := range keys {
+		alias := fmt.Sprintf("selector_prop_%d", i)
+
+		query = query.Join(repo.InnerJoin, repo.JoinCondition{
+			Table:     model.System{},
+			Field:     repo.IDField,
+			JoinTable: model.SystemProperty{},
+			JoinField: repo.IDField,
+			Alias:     alias,
+		})
+
+		ck = ck.
+			Where(fmt.Sprintf("%s.%s", alias, repo.KeyField), k).
+			Where(fmt.Sprintf("%s.%s", alias, repo.ValueField), selector.Properties[k])
+	}
+
+	return query.Where(repo.NewCompositeKeyGroup(ck)), nil
+}
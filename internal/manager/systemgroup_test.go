@@ -0,0 +1,309 @@
+package manager_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+)
+
+func TestSystemGroupManager(t *testing.T) {
+	sm, db, tenant := SetupSystemManager(t, nil)
+	r := sql.NewRepository(db)
+	m := manager.NewSystemGroupManager(r, sm)
+
+	ctx := testutils.CreateCtxWithTenant(tenant)
+	ctx = testutils.InjectBusinessUserDataIntoContext(ctx, "test-user", []string{"test-group"})
+
+	connected := testutils.NewSystem(func(s *model.System) {
+		s.Region = "eu10"
+		s.Status = cmkapi.SystemStatusCONNECTED
+		s.Properties = map[string]string{"landscape": "prod"}
+	})
+	failed := testutils.NewSystem(func(s *model.System) {
+		s.Region = "eu10"
+		s.Status = cmkapi.SystemStatusFAILED
+		s.Properties = map[string]string{"landscape": "prod", "team": "a"}
+	})
+	other := testutils.NewSystem(func(s *model.System) {
+		s.Region = "us10"
+		s.Properties = map[string]string{"landscape": "dev"}
+	})
+	drifted := testutils.NewSystem(func(s *model.System) {
+		s.Region = "ap10"
+		s.Status = cmkapi.SystemStatusDRIFTED
+	})
+
+	testutils.CreateTestEntities(ctx, t, r, connected, failed, other, drifted)
+
+	t.Run("Should create explicit group with status", func(t *testing.T) {
+		group, err := m.CreateSystemGroup(
+			ctx,
+			testutils.NewSystemGroup(func(_ *model.SystemGroup) {}),
+			[]uuid.UUID{connected.ID, failed.ID, failed.ID, drifted.ID},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, model.SystemGroupStatus{Total: 3, Connected: 1, Failed: 1, Drifted: 1}, group.Status)
+
+		systems, count, err := m.GetSystemGroupSystems(ctx, group.ID, repo.Pagination{Count: true})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+		assert.ElementsMatch(t,
+			[]uuid.UUID{connected.ID, failed.ID, drifted.ID},
+			[]uuid.UUID{systems[0].ID, systems[1].ID, systems[2].ID},
+		)
+	})
+
+	t.Run("Should fail explicit group with unknown system", func(t *testing.T) {
+		_, err := m.CreateSystemGroup(
+			ctx,
+			testutils.NewSystemGroup(func(_ *model.SystemGroup) {}),
+			[]uuid.UUID{uuid.New()},
+		)
+		assert.ErrorIs(t, err, manager.ErrSystemGroupUnknownSystems)
+	})
+
+	t.Run("Should fail group with duplicated name", func(t *testing.T) {
+		group := testutils.NewSystemGroup(func(_ *model.SystemGroup) {})
+		testutils.CreateTestEntities(ctx, t, r, group)
+
+		_, err := m.CreateSystemGroup(ctx, testutils.NewSystemGroup(func(g *model.SystemGroup) {
+			g.Name = group.Name
+		}), nil)
+		assert.ErrorIs(t, err, repo.ErrUniqueConstraint)
+	})
+
+	tests := []struct {
+		name     string
+		selector model.SystemSelector
+		expected []uuid.UUID
+	}{
+		{
+			name:     "Should select systems on region",
+			selector: model.SystemSelector{Region: "eu10"},
+			expected: []uuid.UUID{connected.ID, failed.ID},
+		},
+		{
+			name:     "Should select systems on property",
+			selector: model.SystemSelector{Properties: map[string]string{"landscape": "dev"}},
+			expected: []uuid.UUID{other.ID},
+		},
+		{
+			name: "Should select systems matching all properties",
+			selector: model.SystemSelector{
+				Region:     "eu10",
+				Properties: map[string]string{"landscape": "prod", "team": "a"},
+			},
+			expected: []uuid.UUID{failed.ID},
+		},
+		{
+			name:     "Should select no systems",
+			selector: model.SystemSelector{Type: model.SystemTypeSUBACCOUNT},
+			expected: []uuid.UUID{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group := testutils.NewSystemGroup(func(g *model.SystemGroup) {
+				assert.NoError(t, g.SetSelector(&tt.selector))
+			})
+
+			group, err := m.CreateSystemGroup(ctx, group, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.expected), group.Status.Total)
+
+			systems, _, err := m.GetSystemGroupSystems(ctx, group.ID, repo.Pagination{})
+			assert.NoError(t, err)
+
+			ids := make([]uuid.UUID, len(systems))
+			for i, s := range systems {
+				ids[i] = s.ID
+			}
+
+			assert.ElementsMatch(t, tt.expected, ids)
+		})
+	}
+
+	t.Run("Should fail group with selector and systems", func(t *testing.T) {
+		group := testutils.NewSystemGroup(func(g *model.SystemGroup) {
+			assert.NoError(t, g.SetSelector(&model.SystemSelector{Region: "eu10"}))
+		})
+
+		_, err := m.CreateSystemGroup(ctx, group, []uuid.UUID{connected.ID})
+		assert.ErrorIs(t, err, manager.ErrSystemGroupMembership)
+	})
+
+	t.Run("Should fail group with empty selector", func(t *testing.T) {
+		group := testutils.NewSystemGroup(func(g *model.SystemGroup) {
+			assert.NoError(t, g.SetSelector(&model.SystemSelector{}))
+		})
+
+		_, err := m.CreateSystemGroup(ctx, group, nil)
+		assert.ErrorIs(t, err, manager.ErrSystemGroupEmptySelector)
+	})
+
+	t.Run("Should update explicit group systems", func(t *testing.T) {
+		group, err := m.CreateSystemGroup(
+			ctx,
+			testutils.NewSystemGroup(func(_ *model.SystemGroup) {}),
+			[]uuid.UUID{connected.ID},
+		)
+		assert.NoError(t, err)
+
+		group, err = m.UpdateSystemGroup(ctx, group.ID, cmkapi.SystemGroupPatch{
+			Description: new("updated"),
+			SystemIDs:   &[]uuid.UUID{failed.ID, other.ID},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "updated", group.Description)
+		assert.Equal(t, model.SystemGroupStatus{Total: 2, Failed: 1, Disconnected: 1}, group.Status)
+	})
+
+	t.Run("Should fail setting selector on explicit group", func(t *testing.T) {
+		group, err := m.CreateSystemGroup(ctx, testutils.NewSystemGroup(func(_ *model.SystemGroup) {}), nil)
+		assert.NoError(t, err)
+
+		_, err = m.UpdateSystemGroup(ctx, group.ID, cmkapi.SystemGroupPatch{
+			Selector: &cmkapi.SystemSelector{Region: new("eu10")},
+		})
+		assert.ErrorIs(t, err, manager.ErrSystemGroupMembership)
+	})
+
+	t.Run("Should delete group and keep systems", func(t *testing.T) {
+		group, err := m.CreateSystemGroup(
+			ctx,
+			testutils.NewSystemGroup(func(_ *model.SystemGroup) {}),
+			[]uuid.UUID{connected.ID},
+		)
+		assert.NoError(t, err)
+
+		assert.NoError(t, m.DeleteSystemGroup(ctx, group.ID))
+
+		_, err = m.GetSystemGroupByID(ctx, group.ID)
+		assert.ErrorIs(t, err, repo.ErrNotFound)
+
+		_, err = r.First(ctx, &model.System{ID: connected.ID}, *repo.NewQuery())
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should list groups with status", func(t *testing.T) {
+		explicit, err := m.CreateSystemGroup(
+			ctx,
+			testutils.NewSystemGroup(func(_ *model.SystemGroup) {}),
+			[]uuid.UUID{connected.ID, other.ID},
+		)
+		assert.NoError(t, err)
+
+		selected, err := m.CreateSystemGroup(ctx, testutils.NewSystemGroup(func(g *model.SystemGroup) {
+			assert.NoError(t, g.SetSelector(&model.SystemSelector{
+				Region:     "eu10",
+				Properties: map[string]string{"landscape": "prod"},
+			}))
+		}), nil)
+		assert.NoError(t, err)
+
+		groups, _, err := m.GetSystemGroups(ctx, repo.Pagination{})
+		assert.NoError(t, err)
+
+		statuses := make(map[uuid.UUID]model.SystemGroupStatus, len(groups))
+		for _, group := range groups {
+			statuses[group.ID] = group.Status
+		}
+
+		assert.Equal(t, model.SystemGroupStatus{Total: 2, Connected: 1, Disconnected: 1}, statuses[explicit.ID])
+		assert.Equal(t, model.SystemGroupStatus{Total: 2, Connected: 1, Failed: 1}, statuses[selected.ID])
+	})
+
+	t.Run("Should fail on non-existing group", func(t *testing.T) {
+		_, err := m.LinkSystemGroup(ctx, uuid.New(), uuid.New())
+		assert.ErrorIs(t, err, manager.ErrGetSystemGroup)
+	})
+}
+
+func TestSystemGroupLinkActions(t *testing.T) {
+	sm, db, tenant := SetupSystemManager(t, nil)
+	r := sql.NewRepository(db)
+	m := manager.NewSystemGroupManager(r, sm)
+
+	ctx := testutils.CreateCtxWithTenant(tenant)
+	ctx = testutils.InjectBusinessUserDataIntoContext(ctx, "test-user", []string{"test-group"})
+
+	key := testutils.NewKey(func(_ *model.Key) {})
+	adminGroup := testutils.NewGroup(func(g *model.Group) {
+		g.IAMIdentifier = "test-group"
+	})
+	keyConfig := testutils.NewKeyConfig(func(k *model.KeyConfiguration) {
+		k.PrimaryKeyID = &key.ID
+		k.AdminGroupID = adminGroup.ID
+		k.AdminGroup = *adminGroup
+	})
+
+	linked := testutils.NewSystem(func(s *model.System) {
+		s.KeyConfigurationID = &keyConfig.ID
+		s.Status = cmkapi.SystemStatusCONNECTED
+	})
+	unlinked := testutils.NewSystem(func(_ *model.System) {})
+	failed := testutils.NewSystem(func(s *model.System) {
+		s.KeyConfigurationID = &keyConfig.ID
+		s.Status = cmkapi.SystemStatusFAILED
+	})
+
+	testutils.CreateTestEntities(ctx, t, r, key, keyConfig, linked, unlinked, failed)
+
+	group, err := m.CreateSystemGroup(
+		ctx,
+		testutils.NewSystemGroup(func(_ *model.SystemGroup) {}),
+		[]uuid.UUID{linked.ID, unlinked.ID, failed.ID},
+	)
+	assert.NoError(t, err)
+
+	resultsBySystem := func(results []manager.SystemGroupActionResult) map[uuid.UUID]manager.SystemGroupActionResult {
+		res := make(map[uuid.UUID]manager.SystemGroupActionResult, len(results))
+		for _, r := range results {
+			res[r.SystemID] = r
+		}
+
+		return res
+	}
+
+	t.Run("Should link group systems", func(t *testing.T) {
+		results, err := m.LinkSystemGroup(ctx, group.ID, keyConfig.ID)
+		assert.NoError(t, err)
+		assert.Len(t, results, 3)
+
+		res := resultsBySystem(results)
+		assert.True(t, res[linked.ID].Skipped)
+		assert.NoError(t, res[linked.ID].Err)
+		assert.False(t, res[unlinked.ID].Skipped)
+		assert.NoError(t, res[unlinked.ID].Err)
+		assert.ErrorIs(t, res[failed.ID].Err, manager.ErrLinkSystemProcessingOrFailed)
+
+		sys := &model.System{ID: unlinked.ID}
+		_, err = r.First(ctx, sys, *repo.NewQuery())
+		assert.NoError(t, err)
+		assert.Equal(t, cmkapi.SystemStatusPROCESSING, sys.Status)
+	})
+
+	t.Run("Should skip unlinked systems on unlink", func(t *testing.T) {
+		other := testutils.NewSystem(func(_ *model.System) {})
+		testutils.CreateTestEntities(ctx, t, r, other)
+
+		otherGroup, err := m.CreateSystemGroup(
+			ctx,
+			testutils.NewSystemGroup(func(_ *model.SystemGroup) {}),
+			[]uuid.UUID{other.ID},
+		)
+		assert.NoError(t, err)
+
+		results, err := m.UnlinkSystemGroup(ctx, otherGroup.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []manager.SystemGroupActionResult{{SystemID: other.ID, Skipped: true}}, results)
+	})
+}
@@ -7,7 +7,9 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/openkcm/cmk/internal/auditor"
 	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/log"
 	"github.com/openkcm/cmk/internal/model"
 	"github.com/openkcm/cmk/internal/repo"
 )
@@ -24,21 +26,39 @@ type Tags interface {
 }
 
 type TagManager struct {
-	r repo.Repo
+	r          repo.Repo
+	cmkAuditor *auditor.Auditor
 }
 
-func NewTagManager(r repo.Repo) *TagManager {
+func NewTagManager(r repo.Repo, cmkAuditor *auditor.Auditor) *TagManager {
 	return &TagManager{
-		r: r,
+		r:          r,
+		cmkAuditor: cmkAuditor,
 	}
 }
 
 func (m *TagManager) DeleteTags(ctx context.Context, itemID uuid.UUID) error {
-	_, err := m.r.Delete(ctx, &model.Tag{ID: itemID}, *repo.NewQuery())
+	tag := &model.Tag{ID: itemID}
+
+	_, err := m.r.First(ctx, tag, *repo.NewQuery())
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil
+	}
+
 	if err != nil {
 		return errs.Wrap(ErrDeletingTags, err)
 	}
 
+	_, err = m.r.Delete(ctx, &model.Tag{ID: itemID}, *repo.NewQuery())
+	if err != nil {
+		return errs.Wrap(ErrDeletingTags, err)
+	}
+
+	err = m.cmkAuditor.SendConfigDeleteAuditLog(ctx, itemID.String(), string(tag.Values))
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for tags delete", err)
+	}
+
 	return nil
 }
 
@@ -52,7 +72,24 @@ func (m *TagManager) SetTags(ctx context.Context, itemID uuid.UUID, values []str
 		return err
 	}
 
-	return m.r.Set(ctx, &model.Tag{ID: itemID, Values: bytes}, *repo.NewQuery())
+	previous := &model.Tag{ID: itemID}
+
+	_, err = m.r.First(ctx, previous, *repo.NewQuery())
+	if err != nil && !errors.Is(err, repo.ErrNotFound) {
+		return errs.Wrap(ErrGetTags, err)
+	}
+
+	err = m.r.Set(ctx, &model.Tag{ID: itemID, Values: bytes}, *repo.NewQuery())
+	if err != nil {
+		return err
+	}
+
+	err = m.cmkAuditor.SendConfigUpdateAuditLog(ctx, itemID.String(), string(previous.Values), string(bytes))
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for tags update", err)
+	}
+
+	return nil
 }
 
 func (m *TagManager) GetTags(ctx context.Context, itemID uuid.UUID) ([]string, error) {
@@ -24,7 +24,7 @@ func SetupTagManager(t *testing.T) (*manager.TagManager,
 	db, tenants, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
 
 	dbRepository := sql.NewRepository(db)
-	tagManager := manager.NewTagManager(dbRepository)
+	tagManager := manager.NewTagManager(dbRepository, nil)
 
 	return tagManager, db, tenants[0]
 }
@@ -84,9 +84,9 @@ func setupTenantManager(t *testing.T, authzRole *constants.InternalRole, opts ..
 
 	cmkAuditor := auditor.New(ctx, cfg)
 
-	cm := manager.NewCertificateManager(ctx, r, svcRegistry, cfg)
+	cm := manager.NewCertificateManager(ctx, r, svcRegistry, cfg, cmkAuditor)
 	um := testutils.NewUserManager()
-	tagManager := manager.NewTagManager(r)
+	tagManager := manager.NewTagManager(r, cmkAuditor)
 	kcm := manager.NewKeyConfigManager(r, cm, um, tagManager, cmkAuditor, eventFactory, cfg)
 
 	mappingService := mapping.NewFakeService()
@@ -123,7 +123,7 @@ func setupTenantManager(t *testing.T, authzRole *constants.InternalRole, opts ..
 	km := manager.NewKeyManager(
 		r,
 		svcRegistry,
-		manager.NewTenantConfigManager(r, svcRegistry, nil, nil),
+		manager.NewTenantConfigManager(r, svcRegistry, nil, nil, cmkAuditor),
 		kcm,
 		um,
 		cm,
@@ -481,6 +481,14 @@ func (s *mockSystemManager) LinkSystemAction(context.Context, uuid.UUID, cmkapi.
 	panic("not implemented")
 }
 
+func (s *mockSystemManager) ValidateLinkSystem(
+	context.Context,
+	uuid.UUID,
+	uuid.UUID,
+) (cmkapi.SystemLinkValidation, error) {
+	panic("not implemented")
+}
+
 func (s *mockSystemManager) GetRecoveryActions(context.Context, uuid.UUID) (cmkapi.SystemRecoveryAction, error) {
 	panic("not implemented")
 }
@@ -11,9 +11,11 @@ import (
 	tenantpb "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
 
 	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/auditor"
 	"github.com/openkcm/cmk/internal/config"
 	"github.com/openkcm/cmk/internal/constants"
 	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/log"
 	"github.com/openkcm/cmk/internal/model"
 	serviceapi "github.com/openkcm/cmk/internal/pluginregistry/service/api"
 	"github.com/openkcm/cmk/internal/pluginregistry/service/api/common"
@@ -45,6 +47,7 @@ type TenantConfigManager struct {
 	keystorePool *Pool
 	cfg          *config.Config
 	certs        *CertificateManager
+	cmkAuditor   *auditor.Auditor
 }
 
 func NewTenantConfigManager(
@@ -52,6 +55,7 @@ func NewTenantConfigManager(
 	svcRegistry serviceapi.Registry,
 	deploymentConfig *config.Config,
 	certs *CertificateManager,
+	cmkAuditor *auditor.Auditor,
 ) *TenantConfigManager {
 	return &TenantConfigManager{
 		repo:         repo,
@@ -59,6 +63,7 @@ func NewTenantConfigManager(
 		keystorePool: NewPool(repo),
 		cfg:          deploymentConfig,
 		certs:        certs,
+		cmkAuditor:   cmkAuditor,
 	}
 }
 
@@ -75,7 +80,10 @@ var (
 	ErrWorkflowEnableDisableNotAllowed = errors.New("workflow enable/disable is only allowed for ROLE_TEST tenants")
 	ErrDefaultExpiryExceedsMax         = errors.New("defaultExpiryPeriodDays must be" +
 		" less than or equal to maxExpiryPeriodDays")
-	ErrMinimumApprovalsTooLow = errors.New("minimumApprovals must be at least 2")
+	ErrMinimumApprovalsTooLow  = errors.New("minimumApprovals must be at least 2")
+	ErrGetSystemAutoLinkConfig = errors.New("failed to get system auto-link config")
+	ErrSetSystemAutoLinkConfig = errors.New("failed to set system auto-link config")
+	ErrAutoLinkRuleKeyConfig   = errors.New("auto-link rule key configuration not found")
 )
 
 type HYOKKeystore struct {
@@ -152,12 +160,7 @@ func (m *TenantConfigManager) SetWorkflowConfig(
 		return nil, errs.Wrap(ErrMarshalConfig, err)
 	}
 
-	conf := &model.TenantConfig{
-		Key:   constants.WorkflowConfigKey,
-		Value: configValue,
-	}
-
-	err = m.repo.Set(ctx, conf, *repo.NewQuery())
+	err = m.setTenantConfig(ctx, constants.WorkflowConfigKey, configValue)
 	if err != nil {
 		return nil, errs.Wrap(ErrSetWorkflowConfig, err)
 	}
@@ -196,6 +199,49 @@ func (m *TenantConfigManager) UpdateWorkflowConfig(
 	return m.SetWorkflowConfig(ctx, mergedConfig)
 }
 
+// GetSystemAutoLinkConfig retrieves the system auto-link rules of the tenant.
+// An empty config is returned if no rules have been set.
+func (m *TenantConfigManager) GetSystemAutoLinkConfig(ctx context.Context) (*model.SystemAutoLinkConfig, error) {
+	return getSystemAutoLinkConfig(ctx, m.repo)
+}
+
+// SetSystemAutoLinkConfig validates and stores the system auto-link rules of the tenant
+func (m *TenantConfigManager) SetSystemAutoLinkConfig(
+	ctx context.Context,
+	autoLinkConfig *model.SystemAutoLinkConfig,
+) (*model.SystemAutoLinkConfig, error) {
+	for _, rule := range autoLinkConfig.Rules {
+		if rule.Type != "" && !rule.Type.Valid() {
+			return nil, errs.Wrap(ErrSetSystemAutoLinkConfig, model.ErrInvalidSystemType)
+		}
+
+		exist, err := m.repo.First(
+			ctx,
+			&model.KeyConfiguration{ID: rule.KeyConfigurationID},
+			*repo.NewQuery(),
+		)
+		if err != nil && !errors.Is(err, repo.ErrNotFound) {
+			return nil, errs.Wrap(ErrSetSystemAutoLinkConfig, err)
+		}
+
+		if !exist {
+			return nil, errs.Wrap(ErrSetSystemAutoLinkConfig, ErrAutoLinkRuleKeyConfig)
+		}
+	}
+
+	configValue, err := json.Marshal(autoLinkConfig)
+	if err != nil {
+		return nil, errs.Wrap(ErrMarshalConfig, err)
+	}
+
+	err = m.setTenantConfig(ctx, constants.SystemAutoLinkConfigKey, configValue)
+	if err != nil {
+		return nil, errs.Wrap(ErrSetSystemAutoLinkConfig, err)
+	}
+
+	return autoLinkConfig, nil
+}
+
 func (m *TenantConfigManager) GetTenantsKeystores(ctx context.Context) (TenantKeystores, error) {
 	defaultKeystore, found, err := m.getStoredDefaultKeystoreConfig(ctx)
 	if err != nil {
@@ -322,14 +368,37 @@ func (m *TenantConfigManager) setDefaultKeystore(ctx context.Context, keystore *
 		return errs.Wrap(ErrMarshalConfig, err)
 	}
 
-	conf := &model.TenantConfig{
-		Key:   constants.DefaultKeyStore,
-		Value: ksBytes,
+	err = m.setTenantConfig(ctx, constants.DefaultKeyStore, ksBytes)
+	if err != nil {
+		return errs.Wrap(ErrSetDefaultKeystore, err)
+	}
+
+	return nil
+}
+
+// setTenantConfig stores the value of a tenant config and sends an
+// audit log with the values before and after the change
+func (m *TenantConfigManager) setTenantConfig(ctx context.Context, key string, value []byte) error {
+	previous := &model.TenantConfig{}
+	ck := repo.NewCompositeKey().Where(repo.KeyField, key)
+
+	_, err := m.repo.First(ctx, previous, *repo.NewQuery().Where(repo.NewCompositeKeyGroup(ck)))
+	if err != nil && !errors.Is(err, repo.ErrNotFound) {
+		return err
+	}
+
+	err = m.repo.Set(ctx, &model.TenantConfig{Key: key, Value: value}, *repo.NewQuery())
+	if err != nil {
+		return err
+	}
+
+	if string(previous.Value) == string(value) {
+		return nil
 	}
 
-	err = m.repo.Set(ctx, conf, *repo.NewQuery())
+	err = m.cmkAuditor.SendConfigUpdateAuditLog(ctx, key, string(previous.Value), string(value))
 	if err != nil {
-		return errs.Wrap(ErrSetDefaultKeystore, err)
+		log.Error(ctx, "Failed to send audit log for tenant config update", err)
 	}
 
 	return nil
@@ -663,3 +732,30 @@ func (m *TenantConfigManager) getKeystoreManagementClient() (keystoremanagement.
 
 	return nil, errs.Wrapf(ErrGetDefaultKeystore, "no default keystore management client found")
 }
+
+// getSystemAutoLinkConfig is shared by the tenant config and system managers
+func getSystemAutoLinkConfig(ctx context.Context, r repo.Repo) (*model.SystemAutoLinkConfig, error) {
+	var tenantConfig model.TenantConfig
+
+	ck := repo.NewCompositeKey().Where(repo.KeyField, constants.SystemAutoLinkConfigKey)
+	query := repo.NewQuery().Where(
+		repo.NewCompositeKeyGroup(ck),
+	)
+
+	found, err := r.First(ctx, &tenantConfig, *query)
+	if err != nil && !errors.Is(err, repo.ErrNotFound) {
+		return nil, errs.Wrap(ErrGetSystemAutoLinkConfig, err)
+	}
+
+	autoLinkConfig := &model.SystemAutoLinkConfig{}
+	if !found {
+		return autoLinkConfig, nil
+	}
+
+	err = json.Unmarshal(tenantConfig.Value, autoLinkConfig)
+	if err != nil {
+		return nil, errs.Wrap(ErrUnmarshalConfig, err)
+	}
+
+	return autoLinkConfig, nil
+}
@@ -45,7 +45,7 @@ func SetupTenantConfigManager(t *testing.T, opts ...testplugins.RegistryOption)
 			ValidityDays: config.MinCertificateValidityDays,
 		},
 	}
-	tenantManager := manager.NewTenantConfigManager(r, svcRegistry, cfg, nil)
+	tenantManager := manager.NewTenantConfigManager(r, svcRegistry, cfg, nil, nil)
 
 	return tenantManager, db, tenants[0]
 }
@@ -60,7 +60,7 @@ func SetupTenantConfigManagerWithRole(t *testing.T, role string, opts ...testplu
 
 	r := sql.NewRepository(db)
 	svcRegistry := testutils.NewTestPlugins(opts...)
-	tenantManager := manager.NewTenantConfigManager(r, svcRegistry, nil, nil)
+	tenantManager := manager.NewTenantConfigManager(r, svcRegistry, nil, nil, nil)
 
 	return tenantManager, db, tenants[0]
 }
@@ -233,7 +233,7 @@ func TestGetTenantConfigsHyokKeystore(t *testing.T) {
 				),
 			)
 
-			mgr := manager.NewTenantConfigManager(nil, svcRegistry, nil, nil)
+			mgr := manager.NewTenantConfigManager(nil, svcRegistry, nil, nil, nil)
 
 			result := mgr.GetTenantConfigsHyokKeystore()
 			assert.ElementsMatch(t, tt.expectedOutput, result.Provider)
@@ -277,7 +277,7 @@ func TestGetTenantsKeystore(t *testing.T) {
 				},
 			},
 		}
-		m := manager.NewTenantConfigManager(r, nil, cfg, nil)
+		m := manager.NewTenantConfigManager(r, nil, cfg, nil, nil)
 		res, err := m.GetTenantsKeystores(testutils.CreateCtxWithTenant(tenant))
 		assert.NoError(t, err)
 		assert.True(t, res.AllowBYOK)
@@ -295,7 +295,7 @@ func TestGetTenantsKeystore(t *testing.T) {
 				SupportedRegions: testutils.SupportedRegions,
 			},
 		}
-		m := manager.NewTenantConfigManager(r, nil, cfg, nil)
+		m := manager.NewTenantConfigManager(r, nil, cfg, nil, nil)
 		res, err := m.GetTenantsKeystores(testutils.CreateCtxWithTenant(tenant))
 		assert.NoError(t, err)
 		assert.Equal(t, testutils.SupportedRegions, res.BYOK.SupportedRegions)
@@ -304,7 +304,7 @@ func TestGetTenantsKeystore(t *testing.T) {
 	t.Run("BYOK disabled, no stored keystore", func(t *testing.T) {
 		_, db, tenant := SetupTenantConfigManager(t)
 		r := sql.NewRepository(db)
-		m := manager.NewTenantConfigManager(r, nil, &config.Config{}, nil)
+		m := manager.NewTenantConfigManager(r, nil, &config.Config{}, nil, nil)
 		res, err := m.GetTenantsKeystores(testutils.CreateCtxWithTenant(tenant))
 		assert.NoError(t, err)
 		assert.Nil(t, res.BYOK.SupportedRegions)
@@ -317,7 +317,7 @@ func TestGetTenantsKeystore(t *testing.T) {
 				FeatureGates: commoncfg.FeatureGates{"allow-byok": true},
 			},
 		}
-		m := manager.NewTenantConfigManager(sql.NewRepository(db), nil, cfg, nil)
+		m := manager.NewTenantConfigManager(sql.NewRepository(db), nil, cfg, nil, nil)
 		res, err := m.GetTenantsKeystores(testutils.CreateCtxWithTenant(tenant))
 		assert.NoError(t, err)
 		assert.Nil(t, res.BYOK.SupportedRegions)
@@ -340,7 +340,7 @@ func TestGetTenantsKeystore(t *testing.T) {
 				SupportedRegions: testutils.SupportedRegions,
 			},
 		}
-		m := manager.NewTenantConfigManager(sql.NewRepository(db), nil, cfg, nil)
+		m := manager.NewTenantConfigManager(sql.NewRepository(db), nil, cfg, nil, nil)
 		res, err := m.GetTenantsKeystores(ctx)
 		assert.NoError(t, err)
 		assert.Equal(t, testutils.SupportedRegions, res.BYOK.SupportedRegions)
@@ -680,8 +680,8 @@ func setupTenantConfigManagerWithCerts(
 		}
 	}
 
-	certManager := manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg)
-	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, cfg, certManager)
+	certManager := manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg, nil)
+	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, cfg, certManager, nil)
 
 	// Pre-persist a role-management cert so getDefaultKeystoreClientCert doesn't
 	// attempt to call IssueCertificate (the test stub returns an empty chain).
@@ -963,3 +963,67 @@ func TestNeedsDefaultKeystoreProvisioning(t *testing.T) {
 		assert.False(t, needed, "should not need provisioning when LocalityID and AccessData are both set")
 	})
 }
+
+func TestSystemAutoLinkConfig(t *testing.T) {
+	t.Run("Should return empty config when not set", func(t *testing.T) {
+		configManager, _, tenant := SetupTenantConfigManager(t)
+		ctx := testutils.CreateCtxWithTenant(tenant)
+
+		cfg, err := configManager.GetSystemAutoLinkConfig(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, cfg.Rules)
+	})
+
+	t.Run("Should set and get config", func(t *testing.T) {
+		configManager, db, tenant := SetupTenantConfigManager(t)
+		ctx := testutils.CreateCtxWithTenant(tenant)
+		keyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {})
+		testutils.CreateTestEntities(ctx, t, sql.NewRepository(db), keyConfig)
+
+		expected := &model.SystemAutoLinkConfig{
+			Rules: []model.SystemAutoLinkRule{
+				{
+					Name:               "prod",
+					Type:               model.SystemTypeSUBACCOUNT,
+					Region:             "eu10",
+					Properties:         map[string]string{"landscape": "prod"},
+					KeyConfigurationID: keyConfig.ID,
+				},
+			},
+		}
+
+		_, err := configManager.SetSystemAutoLinkConfig(ctx, expected)
+		assert.NoError(t, err)
+
+		cfg, err := configManager.GetSystemAutoLinkConfig(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, cfg)
+	})
+
+	t.Run("Should fail on invalid system type", func(t *testing.T) {
+		configManager, db, tenant := SetupTenantConfigManager(t)
+		ctx := testutils.CreateCtxWithTenant(tenant)
+		keyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {})
+		testutils.CreateTestEntities(ctx, t, sql.NewRepository(db), keyConfig)
+
+		_, err := configManager.SetSystemAutoLinkConfig(ctx, &model.SystemAutoLinkConfig{
+			Rules: []model.SystemAutoLinkRule{
+				{Type: "INVALID", KeyConfigurationID: keyConfig.ID},
+			},
+		})
+		assert.ErrorIs(t, err, manager.ErrSetSystemAutoLinkConfig)
+		assert.ErrorIs(t, err, model.ErrInvalidSystemType)
+	})
+
+	t.Run("Should fail on unknown key configuration", func(t *testing.T) {
+		configManager, _, tenant := SetupTenantConfigManager(t)
+		ctx := testutils.CreateCtxWithTenant(tenant)
+
+		_, err := configManager.SetSystemAutoLinkConfig(ctx, &model.SystemAutoLinkConfig{
+			Rules: []model.SystemAutoLinkRule{
+				{KeyConfigurationID: uuid.New()},
+			},
+		})
+		assert.ErrorIs(t, err, manager.ErrAutoLinkRuleKeyConfig)
+	})
+}
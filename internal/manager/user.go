@@ -47,6 +47,20 @@ type User interface {
 		action authz.APIAction,
 		resource authz.APIResourceType,
 	) (bool, error)
+	ExplainAccess(
+		ctx context.Context,
+		action authz.APIAction,
+		resource authz.APIResourceType,
+		resourceID *uuid.UUID,
+	) (AccessDecision, error)
+}
+
+// AccessDecision is the group filter and resource access decision for a business user
+type AccessDecision struct {
+	GroupFiltered   bool
+	ResourceChecked bool
+	ResourceAllowed bool
+	Reason          string
 }
 
 func NewUserManager(
@@ -83,7 +97,7 @@ func (u *user) NeedsGroupFiltering(
 		return true, err
 	}
 
-	role, err := u.GetRoleFromIAM(ctx, iamIdentifiers)
+	role, err := u.baseRoleFromIAM(ctx, iamIdentifiers)
 	if err != nil {
 		return true, err
 	}
@@ -225,6 +239,13 @@ func (u *user) HasSystemAccess(
 		return isGroupFiltered, errs.Wrap(ErrGettingKeyConfigByID, err)
 	}
 
+	if !isAuthorized {
+		isAuthorized, err = u.hasSystemGrant(ctx, system, action)
+		if err != nil {
+			return isGroupFiltered, err
+		}
+	}
+
 	if !isAuthorized {
 		u.sendUnauthorizedAccessAuditLog(ctx, authz.APIResourceTypeSystem, action)
 		return isGroupFiltered, ErrKeyConfigurationNotAllowed
@@ -233,6 +254,93 @@ func (u *user) HasSystemAccess(
 	return isGroupFiltered, nil
 }
 
+// ExplainAccess evaluates the group filter and resource access decisions the same
+// way as the Has*Access checks, without sending unauthorized access audit logs.
+// The resource ID is a key configuration, key or system ID depending on the resource type.
+func (u *user) ExplainAccess(
+	ctx context.Context,
+	action authz.APIAction,
+	resource authz.APIResourceType,
+	resourceID *uuid.UUID,
+) (AccessDecision, error) {
+	var decision AccessDecision
+
+	isGroupFiltered, err := u.NeedsGroupFiltering(ctx, action, resource)
+	if err != nil {
+		return decision, err
+	}
+
+	decision.GroupFiltered = isGroupFiltered
+
+	if resourceID == nil {
+		return decision, nil
+	}
+
+	authCtx, err := cmkcontext.BusinessToInternalContext(ctx,
+		constants.InternalBusinessAuthzRole)
+	if err != nil {
+		return decision, err
+	}
+
+	var system *model.System
+
+	keyConfigID := *resourceID
+
+	switch resource {
+	case authz.APIResourceTypeKeyConfiguration:
+	case authz.APIResourceTypeKey:
+		key := &model.Key{ID: *resourceID}
+
+		_, err = u.repo.First(authCtx, key, *repo.NewQuery())
+		if err != nil {
+			return decision, errs.Wrap(ErrGettingKeyByID, err)
+		}
+
+		keyConfigID = key.KeyConfigurationID
+	case authz.APIResourceTypeSystem:
+		system = &model.System{ID: *resourceID}
+
+		_, err = u.repo.First(authCtx, system, *repo.NewQuery())
+		if err != nil {
+			return decision, errs.Wrap(ErrGettingSystemByID, err)
+		}
+
+		if system.KeyConfigurationID == nil {
+			decision.ResourceChecked = true
+			decision.ResourceAllowed = true
+			decision.Reason = "System is not linked to a key configuration"
+
+			return decision, nil
+		}
+
+		keyConfigID = *system.KeyConfigurationID
+	default:
+		return decision, nil
+	}
+
+	decision.ResourceChecked = true
+
+	isAuthorized, err := u.hasKeyConfigAccess(ctx, &model.KeyConfiguration{ID: keyConfigID}, action, resource)
+	if err != nil && !errors.Is(err, ErrKeyConfigurationNotAllowed) {
+		return decision, errs.Wrap(ErrGettingKeyConfigByID, err)
+	}
+
+	if !isAuthorized && system != nil {
+		isAuthorized, err = u.hasSystemGrant(ctx, system, action)
+		if err != nil {
+			return decision, err
+		}
+	}
+
+	decision.ResourceAllowed = isAuthorized
+	if !isAuthorized {
+		decision.Reason = "Key configuration is not managed by the groups " +
+			"and no resource grant of the groups allows the action"
+	}
+
+	return decision, nil
+}
+
 func (u *user) GetBusinessUserInfo(ctx context.Context) (BusinessUserInfo, error) {
 	err := ensureBusinessUserOpsAllowed(ctx, []constants.InternalRole{
 		constants.InternalTaskWorkflowApproversRole,
@@ -315,7 +423,7 @@ func (u *user) GetRoleFromIAM(ctx context.Context, iamIdentifiers []string) (con
 	}
 
 	if len(groups) == 0 {
-		return "", nil
+		return u.elevatedRole(ctx)
 	}
 
 	roleMap := map[constants.BusinessRole]bool{}
@@ -334,6 +442,54 @@ func (u *user) GetRoleFromIAM(ctx context.Context, iamIdentifiers []string) (con
 	return "", ErrZeroRolesInGroups
 }
 
+// elevatedRole returns the role of the active role elevations of the user on
+// the key configuration the request is scoped to, used when none of the user
+// groups has a role in the tenant. Elevations do not apply outside their key
+// configuration, so unscoped requests get no role.
+func (u *user) elevatedRole(ctx context.Context) (constants.BusinessRole, error) {
+	keyConfigID, ok := cmkcontext.ExtractKeyConfigurationScope(ctx)
+	if !ok {
+		return "", nil
+	}
+
+	identifier, err := cmkcontext.ExtractBusinessUserDataIdentifier(ctx)
+	if err != nil {
+		// Without a user there are no elevations to honor
+		return "", nil //nolint:nilerr
+	}
+
+	elevations, err := activeRoleElevations(ctx, u.repo, identifier, &keyConfigID)
+	if err != nil {
+		return "", err
+	}
+
+	roleMap := map[constants.BusinessRole]bool{}
+	for _, elevation := range elevations {
+		roleMap[elevation.Role] = true
+	}
+
+	if len(roleMap) > 1 {
+		return "", ErrMultipleRolesInGroups
+	}
+
+	for k := range roleMap {
+		return k, nil
+	}
+
+	return "", nil
+}
+
+// baseRoleFromIAM returns the built-in role the IAM groups act as,
+// resolving custom roles to their base role
+func (u *user) baseRoleFromIAM(ctx context.Context, iamIdentifiers []string) (constants.BusinessRole, error) {
+	role, err := u.GetRoleFromIAM(ctx, iamIdentifiers)
+	if err != nil {
+		return "", err
+	}
+
+	return resolveBaseRole(ctx, u.repo, role)
+}
+
 // roleBypassesGroupFilter returns true when the given role grants full visibility
 // for the resource on read, meaning no group-level filtering is needed.
 func (u *user) roleBypassesGroupFilter(role constants.BusinessRole, resource authz.APIResourceType) bool {
@@ -372,7 +528,7 @@ func (u *user) hasKeyConfigAccess(
 		return false, err
 	}
 
-	role, err := u.GetRoleFromIAM(ctx, iamIdentifiers)
+	role, err := u.baseRoleFromIAM(ctx, iamIdentifiers)
 	if err != nil {
 		return false, err
 	}
@@ -422,7 +578,83 @@ func (u *user) hasKeyConfigAccess(
 		return false, errs.Wrap(ErrCheckKeyConfigManagedByIAMGroups, err)
 	}
 
-	return count > 0, nil
+	if count > 0 {
+		return true, nil
+	}
+
+	isElevated, err := u.hasRoleElevation(ctx, keyConfig.ID, action, resource)
+	if err != nil {
+		return false, err
+	}
+
+	if isElevated {
+		return true, nil
+	}
+
+	// Key configuration grants give access on the keys of the key configuration,
+	// the key configuration itself and its systems can only be read through them
+	if resource != authz.APIResourceTypeKey && action != authz.APIActionRead {
+		return false, nil
+	}
+
+	return hasResourceGrant(ctx, u.repo, iamIdentifiers,
+		model.ResourceGrantTypeKeyConfiguration, keyConfig.ID, action)
+}
+
+// hasRoleElevation checks if the user has an active role elevation on the key
+// configuration with a role allowing the action. Every use of an elevation is audited.
+func (u *user) hasRoleElevation(
+	ctx context.Context,
+	keyConfigID uuid.UUID,
+	action authz.APIAction,
+	resource authz.APIResourceType,
+) (bool, error) {
+	identifier, err := cmkcontext.ExtractBusinessUserDataIdentifier(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	elevations, err := activeRoleElevations(ctx, u.repo, identifier, &keyConfigID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, elevation := range elevations {
+		policies, _, err := rolePolicies(ctx, u.repo, elevation.Role)
+		if err != nil {
+			return false, err
+		}
+
+		if !authz.IsGranted(policies, resource, action) {
+			continue
+		}
+
+		err = u.cmkAuditor.SendRoleElevationUseAuditLog(ctx, elevation.ID.String(),
+			keyConfigID.String(), fmt.Sprintf("%s %s", action, resource))
+		if err != nil {
+			log.Error(ctx, "Failed to send audit log for role elevation use", err)
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// hasSystemGrant checks if the user IAM groups have a grant on the system
+// allowing the action
+func (u *user) hasSystemGrant(
+	ctx context.Context,
+	system *model.System,
+	action authz.APIAction,
+) (bool, error) {
+	iamIdentifiers, err := cmkcontext.ExtractBusinessUserDataGroupsString(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return hasResourceGrant(ctx, u.repo, iamIdentifiers,
+		model.ResourceGrantTypeSystem, system.ID, action)
 }
 
 func (u *user) sendUnauthorizedAccessAuditLog(
@@ -3,9 +3,11 @@ package manager_test
 import (
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/openkcm/cmk/internal/auditor"
+	"github.com/openkcm/cmk/internal/authz"
 	"github.com/openkcm/cmk/internal/config"
 	"github.com/openkcm/cmk/internal/manager"
 	"github.com/openkcm/cmk/internal/model"
@@ -70,3 +72,74 @@ func TestGetRoleFromGroupIAMIdentifiers(t *testing.T) {
 		assert.Empty(t, role)
 	})
 }
+
+func TestResourceGrantAccess(t *testing.T) {
+	m, db, tenant := SetupUserManager(t)
+	r := sql.NewRepository(db)
+	ctx := testutils.CreateCtxWithTenant(tenant)
+
+	readGroup := testutils.NewGroup(func(_ *model.Group) {})
+	operateGroup := testutils.NewGroup(func(_ *model.Group) {})
+	keyConfig := testutils.NewKeyConfig(func(_ *model.KeyConfiguration) {})
+	system := testutils.NewSystem(func(s *model.System) {
+		s.KeyConfigurationID = &keyConfig.ID
+	})
+	testutils.CreateTestEntities(ctx, t, r, readGroup, operateGroup, keyConfig, system,
+		testutils.NewResourceGrant(func(g *model.ResourceGrant) {
+			g.GroupID = readGroup.ID
+			g.ResourceID = keyConfig.ID
+		}),
+		testutils.NewResourceGrant(func(g *model.ResourceGrant) {
+			g.GroupID = operateGroup.ID
+			g.ResourceID = keyConfig.ID
+			g.Access = model.ResourceGrantAccessOperate
+		}),
+		testutils.NewResourceGrant(func(g *model.ResourceGrant) {
+			g.GroupID = operateGroup.ID
+			g.ResourceType = model.ResourceGrantTypeSystem
+			g.ResourceID = system.ID
+			g.Access = model.ResourceGrantAccessOperate
+		}),
+	)
+
+	readCtx := testutils.InjectBusinessUserDataIntoContext(ctx, "reader", []string{readGroup.IAMIdentifier})
+	operateCtx := testutils.InjectBusinessUserDataIntoContext(ctx, "operator", []string{operateGroup.IAMIdentifier})
+
+	t.Run("Should allow key read with read grant", func(t *testing.T) {
+		_, err := m.HasKeyAccess(readCtx, authz.APIActionRead, keyConfig.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should deny key update with read grant", func(t *testing.T) {
+		_, err := m.HasKeyAccess(readCtx, authz.APIActionUpdate, keyConfig.ID)
+		assert.ErrorIs(t, err, manager.ErrKeyConfigurationNotAllowed)
+	})
+
+	t.Run("Should allow key update with operate grant", func(t *testing.T) {
+		_, err := m.HasKeyAccess(operateCtx, authz.APIActionUpdate, keyConfig.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should only allow key configuration read with operate grant", func(t *testing.T) {
+		_, err := m.HasKeyConfigAccess(operateCtx, authz.APIActionRead, keyConfig)
+		assert.NoError(t, err)
+
+		_, err = m.HasKeyConfigAccess(operateCtx, authz.APIActionUpdate, keyConfig)
+		assert.ErrorIs(t, err, manager.ErrKeyConfigurationNotAllowed)
+	})
+
+	t.Run("Should allow system operation with system grant", func(t *testing.T) {
+		_, err := m.HasSystemAccess(operateCtx, authz.APIActionSystemModifyLink, system)
+		assert.NoError(t, err)
+
+		_, err = m.HasSystemAccess(readCtx, authz.APIActionSystemModifyLink, system)
+		assert.ErrorIs(t, err, manager.ErrKeyConfigurationNotAllowed)
+	})
+
+	t.Run("Should deny access without grant", func(t *testing.T) {
+		otherCtx := testutils.InjectBusinessUserDataIntoContext(ctx, "other", []string{uuid.NewString()})
+
+		_, err := m.HasKeyAccess(otherCtx, authz.APIActionRead, keyConfig.ID)
+		assert.ErrorIs(t, err, manager.ErrKeyConfigurationNotAllowed)
+	})
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/openkcm/cmk/internal/api/cmkapi"
 	"github.com/openkcm/cmk/internal/async"
+	"github.com/openkcm/cmk/internal/auditor"
 	"github.com/openkcm/cmk/internal/authz"
 	"github.com/openkcm/cmk/internal/config"
 	"github.com/openkcm/cmk/internal/constants"
@@ -95,6 +96,8 @@ type WorkflowManager struct {
 	tenantConfigManager     *TenantConfigManager
 	svcRegistry             serviceapi.Registry
 	cfg                     *config.Config
+	cmkAuditor              *auditor.Auditor
+	roleElevationManager    *RoleElevationManager
 }
 
 func NewWorkflowManager(
@@ -108,6 +111,7 @@ func NewWorkflowManager(
 	asyncClient async.Client,
 	tenantConfigManager *TenantConfigManager,
 	cfg *config.Config,
+	cmkAuditor *auditor.Auditor,
 ) *WorkflowManager {
 	return &WorkflowManager{
 		repo:                    repository,
@@ -120,6 +124,8 @@ func NewWorkflowManager(
 		asyncClient:             asyncClient,
 		tenantConfigManager:     tenantConfigManager,
 		cfg:                     cfg,
+		cmkAuditor:              cmkAuditor,
+		roleElevationManager:    NewRoleElevationManager(repository, cmkAuditor),
 	}
 }
 
@@ -391,6 +397,17 @@ func (w *WorkflowManager) CreateWorkflow(
 		return nil, errs.Wrap(ErrInDBTransaction, err)
 	}
 
+	err = w.cmkAuditor.SendWorkflowStartAuditLog(
+		ctx,
+		workflow.ID.String(),
+		workflow.ArtifactID.String(),
+		string(workflow.ArtifactType),
+		string(workflow.ActionType),
+	)
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for workflow start", err)
+	}
+
 	return workflow, nil
 }
 
@@ -552,6 +569,8 @@ func (w *WorkflowManager) ExpireWorkflow(
 		return nil, errs.Wrap(ErrGetWorkflowDB, err)
 	}
 
+	oldState := workflow.State
+
 	err = w.repo.Transaction(ctx, func(ctx context.Context) error {
 		userID, err := cmkContext.ExtractUserIdentifier(ctx)
 		if err != nil {
@@ -578,6 +597,8 @@ func (w *WorkflowManager) ExpireWorkflow(
 		return nil, err
 	}
 
+	w.SendTransitionAuditLogs(ctx, workflow, oldState)
+
 	return workflow, nil
 }
 
@@ -968,6 +989,11 @@ func (w *WorkflowManager) checkInitiatorIneligible(
 	workflow *model.Workflow,
 	eligibleUserIDs map[string]bool,
 ) bool {
+	// The initiator of a role elevation is not expected in the approver groups
+	if w.isRoleElevation(workflow) {
+		return false
+	}
+
 	// If initiator is not in eligible set, they can't confirm
 	return !eligibleUserIDs[workflow.InitiatorID]
 }
@@ -981,6 +1007,9 @@ func isInvalidAction(err error) bool {
 		ErrUpdateNonBYOKKeyStatus,
 		ErrPrimaryKeyDisabled,
 		ErrUnsuportedWorkflow,
+		ErrInvalidRoleElevation,
+		ErrRoleElevationNotRequired,
+		model.ErrInvalidRoleElevationParameters,
 	)
 }
 
@@ -1096,10 +1125,12 @@ func (w *WorkflowManager) isSupportedWorkflow(workflow *model.Workflow) bool {
 			return false
 		}
 	case model.WorkflowArtifactTypeKeyConfiguration:
-		if workflow.ActionType == model.WorkflowActionTypeUpdatePrimary {
+		switch workflow.ActionType {
+		case model.WorkflowActionTypeUpdatePrimary, model.WorkflowActionTypeElevate:
 			return true
+		default:
+			return false
 		}
-		return false
 	default:
 		return false
 	}
@@ -1171,7 +1202,7 @@ func (w *WorkflowManager) validateWorkflow(ctx context.Context, workflow *model.
 
 		err = repo.ProcessInBatch(ctx, w.repo, &query, repo.DefaultLimit, func(systems []*model.System) error {
 			for _, s := range systems {
-				if s.Status != cmkapi.SystemStatusCONNECTED {
+				if !s.IsConnected() {
 					return ErrNotAllSystemsConnected
 				}
 			}
@@ -1180,6 +1211,11 @@ func (w *WorkflowManager) validateWorkflow(ctx context.Context, workflow *model.
 		if err != nil {
 			return false, err
 		}
+	case w.isRoleElevation(workflow):
+		err := w.validateRoleElevation(ctx, workflow, keyConfigs[0])
+		if err != nil {
+			return false, err
+		}
 	case w.isKeyStateChange(workflow):
 		key := &model.Key{ID: workflow.ArtifactID}
 		_, err := w.repo.First(ctx, key, *repo.NewQuery())
@@ -1205,6 +1241,48 @@ func (w *WorkflowManager) isPrimaryKeySwitch(workflow *model.Workflow) bool {
 		workflow.ActionType == model.WorkflowActionTypeUpdatePrimary
 }
 
+func (w *WorkflowManager) isRoleElevation(workflow *model.Workflow) bool {
+	return workflow.ArtifactType == model.WorkflowArtifactTypeKeyConfiguration &&
+		workflow.ActionType == model.WorkflowActionTypeElevate
+}
+
+// validateRoleElevation checks the requested role acts as key administrator
+// and the user doesn't already administer the key configuration
+func (w *WorkflowManager) validateRoleElevation(
+	ctx context.Context,
+	workflow *model.Workflow,
+	keyConfig *model.KeyConfiguration,
+) error {
+	params, err := model.ParseRoleElevationParameters(workflow.Parameters)
+	if err != nil {
+		return err
+	}
+
+	baseRole, err := resolveBaseRole(ctx, w.repo, params.Role)
+	if errors.Is(err, repo.ErrNotFound) {
+		return ErrInvalidRoleElevation
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if baseRole != constants.KeyAdminRole {
+		return ErrInvalidRoleElevation
+	}
+
+	iamIdentifiers, err := cmkContext.ExtractBusinessUserDataGroupsString(ctx)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(iamIdentifiers, keyConfig.AdminGroup.IAMIdentifier) {
+		return ErrRoleElevationNotRequired
+	}
+
+	return nil
+}
+
 func (w *WorkflowManager) isSystemConnect(workflow *model.Workflow) bool {
 	return workflow.ArtifactType == model.WorkflowArtifactTypeSystem &&
 		(workflow.ActionType == model.WorkflowActionTypeLink || workflow.ActionType == model.WorkflowActionTypeSwitch)
@@ -1414,6 +1492,7 @@ func (w *WorkflowManager) getWorkflowLifecycleWithEligibility(
 
 	// Set eligible approver IDs if provided (for accurate vote counting)
 	workflowLifecycle.EligibleApproverIDs = eligibleApproverIDs
+	workflowLifecycle.RoleElevationActions = w.roleElevationManager
 
 	return workflowLifecycle, nil
 }
@@ -1429,6 +1508,8 @@ func (w *WorkflowManager) addApproversAndGroupAssociations(
 	approvers []*model.WorkflowApprover,
 	groups []*model.Group,
 ) error {
+	oldState := workflow.State
+
 	err := w.repo.Transaction(ctx, func(ctx context.Context) error {
 		workflowLifecycle, err := w.getWorkflowLifecycle(ctx, workflow, userID)
 		if err != nil {
@@ -1468,6 +1549,8 @@ func (w *WorkflowManager) addApproversAndGroupAssociations(
 		return errs.Wrap(ErrInDBTransaction, err)
 	}
 
+	w.SendTransitionAuditLogs(ctx, workflow, oldState)
+
 	return nil
 }
 
@@ -1480,6 +1563,15 @@ func (w *WorkflowManager) checkOngoingWorkflowForArtifact(
 		Where(fmt.Sprintf("%s_%s", repo.ArtifactField, repo.IDField), workflow.ArtifactID).
 		Where(repo.StateField, model.WorkflowNonTerminalStates)
 
+	// Role elevations are requested per user and don't block other
+	// workflows on the key configuration
+	if w.isRoleElevation(workflow) {
+		ck = ck.Where(repo.ActionTypeField, model.WorkflowActionTypeElevate).
+			Where(repo.InitiatorIDField, workflow.InitiatorID)
+	} else {
+		ck = ck.Where(repo.ActionTypeField, model.WorkflowActionTypeElevate, repo.NotEq)
+	}
+
 	count, err := w.repo.Count(ctx, &model.Workflow{}, *repo.NewQuery().Where(repo.NewCompositeKeyGroup(ck)))
 	if err != nil {
 		return false, errs.Wrap(ErrCheckOngoingWorkflow, err)
@@ -1502,6 +1594,8 @@ func (w *WorkflowManager) applyTransition(
 ) error {
 	var capturedEligibleApproverIDs map[string]bool
 
+	oldState := workflow.State
+
 	err := w.repo.Transaction(ctx, func(ctx context.Context) error {
 		// For approve/reject transitions, fetch eligible approvers BEFORE creating lifecycle
 		eligibleApproverIDs := w.fetchEligibilityForVote(ctx, workflow, transition)
@@ -1513,10 +1607,13 @@ func (w *WorkflowManager) applyTransition(
 			return err
 		}
 
-		// Resolve actor's approver group membership for the lifecycle guard
-		workflowLifecycle.ActorApproverGroupIDs, err = w.resolveActorApproverGroupIDs(ctx, workflow)
-		if err != nil {
-			return err
+		// Resolve actor's approver group membership for the lifecycle guard.
+		// The initiator of a role elevation is not a member of the approver groups
+		if !w.isRoleElevation(workflow) || userID != workflow.InitiatorID {
+			workflowLifecycle.ActorApproverGroupIDs, err = w.resolveActorApproverGroupIDs(ctx, workflow)
+			if err != nil {
+				return err
+			}
 		}
 
 		validateErr := workflowLifecycle.ValidateActor(ctx, transition)
@@ -1560,9 +1657,47 @@ func (w *WorkflowManager) applyTransition(
 		return errs.Wrap(ErrInDBTransaction, err)
 	}
 
+	w.SendTransitionAuditLogs(ctx, workflow, oldState)
+
 	return nil
 }
 
+// SendTransitionAuditLogs audits the state change of a workflow. Reaching
+// a terminal state additionally emits an execute or terminate event
+func (w *WorkflowManager) SendTransitionAuditLogs(
+	ctx context.Context,
+	workflow *model.Workflow,
+	oldState model.WorkflowState,
+) {
+	if workflow.State == oldState {
+		return
+	}
+
+	workflowID := workflow.ID.String()
+
+	err := w.cmkAuditor.SendWorkflowUpdateAuditLog(ctx, workflowID, string(oldState), string(workflow.State))
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for workflow update", err)
+	}
+
+	artifactID := workflow.ArtifactID.String()
+	artifactType := string(workflow.ArtifactType)
+	state := string(workflow.State)
+
+	switch workflow.State {
+	case model.WorkflowStateSuccessful, model.WorkflowStateFailed:
+		err = w.cmkAuditor.SendWorkflowExecuteAuditLog(ctx, workflowID, artifactID, artifactType, state)
+	case model.WorkflowStateRejected, model.WorkflowStateRevoked, model.WorkflowStateExpired:
+		err = w.cmkAuditor.SendWorkflowTerminateAuditLog(ctx, workflowID, artifactID, artifactType, state)
+	default:
+		return
+	}
+
+	if err != nil {
+		log.Error(ctx, "Failed to send audit log for workflow completion", err)
+	}
+}
+
 // fetchEligibilityForVote fetches eligible approver IDs for approve/reject transitions.
 // Returns nil if not a voting transition or if eligibility check fails.
 func (w *WorkflowManager) fetchEligibilityForVote(
@@ -1710,7 +1845,17 @@ func (w *WorkflowManager) checkPermissionToCreateWorkflow(
 			return false, err
 		}
 
-		if userinfo.Role == string(constants.TenantAuditorRole) {
+		role, err := resolveBaseRole(ctx, w.repo, constants.BusinessRole(userinfo.Role))
+		if err != nil {
+			return false, err
+		}
+
+		// Any user with a role in the tenant can request a role elevation
+		if w.isRoleElevation(workflow) {
+			return role != "", nil
+		}
+
+		if role == constants.TenantAuditorRole {
 			return false, nil
 		}
 
@@ -1728,7 +1873,12 @@ func (w *WorkflowManager) getKeyConfigurationsFromArtifact(
 
 	switch workflow.ArtifactType {
 	case model.WorkflowArtifactTypeKeyConfiguration:
-		keyConfig, err := w.keyConfigurationManager.GetKeyConfigurationByID(ctx, workflow.ArtifactID)
+		getKeyConfig := w.keyConfigurationManager.GetKeyConfigurationByID
+		if w.isRoleElevation(workflow) {
+			getKeyConfig = w.getRoleElevationKeyConfig
+		}
+
+		keyConfig, err := getKeyConfig(ctx, workflow.ArtifactID)
 		if err != nil {
 			return nil, errs.Wrap(ErrGetKeyConfigFromArtifact, err)
 		}
@@ -1759,6 +1909,28 @@ func (w *WorkflowManager) getKeyConfigurationsFromArtifact(
 	return keyConfigs, nil
 }
 
+// getRoleElevationKeyConfig reads the key configuration a role elevation is
+// requested on. The requester doesn't administer it, so it is read with the internal role.
+func (w *WorkflowManager) getRoleElevationKeyConfig(
+	ctx context.Context,
+	keyConfigID uuid.UUID,
+) (*model.KeyConfiguration, error) {
+	authCtx, err := cmkContext.BusinessToInternalContext(ctx,
+		constants.InternalBusinessAuthzRole)
+	if err != nil {
+		return nil, err
+	}
+
+	keyConfig := &model.KeyConfiguration{ID: keyConfigID}
+
+	_, err = w.repo.First(authCtx, keyConfig, *repo.NewQuery().Preload(repo.Preload{"AdminGroup"}))
+	if err != nil {
+		return nil, err
+	}
+
+	return keyConfig, nil
+}
+
 func (w *WorkflowManager) getKeyConfigFromSystem(
 	ctx context.Context,
 	workflow *model.Workflow,
@@ -2102,11 +2274,11 @@ func (w *WorkflowManager) populateArtifact(
 		workflow.ArtifactName = new(key.Name)
 
 	case model.WorkflowArtifactTypeKeyConfiguration:
-		keyConfig, err := w.keyConfigurationManager.GetKeyConfigurationByID(ctx, workflow.ArtifactID)
+		keyConfigs, err := w.getKeyConfigurationsFromArtifact(ctx, workflow)
 		if err != nil {
 			return err
 		}
-		workflow.ArtifactName = new(keyConfig.Name)
+		workflow.ArtifactName = new(keyConfigs[0].Name)
 
 	case model.WorkflowArtifactTypeSystem:
 		system, err := w.systemManager.GetSystemByID(ctx, workflow.ArtifactID)
@@ -68,13 +68,13 @@ func SetupWorkflowManager(
 
 	svcRegistry := testutils.NewTestPlugins(opts...)
 
-	certManager := manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg)
-	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, nil, certManager)
+	certManager := manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg, nil)
+	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, nil, certManager, nil)
 	cmkAuditor := auditor.New(t.Context(), cfg)
 	userManager := manager.NewUserManager(authzRepo, cmkAuditor)
-	tagManager := manager.NewTagManager(r)
+	tagManager := manager.NewTagManager(r, cmkAuditor)
 	keyConfigManager := manager.NewKeyConfigManager(r, certManager, userManager, tagManager, cmkAuditor, nil, cfg)
-	groupManager := manager.NewGroupManager(r, svcRegistry, userManager)
+	groupManager := manager.NewGroupManager(r, svcRegistry, userManager, cmkAuditor)
 
 	clientsFactory, err := clients.NewFactory(cfg.Services)
 	assert.NoError(t, err)
@@ -84,6 +84,7 @@ func SetupWorkflowManager(
 	m := manager.NewWorkflowManager(
 		r, svcRegistry, keym, keyConfigManager, systemManager,
 		groupManager, userManager, nil, tenantConfigManager, cfg,
+		cmkAuditor,
 	)
 
 	return m, r, tenants[0]
@@ -508,6 +509,39 @@ func TestWorkflowManager_CheckWorkflow(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should have canCreate on primary key change with drifted system", func(t *testing.T) {
+		sourceKey := testutils.NewKey(func(_ *model.Key) {})
+		keyConfig := testutils.NewKeyConfig(func(kc *model.KeyConfiguration) {
+			kc.AdminGroup = *testGroup
+			kc.AdminGroupID = testGroup.ID
+			kc.PrimaryKeyID = new(sourceKey.ID)
+		})
+		connected := testutils.NewSystem(func(s *model.System) {
+			s.KeyConfigurationID = &keyConfig.ID
+			s.Status = cmkapi.SystemStatusCONNECTED
+		})
+		drifted := testutils.NewSystem(func(s *model.System) {
+			s.KeyConfigurationID = &keyConfig.ID
+			s.Status = cmkapi.SystemStatusDRIFTED
+		})
+		targetKey := testutils.NewKey(func(_ *model.Key) {})
+		testutils.CreateTestEntities(ctxSys, t, r, keyConfig, connected, drifted, sourceKey, targetKey)
+		wf := testutils.NewWorkflow(
+			func(w *model.Workflow) {
+				w.State = model.WorkflowStateInitial
+				w.ActionType = model.WorkflowActionTypeUpdatePrimary
+				w.ArtifactID = keyConfig.ID
+				w.ArtifactType = model.WorkflowArtifactTypeKeyConfiguration
+				w.Parameters = targetKey.ID.String()
+			},
+		)
+
+		status, err := m.CheckWorkflow(ctxSys, wf)
+		assert.NoError(t, err)
+		assert.True(t, status.Valid)
+		assert.True(t, status.CanCreate, "drifted systems should not block the switch: %v", status.ErrDetails)
+	})
+
 	t.Run(
 		"Should return authorization error on non active artifact", func(t *testing.T) {
 			wf, err := createTestWorkflow(
@@ -527,6 +561,70 @@ func TestWorkflowManager_CheckWorkflow(t *testing.T) {
 			assert.ErrorIs(t, err, manager.ErrWorkflowCreationNotAllowed)
 		},
 	)
+	t.Run("Role elevation", func(t *testing.T) {
+		otherAdminGroup := testutils.NewGroup(func(g *model.Group) {
+			g.Role = constants.KeyAdminRole
+		})
+		testutils.CreateTestEntities(ctx, t, r, otherAdminGroup)
+
+		otherAdminCtx := testutils.InjectBusinessUserDataIntoContext(ctx, "other-admin",
+			[]string{otherAdminGroup.IAMIdentifier})
+		adminCtx := testutils.InjectBusinessUserDataIntoContext(ctx, testUser1,
+			[]string{testKeyAdminGroup})
+
+		elevation := func(parameters string) *model.Workflow {
+			return testutils.NewWorkflow(func(w *model.Workflow) {
+				w.State = model.WorkflowStateInitial
+				w.ActionType = model.WorkflowActionTypeElevate
+				w.ArtifactType = model.WorkflowArtifactTypeKeyConfiguration
+				w.ArtifactID = keyConfig.ID
+				w.Parameters = parameters
+			})
+		}
+
+		t.Run("Should be valid for administrator of other key configuration", func(t *testing.T) {
+			status, err := m.CheckWorkflow(otherAdminCtx,
+				elevation(`{"role":"KEY_ADMINISTRATOR","durationHours":4}`))
+			assert.NoError(t, err)
+			assert.True(t, status.Valid)
+			assert.False(t, status.Exists)
+		})
+
+		t.Run("Should be valid for auditor", func(t *testing.T) {
+			auditorCtx := testutils.InjectBusinessUserDataIntoContext(ctx, "auditor",
+				[]string{auditorGroupName})
+
+			status, err := m.CheckWorkflow(auditorCtx,
+				elevation(`{"role":"KEY_ADMINISTRATOR","durationHours":4}`))
+			assert.NoError(t, err)
+			assert.True(t, status.Valid)
+			assert.False(t, status.Exists)
+		})
+
+		t.Run("Should not be required for administrator of the key configuration", func(t *testing.T) {
+			status, err := m.CheckWorkflow(adminCtx,
+				elevation(`{"role":"KEY_ADMINISTRATOR","durationHours":4}`))
+			assert.NoError(t, err)
+			assert.False(t, status.CanCreate)
+			assert.ErrorIs(t, status.ErrDetails, manager.ErrRoleElevationNotRequired)
+		})
+
+		t.Run("Should be invalid on non key administrator role", func(t *testing.T) {
+			status, err := m.CheckWorkflow(otherAdminCtx,
+				elevation(`{"role":"TENANT_AUDITOR","durationHours":4}`))
+			assert.NoError(t, err)
+			assert.False(t, status.CanCreate)
+			assert.ErrorIs(t, status.ErrDetails, manager.ErrInvalidRoleElevation)
+		})
+
+		t.Run("Should be invalid on duration above maximum", func(t *testing.T) {
+			status, err := m.CheckWorkflow(otherAdminCtx,
+				elevation(`{"role":"KEY_ADMINISTRATOR","durationHours":48}`))
+			assert.NoError(t, err)
+			assert.False(t, status.CanCreate)
+			assert.ErrorIs(t, status.ErrDetails, model.ErrInvalidRoleElevationParameters)
+		})
+	})
 }
 
 func TestWorkflowManager_CreateWorkflow(t *testing.T) {
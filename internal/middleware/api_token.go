@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/openkcm/cmk/internal/api/write"
+	"github.com/openkcm/cmk/internal/apierrors"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/log"
+	"github.com/openkcm/cmk/internal/manager"
+	"github.com/openkcm/cmk/internal/model"
+	cmkcontext "github.com/openkcm/cmk/utils/context"
+)
+
+const bearerPrefix = "Bearer "
+
+// APITokenAuthenticator defines the interface for authenticating API tokens for better unit testing
+type APITokenAuthenticator interface {
+	Authenticate(ctx context.Context, secret, request string) (*model.APIToken, error)
+}
+
+// APITokenMiddleware authenticates requests carrying an API token as bearer
+// token and adds the identity of the token to the context. Requests without
+// an API token are left to the BusinessUserDataMiddleware.
+func APITokenMiddleware(authenticator APITokenAuthenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				secret, ok := extractAPIToken(r)
+				if !ok {
+					next.ServeHTTP(w, r)
+					return
+				}
+
+				token, err := authenticator.Authenticate(r.Context(), secret, r.Method+" "+r.URL.Path)
+				if err != nil {
+					log.Error(r.Context(), "API token authentication error", err)
+					e := apierrors.APIErrorMapper.Transform(r.Context(), err)
+					write.ErrorResponse(r.Context(), w, e)
+
+					return
+				}
+
+				ctx := cmkcontext.InjectBusinessUserData(r.Context(), manager.APITokenClientData(token), nil)
+				next.ServeHTTP(w, r.WithContext(ctx)) //nolint:contextcheck
+			},
+		)
+	}
+}
+
+// extractAPIToken returns the bearer token of the request
+// if it has the API token prefix
+func extractAPIToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	if len(header) < len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+
+	secret := strings.TrimSpace(header[len(bearerPrefix):])
+
+	return secret, strings.HasPrefix(secret, constants.APITokenPrefix)
+}
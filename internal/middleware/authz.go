@@ -1,20 +1,106 @@
 package middleware
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
 	"log/slog"
 	"net/http"
 	"strconv"
 	"strings"
 
+	"github.com/google/uuid"
+
 	"github.com/openkcm/cmk/internal/api/write"
 	"github.com/openkcm/cmk/internal/apierrors"
 	"github.com/openkcm/cmk/internal/authz"
 	"github.com/openkcm/cmk/internal/constants"
 	"github.com/openkcm/cmk/internal/controllers/cmk"
 	"github.com/openkcm/cmk/internal/log"
+	"github.com/openkcm/cmk/internal/model"
 	cmkcontext "github.com/openkcm/cmk/utils/context"
 )
 
+// keyConfigurationScope scopes the context to the key configuration the request
+// acts on, so role elevations of the user only apply within it
+func keyConfigurationScope(ctx context.Context, ctr *cmk.APIController, r *http.Request) context.Context {
+	_, ok := cmkcontext.ExtractKeyConfigurationScope(ctx)
+	if ok || !ctr.AuthzLoader.HasRoleElevations(ctx) {
+		return ctx
+	}
+
+	keyConfigID, err := requestKeyConfigurationID(ctx, ctr, r)
+	if err != nil {
+		log.Debug(ctx, "key configuration scope error", log.ErrorAttr(err))
+		return ctx
+	}
+
+	if keyConfigID == uuid.Nil {
+		return ctx
+	}
+
+	return cmkcontext.InjectKeyConfigurationScope(ctx, keyConfigID)
+}
+
+// requestKeyConfigurationID returns the key configuration ID of the path, the
+// query or the body, or the key configuration of the key or system in the path
+func requestKeyConfigurationID(ctx context.Context, ctr *cmk.APIController, r *http.Request) (uuid.UUID, error) {
+	if id := r.PathValue("keyConfigurationID"); id != "" {
+		return uuid.Parse(id)
+	}
+
+	if id := r.PathValue("keyID"); id != "" {
+		keyID, err := uuid.Parse(id)
+		if err != nil {
+			return uuid.Nil, err
+		}
+
+		return ctr.AuthzLoader.KeyConfigurationOf(ctx, &model.Key{ID: keyID})
+	}
+
+	if id := r.PathValue("systemID"); id != "" {
+		systemID, err := uuid.Parse(id)
+		if err != nil {
+			return uuid.Nil, err
+		}
+
+		return ctr.AuthzLoader.KeyConfigurationOf(ctx, &model.System{ID: systemID})
+	}
+
+	if id := r.URL.Query().Get("keyConfigurationID"); id != "" {
+		return uuid.Parse(id)
+	}
+
+	return bodyKeyConfigurationID(r), nil
+}
+
+// bodyKeyConfigurationID returns the key configuration ID of a JSON request body,
+// leaving the body for the next handlers
+func bodyKeyConfigurationID(r *http.Request) uuid.UUID {
+	if r.Body == nil || r.Body == http.NoBody {
+		return uuid.Nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return uuid.Nil
+	}
+
+	var scoped struct {
+		KeyConfigurationID uuid.UUID `json:"keyConfigurationID"`
+	}
+
+	err = json.Unmarshal(body, &scoped)
+	if err != nil {
+		return uuid.Nil
+	}
+
+	return scoped.KeyConfigurationID
+}
+
 // extractPattern removes the base path from a pattern using TrimPrefix to prevent bypass attacks.
 // Pattern format: "METHOD /path" or "/path"
 func extractPattern(pattern, basePath string) string {
@@ -66,6 +152,8 @@ func AuthzMiddleware(
 					return
 				}
 
+				ctx = keyConfigurationScope(ctx, ctr, r)
+
 				allowed, err := authz.CheckAuthz(
 					ctx, ctr.AuthzLoader.AuthzHandler, restriction.APIResourceTypeName, restriction.APIAction,
 				)
@@ -97,6 +185,14 @@ func AuthzMiddleware(
 						return
 					}
 
+					// Role elevations may have been granted after the tenant was loaded
+					loadErr = ctr.AuthzLoader.LoadUserRoleElevations(ctx)
+					if loadErr != nil {
+						log.Debug(ctx, "LoadUserRoleElevations error", log.ErrorAttr(loadErr))
+					}
+
+					ctx = keyConfigurationScope(ctx, ctr, r)
+
 					// Retry authorization after allow list is loaded
 					allowed, err = authz.CheckAuthz(
 						ctx, ctr.AuthzLoader.AuthzHandler, restriction.APIResourceTypeName, restriction.APIAction,
@@ -117,12 +213,12 @@ func AuthzMiddleware(
 						return
 					}
 
-					next.ServeHTTP(w, r)
+					next.ServeHTTP(w, r.WithContext(ctx))
 
 					return
 				}
 
-				next.ServeHTTP(w, r)
+				next.ServeHTTP(w, r.WithContext(ctx))
 			},
 		)
 	}
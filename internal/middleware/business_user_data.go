@@ -69,8 +69,15 @@ func BusinessUserDataMiddleware(
 	}
 }
 
+// canBypassBusinessUserData returns true for the swagger endpoint and for
+// requests already authenticated by the APITokenMiddleware
 func canBypassBusinessUserData(r *http.Request) bool {
+	if manager.IsAPITokenClient(r.Context()) {
+		return true
+	}
+
 	pattern := extractPattern(r.Pattern, constants.BasePath)
+
 	return pattern == "GET /swagger"
 }
 
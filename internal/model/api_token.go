@@ -0,0 +1,70 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openkcm/cmk/internal/authz"
+)
+
+type APITokenStatus string
+
+const (
+	APITokenStatusActive  APITokenStatus = "ACTIVE"
+	APITokenStatusExpired APITokenStatus = "EXPIRED"
+	APITokenStatusRevoked APITokenStatus = "REVOKED"
+)
+
+// APIToken authenticates a machine client of the tenant with the role of
+// its group. Only the hash of the secret is stored, the prefix identifies
+// the token in listings and audit logs.
+type APIToken struct {
+	AutoTimeModel
+
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name       string    `gorm:"type:varchar(64);not null"`
+	GroupID    uuid.UUID `gorm:"type:uuid;not null"`
+	Group      Group     `gorm:"foreignKey:GroupID"`
+	Prefix     string    `gorm:"type:varchar(16);not null"`
+	TokenHash  string    `gorm:"type:varchar(64);not null;uniqueIndex"`
+	CreatedBy  string    `gorm:"type:varchar(255);not null"`
+	ExpiresAt  time.Time `gorm:"not null"`
+	RevokedAt  *time.Time
+	LastUsedAt *time.Time
+}
+
+// TableResourceType return the authz resource type
+func (m APIToken) TableResourceType() authz.RepoResourceType {
+	return authz.RepoResourceTypeAPIToken
+}
+
+// TableName returns the table name for APIToken
+func (m APIToken) TableName() string {
+	return string(m.TableResourceType())
+}
+
+func (APIToken) IsSharedModel() bool {
+	return false
+}
+
+func (m APIToken) CheckAuthz(ctx context.Context,
+	authzHandler *authz.Handler[authz.RepoResourceType, authz.RepoAction],
+	action authz.RepoAction,
+) (bool, error) {
+	return authz.CheckAuthz(ctx, authzHandler, m.TableResourceType(), action)
+}
+
+// Status returns the status of the token at the given time.
+// A revoked token stays revoked after its expiry.
+func (m APIToken) Status(now time.Time) APITokenStatus {
+	switch {
+	case m.RevokedAt != nil:
+		return APITokenStatusRevoked
+	case !now.Before(m.ExpiresAt):
+		return APITokenStatusExpired
+	default:
+		return APITokenStatusActive
+	}
+}
@@ -0,0 +1,29 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/model"
+)
+
+func TestAPITokenTable(t *testing.T) {
+	t.Run("Should have table name api_tokens", func(t *testing.T) {
+		assert.Equal(t, "api_tokens", model.APIToken{}.TableName())
+	})
+
+	t.Run("Should be tenant table", func(t *testing.T) {
+		assert.False(t, model.APIToken{}.IsSharedModel())
+	})
+}
+
+func TestAPITokenStatus(t *testing.T) {
+	now := time.Now()
+
+	assert.Equal(t, model.APITokenStatusActive, model.APIToken{ExpiresAt: now.Add(time.Hour)}.Status(now))
+	assert.Equal(t, model.APITokenStatusExpired, model.APIToken{ExpiresAt: now}.Status(now))
+	assert.Equal(t, model.APITokenStatusRevoked,
+		model.APIToken{ExpiresAt: now.Add(-time.Hour), RevokedAt: &now}.Status(now))
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openkcm/cmk/internal/authz"
+)
+
+// AuditEvent is the tenant local copy of an audit event sent to the audit log.
+// Audit events are append only and are never updated or deleted by CMK.
+// Events are hash chained per tenant, events stored before chaining was
+// introduced have sequence number 0.
+type AuditEvent struct {
+	ID             uuid.UUID       `gorm:"type:uuid;primaryKey"`
+	EventType      string          `gorm:"type:varchar(64);not null"`
+	ObjectID       string          `gorm:"type:varchar(255);not null"`
+	ActorID        string          `gorm:"type:varchar(255);not null"`
+	CorrelationID  string          `gorm:"type:varchar(255)"`
+	Attributes     json.RawMessage `gorm:"type:jsonb"`
+	CreatedAt      time.Time       `gorm:"not null"`
+	SequenceNumber int64           `gorm:"not null;default:0"`
+	PreviousHash   string          `gorm:"type:varchar(64);not null;default:''"`
+	Hash           string          `gorm:"type:varchar(64);not null;default:''"`
+}
+
+// GetAttributes returns the decoded attributes of the audit event
+func (m AuditEvent) GetAttributes() map[string]string {
+	if len(m.Attributes) == 0 {
+		return nil
+	}
+
+	var attributes map[string]string
+
+	err := json.Unmarshal(m.Attributes, &attributes)
+	if err != nil {
+		return nil // Return nil if unmarshalling fails to avoid panic
+	}
+
+	return attributes
+}
+
+// TableResourceType return the authz resource type
+func (m AuditEvent) TableResourceType() authz.RepoResourceType {
+	return authz.RepoResourceTypeAuditEvent
+}
+
+// TableName returns the table name for AuditEvent
+func (m AuditEvent) TableName() string {
+	return string(m.TableResourceType())
+}
+
+func (AuditEvent) IsSharedModel() bool {
+	return false
+}
+
+func (m AuditEvent) CheckAuthz(ctx context.Context,
+	authzHandler *authz.Handler[authz.RepoResourceType, authz.RepoAction],
+	action authz.RepoAction,
+) (bool, error) {
+	// Audit events are written on behalf of whoever triggered the audited
+	// action, so creating them and reading the head of the hash chain runs
+	// without authorization checks
+	if action == authz.RepoActionCreate || action == authz.RepoActionFirst {
+		return true, nil
+	}
+
+	return authz.CheckAuthz(ctx, authzHandler, m.TableResourceType(), action)
+}
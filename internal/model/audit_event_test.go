@@ -0,0 +1,36 @@
+package model_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/model"
+)
+
+func TestAuditEventTable(t *testing.T) {
+	t.Run("Should have table name audit_events", func(t *testing.T) {
+		assert.Equal(t, "audit_events", model.AuditEvent{}.TableName())
+	})
+
+	t.Run("Should be tenant table", func(t *testing.T) {
+		assert.False(t, model.AuditEvent{}.IsSharedModel())
+	})
+}
+
+func TestAuditEventAttributes(t *testing.T) {
+	t.Run("Should decode attributes", func(t *testing.T) {
+		event := model.AuditEvent{Attributes: json.RawMessage(`{"cmkID":"key-1"}`)}
+		assert.Equal(t, map[string]string{"cmkID": "key-1"}, event.GetAttributes())
+	})
+
+	t.Run("Should return nil without attributes", func(t *testing.T) {
+		assert.Nil(t, model.AuditEvent{}.GetAttributes())
+	})
+
+	t.Run("Should return nil on invalid attributes", func(t *testing.T) {
+		event := model.AuditEvent{Attributes: json.RawMessage(`invalid`)}
+		assert.Nil(t, event.GetAttributes())
+	})
+}
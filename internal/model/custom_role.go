@@ -0,0 +1,122 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/constants"
+)
+
+var ErrInvalidCustomRoleName = fmt.Errorf("%w: invalid custom role name", ErrValidation)
+
+// CustomRolePattern matches upper case role names like KEY_OPERATOR
+const CustomRolePattern = `^[A-Z][A-Z0-9_]{0,63}$`
+
+// CustomRole is a tenant defined business role.
+// Groups reference it by name in Group.Role like a built-in role.
+//
+//nolint:recvcheck
+type CustomRole struct {
+	AutoTimeModel
+
+	ID          uuid.UUID              `gorm:"type:uuid;primaryKey"`
+	Name        constants.BusinessRole `gorm:"type:varchar(64);not null;unique"`
+	Description string                 `gorm:"type:text"`
+	BaseRole    constants.BusinessRole `gorm:"type:varchar(255);not null"`
+	Permissions json.RawMessage        `gorm:"type:jsonb;not null"`
+}
+
+// CustomRolePermission grants actions on an API resource type
+type CustomRolePermission struct {
+	ResourceType authz.APIResourceType `json:"resourceType"`
+	Actions      []authz.APIAction     `json:"actions"`
+}
+
+// GetPermissions returns the decoded permissions of the role
+func (m CustomRole) GetPermissions() ([]CustomRolePermission, error) {
+	var permissions []CustomRolePermission
+
+	err := json.Unmarshal(m.Permissions, &permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+// SetPermissions encodes the permissions into the role
+func (m *CustomRole) SetPermissions(permissions []CustomRolePermission) error {
+	bytes, err := json.Marshal(permissions)
+	if err != nil {
+		return err
+	}
+
+	m.Permissions = bytes
+
+	return nil
+}
+
+// ToAuthz returns the role as used by the authorization handler
+func (m CustomRole) ToAuthz() (authz.CustomRole, error) {
+	permissions, err := m.GetPermissions()
+	if err != nil {
+		return authz.CustomRole{}, err
+	}
+
+	resources := make([]authz.Resource[authz.APIResourceType, authz.APIAction], 0, len(permissions))
+	for _, permission := range permissions {
+		resources = append(resources, authz.Resource[authz.APIResourceType, authz.APIAction]{
+			Type:    permission.ResourceType,
+			Actions: permission.Actions,
+		})
+	}
+
+	return authz.CustomRole{
+		Name:        m.Name,
+		BaseRole:    m.BaseRole,
+		Permissions: resources,
+	}, nil
+}
+
+// TableResourceType return the authz resource type
+func (m CustomRole) TableResourceType() authz.RepoResourceType {
+	return authz.RepoResourceTypeCustomRole
+}
+
+// TableName returns the table name for CustomRole
+func (m CustomRole) TableName() string {
+	return string(m.TableResourceType())
+}
+
+func (CustomRole) IsSharedModel() bool {
+	return false
+}
+
+func (m CustomRole) CheckAuthz(ctx context.Context,
+	authzHandler *authz.Handler[authz.RepoResourceType, authz.RepoAction],
+	action authz.RepoAction,
+) (bool, error) {
+	return authz.CheckAuthz(ctx, authzHandler, m.TableResourceType(), action)
+}
+
+// BeforeSave is ran before any creating/updating the custom role
+// but before finishing the transaction
+// If this step fails the transaction should be aborted
+func (m *CustomRole) BeforeSave(_ *gorm.DB) error {
+	if !regexp.MustCompile(CustomRolePattern).MatchString(string(m.Name)) {
+		return ErrInvalidCustomRoleName
+	}
+
+	role, err := m.ToAuthz()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+
+	return role.Validate()
+}
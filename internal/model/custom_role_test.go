@@ -0,0 +1,98 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+)
+
+func TestCustomRoleTable(t *testing.T) {
+	t.Run("Should have table name custom_roles", func(t *testing.T) {
+		assert.Equal(t, "custom_roles", model.CustomRole{}.TableName())
+	})
+
+	t.Run("Should be tenant table", func(t *testing.T) {
+		assert.False(t, model.CustomRole{}.IsSharedModel())
+	})
+}
+
+func TestCustomRoleToAuthz(t *testing.T) {
+	role := model.CustomRole{Name: "KEY_OPERATOR", BaseRole: constants.KeyAdminRole}
+	assert.NoError(t, role.SetPermissions([]model.CustomRolePermission{
+		{
+			ResourceType: authz.APIResourceTypeKey,
+			Actions:      []authz.APIAction{authz.APIActionRead},
+		},
+	}))
+
+	authzRole, err := role.ToAuthz()
+	assert.NoError(t, err)
+	assert.Equal(t, authz.CustomRole{
+		Name:     "KEY_OPERATOR",
+		BaseRole: constants.KeyAdminRole,
+		Permissions: []authz.Resource[authz.APIResourceType, authz.APIAction]{
+			{
+				Type:    authz.APIResourceTypeKey,
+				Actions: []authz.APIAction{authz.APIActionRead},
+			},
+		},
+	}, authzRole)
+}
+
+func TestCustomRoleValidation(t *testing.T) {
+	db, tenants, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
+	r := sql.NewRepository(db)
+	ctx := testutils.CreateCtxWithTenant(tenants[0])
+
+	tests := []struct {
+		name   string
+		mutate func(*model.CustomRole)
+		err    error
+	}{
+		{name: "Should be valid", mutate: func(_ *model.CustomRole) {}},
+		{
+			name:   "Should have valid length",
+			mutate: func(m *model.CustomRole) { m.Name = constants.BusinessRole(strings.Repeat("K", 64)) },
+		},
+		{
+			name:   "Should have invalid characters",
+			mutate: func(m *model.CustomRole) { m.Name = "key operator" },
+			err:    model.ErrInvalidCustomRoleName,
+		},
+		{
+			name:   "Should have invalid length",
+			mutate: func(m *model.CustomRole) { m.Name = constants.BusinessRole(strings.Repeat("K", 65)) },
+			err:    model.ErrInvalidCustomRoleName,
+		},
+		{
+			name:   "Should not shadow built-in role",
+			mutate: func(m *model.CustomRole) { m.Name = constants.KeyAdminRole },
+			err:    authz.ErrCustomRoleName,
+		},
+		{
+			name: "Should not exceed base role",
+			mutate: func(m *model.CustomRole) {
+				m.BaseRole = constants.TenantAuditorRole
+			},
+			err: authz.ErrCustomRolePermission,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := r.Create(ctx, testutils.NewCustomRole(tt.mutate))
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
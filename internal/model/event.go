@@ -27,6 +27,9 @@ type Event struct {
 	// PreviousItemStatus represents the state an item was before the event was sent
 	// This is used for cancel actions to recover an item to it's previous state
 	PreviousItemStatus string `gorm:"type:varchar(255)"`
+
+	// RetryCount is the number of automatic retries done for the event
+	RetryCount int `gorm:"type:integer;not null;default:0"`
 }
 
 // TableResourceType return the authz resource type
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/utils/enums"
+)
+
+// ResourceGrantType identifies the kind of resource a grant is given on.
+//
+//nolint:recvcheck
+type ResourceGrantType string
+
+const (
+	ResourceGrantTypeKeyConfiguration ResourceGrantType = "KEY_CONFIGURATION"
+	ResourceGrantTypeSystem           ResourceGrantType = "SYSTEM"
+)
+
+var ErrInvalidResourceGrantType = fmt.Errorf("%w: invalid resource grant type", ErrValidation)
+
+func (t ResourceGrantType) Valid() bool {
+	switch t {
+	case ResourceGrantTypeKeyConfiguration, ResourceGrantTypeSystem:
+		return true
+	}
+	return false
+}
+
+func (t ResourceGrantType) Value() (driver.Value, error) {
+	return enums.Value(t, ErrInvalidResourceGrantType)
+}
+
+func (t *ResourceGrantType) Scan(src any) error {
+	return enums.Scan(src, t, ErrInvalidResourceGrantType)
+}
+
+// ResourceGrantAccess is the level of access a grant gives on the resource.
+//
+//nolint:recvcheck
+type ResourceGrantAccess string
+
+const (
+	// ResourceGrantAccessRead allows reading the resource
+	ResourceGrantAccessRead ResourceGrantAccess = "READ"
+	// ResourceGrantAccessOperate allows every action on the resource
+	ResourceGrantAccessOperate ResourceGrantAccess = "OPERATE"
+)
+
+var ErrInvalidResourceGrantAccess = fmt.Errorf("%w: invalid resource grant access", ErrValidation)
+
+func (a ResourceGrantAccess) Valid() bool {
+	switch a {
+	case ResourceGrantAccessRead, ResourceGrantAccessOperate:
+		return true
+	}
+	return false
+}
+
+func (a ResourceGrantAccess) Value() (driver.Value, error) {
+	return enums.Value(a, ErrInvalidResourceGrantAccess)
+}
+
+func (a *ResourceGrantAccess) Scan(src any) error {
+	return enums.Scan(src, a, ErrInvalidResourceGrantAccess)
+}
+
+// ResourceGrantAccessesFor returns the access levels allowing the action
+func ResourceGrantAccessesFor(action authz.APIAction) []ResourceGrantAccess {
+	if action == authz.APIActionRead {
+		return []ResourceGrantAccess{ResourceGrantAccessRead, ResourceGrantAccessOperate}
+	}
+
+	return []ResourceGrantAccess{ResourceGrantAccessOperate}
+}
+
+// ResourceGrant gives a group access on a single key configuration or system
+// in addition to the access through its role and the key configuration admin group.
+type ResourceGrant struct {
+	AutoTimeModel
+
+	ID           uuid.UUID           `gorm:"type:uuid;primaryKey"`
+	GroupID      uuid.UUID           `gorm:"type:uuid;not null;uniqueIndex:idx_resource_grant,priority:1"`
+	ResourceType ResourceGrantType   `gorm:"type:varchar(64);not null;uniqueIndex:idx_resource_grant,priority:2"`
+	ResourceID   uuid.UUID           `gorm:"type:uuid;not null;uniqueIndex:idx_resource_grant,priority:3"`
+	Access       ResourceGrantAccess `gorm:"type:varchar(32);not null"`
+}
+
+// TableResourceType return the authz resource type
+func (m ResourceGrant) TableResourceType() authz.RepoResourceType {
+	return authz.RepoResourceTypeResourceGrant
+}
+
+// TableName returns the table name for ResourceGrant
+func (m ResourceGrant) TableName() string {
+	return string(m.TableResourceType())
+}
+
+func (ResourceGrant) IsSharedModel() bool {
+	return false
+}
+
+func (m ResourceGrant) CheckAuthz(ctx context.Context,
+	authzHandler *authz.Handler[authz.RepoResourceType, authz.RepoAction],
+	action authz.RepoAction,
+) (bool, error) {
+	return authz.CheckAuthz(ctx, authzHandler, m.TableResourceType(), action)
+}
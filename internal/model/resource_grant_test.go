@@ -0,0 +1,51 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/model"
+)
+
+func TestResourceGrantTable(t *testing.T) {
+	t.Run("Should have table name resource_grants", func(t *testing.T) {
+		assert.Equal(t, "resource_grants", model.ResourceGrant{}.TableName())
+	})
+
+	t.Run("Should be tenant table", func(t *testing.T) {
+		assert.False(t, model.ResourceGrant{}.IsSharedModel())
+	})
+}
+
+func TestResourceGrantEnums(t *testing.T) {
+	t.Run("Should validate resource type", func(t *testing.T) {
+		assert.True(t, model.ResourceGrantTypeKeyConfiguration.Valid())
+		assert.True(t, model.ResourceGrantTypeSystem.Valid())
+		assert.False(t, model.ResourceGrantType("KEY").Valid())
+
+		_, err := model.ResourceGrantType("KEY").Value()
+		assert.ErrorIs(t, err, model.ErrInvalidResourceGrantType)
+	})
+
+	t.Run("Should validate access", func(t *testing.T) {
+		assert.True(t, model.ResourceGrantAccessRead.Valid())
+		assert.True(t, model.ResourceGrantAccessOperate.Valid())
+		assert.False(t, model.ResourceGrantAccess("WRITE").Valid())
+
+		var access model.ResourceGrantAccess
+		assert.ErrorIs(t, access.Scan("WRITE"), model.ErrInvalidResourceGrantAccess)
+	})
+}
+
+func TestResourceGrantAccessesFor(t *testing.T) {
+	assert.Equal(t,
+		[]model.ResourceGrantAccess{model.ResourceGrantAccessRead, model.ResourceGrantAccessOperate},
+		model.ResourceGrantAccessesFor(authz.APIActionRead),
+	)
+	assert.Equal(t,
+		[]model.ResourceGrantAccess{model.ResourceGrantAccessOperate},
+		model.ResourceGrantAccessesFor(authz.APIActionDelete),
+	)
+}
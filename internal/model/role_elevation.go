@@ -0,0 +1,85 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openkcm/cmk/internal/authz"
+	"github.com/openkcm/cmk/internal/constants"
+)
+
+var ErrInvalidRoleElevationParameters = fmt.Errorf("%w: invalid role elevation parameters", ErrValidation)
+
+// RoleElevationParameters are the parameters of a key configuration
+// role elevation workflow
+type RoleElevationParameters struct {
+	Role          constants.BusinessRole `json:"role"`
+	DurationHours int                    `json:"durationHours"`
+}
+
+// ParseRoleElevationParameters parses and validates the parameters of
+// a role elevation workflow
+func ParseRoleElevationParameters(parameters string) (RoleElevationParameters, error) {
+	var params RoleElevationParameters
+
+	err := json.Unmarshal([]byte(parameters), &params)
+	if err != nil {
+		return params, fmt.Errorf("%w: %w", ErrInvalidRoleElevationParameters, err)
+	}
+
+	if params.Role == "" {
+		return params, fmt.Errorf("%w: role is required", ErrInvalidRoleElevationParameters)
+	}
+
+	if params.DurationHours < 1 || params.DurationHours > constants.MaxRoleElevationHours {
+		return params, fmt.Errorf("%w: duration must be between 1 and %d hours",
+			ErrInvalidRoleElevationParameters, constants.MaxRoleElevationHours)
+	}
+
+	return params, nil
+}
+
+// RoleElevation grants a user a role on a key configuration until it expires.
+// It is created by an approved role elevation workflow. Expired is set once
+// the expiry has been processed, the grant ends at ExpiresAt regardless.
+type RoleElevation struct {
+	AutoTimeModel
+
+	ID                 uuid.UUID              `gorm:"type:uuid;primaryKey"`
+	UserID             string                 `gorm:"type:varchar(255);not null"`
+	KeyConfigurationID uuid.UUID              `gorm:"type:uuid;not null"`
+	Role               constants.BusinessRole `gorm:"type:varchar(50);not null"`
+	WorkflowID         uuid.UUID              `gorm:"type:uuid;not null"`
+	ExpiresAt          time.Time              `gorm:"not null"`
+	Expired            bool                   `gorm:"not null;default:false"`
+}
+
+// TableResourceType return the authz resource type
+func (m RoleElevation) TableResourceType() authz.RepoResourceType {
+	return authz.RepoResourceTypeRoleElevation
+}
+
+// TableName returns the table name for RoleElevation
+func (m RoleElevation) TableName() string {
+	return string(m.TableResourceType())
+}
+
+func (RoleElevation) IsSharedModel() bool {
+	return false
+}
+
+func (m RoleElevation) CheckAuthz(ctx context.Context,
+	authzHandler *authz.Handler[authz.RepoResourceType, authz.RepoAction],
+	action authz.RepoAction,
+) (bool, error) {
+	return authz.CheckAuthz(ctx, authzHandler, m.TableResourceType(), action)
+}
+
+// IsActive returns true if the elevation grants the role at the given time
+func (m RoleElevation) IsActive(now time.Time) bool {
+	return !m.Expired && now.Before(m.ExpiresAt)
+}
@@ -0,0 +1,56 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/constants"
+	"github.com/openkcm/cmk/internal/model"
+)
+
+func TestRoleElevationTable(t *testing.T) {
+	t.Run("Should have table name role_elevations", func(t *testing.T) {
+		assert.Equal(t, "role_elevations", model.RoleElevation{}.TableName())
+	})
+
+	t.Run("Should be tenant table", func(t *testing.T) {
+		assert.False(t, model.RoleElevation{}.IsSharedModel())
+	})
+}
+
+func TestRoleElevationIsActive(t *testing.T) {
+	now := time.Now()
+
+	assert.True(t, model.RoleElevation{ExpiresAt: now.Add(time.Hour)}.IsActive(now))
+	assert.False(t, model.RoleElevation{ExpiresAt: now.Add(-time.Hour)}.IsActive(now))
+	assert.False(t, model.RoleElevation{ExpiresAt: now.Add(time.Hour), Expired: true}.IsActive(now))
+}
+
+func TestParseRoleElevationParameters(t *testing.T) {
+	t.Run("Should parse parameters", func(t *testing.T) {
+		params, err := model.ParseRoleElevationParameters(`{"role":"KEY_ADMINISTRATOR","durationHours":4}`)
+		assert.NoError(t, err)
+		assert.Equal(t, constants.KeyAdminRole, params.Role)
+		assert.Equal(t, 4, params.DurationHours)
+	})
+
+	tests := []struct {
+		name       string
+		parameters string
+	}{
+		{name: "invalid json", parameters: "KEY_ADMINISTRATOR"},
+		{name: "missing role", parameters: `{"durationHours":4}`},
+		{name: "zero duration", parameters: `{"role":"KEY_ADMINISTRATOR","durationHours":0}`},
+		{name: "duration above maximum", parameters: `{"role":"KEY_ADMINISTRATOR","durationHours":25}`},
+	}
+
+	for _, tt := range tests {
+		t.Run("Should fail on "+tt.name, func(t *testing.T) {
+			_, err := model.ParseRoleElevationParameters(tt.parameters)
+			assert.ErrorIs(t, err, model.ErrInvalidRoleElevationParameters)
+			assert.ErrorIs(t, err, model.ErrValidation)
+		})
+	}
+}
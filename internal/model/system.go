@@ -119,6 +119,12 @@ func (m *System) UpdateSystemProperties(
 	return updated, nil
 }
 
+// IsConnected returns true if the system is connected to its key configuration.
+// Drifted systems are still connected, only their reported key state differs.
+func (m System) IsConnected() bool {
+	return m.Status == cmkapi.SystemStatusCONNECTED || m.Status == cmkapi.SystemStatusDRIFTED
+}
+
 // AfterSave is ran before any creating/updating the system
 // but before finishing the transaction
 // If this step fails the transaction should be aborted
@@ -0,0 +1,157 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openkcm/cmk/internal/authz"
+)
+
+var ErrInvalidSystemGroupName = fmt.Errorf("%w: invalid system group name", ErrValidation)
+
+// SystemGroup is a named set of systems, also known as a landscape.
+// Membership is either derived from Selector or explicitly maintained
+// in the system_group_systems table.
+//
+//nolint:recvcheck
+type SystemGroup struct {
+	AutoTimeModel
+
+	ID          uuid.UUID       `gorm:"type:uuid;primaryKey"`
+	Name        string          `gorm:"type:varchar(64);not null;unique"`
+	Description string          `gorm:"type:text"`
+	Selector    json.RawMessage `gorm:"type:jsonb"`
+
+	Status SystemGroupStatus `gorm:"-:all"`
+}
+
+// SystemSelector matches systems by type, region and properties.
+// Empty fields are not used for matching.
+type SystemSelector struct {
+	Type       SystemType        `json:"type,omitempty"`
+	Region     string            `json:"region,omitempty"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// IsEmpty returns true if the selector would match every system
+func (s SystemSelector) IsEmpty() bool {
+	return s.Type == "" && s.Region == "" && len(s.Properties) == 0
+}
+
+// SystemGroupStatus holds the number of group members in each system status
+type SystemGroupStatus struct {
+	Total        int
+	Connected    int
+	Disconnected int
+	Processing   int
+	Failed       int
+	Drifted      int
+}
+
+// HasSelector returns true if the group membership is selector based
+func (m SystemGroup) HasSelector() bool {
+	return len(m.Selector) > 0 && string(m.Selector) != "null"
+}
+
+// GetSelector returns the decoded selector
+// or nil if the group has explicit membership
+func (m SystemGroup) GetSelector() (*SystemSelector, error) {
+	if !m.HasSelector() {
+		return nil, nil //nolint:nilnil // nil selector means explicit membership
+	}
+
+	var selector SystemSelector
+
+	err := json.Unmarshal(m.Selector, &selector)
+	if err != nil {
+		return nil, err
+	}
+
+	return &selector, nil
+}
+
+// SetSelector encodes the selector into the group
+// A nil selector clears it
+func (m *SystemGroup) SetSelector(selector *SystemSelector) error {
+	if selector == nil {
+		m.Selector = nil
+		return nil
+	}
+
+	if selector.Type != "" && !selector.Type.Valid() {
+		return ErrInvalidSystemType
+	}
+
+	bytes, err := json.Marshal(selector)
+	if err != nil {
+		return err
+	}
+
+	m.Selector = bytes
+
+	return nil
+}
+
+// TableResourceType return the authz resource type
+func (m SystemGroup) TableResourceType() authz.RepoResourceType {
+	return authz.RepoResourceTypeSystemGroup
+}
+
+// TableName returns the table name for SystemGroup
+func (m SystemGroup) TableName() string {
+	return string(m.TableResourceType())
+}
+
+func (SystemGroup) IsSharedModel() bool {
+	return false
+}
+
+func (m SystemGroup) CheckAuthz(ctx context.Context,
+	authzHandler *authz.Handler[authz.RepoResourceType, authz.RepoAction],
+	action authz.RepoAction,
+) (bool, error) {
+	return authz.CheckAuthz(ctx, authzHandler, m.TableResourceType(), action)
+}
+
+// BeforeSave is ran before any creating/updating the system group
+// but before finishing the transaction
+// If this step fails the transaction should be aborted
+func (m *SystemGroup) BeforeSave(_ *gorm.DB) error {
+	textValidator := regexp.MustCompile(ValidTextPattern)
+
+	if !textValidator.MatchString(m.Name) || len(m.Name) > MaxNameLength {
+		return ErrInvalidSystemGroupName
+	}
+
+	return nil
+}
+
+// SystemGroupSystem represents the explicit membership of a system in a system group
+type SystemGroupSystem struct {
+	SystemGroupID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	SystemID      uuid.UUID `gorm:"type:uuid;primaryKey"`
+}
+
+func (m SystemGroupSystem) TableName() string {
+	return "system_group_systems"
+}
+
+func (SystemGroupSystem) IsSharedModel() bool {
+	return false
+}
+
+func (m SystemGroupSystem) TableResourceType() authz.RepoResourceType {
+	return authz.RepoResourceTypeSystemGroup
+}
+
+func (m SystemGroupSystem) CheckAuthz(ctx context.Context,
+	authzHandler *authz.Handler[authz.RepoResourceType, authz.RepoAction],
+	action authz.RepoAction,
+) (bool, error) {
+	return authz.CheckAuthz(ctx, authzHandler, m.TableResourceType(), action)
+}
@@ -0,0 +1,100 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/model"
+	"github.com/openkcm/cmk/internal/repo/sql"
+	"github.com/openkcm/cmk/internal/testutils"
+)
+
+func TestSystemGroupTable(t *testing.T) {
+	t.Run("Should have table name system_groups", func(t *testing.T) {
+		assert.Equal(t, "system_groups", model.SystemGroup{}.TableName())
+	})
+
+	t.Run("Should have table name system_group_systems", func(t *testing.T) {
+		assert.Equal(t, "system_group_systems", model.SystemGroupSystem{}.TableName())
+	})
+
+	t.Run("Should be tenant tables", func(t *testing.T) {
+		assert.False(t, model.SystemGroup{}.IsSharedModel())
+		assert.False(t, model.SystemGroupSystem{}.IsSharedModel())
+	})
+}
+
+func TestSystemGroupSelector(t *testing.T) {
+	t.Run("Should have no selector by default", func(t *testing.T) {
+		group := model.SystemGroup{}
+
+		selector, err := group.GetSelector()
+		assert.NoError(t, err)
+		assert.Nil(t, selector)
+		assert.False(t, group.HasSelector())
+	})
+
+	t.Run("Should set and get selector", func(t *testing.T) {
+		group := model.SystemGroup{}
+		expected := &model.SystemSelector{
+			Type:       model.SystemTypeSUBACCOUNT,
+			Region:     "eu10",
+			Properties: map[string]string{"landscape": "prod"},
+		}
+
+		err := group.SetSelector(expected)
+		assert.NoError(t, err)
+		assert.True(t, group.HasSelector())
+
+		selector, err := group.GetSelector()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, selector)
+	})
+
+	t.Run("Should clear selector", func(t *testing.T) {
+		group := model.SystemGroup{}
+		assert.NoError(t, group.SetSelector(&model.SystemSelector{Region: "eu10"}))
+
+		assert.NoError(t, group.SetSelector(nil))
+		assert.False(t, group.HasSelector())
+	})
+
+	t.Run("Should fail on invalid type", func(t *testing.T) {
+		group := model.SystemGroup{}
+
+		err := group.SetSelector(&model.SystemSelector{Type: "BOGUS"})
+		assert.ErrorIs(t, err, model.ErrInvalidSystemType)
+	})
+
+	t.Run("Should be empty without criteria", func(t *testing.T) {
+		assert.True(t, model.SystemSelector{}.IsEmpty())
+		assert.False(t, model.SystemSelector{Region: "eu10"}.IsEmpty())
+	})
+}
+
+func TestSystemGroupValidation(t *testing.T) {
+	db, tenants, _ := testutils.NewTestDB(t, testutils.TestDBConfig{})
+	r := sql.NewRepository(db)
+	ctx := testutils.CreateCtxWithTenant(tenants[0])
+
+	tests := []struct {
+		name  string
+		input string
+		err   assert.ErrorAssertionFunc
+	}{
+		{name: "Should have valid characters", input: "Landscape_123-prod", err: assert.NoError},
+		{name: "Should have valid length", input: strings.Repeat("l", 64), err: assert.NoError},
+		{name: "Should have invalid characters", input: "$", err: assert.Error},
+		{name: "Should have invalid length", input: strings.Repeat("l", 65), err: assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := r.Create(ctx, testutils.NewSystemGroup(func(g *model.SystemGroup) {
+				g.Name = tt.input
+			}))
+			tt.err(t, err)
+		})
+	}
+}
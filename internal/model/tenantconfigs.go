@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/google/uuid"
+
 	"github.com/openkcm/cmk/internal/authz"
 )
 
@@ -50,3 +52,60 @@ type WorkflowConfig struct {
 	// MaxExpiryPeriodDays is the maximum settable value for the expiry period
 	MaxExpiryPeriodDays int
 }
+
+// SystemAutoLinkConfig holds the tenant rules used to link newly discovered systems
+type SystemAutoLinkConfig struct {
+	// Rules are evaluated in order and the first matching rule is applied
+	Rules []SystemAutoLinkRule
+}
+
+// Match returns the first rule matching the system or nil if no rule matches
+func (c SystemAutoLinkConfig) Match(system *System) *SystemAutoLinkRule {
+	for i := range c.Rules {
+		if c.Rules[i].Matches(system) {
+			return &c.Rules[i]
+		}
+	}
+
+	return nil
+}
+
+type SystemAutoLinkRule struct {
+	// Name is an optional label of the rule
+	Name string
+
+	// Type matches the system type. Empty matches any type
+	Type SystemType
+
+	// Region matches the system region. Empty matches any region
+	Region string
+
+	// Properties must all be present on the system with the same value
+	Properties map[string]string
+
+	// KeyConfigurationID is the key configuration matching systems are linked to
+	KeyConfigurationID uuid.UUID
+}
+
+// Matches reports whether the system satisfies all criteria of the rule
+func (r SystemAutoLinkRule) Matches(system *System) bool {
+	if system == nil {
+		return false
+	}
+
+	if r.Type != "" && r.Type != system.Type {
+		return false
+	}
+
+	if r.Region != "" && r.Region != system.Region {
+		return false
+	}
+
+	for k, v := range r.Properties {
+		if system.Properties[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
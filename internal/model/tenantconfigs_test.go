@@ -0,0 +1,98 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/model"
+)
+
+func TestTenantConfigTable(t *testing.T) {
+	t.Run("Should have table name tenant_configs", func(t *testing.T) {
+		assert.Equal(t, "tenant_configs", model.TenantConfig{}.TableName())
+	})
+
+	t.Run("Should be a tenant table", func(t *testing.T) {
+		assert.False(t, model.TenantConfig{}.IsSharedModel())
+	})
+}
+
+func TestSystemAutoLinkRuleMatches(t *testing.T) {
+	system := &model.System{
+		Type:   model.SystemTypeSUBACCOUNT,
+		Region: "eu10",
+		Properties: map[string]string{
+			"landscape": "prod",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		rule     model.SystemAutoLinkRule
+		expected bool
+	}{
+		{
+			name:     "Empty rule matches any system",
+			rule:     model.SystemAutoLinkRule{},
+			expected: true,
+		},
+		{
+			name: "All criteria match",
+			rule: model.SystemAutoLinkRule{
+				Type:       model.SystemTypeSUBACCOUNT,
+				Region:     "eu10",
+				Properties: map[string]string{"landscape": "prod"},
+			},
+			expected: true,
+		},
+		{
+			name:     "Type mismatch",
+			rule:     model.SystemAutoLinkRule{Type: model.SystemTypeSYSTEM},
+			expected: false,
+		},
+		{
+			name:     "Region mismatch",
+			rule:     model.SystemAutoLinkRule{Region: "us10"},
+			expected: false,
+		},
+		{
+			name:     "Property value mismatch",
+			rule:     model.SystemAutoLinkRule{Properties: map[string]string{"landscape": "dev"}},
+			expected: false,
+		},
+		{
+			name:     "Missing property",
+			rule:     model.SystemAutoLinkRule{Properties: map[string]string{"owner": "team"}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.rule.Matches(system))
+		})
+	}
+}
+
+func TestSystemAutoLinkConfigMatch(t *testing.T) {
+	first := uuid.New()
+	second := uuid.New()
+	cfg := model.SystemAutoLinkConfig{
+		Rules: []model.SystemAutoLinkRule{
+			{Region: "us10", KeyConfigurationID: first},
+			{Type: model.SystemTypeSYSTEM, KeyConfigurationID: second},
+		},
+	}
+
+	t.Run("Should return first matching rule", func(t *testing.T) {
+		rule := cfg.Match(&model.System{Type: model.SystemTypeSYSTEM, Region: "us10"})
+		assert.NotNil(t, rule)
+		assert.Equal(t, first, rule.KeyConfigurationID)
+	})
+
+	t.Run("Should return nil when no rule matches", func(t *testing.T) {
+		assert.Nil(t, cfg.Match(&model.System{Type: model.SystemTypeSUBACCOUNT, Region: "eu10"}))
+	})
+}
@@ -297,6 +297,7 @@ const (
 	WorkflowActionTypeUnlink        WorkflowActionType = "UNLINK"
 	WorkflowActionTypeSwitch        WorkflowActionType = "SWITCH"
 	WorkflowActionTypeDelete        WorkflowActionType = "DELETE"
+	WorkflowActionTypeElevate       WorkflowActionType = "ELEVATE"
 
 	WorkflowParametersResourceTypeKey              WorkflowParametersResourceType = "KEY"
 	WorkflowParametersResourceTypeKeyConfiguration WorkflowParametersResourceType = "KEY_CONFIGURATION"
@@ -352,7 +353,8 @@ func (t *WorkflowArtifactType) Scan(src any) error {
 func (t WorkflowActionType) Valid() bool {
 	switch t {
 	case WorkflowActionTypeUpdateState, WorkflowActionTypeUpdatePrimary,
-		WorkflowActionTypeLink, WorkflowActionTypeUnlink, WorkflowActionTypeSwitch, WorkflowActionTypeDelete:
+		WorkflowActionTypeLink, WorkflowActionTypeUnlink, WorkflowActionTypeSwitch, WorkflowActionTypeDelete,
+		WorkflowActionTypeElevate:
 		return true
 	}
 	return false
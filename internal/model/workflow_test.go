@@ -286,6 +286,7 @@ func TestWorkflowArtifactType_Scan(t *testing.T) {
 
 func TestWorkflowActionType_Valid(t *testing.T) {
 	assert.True(t, model.WorkflowActionTypeDelete.Valid())
+	assert.True(t, model.WorkflowActionTypeElevate.Valid())
 	assert.False(t, model.WorkflowActionType("").Valid())
 	assert.False(t, model.WorkflowActionType("BOGUS").Valid())
 }
@@ -114,9 +114,9 @@ func createManagers(
 	f, err := clients.NewFactory(config.Services{})
 	assert.NoError(t, err)
 
-	cm := manager.NewCertificateManager(ctx, authzRepo, svcRegistry, cfg)
+	cm := manager.NewCertificateManager(ctx, authzRepo, svcRegistry, cfg, cmkAuditor)
 	um := manager.NewUserManager(authzRepo, cmkAuditor)
-	tagm := manager.NewTagManager(r)
+	tagm := manager.NewTagManager(r, cmkAuditor)
 	kcm := manager.NewKeyConfigManager(authzRepo, cm, um, tagm, cmkAuditor, nil, cfg)
 
 	sys := manager.NewSystemManager(
@@ -133,7 +133,7 @@ func createManagers(
 	km := manager.NewKeyManager(
 		authzRepo,
 		svcRegistry,
-		manager.NewTenantConfigManager(r, svcRegistry, nil, nil),
+		manager.NewTenantConfigManager(r, svcRegistry, nil, nil, cmkAuditor),
 		kcm,
 		um,
 		cm,
@@ -146,7 +146,7 @@ func createManagers(
 	assert.NoError(t, err)
 
 	return manager.NewTenantManager(authzRepo, sys, km, um, cmkAuditor, migrator),
-		manager.NewGroupManager(authzRepo, svcRegistry, um),
+		manager.NewGroupManager(authzRepo, svcRegistry, um, cmkAuditor),
 		authzRepo
 }
 
@@ -3,6 +3,8 @@ package cmkpluginregistry
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/metric"
+
 	plugincatalog "github.com/openkcm/plugin-sdk/pkg/catalog"
 
 	"github.com/openkcm/cmk/internal/config"
@@ -15,12 +17,21 @@ const (
 	DefaultPluginWatchInterval = defaultPluginWatchInterval
 )
 
+func NewPluginWatcherWithMeter(catalog pluginLister, meter metric.Meter) *PluginWatcher {
+	return newPluginWatcher(catalog, defaultPluginWatchInterval, NewHealthTracker(meter))
+}
+
 func (w *PluginWatcher) SetShutdown(fn func(err error)) {
 	w.shutdown = fn
 }
 
 func (w *PluginWatcher) FailureCounts() map[string]int {
-	return w.failureCounts
+	counts := make(map[string]int)
+	for _, plugin := range w.health.Plugins() {
+		counts[plugin.Type+"/"+plugin.Name] = plugin.ConsecutiveFailures
+	}
+
+	return counts
 }
 
 func (w *PluginWatcher) Check(ctx context.Context) {
@@ -37,3 +48,10 @@ func MergePluginConfigsWithCMKConfigs(
 ) ([]plugincatalog.PluginConfig, error) {
 	return mergePluginConfigsWithCMKConfigs(plugins, overrides)
 }
+
+func DeclareCapabilities(
+	repo keyManagementRepository,
+	cfg map[string]config.KeystoreCapabilities,
+) error {
+	return declareCapabilities(repo, cfg)
+}
@@ -0,0 +1,302 @@
+package identitycache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/cmk/internal/async"
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/log"
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/identitymanagement"
+)
+
+const (
+	kindUser       = "user"
+	kindGroup      = "group"
+	kindGroups     = "groups"
+	kindGroupUsers = "groupUsers"
+	kindUserGroups = "userGroups"
+
+	// scopeLength is the number of bytes of the auth context hash in keys
+	scopeLength = 16
+)
+
+var ErrUnsupportedRedisClient = errors.New("redis client of the identity cache is not supported")
+
+// Cache caches the lookups of an identity management service. Lookups are
+// cached per auth context, so tenants with different identity providers do
+// not share entries. Users and groups not found are cached as well. Store
+// failures are logged and the lookup is passed to the service.
+type Cache struct {
+	identitymanagement.IdentityManagement
+
+	store  Store
+	ttl    config.IdentityCacheTTL
+	prefix string
+}
+
+var (
+	_ identitymanagement.IdentityManagement = (*Cache)(nil)
+	_ identitymanagement.Invalidator        = (*Cache)(nil)
+)
+
+// entry is the encoded form of a lookup
+type entry[T any] struct {
+	Value    T      `json:"value"`
+	NotFound bool   `json:"notFound,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// NewStore returns the store of the configured backend, or nil if caching
+// is disabled
+func NewStore(cfg config.IdentityCache) (Store, error) {
+	switch cfg.Backend {
+	case config.IdentityCacheBackendNone:
+		return nil, nil //nolint:nilnil
+	case config.IdentityCacheBackendRedis:
+		opts, err := async.RedisClientOpt(cfg.Redis)
+		if err != nil {
+			return nil, err
+		}
+
+		client, ok := opts.MakeRedisClient().(redis.UniversalClient)
+		if !ok {
+			return nil, ErrUnsupportedRedisClient
+		}
+
+		return NewRedisStore(client), nil
+	default:
+		return NewMemoryStore(), nil
+	}
+}
+
+func New(idm identitymanagement.IdentityManagement, store Store, cfg config.IdentityCache) *Cache {
+	return &Cache{
+		IdentityManagement: idm,
+		store:              store,
+		ttl:                cfg.TTL,
+		prefix:             cfg.KeyPrefix,
+	}
+}
+
+func (c *Cache) GetUser(
+	ctx context.Context,
+	req *identitymanagement.GetUserRequest,
+) (*identitymanagement.GetUserResponse, error) {
+	user, err := lookup(ctx, c, c.key(req.AuthContext, kindUser, req.UserID), c.ttl.Users,
+		func() (identitymanagement.User, error) {
+			resp, err := c.IdentityManagement.GetUser(ctx, req)
+			if err != nil {
+				return identitymanagement.User{}, err
+			}
+
+			return resp.User, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &identitymanagement.GetUserResponse{User: user}, nil
+}
+
+func (c *Cache) GetGroup(
+	ctx context.Context,
+	req *identitymanagement.GetGroupRequest,
+) (*identitymanagement.GetGroupResponse, error) {
+	group, err := lookup(ctx, c, c.key(req.AuthContext, kindGroup, req.GroupName), c.ttl.Groups,
+		func() (identitymanagement.Group, error) {
+			resp, err := c.IdentityManagement.GetGroup(ctx, req)
+			if err != nil {
+				return identitymanagement.Group{}, err
+			}
+
+			return resp.Group, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &identitymanagement.GetGroupResponse{Group: group}, nil
+}
+
+func (c *Cache) ListGroups(
+	ctx context.Context,
+	req *identitymanagement.ListGroupsRequest,
+) (*identitymanagement.ListGroupsResponse, error) {
+	groups, err := lookup(ctx, c, c.key(req.AuthContext, kindGroups, ""), c.ttl.Groups,
+		func() ([]identitymanagement.Group, error) {
+			resp, err := c.IdentityManagement.ListGroups(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return resp.Groups, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &identitymanagement.ListGroupsResponse{Groups: groups}, nil
+}
+
+func (c *Cache) ListGroupUsers(
+	ctx context.Context,
+	req *identitymanagement.ListGroupUsersRequest,
+) (*identitymanagement.ListGroupUsersResponse, error) {
+	users, err := lookup(ctx, c, c.key(req.AuthContext, kindGroupUsers, req.GroupID), c.ttl.Memberships,
+		func() ([]identitymanagement.User, error) {
+			resp, err := c.IdentityManagement.ListGroupUsers(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return resp.Users, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &identitymanagement.ListGroupUsersResponse{Users: users}, nil
+}
+
+func (c *Cache) ListUserGroups(
+	ctx context.Context,
+	req *identitymanagement.ListUserGroupsRequest,
+) (*identitymanagement.ListUserGroupsResponse, error) {
+	groups, err := lookup(ctx, c, c.key(req.AuthContext, kindUserGroups, req.UserID), c.ttl.Memberships,
+		func() ([]identitymanagement.Group, error) {
+			resp, err := c.IdentityManagement.ListUserGroups(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return resp.Groups, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &identitymanagement.ListUserGroupsResponse{Groups: groups}, nil
+}
+
+func (c *Cache) InvalidateUser(ctx context.Context, authCtx identitymanagement.AuthContext, userID string) error {
+	return c.store.Delete(ctx, c.key(authCtx, kindUser, userID), c.key(authCtx, kindUserGroups, userID))
+}
+
+// InvalidateGroup drops the group, the group list and the users of the
+// group if the ID of the group is cached. The groups of the members expire
+// with their TTL.
+func (c *Cache) InvalidateGroup(
+	ctx context.Context,
+	authCtx identitymanagement.AuthContext,
+	groupName string,
+) error {
+	groupKey := c.key(authCtx, kindGroup, groupName)
+	keys := []string{groupKey, c.key(authCtx, kindGroups, "")}
+
+	var cached entry[identitymanagement.Group]
+	if c.get(ctx, groupKey, &cached) && !cached.NotFound {
+		keys = append(keys, c.key(authCtx, kindGroupUsers, cached.Value.ID))
+	}
+
+	return c.store.Delete(ctx, keys...)
+}
+
+func (c *Cache) InvalidateAll(ctx context.Context, authCtx identitymanagement.AuthContext) error {
+	return c.store.DeletePrefix(ctx, c.scopePrefix(authCtx))
+}
+
+// lookup returns the cached value of the key, or loads and caches it
+func lookup[T any](ctx context.Context, c *Cache, key string, ttl time.Duration, load func() (T, error)) (T, error) {
+	var cached entry[T]
+	if c.get(ctx, key, &cached) {
+		if cached.NotFound {
+			var zero T
+			return zero, status.Error(codes.NotFound, cached.Message)
+		}
+
+		return cached.Value, nil
+	}
+
+	value, err := load()
+
+	switch {
+	case status.Code(err) == codes.NotFound:
+		c.set(ctx, key, entry[T]{NotFound: true, Message: status.Convert(err).Message()}, c.ttl.NotFound)
+	case err == nil:
+		c.set(ctx, key, entry[T]{Value: value}, ttl)
+	}
+
+	return value, err
+}
+
+func (c *Cache) get(ctx context.Context, key string, v any) bool {
+	data, ok, err := c.store.Get(ctx, key)
+	if err != nil {
+		log.Warn(ctx, "Failed to read identity cache", log.ErrorAttr(err))
+		return false
+	}
+
+	if !ok {
+		return false
+	}
+
+	err = json.Unmarshal(data, v)
+	if err != nil {
+		log.Warn(ctx, "Failed to decode identity cache entry", log.ErrorAttr(err))
+		return false
+	}
+
+	return true
+}
+
+func (c *Cache) set(ctx context.Context, key string, v any, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	data, err := json.Marshal(v)
+	if err == nil {
+		err = c.store.Set(ctx, key, data, ttl)
+	}
+
+	if err != nil {
+		log.Warn(ctx, "Failed to write identity cache", log.ErrorAttr(err))
+	}
+}
+
+func (c *Cache) key(authCtx identitymanagement.AuthContext, kind, id string) string {
+	return c.scopePrefix(authCtx) + kind + ":" + id
+}
+
+// scopePrefix returns the prefix of the keys of the auth context, a hash of
+// its sorted data
+func (c *Cache) scopePrefix(authCtx identitymanagement.AuthContext) string {
+	h := sha256.New()
+	for _, k := range slices.Sorted(maps.Keys(authCtx.Data)) {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write([]byte(authCtx.Data[k]))
+		h.Write([]byte{0})
+	}
+
+	var b strings.Builder
+
+	b.WriteString(c.prefix)
+	b.WriteString(":")
+	b.WriteString(hex.EncodeToString(h.Sum(nil)[:scopeLength]))
+	b.WriteString(":")
+
+	return b.String()
+}
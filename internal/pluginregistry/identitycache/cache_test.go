@@ -0,0 +1,275 @@
+package identitycache_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openkcm/plugin-sdk/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/pluginregistry/identitycache"
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/identitymanagement"
+)
+
+var (
+	alice  = identitymanagement.User{ID: "alice", Name: "Alice", Email: "alice@example.org"}
+	admins = identitymanagement.Group{ID: "group-admins", Name: "admins"}
+
+	tenantA = identitymanagement.AuthContext{Data: map[string]string{"issuer": "https://a.example.org"}}
+	tenantB = identitymanagement.AuthContext{Data: map[string]string{"issuer": "https://b.example.org"}}
+)
+
+// countingIDM is an identity management service counting the calls per method
+type countingIDM struct {
+	mu    sync.Mutex
+	calls map[string]int
+	users map[string]identitymanagement.User
+}
+
+func newCountingIDM() *countingIDM {
+	return &countingIDM{
+		calls: map[string]int{},
+		users: map[string]identitymanagement.User{alice.ID: alice},
+	}
+}
+
+func (c *countingIDM) count(method string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls[method]++
+}
+
+func (c *countingIDM) callsOf(method string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.calls[method]
+}
+
+func (c *countingIDM) ServiceInfo() api.Info {
+	return nil
+}
+
+func (c *countingIDM) GetUser(
+	_ context.Context,
+	req *identitymanagement.GetUserRequest,
+) (*identitymanagement.GetUserResponse, error) {
+	c.count("GetUser")
+
+	c.mu.Lock()
+	u, ok := c.users[req.UserID]
+	c.mu.Unlock()
+
+	if !ok {
+		return nil, status.Error(codes.NotFound, "user does not exist")
+	}
+
+	return &identitymanagement.GetUserResponse{User: u}, nil
+}
+
+func (c *countingIDM) GetGroup(
+	_ context.Context,
+	req *identitymanagement.GetGroupRequest,
+) (*identitymanagement.GetGroupResponse, error) {
+	c.count("GetGroup")
+
+	if req.GroupName != admins.Name {
+		return nil, status.Error(codes.NotFound, "group does not exist")
+	}
+
+	return &identitymanagement.GetGroupResponse{Group: admins}, nil
+}
+
+func (c *countingIDM) ListGroups(
+	context.Context,
+	*identitymanagement.ListGroupsRequest,
+) (*identitymanagement.ListGroupsResponse, error) {
+	c.count("ListGroups")
+	return &identitymanagement.ListGroupsResponse{Groups: []identitymanagement.Group{admins}}, nil
+}
+
+func (c *countingIDM) ListGroupUsers(
+	context.Context,
+	*identitymanagement.ListGroupUsersRequest,
+) (*identitymanagement.ListGroupUsersResponse, error) {
+	c.count("ListGroupUsers")
+	return &identitymanagement.ListGroupUsersResponse{Users: []identitymanagement.User{alice}}, nil
+}
+
+func (c *countingIDM) ListUserGroups(
+	context.Context,
+	*identitymanagement.ListUserGroupsRequest,
+) (*identitymanagement.ListUserGroupsResponse, error) {
+	c.count("ListUserGroups")
+	return nil, status.Error(codes.Unavailable, "identity provider unavailable")
+}
+
+func newCache(t *testing.T, ttl config.IdentityCacheTTL) (*identitycache.Cache, *countingIDM) {
+	t.Helper()
+
+	store := identitycache.NewMemoryStore()
+	t.Cleanup(func() { _ = store.Close() })
+
+	idm := newCountingIDM()
+
+	return identitycache.New(idm, store, config.IdentityCache{TTL: ttl, KeyPrefix: "cmk:identity"}), idm
+}
+
+func defaultTTL() config.IdentityCacheTTL {
+	return config.IdentityCacheTTL{
+		Users:       time.Hour,
+		Groups:      time.Hour,
+		Memberships: time.Hour,
+		NotFound:    time.Hour,
+	}
+}
+
+func TestCacheLookups(t *testing.T) {
+	c, idm := newCache(t, defaultTTL())
+
+	t.Run("Should cache users per auth context", func(t *testing.T) {
+		for range 3 {
+			resp, err := c.GetUser(t.Context(), &identitymanagement.GetUserRequest{
+				UserID: alice.ID, AuthContext: tenantA,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, alice, resp.User)
+		}
+
+		assert.Equal(t, 1, idm.callsOf("GetUser"))
+
+		_, err := c.GetUser(t.Context(), &identitymanagement.GetUserRequest{UserID: alice.ID, AuthContext: tenantB})
+		require.NoError(t, err)
+		assert.Equal(t, 2, idm.callsOf("GetUser"))
+	})
+
+	t.Run("Should cache groups and memberships", func(t *testing.T) {
+		for range 3 {
+			group, err := c.GetGroup(t.Context(), &identitymanagement.GetGroupRequest{
+				GroupName: admins.Name, AuthContext: tenantA,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, admins, group.Group)
+
+			users, err := c.ListGroupUsers(t.Context(), &identitymanagement.ListGroupUsersRequest{
+				GroupID: group.Group.ID, AuthContext: tenantA,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, []identitymanagement.User{alice}, users.Users)
+
+			groups, err := c.ListGroups(t.Context(), &identitymanagement.ListGroupsRequest{AuthContext: tenantA})
+			require.NoError(t, err)
+			assert.Equal(t, []identitymanagement.Group{admins}, groups.Groups)
+		}
+
+		assert.Equal(t, 1, idm.callsOf("GetGroup"))
+		assert.Equal(t, 1, idm.callsOf("ListGroupUsers"))
+		assert.Equal(t, 1, idm.callsOf("ListGroups"))
+	})
+
+	t.Run("Should cache users and groups not found", func(t *testing.T) {
+		for range 3 {
+			_, err := c.GetUser(t.Context(), &identitymanagement.GetUserRequest{UserID: "mallory", AuthContext: tenantA})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+
+			_, err = c.GetGroup(t.Context(), &identitymanagement.GetGroupRequest{
+				GroupName: "operators", AuthContext: tenantA,
+			})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		}
+
+		assert.Equal(t, 3, idm.callsOf("GetUser"))
+		assert.Equal(t, 2, idm.callsOf("GetGroup"))
+	})
+
+	t.Run("Should not cache failures", func(t *testing.T) {
+		for range 2 {
+			_, err := c.ListUserGroups(t.Context(), &identitymanagement.ListUserGroupsRequest{
+				UserID: alice.ID, AuthContext: tenantA,
+			})
+			assert.Equal(t, codes.Unavailable, status.Code(err))
+		}
+
+		assert.Equal(t, 2, idm.callsOf("ListUserGroups"))
+	})
+}
+
+func TestCacheTTL(t *testing.T) {
+	c, idm := newCache(t, config.IdentityCacheTTL{
+		Users:    50 * time.Millisecond,
+		NotFound: 0,
+	})
+
+	lookup := func(userID string) {
+		_, _ = c.GetUser(t.Context(), &identitymanagement.GetUserRequest{UserID: userID, AuthContext: tenantA})
+	}
+
+	lookup(alice.ID)
+	lookup(alice.ID)
+	assert.Equal(t, 1, idm.callsOf("GetUser"))
+
+	time.Sleep(100 * time.Millisecond)
+
+	lookup(alice.ID)
+	assert.Equal(t, 2, idm.callsOf("GetUser"))
+
+	lookup("mallory")
+	lookup("mallory")
+	assert.Equal(t, 4, idm.callsOf("GetUser"), "zero ttl should disable caching")
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	c, idm := newCache(t, defaultTTL())
+
+	getUser := func(authCtx identitymanagement.AuthContext) {
+		_, err := c.GetUser(t.Context(), &identitymanagement.GetUserRequest{UserID: alice.ID, AuthContext: authCtx})
+		require.NoError(t, err)
+	}
+
+	getGroupUsers := func() {
+		group, err := c.GetGroup(t.Context(), &identitymanagement.GetGroupRequest{
+			GroupName: admins.Name, AuthContext: tenantA,
+		})
+		require.NoError(t, err)
+
+		_, err = c.ListGroupUsers(t.Context(), &identitymanagement.ListGroupUsersRequest{
+			GroupID: group.Group.ID, AuthContext: tenantA,
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("Should invalidate user", func(t *testing.T) {
+		getUser(tenantA)
+		require.NoError(t, c.InvalidateUser(t.Context(), tenantA, alice.ID))
+		getUser(tenantA)
+
+		assert.Equal(t, 2, idm.callsOf("GetUser"))
+	})
+
+	t.Run("Should invalidate group and its users", func(t *testing.T) {
+		getGroupUsers()
+		require.NoError(t, c.InvalidateGroup(t.Context(), tenantA, admins.Name))
+		getGroupUsers()
+
+		assert.Equal(t, 2, idm.callsOf("GetGroup"))
+		assert.Equal(t, 2, idm.callsOf("ListGroupUsers"))
+	})
+
+	t.Run("Should invalidate only the auth context", func(t *testing.T) {
+		getUser(tenantA)
+		getUser(tenantB)
+		require.NoError(t, c.InvalidateAll(t.Context(), tenantB))
+		getUser(tenantA)
+		getUser(tenantB)
+
+		// One lookup of tenant B before and one after the invalidation
+		assert.Equal(t, 4, idm.callsOf("GetUser"))
+	})
+}
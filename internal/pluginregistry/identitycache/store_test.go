@@ -0,0 +1,67 @@
+package identitycache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/pluginregistry/identitycache"
+	"github.com/openkcm/cmk/internal/testutils"
+)
+
+func TestStores(t *testing.T) {
+	cfg := config.Config{}
+	testutils.StartRedis(t, &cfg.Scheduler)
+
+	redisStore, err := identitycache.NewStore(config.IdentityCache{
+		Backend: config.IdentityCacheBackendRedis,
+		Redis:   cfg.Scheduler.TaskQueue,
+	})
+	require.NoError(t, err)
+
+	for name, store := range map[string]identitycache.Store{
+		"memory": identitycache.NewMemoryStore(),
+		"redis":  redisStore,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Cleanup(func() { _ = store.Close() })
+
+			prefix := "test:" + name + ":*:"
+
+			require.NoError(t, store.Set(t.Context(), prefix+"a", []byte("a"), time.Minute))
+			require.NoError(t, store.Set(t.Context(), prefix+"b", []byte("b"), time.Minute))
+			require.NoError(t, store.Set(t.Context(), "test:"+name+":x:other", []byte("c"), time.Minute))
+			require.NoError(t, store.Set(t.Context(), prefix+"expiring", []byte("d"), 50*time.Millisecond))
+
+			value, ok, err := store.Get(t.Context(), prefix+"a")
+			require.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, []byte("a"), value)
+
+			time.Sleep(100 * time.Millisecond)
+
+			_, ok, err = store.Get(t.Context(), prefix+"expiring")
+			require.NoError(t, err)
+			assert.False(t, ok)
+
+			require.NoError(t, store.Delete(t.Context(), prefix+"a"))
+
+			_, ok, err = store.Get(t.Context(), prefix+"a")
+			require.NoError(t, err)
+			assert.False(t, ok)
+
+			require.NoError(t, store.DeletePrefix(t.Context(), prefix))
+
+			_, ok, err = store.Get(t.Context(), prefix+"b")
+			require.NoError(t, err)
+			assert.False(t, ok)
+
+			_, ok, err = store.Get(t.Context(), "test:"+name+":x:other")
+			require.NoError(t, err)
+			assert.True(t, ok, "prefix should be matched literally")
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package cmkpluginregistry
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/keymanagement"
+)
+
+type keyManagementRepository interface {
+	KeyManagements() (map[string]keymanagement.KeyManagement, error)
+	AddKeyManagement(instance keymanagement.KeyManagement)
+}
+
+// declaredKeyManagement is a KeyManagement declaring the capabilities
+// configured for its plugin
+type declaredKeyManagement struct {
+	keymanagement.KeyManagement
+
+	capabilities keymanagement.Capabilities
+}
+
+var _ keymanagement.CapabilityDeclarer = declaredKeyManagement{}
+
+func (d declaredKeyManagement) Capabilities() keymanagement.Capabilities {
+	return d.capabilities
+}
+
+// declareCapabilities declares the configured capabilities for the
+// KeyManagement plugins. Capabilities of plugins not loaded are ignored.
+func declareCapabilities(
+	repo keyManagementRepository,
+	cfg map[string]config.KeystoreCapabilities,
+) error {
+	if len(cfg) == 0 {
+		return nil
+	}
+
+	services, err := repo.KeyManagements()
+	if err != nil {
+		return nil //nolint:nilerr
+	}
+
+	for name, declared := range cfg {
+		service, ok := services[name]
+		if !ok {
+			slog.Warn("Capabilities configured for a keystore plugin not loaded", "plugin", name)
+			continue
+		}
+
+		capabilities, err := parseCapabilities(declared)
+		if err != nil {
+			return fmt.Errorf("invalid capabilities of keystore plugin %s: %w", name, err)
+		}
+
+		capabilities.Version = keymanagement.GetCapabilities(service).Version
+
+		repo.AddKeyManagement(declaredKeyManagement{
+			KeyManagement: service,
+			capabilities:  capabilities,
+		})
+	}
+
+	return nil
+}
+
+func parseCapabilities(declared config.KeystoreCapabilities) (keymanagement.Capabilities, error) {
+	algorithms, err := keymanagement.ParseKeyAlgorithms(declared.Algorithms)
+	if err != nil {
+		return keymanagement.Capabilities{}, err
+	}
+
+	keyTypes, err := keymanagement.ParseKeyTypes(declared.KeyTypes)
+	if err != nil {
+		return keymanagement.Capabilities{}, err
+	}
+
+	return keymanagement.Capabilities{
+		Algorithms: algorithms,
+		KeyTypes:   keyTypes,
+		Import:     declared.Import,
+		Rotation:   declared.Rotation,
+		Regions:    declared.Regions,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package cmkpluginregistry_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/cmk/internal/config"
+	cmkpluginregistry "github.com/openkcm/cmk/internal/pluginregistry"
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/keymanagement"
+	"github.com/openkcm/cmk/internal/pluginregistry/service/wrapper/key_management"
+	"github.com/openkcm/cmk/internal/testutils/testplugins"
+)
+
+func TestDeclareCapabilities(t *testing.T) {
+	t.Run("Should declare the configured capabilities", func(t *testing.T) {
+		repo := &key_management.Repository{}
+		repo.AddKeyManagement(testplugins.NewTestKeyManagement(false, true))
+
+		err := cmkpluginregistry.DeclareCapabilities(repo, map[string]config.KeystoreCapabilities{
+			testplugins.Name: {
+				Algorithms: []string{"AES256"},
+				KeyTypes:   []string{"BYOK"},
+				Rotation:   true,
+				Regions:    []string{"eu10"},
+			},
+		})
+		require.NoError(t, err)
+
+		capabilities := keymanagement.GetCapabilities(repo.Instances[testplugins.Name])
+		assert.Equal(t, keymanagement.Capabilities{
+			Declared:   true,
+			Algorithms: []keymanagement.KeyAlgorithm{keymanagement.AES256},
+			KeyTypes:   []keymanagement.KeyType{keymanagement.BYOK},
+			Rotation:   true,
+			Regions:    []string{"eu10"},
+		}, capabilities)
+	})
+
+	t.Run("Should leave plugins without capabilities undeclared", func(t *testing.T) {
+		repo := &key_management.Repository{}
+		repo.AddKeyManagement(testplugins.NewTestKeyManagement(false, true))
+
+		err := cmkpluginregistry.DeclareCapabilities(repo, map[string]config.KeystoreCapabilities{
+			"other": {Rotation: true},
+		})
+		require.NoError(t, err)
+
+		assert.False(t, keymanagement.GetCapabilities(repo.Instances[testplugins.Name]).Declared)
+	})
+
+	t.Run("Should fail on unknown names", func(t *testing.T) {
+		repo := &key_management.Repository{}
+		repo.AddKeyManagement(testplugins.NewTestKeyManagement(false, true))
+
+		err := cmkpluginregistry.DeclareCapabilities(repo, map[string]config.KeystoreCapabilities{
+			testplugins.Name: {KeyTypes: []string{"EXTERNAL"}},
+		})
+		assert.ErrorIs(t, err, keymanagement.ErrUnknownCapability)
+	})
+}
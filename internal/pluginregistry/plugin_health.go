@@ -0,0 +1,209 @@
+package cmkpluginregistry
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	pluginapi "github.com/openkcm/plugin-sdk/api"
+
+	"github.com/openkcm/cmk/internal/errs"
+)
+
+const (
+	pluginMeterName = "github.com/openkcm/cmk/internal/pluginregistry"
+
+	attrPluginType = "plugin.type"
+	attrPluginName = "plugin.name"
+
+	latencyHistogramName = "plugin_health_check_duration"
+	latencyHistogramDesc = "The duration of the plugin health checks in seconds"
+	upGaugeName          = "plugin_health_up"
+	upGaugeDesc          = "Whether the last health check of the plugin succeeded (1) or not (0)"
+	failuresGaugeName    = "plugin_health_consecutive_failures"
+	failuresGaugeDesc    = "The number of consecutive failed health checks of the plugin"
+	lastSuccessGaugeName = "plugin_health_last_success"
+	lastSuccessGaugeDesc = "The unix time of the last successful health check of the plugin"
+	latencyHistogramUnit = "s"
+
+	// serviceTypeSuffix is the suffix of the alias of a plugin type,
+	// like IdentityManagementService of IdentityManagement
+	serviceTypeSuffix = "Service"
+)
+
+var ErrPluginUnhealthy = errors.New("plugin unhealthy")
+
+// defaultHealthTracker is shared by the plugin watcher of the registry and
+// the status server, which starts before the registry is created.
+var defaultHealthTracker = sync.OnceValue(func() *HealthTracker {
+	return NewHealthTracker(otel.Meter(pluginMeterName, metric.WithInstrumentationVersion(otel.Version())))
+})
+
+// Health returns the health state of the plugins watched by the registry
+func Health() *HealthTracker {
+	return defaultHealthTracker()
+}
+
+// PluginHealth is the health state of a plugin
+type PluginHealth struct {
+	Type                string    `json:"type"`
+	Name                string    `json:"name"`
+	Healthy             bool      `json:"healthy"`
+	ConsecutiveFailures int       `json:"consecutiveFailures"`
+	LastCheck           time.Time `json:"lastCheck"`
+	LastSuccess         time.Time `json:"lastSuccess,omitzero"`
+	LastError           string    `json:"lastError,omitempty"`
+}
+
+// HealthTracker keeps the health state of the plugins from the checks of the
+// PluginWatcher and exports it as metrics.
+type HealthTracker struct {
+	mu      sync.RWMutex
+	plugins map[string]*PluginHealth
+	metrics healthMetrics
+}
+
+type healthMetrics struct {
+	latency     metric.Float64Histogram
+	up          metric.Int64Gauge
+	failures    metric.Int64Gauge
+	lastSuccess metric.Int64Gauge
+}
+
+// NewHealthTracker creates a HealthTracker recording metrics with the meter.
+// Metrics are not recorded if the instruments cannot be created.
+func NewHealthTracker(meter metric.Meter) *HealthTracker {
+	metrics, err := newHealthMetrics(meter)
+	if err != nil {
+		metrics, _ = newHealthMetrics(noop.Meter{})
+	}
+
+	return &HealthTracker{
+		plugins: make(map[string]*PluginHealth),
+		metrics: metrics,
+	}
+}
+
+func newHealthMetrics(meter metric.Meter) (healthMetrics, error) {
+	latency, latencyErr := meter.Float64Histogram(latencyHistogramName,
+		metric.WithDescription(latencyHistogramDesc),
+		metric.WithUnit(latencyHistogramUnit),
+	)
+	up, upErr := meter.Int64Gauge(upGaugeName, metric.WithDescription(upGaugeDesc))
+	failures, failuresErr := meter.Int64Gauge(failuresGaugeName, metric.WithDescription(failuresGaugeDesc))
+	lastSuccess, lastSuccessErr := meter.Int64Gauge(lastSuccessGaugeName, metric.WithDescription(lastSuccessGaugeDesc))
+
+	return healthMetrics{
+		latency:     latency,
+		up:          up,
+		failures:    failures,
+		lastSuccess: lastSuccess,
+	}, errors.Join(latencyErr, upErr, failuresErr, lastSuccessErr)
+}
+
+// record updates the health state of the plugin with the result of a check
+// and returns its number of consecutive failures
+func (t *HealthTracker) record(ctx context.Context, info pluginapi.Info, latency time.Duration, err error) int {
+	now := time.Now()
+	key := info.Type() + "/" + info.Name()
+
+	t.mu.Lock()
+
+	plugin, ok := t.plugins[key]
+	if !ok {
+		plugin = &PluginHealth{Type: info.Type(), Name: info.Name()}
+		t.plugins[key] = plugin
+	}
+
+	plugin.LastCheck = now
+	plugin.Healthy = err == nil
+
+	if err != nil {
+		plugin.ConsecutiveFailures++
+		plugin.LastError = err.Error()
+	} else {
+		plugin.ConsecutiveFailures = 0
+		plugin.LastSuccess = now
+		plugin.LastError = ""
+	}
+
+	state := *plugin
+
+	t.mu.Unlock()
+
+	t.metrics.record(ctx, state, latency)
+
+	return state.ConsecutiveFailures
+}
+
+func (m healthMetrics) record(ctx context.Context, state PluginHealth, latency time.Duration) {
+	attrs := metric.WithAttributes(
+		attribute.String(attrPluginType, state.Type),
+		attribute.String(attrPluginName, state.Name),
+	)
+
+	var up int64
+	if state.Healthy {
+		up = 1
+	}
+
+	m.latency.Record(ctx, latency.Seconds(), attrs)
+	m.up.Record(ctx, up, attrs)
+	m.failures.Record(ctx, int64(state.ConsecutiveFailures), attrs)
+
+	if !state.LastSuccess.IsZero() {
+		m.lastSuccess.Record(ctx, state.LastSuccess.Unix(), attrs)
+	}
+}
+
+// Plugins returns the health state of the checked plugins ordered by type
+// and name
+func (t *HealthTracker) Plugins() []PluginHealth {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	plugins := make([]PluginHealth, 0, len(t.plugins))
+	for _, plugin := range t.plugins {
+		plugins = append(plugins, *plugin)
+	}
+
+	slices.SortFunc(plugins, func(a, b PluginHealth) int {
+		return cmp.Or(cmp.Compare(a.Type, b.Type), cmp.Compare(a.Name, b.Name))
+	})
+
+	return plugins
+}
+
+// CheckTypes returns ErrPluginUnhealthy if a plugin of the types failed its
+// last health check. Plugins not checked yet are not considered unhealthy.
+// A type matches its alias with the Service suffix, so IdentityManagement
+// matches plugins of type IdentityManagementService too.
+func (t *HealthTracker) CheckTypes(types ...string) error {
+	baseTypes := make([]string, 0, len(types))
+	for _, typ := range types {
+		baseTypes = append(baseTypes, strings.TrimSuffix(typ, serviceTypeSuffix))
+	}
+
+	var unhealthy []string
+
+	for _, plugin := range t.Plugins() {
+		if !plugin.Healthy && slices.Contains(baseTypes, strings.TrimSuffix(plugin.Type, serviceTypeSuffix)) {
+			unhealthy = append(unhealthy, plugin.Type+"/"+plugin.Name)
+		}
+	}
+
+	if len(unhealthy) > 0 {
+		return errs.Wrapf(ErrPluginUnhealthy, strings.Join(unhealthy, ", "))
+	}
+
+	return nil
+}
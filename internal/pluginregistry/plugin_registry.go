@@ -13,6 +13,7 @@ import (
 	slogctx "github.com/veqryn/slog-context"
 
 	"github.com/openkcm/cmk/internal/config"
+	"github.com/openkcm/cmk/internal/pluginregistry/identitycache"
 	servicewrapper "github.com/openkcm/cmk/internal/pluginregistry/service/wrapper"
 	"github.com/openkcm/cmk/internal/plugins"
 )
@@ -53,6 +54,18 @@ func New(ctx context.Context, cfg *config.Config, opts ...Option) (*Registry, er
 		return nil, fmt.Errorf("error loading plugins: %w", err)
 	}
 
+	err = declareCapabilities(svcRepo, cfg.KeystoreCapabilities)
+	if err != nil {
+		_ = svcRepo.Close()
+		return nil, fmt.Errorf("failed to declare keystore capabilities: %w", err)
+	}
+
+	identityCache, err := cacheIdentityManagement(svcRepo, cfg.IdentityCache)
+	if err != nil {
+		_ = svcRepo.Close()
+		return nil, fmt.Errorf("failed to create identity cache: %w", err)
+	}
+
 	baseConfig := &cfg.BaseConfig
 
 	defaultBuildInfo := "{}"
@@ -76,11 +89,33 @@ func New(ctx context.Context, cfg *config.Config, opts ...Option) (*Registry, er
 	}
 
 	return &Registry{
-		Registry: svcRepo,
-		Catalog:  svcRepo.RawCatalog,
+		Registry:      svcRepo,
+		Catalog:       svcRepo.RawCatalog,
+		identityCache: identityCache,
 	}, nil
 }
 
+// cacheIdentityManagement puts a cache in front of the identity management
+// plugin. It returns the store of the cache, or nil if there is no cache.
+func cacheIdentityManagement(
+	svcRepo *servicewrapper.Repository,
+	cfg config.IdentityCache,
+) (identitycache.Store, error) {
+	idm, err := svcRepo.IdentityManagement()
+	if err != nil {
+		return nil, nil //nolint:nilerr,nilnil
+	}
+
+	store, err := identitycache.NewStore(cfg)
+	if err != nil || store == nil {
+		return nil, err
+	}
+
+	svcRepo.SetIdentityManagement(identitycache.New(idm, store, cfg))
+
+	return store, nil
+}
+
 func WithBuiltInPlugins(plugins []catalog.BuiltInPlugin) Option {
 	return func(buildInPlugins catalog.BuiltInPluginRegistry) {
 		for _, p := range plugins {
@@ -8,6 +8,7 @@ import (
 	"syscall"
 	"time"
 
+	"go.opentelemetry.io/otel/metric/noop"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
@@ -40,26 +41,35 @@ type pluginLister interface {
 }
 
 type PluginWatcher struct {
-	catalog       pluginLister
-	interval      time.Duration
-	shutdown      func(err error)
-	failureCounts map[string]int
+	catalog  pluginLister
+	interval time.Duration
+	shutdown func(err error)
+	health   *HealthTracker
 }
 
 func NewPluginWatcher(catalog pluginLister, interval time.Duration) *PluginWatcher {
+	return newPluginWatcher(catalog, interval, NewHealthTracker(noop.Meter{}))
+}
+
+func newPluginWatcher(catalog pluginLister, interval time.Duration, health *HealthTracker) *PluginWatcher {
 	if interval <= 0 {
 		interval = defaultPluginWatchInterval
 	}
 	return &PluginWatcher{
-		catalog:       catalog,
-		interval:      interval,
-		failureCounts: make(map[string]int),
+		catalog:  catalog,
+		interval: interval,
+		health:   health,
 		shutdown: func(err error) {
 			_ = syscall.Kill(syscall.Getpid(), syscall.SIGTERM)
 		},
 	}
 }
 
+// Health returns the health state of the watched plugins
+func (w *PluginWatcher) Health() *HealthTracker {
+	return w.health
+}
+
 func (w *PluginWatcher) Start(ctx context.Context) {
 	ticker := time.NewTicker(w.interval)
 	defer ticker.Stop()
@@ -83,18 +93,19 @@ func (w *PluginWatcher) check(ctx context.Context) {
 			continue
 		}
 
-		key := info.Type() + "/" + info.Name()
+		start := time.Now()
+		err := w.ping(ctx, plugin)
+		failures := w.health.record(ctx, info, time.Since(start), err)
 
-		if err := w.ping(ctx, plugin); err != nil {
-			w.failureCounts[key]++
+		if err != nil {
 			log.Warn(ctx, "Plugin health check failed",
 				slog.String("plugin", info.Name()),
 				slog.String("type", info.Type()),
-				slog.Int("consecutiveFailures", w.failureCounts[key]),
+				slog.Int("consecutiveFailures", failures),
 				slog.Int("threshold", pluginFailureThreshold),
 				log.ErrorAttr(err),
 			)
-			if w.failureCounts[key] >= pluginFailureThreshold {
+			if failures >= pluginFailureThreshold {
 				log.Error(ctx, "Plugin health check threshold reached, initiating shutdown",
 					err,
 					slog.String("plugin", info.Name()),
@@ -103,8 +114,6 @@ func (w *PluginWatcher) check(ctx context.Context) {
 				w.shutdown(err)
 				return
 			}
-		} else {
-			w.failureCounts[key] = 0
 		}
 	}
 }
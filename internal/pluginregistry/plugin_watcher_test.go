@@ -9,6 +9,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -202,3 +204,118 @@ func TestPluginWatcher_NotServingTriggersShutdown(t *testing.T) {
 
 	assert.Equal(t, 1, *shutdownCount)
 }
+
+func TestPluginWatcher_HealthState(t *testing.T) {
+	plugin := &fakePlugin{info: fakeInfo{name: "kms", typ: "KeyManagement"}, conn: healthyConn()}
+	w, _ := newWatcher(newFakeCatalog(plugin))
+
+	w.Check(t.Context())
+
+	plugins := w.Health().Plugins()
+	require.Len(t, plugins, 1)
+	assert.True(t, plugins[0].Healthy)
+	assert.Equal(t, "kms", plugins[0].Name)
+	assert.Equal(t, "KeyManagement", plugins[0].Type)
+	assert.False(t, plugins[0].LastSuccess.IsZero())
+	lastSuccess := plugins[0].LastSuccess
+
+	plugin.conn = failingConn(errConnectionRefused)
+	w.Check(t.Context())
+
+	plugins = w.Health().Plugins()
+	require.Len(t, plugins, 1)
+	assert.False(t, plugins[0].Healthy)
+	assert.Equal(t, 1, plugins[0].ConsecutiveFailures)
+	assert.Equal(t, lastSuccess, plugins[0].LastSuccess, "last success should be kept")
+	assert.True(t, plugins[0].LastCheck.After(lastSuccess))
+	assert.Contains(t, plugins[0].LastError, errConnectionRefused.Error())
+
+	plugin.conn = healthyConn()
+	w.Check(t.Context())
+
+	plugins = w.Health().Plugins()
+	require.Len(t, plugins, 1)
+	assert.True(t, plugins[0].Healthy)
+	assert.Empty(t, plugins[0].LastError)
+}
+
+func TestHealthTracker_CheckTypes(t *testing.T) {
+	kms := &fakePlugin{info: fakeInfo{name: "kms", typ: "KeyManagement"}, conn: failingConn(errConnectionRefused)}
+	idm := &fakePlugin{info: fakeInfo{name: "idm", typ: "IdentityManagement"}, conn: healthyConn()}
+	w, _ := newWatcher(newFakeCatalog(kms, idm))
+
+	assert.NoError(t, w.Health().CheckTypes("KeyManagement"), "unchecked plugins should not be unhealthy")
+
+	w.Check(t.Context())
+
+	err := w.Health().CheckTypes("KeyManagement", "IdentityManagement")
+	require.ErrorIs(t, err, cmkpluginregistry.ErrPluginUnhealthy)
+	assert.Contains(t, err.Error(), "KeyManagement/kms")
+	assert.NotContains(t, err.Error(), "IdentityManagement/idm")
+
+	assert.NoError(t, w.Health().CheckTypes("IdentityManagement"))
+
+	kms.conn = healthyConn()
+	w.Check(t.Context())
+
+	assert.NoError(t, w.Health().CheckTypes("KeyManagement", "IdentityManagement"))
+}
+
+func TestHealthTracker_CheckTypesWithServiceAlias(t *testing.T) {
+	idm := &fakePlugin{
+		info: fakeInfo{name: "idm", typ: "IdentityManagementService"},
+		conn: failingConn(errConnectionRefused),
+	}
+	w, _ := newWatcher(newFakeCatalog(idm))
+
+	w.Check(t.Context())
+
+	err := w.Health().CheckTypes("IdentityManagement")
+	require.ErrorIs(t, err, cmkpluginregistry.ErrPluginUnhealthy)
+	assert.Contains(t, err.Error(), "IdentityManagementService/idm")
+
+	err = w.Health().CheckTypes("IdentityManagementService")
+	require.ErrorIs(t, err, cmkpluginregistry.ErrPluginUnhealthy)
+
+	assert.NoError(t, w.Health().CheckTypes("KeystoreInstanceKeyOperation"))
+}
+
+func TestPluginWatcher_Metrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+
+	plugin := &fakePlugin{info: fakeInfo{name: "kms", typ: "KeyManagement"}, conn: failingConn(errConnectionRefused)}
+	w := cmkpluginregistry.NewPluginWatcherWithMeter(newFakeCatalog(plugin), meter)
+	w.SetShutdown(func(error) {})
+
+	w.Check(t.Context())
+	w.Check(t.Context())
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	metrics := make(map[string]metricdata.Aggregation)
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m.Data
+	}
+
+	latency, ok := metrics["plugin_health_check_duration"].(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, latency.DataPoints, 1)
+	assert.Equal(t, uint64(2), latency.DataPoints[0].Count)
+
+	pluginType, _ := latency.DataPoints[0].Attributes.Value("plugin.type")
+	assert.Equal(t, "KeyManagement", pluginType.AsString())
+
+	up, ok := metrics["plugin_health_up"].(metricdata.Gauge[int64])
+	require.True(t, ok)
+	assert.Equal(t, int64(0), up.DataPoints[0].Value)
+
+	failures, ok := metrics["plugin_health_consecutive_failures"].(metricdata.Gauge[int64])
+	require.True(t, ok)
+	assert.Equal(t, int64(2), failures.DataPoints[0].Value)
+
+	_, ok = metrics["plugin_health_last_success"]
+	assert.False(t, ok, "last success should not be recorded before a success")
+}
@@ -5,21 +5,29 @@ import (
 
 	plugincatalog "github.com/openkcm/plugin-sdk/pkg/catalog"
 
+	"github.com/openkcm/cmk/internal/pluginregistry/identitycache"
 	serviceapi "github.com/openkcm/cmk/internal/pluginregistry/service/api"
 )
 
 type Registry struct {
 	serviceapi.Registry
 	*plugincatalog.Catalog
+
+	identityCache identitycache.Store
 }
 
 func (p *Registry) Close() error {
+	if p.identityCache != nil {
+		_ = p.identityCache.Close()
+	}
+
 	return p.Registry.Close()
 }
 
 // WatchPlugins starts the plugin health watcher as a background goroutine.
 // On detecting a dead plugin it sends SIGTERM to trigger graceful shutdown.
+// The health state of the plugins is kept in Health.
 func (p *Registry) WatchPlugins(ctx context.Context) {
-	watcher := NewPluginWatcher(p.Catalog, defaultPluginWatchInterval)
+	watcher := newPluginWatcher(p.Catalog, defaultPluginWatchInterval, Health())
 	go watcher.Start(ctx)
 }
@@ -0,0 +1,21 @@
+package serviceapi
+
+import (
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/keymanagement"
+)
+
+// KeyManagementCapabilities returns the capabilities of all available
+// KeyManagement services, keyed like KeyManagements.
+func KeyManagementCapabilities(r Registry) (map[string]keymanagement.Capabilities, error) {
+	services, err := r.KeyManagements()
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities := make(map[string]keymanagement.Capabilities, len(services))
+	for name, service := range services {
+		capabilities[name] = keymanagement.GetCapabilities(service)
+	}
+
+	return capabilities, nil
+}
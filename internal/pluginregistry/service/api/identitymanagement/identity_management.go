@@ -16,6 +16,17 @@ type IdentityManagement interface {
 	ListUserGroups(ctx context.Context, req *ListUserGroupsRequest) (*ListUserGroupsResponse, error)
 }
 
+// Invalidator is implemented by identity management services caching
+// lookups. Invalidated entries are read from the plugin on the next lookup.
+type Invalidator interface {
+	// InvalidateUser drops the user and the groups of the user
+	InvalidateUser(ctx context.Context, authCtx AuthContext, userID string) error
+	// InvalidateGroup drops the group and the users of the group
+	InvalidateGroup(ctx context.Context, authCtx AuthContext, groupName string) error
+	// InvalidateAll drops all lookups made with the auth context
+	InvalidateAll(ctx context.Context, authCtx AuthContext) error
+}
+
 type AuthContext struct {
 	// V1 Fields
 	Data map[string]string
@@ -0,0 +1,121 @@
+package keymanagement
+
+import (
+	"fmt"
+	"slices"
+)
+
+var (
+	keyAlgorithmNames = map[string]KeyAlgorithm{
+		"AES256": AES256,
+	}
+	keyTypeNames = map[string]KeyType{
+		"SYSTEM_MANAGED": SystemManaged,
+		"BYOK":           BYOK,
+		"HYOK":           HYOK,
+	}
+)
+
+// Capabilities are the operations a KeyManagement plugin supports. Only
+// KeyManagement plugins declare capabilities, and they describe the V1
+// plugin interface, the only version the catalog binds.
+//
+// Services not declaring capabilities are assumed to support everything,
+// so plugins configured before the capabilities keep working.
+type Capabilities struct {
+	// Declared is false for services not declaring capabilities
+	Declared bool
+	// Version of the plugin interface bound by the catalog
+	Version uint
+	// Algorithms supported, nil if not restricted
+	Algorithms []KeyAlgorithm
+	// KeyTypes supported, nil if not restricted
+	KeyTypes []KeyType
+	// Import of key material into BYOK keys
+	Import bool
+	// Rotation of the keys by the plugin
+	Rotation bool
+	// Regions served, empty if not restricted
+	Regions []string
+}
+
+// CapabilityDeclarer is implemented by the KeyManagement services declaring
+// their capabilities
+type CapabilityDeclarer interface {
+	Capabilities() Capabilities
+}
+
+// versioned is implemented by the facades of the plugin interface versions
+type versioned interface {
+	Version() uint
+}
+
+// GetCapabilities returns the capabilities declared by the service
+func GetCapabilities(km KeyManagement) Capabilities {
+	if declarer, ok := km.(CapabilityDeclarer); ok {
+		caps := declarer.Capabilities()
+		caps.Declared = true
+
+		return caps
+	}
+
+	var caps Capabilities
+	if facade, ok := km.(versioned); ok {
+		caps.Version = facade.Version()
+	}
+
+	return caps
+}
+
+// ParseKeyAlgorithms returns the key algorithms of the names, or nil if no
+// names are given
+func ParseKeyAlgorithms(names []string) ([]KeyAlgorithm, error) {
+	return parseNames(names, keyAlgorithmNames)
+}
+
+// ParseKeyTypes returns the key types of the names, or nil if no names
+// are given
+func ParseKeyTypes(names []string) ([]KeyType, error) {
+	return parseNames(names, keyTypeNames)
+}
+
+func parseNames[T any](names []string, known map[string]T) ([]T, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	values := make([]T, 0, len(names))
+
+	for _, name := range names {
+		value, ok := known[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCapability, name)
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func (c Capabilities) SupportsAlgorithm(alg KeyAlgorithm) bool {
+	return c.Algorithms == nil || slices.Contains(c.Algorithms, alg)
+}
+
+func (c Capabilities) SupportsKeyType(keyType KeyType) bool {
+	return c.KeyTypes == nil || slices.Contains(c.KeyTypes, keyType)
+}
+
+// SupportsRegion returns true for the regions served. An empty region is
+// the default region of the plugin.
+func (c Capabilities) SupportsRegion(region string) bool {
+	return region == "" || len(c.Regions) == 0 || slices.Contains(c.Regions, region)
+}
+
+func (c Capabilities) SupportsImport() bool {
+	return !c.Declared || c.Import
+}
+
+func (c Capabilities) SupportsRotation() bool {
+	return !c.Declared || c.Rotation
+}
@@ -0,0 +1,93 @@
+package keymanagement_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/keymanagement"
+)
+
+// facade is a KeyManagement only implementing the facade version
+type facade struct {
+	keymanagement.KeyManagement
+}
+
+func (facade) Version() uint { return 1 }
+
+// declared is a KeyManagement declaring capabilities
+type declared struct {
+	facade
+
+	capabilities keymanagement.Capabilities
+}
+
+func (d declared) Capabilities() keymanagement.Capabilities { return d.capabilities }
+
+func TestGetCapabilities(t *testing.T) {
+	t.Run("Should support everything without declaration", func(t *testing.T) {
+		capabilities := keymanagement.GetCapabilities(facade{})
+
+		assert.False(t, capabilities.Declared)
+		assert.Equal(t, uint(1), capabilities.Version)
+		assert.True(t, capabilities.SupportsAlgorithm(keymanagement.AES256))
+		assert.True(t, capabilities.SupportsKeyType(keymanagement.HYOK))
+		assert.True(t, capabilities.SupportsRegion("eu10"))
+		assert.True(t, capabilities.SupportsImport())
+		assert.True(t, capabilities.SupportsRotation())
+	})
+
+	t.Run("Should restrict to declaration", func(t *testing.T) {
+		capabilities := keymanagement.GetCapabilities(declared{capabilities: keymanagement.Capabilities{
+			Algorithms: []keymanagement.KeyAlgorithm{keymanagement.AES256},
+			KeyTypes:   []keymanagement.KeyType{keymanagement.BYOK},
+			Rotation:   true,
+			Regions:    []string{"eu10"},
+		}})
+
+		assert.True(t, capabilities.Declared)
+		assert.True(t, capabilities.SupportsAlgorithm(keymanagement.AES256))
+		assert.False(t, capabilities.SupportsAlgorithm(keymanagement.UnspecifiedKeyAlgorithm))
+		assert.True(t, capabilities.SupportsKeyType(keymanagement.BYOK))
+		assert.False(t, capabilities.SupportsKeyType(keymanagement.HYOK))
+		assert.True(t, capabilities.SupportsRegion("eu10"))
+		assert.True(t, capabilities.SupportsRegion(""), "empty region should default to the plugin region")
+		assert.False(t, capabilities.SupportsRegion("us10"))
+		assert.False(t, capabilities.SupportsImport())
+		assert.True(t, capabilities.SupportsRotation())
+	})
+
+	t.Run("Should not rotate without declaring rotation", func(t *testing.T) {
+		capabilities := keymanagement.GetCapabilities(declared{})
+
+		assert.True(t, capabilities.Declared)
+		assert.False(t, capabilities.SupportsRotation())
+	})
+}
+
+func TestParseCapabilityNames(t *testing.T) {
+	t.Run("Should parse known names", func(t *testing.T) {
+		algorithms, err := keymanagement.ParseKeyAlgorithms([]string{"AES256"})
+		require.NoError(t, err)
+		assert.Equal(t, []keymanagement.KeyAlgorithm{keymanagement.AES256}, algorithms)
+
+		keyTypes, err := keymanagement.ParseKeyTypes([]string{"SYSTEM_MANAGED", "HYOK"})
+		require.NoError(t, err)
+		assert.Equal(t, []keymanagement.KeyType{keymanagement.SystemManaged, keymanagement.HYOK}, keyTypes)
+	})
+
+	t.Run("Should not restrict without names", func(t *testing.T) {
+		algorithms, err := keymanagement.ParseKeyAlgorithms(nil)
+		require.NoError(t, err)
+		assert.Nil(t, algorithms)
+	})
+
+	t.Run("Should fail on unknown names", func(t *testing.T) {
+		_, err := keymanagement.ParseKeyAlgorithms([]string{"RSA3072"})
+		assert.ErrorIs(t, err, keymanagement.ErrUnknownCapability)
+
+		_, err = keymanagement.ParseKeyTypes([]string{"BYOK", "EXTERNAL"})
+		assert.ErrorIs(t, err, keymanagement.ErrUnknownCapability)
+	})
+}
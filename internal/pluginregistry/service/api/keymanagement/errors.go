@@ -6,4 +6,5 @@ var (
 	ErrProviderAuthenticationFailed = errors.New("failed to authenticate with the keystore provider")
 	ErrHYOKKeyNotFound              = errors.New("HYOK provider key not found")
 	ErrGenericGetKeyError           = errors.New("failed to get key")
+	ErrUnknownCapability            = errors.New("unknown capability")
 )
@@ -11,7 +11,6 @@ import (
 	grpcidentitymanagementv1 "github.com/openkcm/plugin-sdk/proto/plugin/identity_management/v1"
 
 	"github.com/openkcm/cmk/internal/pluginregistry/service/api/identitymanagement"
-	"github.com/openkcm/cmk/utils/cache"
 )
 
 const (
@@ -21,8 +20,6 @@ const (
 type V1 struct {
 	plugin.Facade
 	grpcidentitymanagementv1.IdentityManagementServicePluginClient
-
-	UserCache *cache.TTLCache[string, identitymanagement.User] // key is userID
 }
 
 func (v1 *V1) Version() uint {
@@ -45,23 +42,13 @@ func (v1 *V1) GetUser(
 		return nil, fmt.Errorf(errFailedValidationMsg, err)
 	}
 
-	user, ok := v1.UserCache.Get(req.UserID)
-	if ok {
-		return &identitymanagement.GetUserResponse{User: user}, nil
-	}
-
 	grpcResp, err := v1.IdentityManagementServicePluginClient.GetUser(ctx, in)
 	if err != nil {
 		return nil, err
 	}
 
-	user = FromGRPCUser(grpcResp.GetUser())
-	if user != (identitymanagement.User{}) {
-		v1.UserCache.Set(req.UserID, user)
-	}
-
 	return &identitymanagement.GetUserResponse{
-		User: user,
+		User: FromGRPCUser(grpcResp.GetUser()),
 	}, nil
 }
 
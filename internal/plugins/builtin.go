@@ -3,21 +3,35 @@ package plugins
 import (
 	"github.com/openkcm/plugin-sdk/pkg/catalog"
 
+	certificateissuerlocalca "github.com/openkcm/cmk/internal/plugins/certificate-issuer/localca"
 	certificateissuernoop "github.com/openkcm/cmk/internal/plugins/certificate-issuer/noop"
+	identitymanagementldap "github.com/openkcm/cmk/internal/plugins/identity-management/ldap"
 	identitymanagementnoop "github.com/openkcm/cmk/internal/plugins/identity-management/noop"
 	identitymanagementscim "github.com/openkcm/cmk/internal/plugins/identity-management/scim"
 	keymanagementnoop "github.com/openkcm/cmk/internal/plugins/key-management/noop"
+	keymanagementpkcs11 "github.com/openkcm/cmk/internal/plugins/key-management/pkcs11"
+	keymanagementsoftware "github.com/openkcm/cmk/internal/plugins/key-management/software"
 	keystoremanagementnoop "github.com/openkcm/cmk/internal/plugins/keystore-management/noop"
 	notificationnoop "github.com/openkcm/cmk/internal/plugins/notification/noop"
+	notificationsmtp "github.com/openkcm/cmk/internal/plugins/notification/smtp"
+	notificationwebhook "github.com/openkcm/cmk/internal/plugins/notification/webhook"
+	systeminformationfile "github.com/openkcm/cmk/internal/plugins/system-information/file"
 	systeminformationnoop "github.com/openkcm/cmk/internal/plugins/system-information/noop"
 )
 
 func RegisterAllBuiltInPlugins(registry catalog.BuiltInPluginRegistry) {
 	identitymanagementnoop.Register(registry)
 	identitymanagementscim.Register(registry)
+	identitymanagementldap.Register(registry)
 	notificationnoop.Register(registry)
+	notificationsmtp.Register(registry)
+	notificationwebhook.Register(registry)
 	systeminformationnoop.Register(registry)
+	systeminformationfile.Register(registry)
 	certificateissuernoop.Register(registry)
+	certificateissuerlocalca.Register(registry)
 	keystoremanagementnoop.Register(registry)
 	keymanagementnoop.Register(registry)
+	keymanagementsoftware.Register(registry)
+	keymanagementpkcs11.Register(registry)
 }
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"time"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+)
+
+type Subject struct {
+	Country            []string `yaml:"country"`
+	Organization       []string `yaml:"organization"`
+	OrganizationalUnit []string `yaml:"organizationalUnit"`
+}
+
+type Config struct {
+	// Certificate is the PEM encoded intermediate CA certificate, optionally
+	// followed by the certificates of its issuers up to the root
+	Certificate commoncfg.SourceRef `yaml:"certificate"`
+	// PrivateKey is the PEM encoded private key of the intermediate CA
+	PrivateKey commoncfg.SourceRef `yaml:"privateKey"`
+	// Subject is added to the subject of the issued certificates
+	Subject Subject `yaml:"subject"`
+	// DefaultValidity is used for requests without validity
+	DefaultValidity time.Duration `yaml:"defaultValidity"`
+}
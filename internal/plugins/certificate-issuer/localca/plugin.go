@@ -0,0 +1,345 @@
+// Package localca is a built-in certificate issuer signing the keys of
+// certificate requests with a configured intermediate CA. It gives local
+// environments and integration tests realistic mTLS certificates without
+// an external PKI service.
+package localca
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"log/slog"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/openkcm/plugin-sdk/pkg/catalog"
+	"github.com/openkcm/plugin-sdk/pkg/hclog2slog"
+	"github.com/samber/oops"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+
+	certificateissuerv1 "github.com/openkcm/plugin-sdk/proto/plugin/certificate_issuer/v1"
+	configv1 "github.com/openkcm/plugin-sdk/proto/service/common/config/v1"
+
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/certificateissuer"
+	"github.com/openkcm/cmk/internal/plugins/certificate-issuer/localca/config"
+)
+
+const (
+	defaultValidity = 30 * 24 * time.Hour
+	// notBeforeSkew backdates the certificates for clocks running behind
+	notBeforeSkew = 5 * time.Minute
+	serialBits    = 128
+)
+
+var (
+	ErrLocalCA = oops.In("Local CA certificate issuer plugin")
+
+	ErrNotConfigured   = status.Error(codes.FailedPrecondition, "local CA is not configured")
+	ErrInvalidKey      = status.Error(codes.InvalidArgument, "invalid private key or certificate request")
+	ErrInvalidValidity = status.Error(codes.InvalidArgument, "invalid certificate validity")
+)
+
+func Register(registry catalog.BuiltInPluginRegistry) {
+	registry.Register(builtin(NewPlugin()))
+}
+
+func builtin(p *Plugin) catalog.BuiltInPlugin {
+	return catalog.MakeBuiltIn("localca",
+		certificateissuerv1.CertificateIssuerServicePluginServer(p),
+		configv1.ConfigServiceServer(p))
+}
+
+type Plugin struct {
+	certificateissuerv1.UnsafeCertificateIssuerServiceServer
+	configv1.UnsafeConfigServer
+
+	logger    *slog.Logger
+	buildInfo string
+
+	mu sync.RWMutex
+	ca *authority
+}
+
+// authority is the intermediate CA signing the certificates
+type authority struct {
+	cert            *x509.Certificate
+	signer          crypto.Signer
+	chainPEM        string
+	subject         config.Subject
+	defaultValidity time.Duration
+}
+
+var (
+	_ certificateissuerv1.CertificateIssuerServiceServer = (*Plugin)(nil)
+	_ configv1.ConfigServer                              = (*Plugin)(nil)
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{
+		buildInfo: "{}",
+	}
+}
+
+func (p *Plugin) SetLogger(logger hclog.Logger) {
+	p.logger = hclog2slog.New(logger)
+}
+
+func (p *Plugin) Configure(_ context.Context, req *configv1.ConfigureRequest) (*configv1.ConfigureResponse, error) {
+	slog.Info("Configuring plugin")
+
+	cfg := &config.Config{}
+
+	err := yaml.Unmarshal([]byte(req.GetYamlConfiguration()), cfg)
+	if err != nil {
+		return nil, ErrLocalCA.Wrapf(err, "Failed to get yaml Configuration")
+	}
+
+	ca, err := newAuthority(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	p.ca = ca
+	p.mu.Unlock()
+
+	return &configv1.ConfigureResponse{
+		BuildInfo: &p.buildInfo,
+	}, nil
+}
+
+// GetCertificate issues a client certificate for the public key of the
+// private key or certificate request of the request. The chain holds the
+// issued certificate followed by the chain of the CA.
+func (p *Plugin) GetCertificate(
+	_ context.Context,
+	req *certificateissuerv1.GetCertificateRequest,
+) (*certificateissuerv1.GetCertificateResponse, error) {
+	p.mu.RLock()
+	ca := p.ca
+	p.mu.RUnlock()
+
+	if ca == nil {
+		return nil, ErrNotConfigured
+	}
+
+	publicKey, err := parsePublicKey(req.GetPrivateKey().GetData())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	notAfter, err := ca.notAfter(now, req.GetValidity())
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialBits))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:         req.GetCommonName(),
+			Locality:           req.GetLocality(),
+			Country:            ca.subject.Country,
+			Organization:       ca.subject.Organization,
+			OrganizationalUnit: ca.subject.OrganizationalUnit,
+		},
+		NotBefore:             now.Add(-notBeforeSkew),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, publicKey, ca.signer)
+	if err != nil {
+		p.logError("Failed to sign certificate", err)
+		return nil, status.Error(codes.Internal, "failed to sign certificate")
+	}
+
+	chain := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})) + ca.chainPEM
+
+	return &certificateissuerv1.GetCertificateResponse{CertificateChain: chain}, nil
+}
+
+func (p *Plugin) logError(msg string, err error) {
+	if p.logger != nil {
+		p.logger.Error(msg, "error", err)
+	}
+}
+
+func newAuthority(cfg *config.Config) (*authority, error) {
+	certPEM, err := commoncfg.LoadValueFromSourceRef(cfg.Certificate)
+	if err != nil {
+		return nil, ErrLocalCA.Wrapf(err, "Failed loading CA certificate")
+	}
+
+	keyPEM, err := commoncfg.LoadValueFromSourceRef(cfg.PrivateKey)
+	if err != nil {
+		return nil, ErrLocalCA.Wrapf(err, "Failed loading CA private key")
+	}
+
+	chain, err := parseCertificates(certPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := parsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, ErrLocalCA.Wrapf(err, "Failed parsing CA private key")
+	}
+
+	cert := chain[0]
+	if !cert.IsCA || cert.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return nil, ErrLocalCA.Errorf("Certificate %q is no CA certificate", cert.Subject)
+	}
+
+	publicKey, ok := signer.Public().(interface{ Equal(x crypto.PublicKey) bool })
+	if !ok || !publicKey.Equal(cert.PublicKey) {
+		return nil, ErrLocalCA.Errorf("CA private key does not match the CA certificate")
+	}
+
+	var chainPEM strings.Builder
+	for _, c := range chain {
+		chainPEM.Write(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Raw}))
+	}
+
+	ca := &authority{
+		cert:            cert,
+		signer:          signer,
+		chainPEM:        chainPEM.String(),
+		subject:         cfg.Subject,
+		defaultValidity: cfg.DefaultValidity,
+	}
+
+	if ca.defaultValidity <= 0 {
+		ca.defaultValidity = defaultValidity
+	}
+
+	return ca, nil
+}
+
+// notAfter returns the end of the validity starting now. Certificates
+// never outlive the CA.
+func (ca *authority) notAfter(now time.Time, validity *certificateissuerv1.GetCertificateValidity) (time.Time, error) {
+	value := int(validity.GetValue())
+
+	var notAfter time.Time
+
+	switch certificateissuer.ValidityType(validity.GetType()) {
+	case certificateissuer.Unspecified:
+		notAfter = now.Add(ca.defaultValidity)
+	case certificateissuer.Days:
+		notAfter = now.AddDate(0, 0, value)
+	case certificateissuer.Months:
+		notAfter = now.AddDate(0, value, 0)
+	case certificateissuer.Years:
+		notAfter = now.AddDate(value, 0, 0)
+	default:
+		return time.Time{}, ErrInvalidValidity
+	}
+
+	if !notAfter.After(now) {
+		return time.Time{}, ErrInvalidValidity
+	}
+
+	if notAfter.After(ca.cert.NotAfter) {
+		notAfter = ca.cert.NotAfter
+	}
+
+	return notAfter, nil
+}
+
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, ErrLocalCA.Wrapf(err, "Failed parsing CA certificate")
+		}
+
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, ErrLocalCA.Errorf("No CA certificate found")
+	}
+
+	return certs, nil
+}
+
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrLocalCA.Errorf("No PEM block found")
+	}
+
+	var (
+		key any
+		err error
+	)
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, ErrLocalCA.Errorf("Unsupported private key")
+	}
+
+	return signer, nil
+}
+
+// parsePublicKey returns the public key of a PEM encoded private key or
+// certificate request. The signature of a certificate request is checked.
+func parsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidKey
+	}
+
+	if block.Type == "CERTIFICATE REQUEST" {
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil || csr.CheckSignature() != nil {
+			return nil, ErrInvalidKey
+		}
+
+		return csr.PublicKey, nil
+	}
+
+	signer, err := parsePrivateKey(data)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+
+	return signer.Public(), nil
+}
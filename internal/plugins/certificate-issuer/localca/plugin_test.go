@@ -0,0 +1,286 @@
+package localca_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	certificateissuerv1 "github.com/openkcm/plugin-sdk/proto/plugin/certificate_issuer/v1"
+	configv1 "github.com/openkcm/plugin-sdk/proto/service/common/config/v1"
+
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/certificateissuer"
+	"github.com/openkcm/cmk/internal/plugins/certificate-issuer/localca"
+)
+
+const rsaKeyBits = 2048
+
+type testCA struct {
+	root         *x509.Certificate
+	intermediate *x509.Certificate
+	certPath     string
+	keyPath      string
+}
+
+func newCA(t *testing.T, validity time.Duration, isCA bool) testCA {
+	t.Helper()
+
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	root := createCert(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(2 * validity),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, nil, rootKey.Public(), rootKey)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	intermediate := createCert(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "Test Intermediate CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(validity),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}, root, key.Public(), rootKey)
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	ca := testCA{
+		root:         root,
+		intermediate: intermediate,
+		certPath:     filepath.Join(dir, "ca.pem"),
+		keyPath:      filepath.Join(dir, "ca.key"),
+	}
+
+	certs := append(pemEncode("CERTIFICATE", intermediate.Raw), pemEncode("CERTIFICATE", root.Raw)...)
+	require.NoError(t, os.WriteFile(ca.certPath, certs, 0o600))
+	require.NoError(t, os.WriteFile(ca.keyPath, pemEncode("PRIVATE KEY", keyDER), 0o600))
+
+	return ca
+}
+
+func createCert(
+	t *testing.T,
+	template, parent *x509.Certificate,
+	publicKey crypto.PublicKey,
+	signer crypto.Signer,
+) *x509.Certificate {
+	t.Helper()
+
+	if parent == nil {
+		parent = template
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, publicKey, signer)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert
+}
+
+func pemEncode(blockType string, der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+}
+
+func configure(t *testing.T, ca testCA) (*localca.Plugin, error) {
+	t.Helper()
+
+	p := localca.NewPlugin()
+	_, err := p.Configure(t.Context(), &configv1.ConfigureRequest{
+		YamlConfiguration: `
+certificate:
+  source: file
+  file:
+    path: ` + ca.certPath + `
+privateKey:
+  source: file
+  file:
+    path: ` + ca.keyPath + `
+subject:
+  organization: ["OpenKCM"]`,
+	})
+
+	return p, err
+}
+
+func rsaKeyPEM(t *testing.T) (*rsa.PrivateKey, []byte) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	require.NoError(t, err)
+
+	return key, pemEncode("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key))
+}
+
+func validity(value int64, validityType certificateissuer.ValidityType) *certificateissuerv1.GetCertificateValidity {
+	return &certificateissuerv1.GetCertificateValidity{
+		Value: value,
+		Type:  certificateissuerv1.ValidityType(validityType),
+	}
+}
+
+func decodeChain(t *testing.T, chainPEM string) []*x509.Certificate {
+	t.Helper()
+
+	var certs []*x509.Certificate
+
+	for block, rest := pem.Decode([]byte(chainPEM)); block != nil; block, rest = pem.Decode(rest) {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+
+		certs = append(certs, cert)
+	}
+
+	return certs
+}
+
+func TestConfigure(t *testing.T) {
+	t.Run("Should fail on certificate without CA", func(t *testing.T) {
+		_, err := configure(t, newCA(t, 24*time.Hour, false))
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail on private key not matching the certificate", func(t *testing.T) {
+		ca := newCA(t, 24*time.Hour, true)
+		other := newCA(t, 24*time.Hour, true)
+		ca.keyPath = other.keyPath
+
+		_, err := configure(t, ca)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail before configuration", func(t *testing.T) {
+		_, err := localca.NewPlugin().GetCertificate(t.Context(), &certificateissuerv1.GetCertificateRequest{})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestGetCertificate(t *testing.T) {
+	ca := newCA(t, 90*24*time.Hour, true)
+
+	p, err := configure(t, ca)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.root)
+
+	verify := func(t *testing.T, certs []*x509.Certificate) {
+		t.Helper()
+
+		intermediates := x509.NewCertPool()
+		for _, c := range certs[1:] {
+			intermediates.AddCert(c)
+		}
+
+		_, err := certs[0].Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+		assert.NoError(t, err)
+	}
+
+	t.Run("Should issue certificate for private key", func(t *testing.T) {
+		key, keyPEM := rsaKeyPEM(t)
+
+		resp, err := p.GetCertificate(t.Context(), &certificateissuerv1.GetCertificateRequest{
+			CommonName: "tenant-1",
+			Locality:   []string{"eu10"},
+			Validity:   validity(7, certificateissuer.Days),
+			PrivateKey: &certificateissuerv1.PrivateKey{Data: keyPEM},
+		})
+		require.NoError(t, err)
+
+		certs := decodeChain(t, resp.GetCertificateChain())
+		require.Len(t, certs, 3)
+		assert.Equal(t, ca.intermediate.Raw, certs[1].Raw)
+		assert.Equal(t, ca.root.Raw, certs[2].Raw)
+
+		leaf := certs[0]
+		assert.True(t, key.PublicKey.Equal(leaf.PublicKey))
+		assert.Equal(t, "tenant-1", leaf.Subject.CommonName)
+		assert.Equal(t, []string{"eu10"}, leaf.Subject.Locality)
+		assert.Equal(t, []string{"OpenKCM"}, leaf.Subject.Organization)
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, 7), leaf.NotAfter, time.Minute)
+
+		verify(t, certs)
+	})
+
+	t.Run("Should issue certificate for certificate request", func(t *testing.T) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, key)
+		require.NoError(t, err)
+
+		resp, err := p.GetCertificate(t.Context(), &certificateissuerv1.GetCertificateRequest{
+			CommonName: "tenant-2",
+			Validity:   validity(1, certificateissuer.Months),
+			PrivateKey: &certificateissuerv1.PrivateKey{Data: pemEncode("CERTIFICATE REQUEST", csr)},
+		})
+		require.NoError(t, err)
+
+		certs := decodeChain(t, resp.GetCertificateChain())
+		assert.True(t, key.PublicKey.Equal(certs[0].PublicKey))
+		verify(t, certs)
+	})
+
+	t.Run("Should not outlive the CA", func(t *testing.T) {
+		_, keyPEM := rsaKeyPEM(t)
+
+		resp, err := p.GetCertificate(t.Context(), &certificateissuerv1.GetCertificateRequest{
+			CommonName: "tenant-3",
+			Validity:   validity(10, certificateissuer.Years),
+			PrivateKey: &certificateissuerv1.PrivateKey{Data: keyPEM},
+		})
+		require.NoError(t, err)
+
+		certs := decodeChain(t, resp.GetCertificateChain())
+		assert.Equal(t, ca.intermediate.NotAfter, certs[0].NotAfter)
+	})
+
+	t.Run("Should fail on invalid key", func(t *testing.T) {
+		_, err := p.GetCertificate(t.Context(), &certificateissuerv1.GetCertificateRequest{
+			CommonName: "tenant-4",
+			PrivateKey: &certificateissuerv1.PrivateKey{Data: []byte("not a key")},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Should fail on invalid validity", func(t *testing.T) {
+		_, keyPEM := rsaKeyPEM(t)
+
+		_, err := p.GetCertificate(t.Context(), &certificateissuerv1.GetCertificateRequest{
+			CommonName: "tenant-5",
+			Validity:   validity(0, certificateissuer.Days),
+			PrivateKey: &certificateissuerv1.PrivateKey{Data: keyPEM},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
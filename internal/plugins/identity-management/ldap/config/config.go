@@ -0,0 +1,55 @@
+package config
+
+import (
+	"time"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+)
+
+// Directory selects the default schema of users and groups
+type Directory string
+
+const (
+	DirectoryOpenLDAP        Directory = "openldap"
+	DirectoryActiveDirectory Directory = "activedirectory"
+)
+
+type Users struct {
+	BaseDN string `yaml:"baseDN"`
+	Filter string `yaml:"filter"`
+	// IDAttribute holds the user IDs known to CMK, like the subject of tokens
+	IDAttribute    string `yaml:"idAttribute"`
+	NameAttribute  string `yaml:"nameAttribute"`
+	EmailAttribute string `yaml:"emailAttribute"`
+}
+
+type Groups struct {
+	BaseDN          string `yaml:"baseDN"`
+	Filter          string `yaml:"filter"`
+	NameAttribute   string `yaml:"nameAttribute"`
+	MemberAttribute string `yaml:"memberAttribute"`
+	// Nested resolves members of member groups, up to MaxNestingDepth levels
+	Nested          bool `yaml:"nested"`
+	MaxNestingDepth int  `yaml:"maxNestingDepth"`
+}
+
+type Pool struct {
+	Size        int           `yaml:"size"`
+	IdleTimeout time.Duration `yaml:"idleTimeout"`
+}
+
+type Config struct {
+	// URL is a ldap:// or ldaps:// URL of the directory
+	URL           string              `yaml:"url"`
+	StartTLS      bool                `yaml:"startTLS"`
+	CACertificate commoncfg.SourceRef `yaml:"caCertificate"`
+	BindDN        commoncfg.SourceRef `yaml:"bindDN"`
+	BindPassword  commoncfg.SourceRef `yaml:"bindPassword"`
+	Directory     Directory           `yaml:"directory"`
+	BaseDN        string              `yaml:"baseDN"`
+	Users         Users               `yaml:"users"`
+	Groups        Groups              `yaml:"groups"`
+	PageSize      uint32              `yaml:"pageSize"`
+	Timeout       time.Duration       `yaml:"timeout"`
+	Pool          Pool                `yaml:"pool"`
+}
@@ -0,0 +1,256 @@
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/openkcm/cmk/internal/plugins/identity-management/ldap/config"
+)
+
+type user struct {
+	dn    string
+	id    string
+	name  string
+	email string
+}
+
+type group struct {
+	dn   string
+	name string
+}
+
+// directory runs the searches for users and groups
+type directory struct {
+	pool     *pool
+	users    config.Users
+	groups   config.Groups
+	pageSize uint32
+}
+
+// user returns the user with the ID, or nil if there is none
+func (d *directory) user(ctx context.Context, id string) (*user, error) {
+	filter := fmt.Sprintf("(&%s(%s=%s))", d.users.Filter, d.users.IDAttribute, ldap.EscapeFilter(id))
+
+	entries, err := d.search(ctx, d.users.BaseDN, ldap.ScopeWholeSubtree, filter, d.userAttributes())
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(entries) {
+	case 0:
+		return nil, nil //nolint:nilnil
+	case 1:
+		return d.toUser(entries[0]), nil
+	default:
+		return nil, ErrMultipleEntries
+	}
+}
+
+// group returns the group with the name, or nil if there is none
+func (d *directory) group(ctx context.Context, name string) (*group, error) {
+	filter := fmt.Sprintf("(&%s(%s=%s))", d.groups.Filter, d.groups.NameAttribute, ldap.EscapeFilter(name))
+
+	entries, err := d.search(ctx, d.groups.BaseDN, ldap.ScopeWholeSubtree, filter, []string{d.groups.NameAttribute})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(entries) {
+	case 0:
+		return nil, nil //nolint:nilnil
+	case 1:
+		return d.toGroup(entries[0]), nil
+	default:
+		return nil, ErrMultipleEntries
+	}
+}
+
+func (d *directory) allGroups(ctx context.Context) ([]group, error) {
+	entries, err := d.search(ctx, d.groups.BaseDN, ldap.ScopeWholeSubtree, d.groups.Filter,
+		[]string{d.groups.NameAttribute})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]group, 0, len(entries))
+	for _, e := range entries {
+		groups = append(groups, *d.toGroup(e))
+	}
+
+	return groups, nil
+}
+
+// groupUsers returns the users of the group with the DN. Member groups are
+// resolved if nesting is enabled, every user is returned once.
+func (d *directory) groupUsers(ctx context.Context, groupDN string) ([]user, error) {
+	root, err := d.entry(ctx, groupDN, d.groups.Filter, []string{d.groups.MemberAttribute})
+	if err != nil {
+		return nil, err
+	}
+
+	if root == nil {
+		return nil, ErrGroupNotFound
+	}
+
+	var users []user
+
+	visited := map[string]bool{normalizeDN(root.DN): true}
+	members := root.GetEqualFoldAttributeValues(d.groups.MemberAttribute)
+
+	for depth := 0; len(members) > 0; depth++ {
+		var next []string
+
+		for _, member := range members {
+			if visited[normalizeDN(member)] {
+				continue
+			}
+
+			visited[normalizeDN(member)] = true
+
+			entry, err := d.entry(ctx, member, d.users.Filter, d.userAttributes())
+			if err != nil {
+				return nil, err
+			}
+
+			if entry != nil {
+				users = append(users, *d.toUser(entry))
+				continue
+			}
+
+			if !d.groups.Nested || depth >= d.groups.MaxNestingDepth {
+				continue
+			}
+
+			entry, err = d.entry(ctx, member, d.groups.Filter, []string{d.groups.MemberAttribute})
+			if err != nil {
+				return nil, err
+			}
+
+			if entry != nil {
+				next = append(next, entry.GetEqualFoldAttributeValues(d.groups.MemberAttribute)...)
+			}
+		}
+
+		members = next
+	}
+
+	return users, nil
+}
+
+// userGroups returns the groups the user is member of. With nesting
+// enabled, the groups containing these groups are returned too.
+func (d *directory) userGroups(ctx context.Context, u *user) ([]group, error) {
+	var groups []group
+
+	visited := map[string]bool{}
+	members := []string{u.dn}
+
+	for depth := 0; len(members) > 0; depth++ {
+		memberFilters := make([]string, 0, len(members))
+		for _, m := range members {
+			memberFilters = append(memberFilters,
+				fmt.Sprintf("(%s=%s)", d.groups.MemberAttribute, ldap.EscapeFilter(m)))
+		}
+
+		filter := fmt.Sprintf("(&%s(|%s))", d.groups.Filter, strings.Join(memberFilters, ""))
+
+		entries, err := d.search(ctx, d.groups.BaseDN, ldap.ScopeWholeSubtree, filter,
+			[]string{d.groups.NameAttribute})
+		if err != nil {
+			return nil, err
+		}
+
+		var next []string
+
+		for _, e := range entries {
+			if visited[normalizeDN(e.DN)] {
+				continue
+			}
+
+			visited[normalizeDN(e.DN)] = true
+
+			groups = append(groups, *d.toGroup(e))
+			next = append(next, e.DN)
+		}
+
+		if !d.groups.Nested || depth >= d.groups.MaxNestingDepth {
+			break
+		}
+
+		members = next
+	}
+
+	return groups, nil
+}
+
+// entry returns the entry with the DN if it matches the filter, or nil
+func (d *directory) entry(ctx context.Context, dn, filter string, attributes []string) (*ldap.Entry, error) {
+	entries, err := d.search(ctx, dn, ldap.ScopeBaseObject, filter, attributes)
+	if ldap.IsErrorAnyOf(err, ldap.LDAPResultNoSuchObject, ldap.LDAPResultInvalidDNSyntax) {
+		return nil, nil //nolint:nilnil
+	}
+
+	if err != nil || len(entries) == 0 {
+		return nil, err
+	}
+
+	return entries[0], nil
+}
+
+// search runs a paged search on a pooled connection
+func (d *directory) search(
+	ctx context.Context,
+	baseDN string,
+	scope int,
+	filter string,
+	attributes []string,
+) ([]*ldap.Entry, error) {
+	conn, err := d.pool.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := ldap.NewSearchRequest(baseDN, scope, ldap.NeverDerefAliases, 0, 0, false, filter, attributes, nil)
+
+	result, err := conn.SearchWithPaging(req, d.pageSize)
+	d.pool.put(conn, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Entries, nil
+}
+
+func (d *directory) userAttributes() []string {
+	return []string{d.users.IDAttribute, d.users.NameAttribute, d.users.EmailAttribute}
+}
+
+func (d *directory) toUser(e *ldap.Entry) *user {
+	return &user{
+		dn:    e.DN,
+		id:    e.GetEqualFoldAttributeValue(d.users.IDAttribute),
+		name:  e.GetEqualFoldAttributeValue(d.users.NameAttribute),
+		email: e.GetEqualFoldAttributeValue(d.users.EmailAttribute),
+	}
+}
+
+func (d *directory) toGroup(e *ldap.Entry) *group {
+	return &group{
+		dn:   e.DN,
+		name: e.GetEqualFoldAttributeValue(d.groups.NameAttribute),
+	}
+}
+
+// normalizeDN returns the DN in a form comparable with other DNs
+func normalizeDN(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil {
+		return strings.ToLower(dn)
+	}
+
+	return strings.ToLower(parsed.String())
+}
@@ -0,0 +1,491 @@
+// Package ldap is a built-in identity management plugin reading users and
+// groups from a LDAP directory like OpenLDAP or Active Directory.
+//
+// Users are identified by the configured ID attribute and groups by their
+// DN, group names are the values of the name attribute. Searches are paged
+// and run on a pool of connections bound with a service account.
+package ldap
+
+import (
+	"cmp"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"log/slog"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/go-hclog"
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/openkcm/plugin-sdk/pkg/catalog"
+	"github.com/openkcm/plugin-sdk/pkg/hclog2slog"
+	"github.com/samber/oops"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+
+	idmangv1 "github.com/openkcm/plugin-sdk/proto/plugin/identity_management/v1"
+	configv1 "github.com/openkcm/plugin-sdk/proto/service/common/config/v1"
+
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/plugins/identity-management/ldap/config"
+)
+
+const (
+	defaultPageSize        = 500
+	defaultMaxNestingDepth = 10
+	defaultPoolSize        = 4
+	defaultIdleTimeout     = 5 * time.Minute
+	defaultTimeout         = 10 * time.Second
+)
+
+var (
+	ErrLDAP = oops.In("LDAP identity management plugin")
+
+	ErrNotConfigured    = status.Error(codes.FailedPrecondition, "LDAP plugin is not configured")
+	ErrUserNotFound     = status.Error(codes.NotFound, "user does not exist")
+	ErrGroupNotFound    = status.Error(codes.NotFound, "group does not exist")
+	ErrNoID             = status.Error(codes.InvalidArgument, "no id provided")
+	ErrMultipleEntries  = errors.New("more than one entry found")
+	ErrGetUser          = errors.New("failed to get user")
+	ErrGetGroup         = errors.New("failed to get group")
+	ErrGetAllGroups     = errors.New("failed to get all groups")
+	ErrGetUsersForGroup = errors.New("failed to get users for group")
+	ErrGetGroupsForUser = errors.New("failed to get groups for user")
+)
+
+// defaultSchemas are the user and group defaults of the directories
+var defaultSchemas = map[config.Directory]struct {
+	users  config.Users
+	groups config.Groups
+}{
+	config.DirectoryOpenLDAP: {
+		users: config.Users{
+			Filter:         "(objectClass=inetOrgPerson)",
+			IDAttribute:    "uid",
+			NameAttribute:  "cn",
+			EmailAttribute: "mail",
+		},
+		groups: config.Groups{
+			Filter:          "(objectClass=groupOfNames)",
+			NameAttribute:   "cn",
+			MemberAttribute: "member",
+		},
+	},
+	config.DirectoryActiveDirectory: {
+		users: config.Users{
+			Filter:         "(&(objectClass=user)(objectCategory=person))",
+			IDAttribute:    "sAMAccountName",
+			NameAttribute:  "displayName",
+			EmailAttribute: "mail",
+		},
+		groups: config.Groups{
+			Filter:          "(objectClass=group)",
+			NameAttribute:   "cn",
+			MemberAttribute: "member",
+		},
+	},
+}
+
+func Register(registry catalog.BuiltInPluginRegistry) {
+	registry.Register(builtin(NewPlugin()))
+}
+
+func builtin(p *Plugin) catalog.BuiltInPlugin {
+	return catalog.MakeBuiltIn("ldap",
+		idmangv1.IdentityManagementServicePluginServer(p),
+		configv1.ConfigServiceServer(p))
+}
+
+type Plugin struct {
+	idmangv1.UnsafeIdentityManagementServiceServer
+	configv1.UnsafeConfigServer
+
+	logger    *slog.Logger
+	buildInfo string
+
+	mu        sync.RWMutex
+	directory *directory
+}
+
+var (
+	_ idmangv1.IdentityManagementServiceServer = (*Plugin)(nil)
+	_ configv1.ConfigServer                    = (*Plugin)(nil)
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{
+		buildInfo: "{}",
+	}
+}
+
+func (p *Plugin) SetLogger(logger hclog.Logger) {
+	p.logger = hclog2slog.New(logger)
+}
+
+func (p *Plugin) Configure(_ context.Context, req *configv1.ConfigureRequest) (*configv1.ConfigureResponse, error) {
+	slog.Info("Configuring plugin")
+
+	cfg := &config.Config{}
+
+	err := yaml.Unmarshal([]byte(req.GetYamlConfiguration()), cfg)
+	if err != nil {
+		return nil, ErrLDAP.Wrapf(err, "Failed to get yaml Configuration")
+	}
+
+	d, err := newDirectory(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	previous := p.directory
+	p.directory = d
+	p.mu.Unlock()
+
+	if previous != nil {
+		previous.pool.close()
+	}
+
+	return &configv1.ConfigureResponse{
+		BuildInfo: &p.buildInfo,
+	}, nil
+}
+
+// Close closes the idle connections to the directory
+func (p *Plugin) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.directory != nil {
+		p.directory.pool.close()
+	}
+
+	return nil
+}
+
+func (p *Plugin) GetUser(
+	ctx context.Context,
+	request *idmangv1.GetUserRequest,
+) (*idmangv1.GetUserResponse, error) {
+	d, err := p.getDirectory()
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := p.findUser(ctx, d, request.GetUserId())
+	if err != nil {
+		return nil, p.wrapError(ErrGetUser, err)
+	}
+
+	return &idmangv1.GetUserResponse{User: toGRPCUser(*u)}, nil
+}
+
+func (p *Plugin) GetGroup(
+	ctx context.Context,
+	request *idmangv1.GetGroupRequest,
+) (*idmangv1.GetGroupResponse, error) {
+	d, err := p.getDirectory()
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetGroupName() == "" {
+		return nil, ErrNoID
+	}
+
+	g, err := d.group(ctx, request.GetGroupName())
+	if err != nil {
+		return nil, p.wrapError(ErrGetGroup, err)
+	}
+
+	if g == nil {
+		return nil, ErrGroupNotFound
+	}
+
+	return &idmangv1.GetGroupResponse{Group: toGRPCGroup(*g)}, nil
+}
+
+func (p *Plugin) GetAllGroups(
+	ctx context.Context,
+	_ *idmangv1.GetAllGroupsRequest,
+) (*idmangv1.GetAllGroupsResponse, error) {
+	d, err := p.getDirectory()
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := d.allGroups(ctx)
+	if err != nil {
+		return nil, p.wrapError(ErrGetAllGroups, err)
+	}
+
+	return &idmangv1.GetAllGroupsResponse{Groups: toGRPCGroups(groups)}, nil
+}
+
+// GetUsersForGroup returns the users of the group with the ID, which is
+// the DN of the group
+func (p *Plugin) GetUsersForGroup(
+	ctx context.Context,
+	request *idmangv1.GetUsersForGroupRequest,
+) (*idmangv1.GetUsersForGroupResponse, error) {
+	d, err := p.getDirectory()
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetGroupId() == "" {
+		return nil, ErrNoID
+	}
+
+	users, err := d.groupUsers(ctx, request.GetGroupId())
+	if err != nil {
+		return nil, p.wrapError(ErrGetUsersForGroup, err)
+	}
+
+	responseUsers := make([]*idmangv1.User, 0, len(users))
+	for _, u := range users {
+		responseUsers = append(responseUsers, toGRPCUser(u))
+	}
+
+	return &idmangv1.GetUsersForGroupResponse{Users: responseUsers}, nil
+}
+
+func (p *Plugin) GetGroupsForUser(
+	ctx context.Context,
+	request *idmangv1.GetGroupsForUserRequest,
+) (*idmangv1.GetGroupsForUserResponse, error) {
+	d, err := p.getDirectory()
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := p.findUser(ctx, d, request.GetUserId())
+	if err != nil {
+		return nil, p.wrapError(ErrGetGroupsForUser, err)
+	}
+
+	groups, err := d.userGroups(ctx, u)
+	if err != nil {
+		return nil, p.wrapError(ErrGetGroupsForUser, err)
+	}
+
+	return &idmangv1.GetGroupsForUserResponse{Groups: toGRPCGroups(groups)}, nil
+}
+
+func (p *Plugin) getDirectory() (*directory, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.directory == nil {
+		return nil, ErrNotConfigured
+	}
+
+	return p.directory, nil
+}
+
+func (p *Plugin) findUser(ctx context.Context, d *directory, id string) (*user, error) {
+	if id == "" {
+		return nil, ErrNoID
+	}
+
+	u, err := d.user(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+
+	return u, nil
+}
+
+// wrapError keeps gRPC status errors, so callers can tell missing users
+// and groups from failures
+func (p *Plugin) wrapError(base error, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if p.logger != nil {
+		p.logger.Error(base.Error(), "error", err)
+	}
+
+	return errs.Wrap(base, err)
+}
+
+func toGRPCUser(u user) *idmangv1.User {
+	return &idmangv1.User{
+		Id:    u.id,
+		Name:  u.name,
+		Email: u.email,
+	}
+}
+
+func toGRPCGroups(groups []group) []*idmangv1.Group {
+	responseGroups := make([]*idmangv1.Group, 0, len(groups))
+	for _, g := range groups {
+		responseGroups = append(responseGroups, toGRPCGroup(g))
+	}
+
+	return responseGroups
+}
+
+func toGRPCGroup(g group) *idmangv1.Group {
+	return &idmangv1.Group{
+		Id:   g.dn,
+		Name: g.name,
+	}
+}
+
+func newDirectory(cfg *config.Config) (*directory, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil || (u.Scheme != "ldap" && u.Scheme != "ldaps") || u.Host == "" {
+		return nil, ErrLDAP.Errorf("Invalid URL %q, expected ldap:// or ldaps://", cfg.URL)
+	}
+
+	users, groups, err := schemaOf(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{ServerName: u.Hostname(), MinVersion: tls.VersionTLS12}
+
+	caCert, err := loadOptional(cfg.CACertificate)
+	if err != nil {
+		return nil, ErrLDAP.Wrapf(err, "Failed loading CA certificate")
+	}
+
+	if len(caCert) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, ErrLDAP.Errorf("No CA certificate found")
+		}
+	}
+
+	bindDN, err := loadOptional(cfg.BindDN)
+	if err != nil {
+		return nil, ErrLDAP.Wrapf(err, "Failed loading bind DN")
+	}
+
+	bindPassword, err := loadOptional(cfg.BindPassword)
+	if err != nil {
+		return nil, ErrLDAP.Wrapf(err, "Failed loading bind password")
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	dial := func() (*ldap.Conn, error) {
+		conn, err := ldap.DialURL(cfg.URL,
+			ldap.DialWithDialer(&net.Dialer{Timeout: timeout}),
+			ldap.DialWithTLSConfig(tlsConfig))
+		if err != nil {
+			return nil, err
+		}
+
+		conn.SetTimeout(timeout)
+
+		if cfg.StartTLS && u.Scheme == "ldap" {
+			err = conn.StartTLS(tlsConfig)
+			if err != nil {
+				_ = conn.Close()
+				return nil, err
+			}
+		}
+
+		if len(bindDN) > 0 {
+			err = conn.Bind(strings.TrimSpace(string(bindDN)), strings.TrimSpace(string(bindPassword)))
+			if err != nil {
+				_ = conn.Close()
+				return nil, err
+			}
+		}
+
+		return conn, nil
+	}
+
+	d := &directory{
+		users:    users,
+		groups:   groups,
+		pageSize: cfg.PageSize,
+	}
+
+	if d.pageSize == 0 {
+		d.pageSize = defaultPageSize
+	}
+
+	poolSize := cfg.Pool.Size
+	if poolSize <= 0 {
+		poolSize = defaultPoolSize
+	}
+
+	idleTimeout := cfg.Pool.IdleTimeout
+	if idleTimeout <= 0 {
+		idleTimeout = defaultIdleTimeout
+	}
+
+	d.pool = newPool(poolSize, idleTimeout, dial)
+
+	return d, nil
+}
+
+// schemaOf returns the user and group settings, unset fields have the
+// defaults of the directory
+func schemaOf(cfg *config.Config) (config.Users, config.Groups, error) {
+	if cfg.Directory == "" {
+		cfg.Directory = config.DirectoryOpenLDAP
+	}
+
+	defaults, ok := defaultSchemas[cfg.Directory]
+	if !ok {
+		return config.Users{}, config.Groups{}, ErrLDAP.Errorf("Unknown directory %q", cfg.Directory)
+	}
+
+	users := cfg.Users
+	users.BaseDN = cmp.Or(users.BaseDN, cfg.BaseDN)
+	users.Filter = cmp.Or(users.Filter, defaults.users.Filter)
+	users.IDAttribute = cmp.Or(users.IDAttribute, defaults.users.IDAttribute)
+	users.NameAttribute = cmp.Or(users.NameAttribute, defaults.users.NameAttribute)
+	users.EmailAttribute = cmp.Or(users.EmailAttribute, defaults.users.EmailAttribute)
+
+	groups := cfg.Groups
+	groups.BaseDN = cmp.Or(groups.BaseDN, cfg.BaseDN)
+	groups.Filter = cmp.Or(groups.Filter, defaults.groups.Filter)
+	groups.NameAttribute = cmp.Or(groups.NameAttribute, defaults.groups.NameAttribute)
+	groups.MemberAttribute = cmp.Or(groups.MemberAttribute, defaults.groups.MemberAttribute)
+
+	if groups.MaxNestingDepth <= 0 {
+		groups.MaxNestingDepth = defaultMaxNestingDepth
+	}
+
+	if users.BaseDN == "" || groups.BaseDN == "" {
+		return config.Users{}, config.Groups{}, ErrLDAP.Errorf("Base DN of users and groups is required")
+	}
+
+	for _, filter := range []string{users.Filter, groups.Filter} {
+		_, err := ldap.CompileFilter(filter)
+		if err != nil {
+			return config.Users{}, config.Groups{}, ErrLDAP.Wrapf(err, "Invalid filter %q", filter)
+		}
+	}
+
+	return users, groups, nil
+}
+
+// loadOptional loads the value of a source reference that may be left out
+func loadOptional(ref commoncfg.SourceRef) ([]byte, error) {
+	if ref == (commoncfg.SourceRef{}) {
+		return nil, nil
+	}
+
+	return commoncfg.LoadValueFromSourceRef(ref)
+}
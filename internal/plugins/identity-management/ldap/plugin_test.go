@@ -0,0 +1,315 @@
+package ldap_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	idmangv1 "github.com/openkcm/plugin-sdk/proto/plugin/identity_management/v1"
+	configv1 "github.com/openkcm/plugin-sdk/proto/service/common/config/v1"
+
+	"github.com/openkcm/cmk/internal/plugins/identity-management/ldap"
+)
+
+const (
+	adminsDN   = "cn=admins,ou=groups,dc=example,dc=org"
+	leadsDN    = "cn=leads,ou=groups,dc=example,dc=org"
+	auditorsDN = "cn=auditors,ou=groups,dc=example,dc=org"
+
+	nestedConfig = "groups:\n  nested: true"
+)
+
+func person(uid, name string) testEntry {
+	return testEntry{
+		dn: "uid=" + uid + ",ou=people,dc=example,dc=org",
+		attributes: map[string][]string{
+			"objectClass": {"inetOrgPerson"},
+			"uid":         {uid},
+			"cn":          {name},
+			"mail":        {uid + "@example.org"},
+		},
+	}
+}
+
+func groupOfNames(name string, members ...string) testEntry {
+	return testEntry{
+		dn: "cn=" + name + ",ou=groups,dc=example,dc=org",
+		attributes: map[string][]string{
+			"objectClass": {"groupOfNames"},
+			"cn":          {name},
+			"member":      members,
+		},
+	}
+}
+
+// testEntries is a directory where admins contain leads and leads contain
+// admins again. Auditors have a member that does not exist.
+func testEntries() []testEntry {
+	alice := person("alice", "Alice")
+	bob := person("bob", "Bob")
+	carol := person("carol", "Carol")
+
+	return []testEntry{
+		{dn: "dc=example,dc=org", attributes: map[string][]string{"objectClass": {"domain"}}},
+		{dn: "ou=people,dc=example,dc=org", attributes: map[string][]string{"objectClass": {"organizationalUnit"}}},
+		{dn: "ou=groups,dc=example,dc=org", attributes: map[string][]string{"objectClass": {"organizationalUnit"}}},
+		alice, bob, carol,
+		groupOfNames("admins", alice.dn, leadsDN),
+		groupOfNames("leads", bob.dn, adminsDN),
+		groupOfNames("auditors", carol.dn, "uid=ghost,ou=people,dc=example,dc=org"),
+	}
+}
+
+func newServerTLS(t *testing.T) (*tls.Config, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "LDAP stand-in"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	caPath := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+		MinVersion:   tls.VersionTLS12,
+	}, caPath
+}
+
+func configure(t *testing.T, url, extraConfig string) (*ldap.Plugin, error) {
+	t.Helper()
+
+	p := ldap.NewPlugin()
+	t.Cleanup(func() { _ = p.Close() })
+
+	_, err := p.Configure(t.Context(), &configv1.ConfigureRequest{
+		YamlConfiguration: `
+url: ` + url + `
+baseDN: dc=example,dc=org
+bindDN:
+  source: embedded
+  value: cn=service,dc=example,dc=org
+bindPassword:
+  source: embedded
+  value: secret
+pool:
+  size: 2
+` + extraConfig,
+	})
+
+	return p, err
+}
+
+func setupPlugin(t *testing.T, s *standIn, extraConfig string) *ldap.Plugin {
+	t.Helper()
+
+	p, err := configure(t, "ldap://127.0.0.1:"+s.port(), extraConfig)
+	require.NoError(t, err)
+
+	return p
+}
+
+func groupNames(groups []*idmangv1.Group) []string {
+	names := make([]string, 0, len(groups))
+	for _, g := range groups {
+		names = append(names, g.GetName())
+	}
+
+	return names
+}
+
+func userIDs(users []*idmangv1.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.GetId())
+	}
+
+	return ids
+}
+
+func TestConfigure(t *testing.T) {
+	for name, tc := range map[string]struct {
+		url         string
+		extraConfig string
+	}{
+		"Should fail on invalid URL":       {url: "http://localhost"},
+		"Should fail on unknown directory": {url: "ldap://localhost", extraConfig: "directory: novell"},
+		"Should fail on invalid filter":    {url: "ldap://localhost", extraConfig: "users:\n  filter: (uid="},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := configure(t, tc.url, tc.extraConfig)
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("Should fail before configuration", func(t *testing.T) {
+		_, err := ldap.NewPlugin().GetUser(t.Context(), &idmangv1.GetUserRequest{UserId: "alice"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestGetUser(t *testing.T) {
+	s := newStandIn(t, nil, testEntries())
+	p := setupPlugin(t, s, "")
+
+	t.Run("Should return user", func(t *testing.T) {
+		resp, err := p.GetUser(t.Context(), &idmangv1.GetUserRequest{UserId: "alice"})
+		require.NoError(t, err)
+		assert.Equal(t, "alice", resp.GetUser().GetId())
+		assert.Equal(t, "Alice", resp.GetUser().GetName())
+		assert.Equal(t, "alice@example.org", resp.GetUser().GetEmail())
+	})
+
+	t.Run("Should not find unknown user", func(t *testing.T) {
+		_, err := p.GetUser(t.Context(), &idmangv1.GetUserRequest{UserId: "mallory)(uid=*"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Should reuse pooled connections", func(t *testing.T) {
+		for range 5 {
+			_, err := p.GetUser(t.Context(), &idmangv1.GetUserRequest{UserId: "bob"})
+			require.NoError(t, err)
+		}
+
+		connections, _ := s.stats()
+		assert.Equal(t, 1, connections)
+	})
+
+	t.Run("Should fail on invalid bind credentials", func(t *testing.T) {
+		s := newStandIn(t, nil, testEntries())
+		s.bindPassword = "rotated"
+		p := setupPlugin(t, s, "")
+
+		_, err := p.GetUser(t.Context(), &idmangv1.GetUserRequest{UserId: "alice"})
+		require.Error(t, err)
+		assert.NotEqual(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestGetGroup(t *testing.T) {
+	s := newStandIn(t, nil, testEntries())
+	p := setupPlugin(t, s, "")
+
+	resp, err := p.GetGroup(t.Context(), &idmangv1.GetGroupRequest{GroupName: "admins"})
+	require.NoError(t, err)
+	assert.Equal(t, adminsDN, resp.GetGroup().GetId())
+	assert.Equal(t, "admins", resp.GetGroup().GetName())
+
+	_, err = p.GetGroup(t.Context(), &idmangv1.GetGroupRequest{GroupName: "operators"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetAllGroups(t *testing.T) {
+	s := newStandIn(t, nil, testEntries())
+	p := setupPlugin(t, s, "pageSize: 2")
+
+	resp, err := p.GetAllGroups(t.Context(), &idmangv1.GetAllGroupsRequest{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"admins", "leads", "auditors"}, groupNames(resp.GetGroups()))
+
+	_, pages := s.stats()
+	assert.Equal(t, 2, pages)
+}
+
+func TestGetUsersForGroup(t *testing.T) {
+	s := newStandIn(t, nil, testEntries())
+
+	for name, tc := range map[string]struct {
+		config   string
+		groupID  string
+		expected []string
+	}{
+		"Should return direct members": {
+			groupID: adminsDN, expected: []string{"alice"},
+		},
+		"Should resolve nested groups": {
+			config: nestedConfig, groupID: adminsDN, expected: []string{"alice", "bob"},
+		},
+		"Should resolve cyclic nested groups once": {
+			config: nestedConfig, groupID: leadsDN, expected: []string{"bob", "alice"},
+		},
+		"Should skip members that do not exist": {
+			groupID: auditorsDN, expected: []string{"carol"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			p := setupPlugin(t, s, tc.config)
+
+			resp, err := p.GetUsersForGroup(t.Context(), &idmangv1.GetUsersForGroupRequest{GroupId: tc.groupID})
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expected, userIDs(resp.GetUsers()))
+		})
+	}
+
+	t.Run("Should not find unknown group", func(t *testing.T) {
+		p := setupPlugin(t, s, "")
+
+		_, err := p.GetUsersForGroup(t.Context(), &idmangv1.GetUsersForGroupRequest{
+			GroupId: "cn=operators,ou=groups,dc=example,dc=org",
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestGetGroupsForUser(t *testing.T) {
+	s := newStandIn(t, nil, testEntries())
+
+	p := setupPlugin(t, s, "")
+	resp, err := p.GetGroupsForUser(t.Context(), &idmangv1.GetGroupsForUserRequest{UserId: "bob"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"leads"}, groupNames(resp.GetGroups()))
+
+	p = setupPlugin(t, s, nestedConfig)
+	resp, err = p.GetGroupsForUser(t.Context(), &idmangv1.GetGroupsForUserRequest{UserId: "bob"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"leads", "admins"}, groupNames(resp.GetGroups()))
+
+	_, err = p.GetGroupsForUser(t.Context(), &idmangv1.GetGroupsForUserRequest{UserId: "mallory"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestLDAPS(t *testing.T) {
+	tlsConfig, caPath := newServerTLS(t)
+	s := newStandIn(t, tlsConfig, testEntries())
+
+	p, err := configure(t, "ldaps://127.0.0.1:"+s.port(), fmt.Sprintf(`
+caCertificate:
+  source: file
+  file:
+    path: %s`, caPath))
+	require.NoError(t, err)
+
+	resp, err := p.GetUser(t.Context(), &idmangv1.GetUserRequest{UserId: "carol"})
+	require.NoError(t, err)
+	assert.Equal(t, "Carol", resp.GetUser().GetName())
+}
@@ -0,0 +1,100 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// pool holds connections bound with the service account. At most size
+// connections are open at a time, idle connections are closed once they
+// exceed the idle timeout.
+type pool struct {
+	dial        func() (*ldap.Conn, error)
+	slots       chan struct{}
+	idle        chan *pooledConn
+	idleTimeout time.Duration
+}
+
+type pooledConn struct {
+	*ldap.Conn
+
+	lastUsed time.Time
+}
+
+func newPool(size int, idleTimeout time.Duration, dial func() (*ldap.Conn, error)) *pool {
+	return &pool{
+		dial:        dial,
+		slots:       make(chan struct{}, size),
+		idle:        make(chan *pooledConn, size),
+		idleTimeout: idleTimeout,
+	}
+}
+
+// get returns an idle connection or dials a new one. It blocks while all
+// connections are in use.
+func (p *pool) get(ctx context.Context) (*pooledConn, error) {
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if c := p.idleConn(); c != nil {
+		return c, nil
+	}
+
+	conn, err := p.dial()
+	if err != nil {
+		<-p.slots
+		return nil, err
+	}
+
+	return &pooledConn{Conn: conn}, nil
+}
+
+// idleConn returns an idle connection still usable, or nil if none
+func (p *pool) idleConn() *pooledConn {
+	for {
+		select {
+		case c := <-p.idle:
+			if c.IsClosing() || time.Since(c.lastUsed) > p.idleTimeout {
+				_ = c.Close()
+				continue
+			}
+
+			return c
+		default:
+			return nil
+		}
+	}
+}
+
+// put returns the connection after use. Connections failing with other
+// than LDAP result errors are closed, as their state is unknown.
+func (p *pool) put(c *pooledConn, err error) {
+	defer func() { <-p.slots }()
+
+	var ldapErr *ldap.Error
+	if c.IsClosing() || err != nil && (!errors.As(err, &ldapErr) || ldapErr.ResultCode >= ldap.ErrorNetwork) {
+		_ = c.Close()
+		return
+	}
+
+	c.lastUsed = time.Now()
+	p.idle <- c
+}
+
+// close closes the idle connections
+func (p *pool) close() {
+	for {
+		select {
+		case c := <-p.idle:
+			_ = c.Close()
+		default:
+			return
+		}
+	}
+}
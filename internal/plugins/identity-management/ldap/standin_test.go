@@ -0,0 +1,293 @@
+package ldap_test
+
+import (
+	"crypto/tls"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/require"
+)
+
+type testEntry struct {
+	dn         string
+	attributes map[string][]string
+}
+
+// standIn is a local LDAP stand-in serving binds and paged searches on
+// a fixed set of entries. Filters support and, or, not, equality and
+// presence.
+type standIn struct {
+	listener     net.Listener
+	entries      []testEntry
+	bindDN       string
+	bindPassword string
+
+	mu          sync.Mutex
+	connections int
+	pages       int
+}
+
+func newStandIn(t *testing.T, tlsConfig *tls.Config, entries []testEntry) *standIn {
+	t.Helper()
+
+	s := &standIn{
+		entries:      entries,
+		bindDN:       "cn=service,dc=example,dc=org",
+		bindPassword: "secret",
+	}
+
+	var err error
+	if tlsConfig != nil {
+		s.listener, err = tls.Listen("tcp", "127.0.0.1:0", tlsConfig)
+	} else {
+		s.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = s.listener.Close() })
+
+	go s.serve()
+
+	return s
+}
+
+func (s *standIn) port() string {
+	return strconv.Itoa(s.listener.Addr().(*net.TCPAddr).Port)
+}
+
+func (s *standIn) stats() (int, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.connections, s.pages
+}
+
+func (s *standIn) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		s.connections++
+		s.mu.Unlock()
+
+		go s.handle(conn)
+	}
+}
+
+func (s *standIn) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+
+		messageID, _ := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			code := uint16(ldap.LDAPResultSuccess)
+			if op.Children[1].Data.String() != s.bindDN || op.Children[2].Data.String() != s.bindPassword {
+				code = ldap.LDAPResultInvalidCredentials
+			}
+
+			s.write(conn, messageID, result(ldap.ApplicationBindResponse, code), nil)
+		case ldap.ApplicationSearchRequest:
+			s.search(conn, messageID, op, controls(packet))
+		default:
+			return
+		}
+	}
+}
+
+func (s *standIn) search(conn net.Conn, messageID int64, op *ber.Packet, requestControls []ldap.Control) {
+	baseDN := op.Children[0].Data.String()
+	scope, _ := op.Children[1].Value.(int64)
+	filter := op.Children[6]
+
+	var attributes []string
+	for _, a := range op.Children[7].Children {
+		attributes = append(attributes, a.Data.String())
+	}
+
+	var matches []testEntry
+
+	baseFound := false
+
+	for _, e := range s.entries {
+		isBase := strings.EqualFold(e.dn, baseDN)
+		baseFound = baseFound || isBase
+
+		inScope := isBase || scope == ldap.ScopeWholeSubtree && hasSuffixFold(e.dn, ","+baseDN)
+		if inScope && matchFilter(filter, e) {
+			matches = append(matches, e)
+		}
+	}
+
+	if !baseFound {
+		s.write(conn, messageID, result(ldap.ApplicationSearchResultDone, ldap.LDAPResultNoSuchObject), nil)
+		return
+	}
+
+	var responseControl *ldap.ControlPaging
+
+	if c, ok := ldap.FindControl(requestControls, ldap.ControlTypePaging).(*ldap.ControlPaging); ok {
+		if c.PagingSize == 0 {
+			s.write(conn, messageID, result(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess), nil)
+			return
+		}
+
+		total := len(matches)
+		offset, _ := strconv.Atoi(string(c.Cookie))
+		end := min(offset+int(c.PagingSize), total)
+		matches = matches[offset:end]
+
+		responseControl = ldap.NewControlPaging(c.PagingSize)
+		if end < total {
+			responseControl.SetCookie([]byte(strconv.Itoa(end)))
+		}
+
+		s.mu.Lock()
+		s.pages++
+		s.mu.Unlock()
+	}
+
+	for _, e := range matches {
+		s.write(conn, messageID, searchEntry(e, attributes), nil)
+	}
+
+	var responseControls []ldap.Control
+	if responseControl != nil {
+		responseControls = append(responseControls, responseControl)
+	}
+
+	s.write(conn, messageID, result(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess), responseControls)
+}
+
+func (s *standIn) write(conn net.Conn, messageID int64, op *ber.Packet, responseControls []ldap.Control) {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+	packet.AppendChild(op)
+
+	if len(responseControls) > 0 {
+		c := ber.Encode(ber.ClassContext, ber.TypeConstructed, 0, nil, "Controls")
+		for _, control := range responseControls {
+			c.AppendChild(control.Encode())
+		}
+
+		packet.AppendChild(c)
+	}
+
+	_, _ = conn.Write(packet.Bytes())
+}
+
+func result(tag ber.Tag, code uint16) *ber.Packet {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "Code"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Message"))
+
+	return op
+}
+
+func searchEntry(e testEntry, attributes []string) *ber.Packet {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Entry")
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.dn, "DN"))
+
+	list := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+
+	for name, values := range e.attributes {
+		if len(attributes) > 0 && !slices.ContainsFunc(attributes, func(a string) bool {
+			return strings.EqualFold(a, name)
+		}) {
+			continue
+		}
+
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, v := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+		}
+
+		attribute.AppendChild(set)
+		list.AppendChild(attribute)
+	}
+
+	op.AppendChild(list)
+
+	return op
+}
+
+func controls(packet *ber.Packet) []ldap.Control {
+	if len(packet.Children) < 3 {
+		return nil
+	}
+
+	var result []ldap.Control
+
+	for _, child := range packet.Children[2].Children {
+		c, err := ldap.DecodeControl(child)
+		if err == nil {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+func matchFilter(filter *ber.Packet, e testEntry) bool {
+	switch filter.Tag {
+	case ldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !matchFilter(child, e) {
+				return false
+			}
+		}
+
+		return true
+	case ldap.FilterOr:
+		return slices.ContainsFunc(filter.Children, func(child *ber.Packet) bool {
+			return matchFilter(child, e)
+		})
+	case ldap.FilterNot:
+		return !matchFilter(filter.Children[0], e)
+	case ldap.FilterEqualityMatch:
+		values := attributeValues(e, filter.Children[0].Data.String())
+
+		return slices.ContainsFunc(values, func(v string) bool {
+			return strings.EqualFold(v, filter.Children[1].Data.String())
+		})
+	case ldap.FilterPresent:
+		return len(attributeValues(e, filter.Data.String())) > 0
+	default:
+		return false
+	}
+}
+
+func attributeValues(e testEntry, name string) []string {
+	for n, values := range e.attributes {
+		if strings.EqualFold(n, name) {
+			return values
+		}
+	}
+
+	return nil
+}
+
+func hasSuffixFold(s, suffix string) bool {
+	return len(s) >= len(suffix) && strings.EqualFold(s[len(s)-len(suffix):], suffix)
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"time"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+)
+
+type Config struct {
+	// Library is the path of the PKCS#11 module of the HSM vendor
+	Library string `yaml:"library"`
+	// TokenLabel selects the token holding the keys
+	TokenLabel string `yaml:"tokenLabel"`
+	// PIN is the PIN of the user of the token
+	PIN commoncfg.SourceRef `yaml:"pin"`
+	// Region is the region reported for the keys of the token. The HSM
+	// is on premise, so the region can't be read from a cloud.
+	Region string `yaml:"region"`
+	// AllowedRegions are the regions accepted on key creation and mapped
+	// to the region of the token. Defaults to the region of the token.
+	AllowedRegions []string `yaml:"allowedRegions"`
+	// RotationPeriod rotates the keys once their latest version is older.
+	// Keys are not rotated without it.
+	RotationPeriod time.Duration `yaml:"rotationPeriod"`
+	// ImportValidity is how long import parameters can be used
+	ImportValidity time.Duration `yaml:"importValidity"`
+}
@@ -0,0 +1,21 @@
+// Package hsm wraps the PKCS#11 objects of a token keeping the keys of the
+// PKCS#11 keystore. Every key is a data object holding its metadata and a
+// secret key object per version, all labelled with the key ID.
+package hsm
+
+import (
+	"errors"
+
+	"github.com/samber/oops"
+)
+
+var (
+	ErrLoadModule         = errors.New("failed to load PKCS#11 module")
+	ErrTokenNotFound      = errors.New("token not found")
+	ErrNotFound           = errors.New("object not found")
+	ErrExists             = errors.New("object already exists")
+	ErrInvalidKeyMaterial = errors.New("invalid wrapped key material")
+	ErrCgoDisabled        = errors.New("PKCS#11 requires a build with cgo")
+
+	ErrHSM = oops.In("HSM")
+)
@@ -0,0 +1,433 @@
+//go:build cgo
+
+package hsm
+
+import (
+	"crypto/rsa"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/miekg/pkcs11"
+)
+
+const (
+	// application marks the data objects holding key metadata
+	application     = "openkcm-cmk"
+	aesKeySize      = 32
+	wrappingKeyBits = 3072
+	findBatchSize   = 64
+)
+
+var rsaPublicExponent = []byte{0x01, 0x00, 0x01}
+
+// Token is a logged in session on a PKCS#11 token.
+// A Token is not safe for concurrent use.
+type Token struct {
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+}
+
+// Open loads the PKCS#11 module and logs in as user on the token with the label
+func Open(library, tokenLabel, pin string) (*Token, error) {
+	ctx := pkcs11.New(library)
+	if ctx == nil {
+		return nil, ErrHSM.Wrapf(ErrLoadModule, "loading %s", library)
+	}
+
+	err := ctx.Initialize()
+	if err != nil && !isError(err, pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		ctx.Destroy()
+		return nil, ErrHSM.Wrapf(err, "initializing module")
+	}
+
+	t := &Token{ctx: ctx}
+
+	err = t.open(tokenLabel, pin)
+	if err != nil {
+		_ = ctx.Finalize()
+		ctx.Destroy()
+
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Close logs out and unloads the module
+func (t *Token) Close() error {
+	_ = t.ctx.Logout(t.session)
+	_ = t.ctx.CloseSession(t.session)
+
+	err := t.ctx.Finalize()
+	t.ctx.Destroy()
+
+	if err != nil {
+		return ErrHSM.Wrapf(err, "finalizing module")
+	}
+
+	return nil
+}
+
+// Metadata returns the metadata of the key
+func (t *Token) Metadata(label string) ([]byte, error) {
+	handles, err := t.find(metadataTemplate(label))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(handles) == 0 {
+		return nil, ErrNotFound
+	}
+
+	attrs, err := t.ctx.GetAttributeValue(t.session, handles[0], []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil),
+	})
+	if err != nil {
+		return nil, ErrHSM.Wrapf(err, "reading metadata of %s", label)
+	}
+
+	return attrs[0].Value, nil
+}
+
+// CreateMetadata creates the metadata of a new key
+func (t *Token) CreateMetadata(label string, value []byte) error {
+	handles, err := t.find(metadataTemplate(label))
+	if err != nil {
+		return err
+	}
+
+	if len(handles) > 0 {
+		return ErrExists
+	}
+
+	return t.createMetadata(label, value)
+}
+
+// UpdateMetadata replaces the metadata of the key. The new object is
+// created before the old one is destroyed, so the metadata is never lost.
+func (t *Token) UpdateMetadata(label string, value []byte) error {
+	handles, err := t.find(metadataTemplate(label))
+	if err != nil {
+		return err
+	}
+
+	if len(handles) == 0 {
+		return ErrNotFound
+	}
+
+	err = t.createMetadata(label, value)
+	if err != nil {
+		return err
+	}
+
+	return t.destroy(handles)
+}
+
+// GenerateAESKey generates the AES-256 key of a key version. The key
+// never leaves the token.
+func (t *Token) GenerateAESKey(label, id string) error {
+	_, err := t.ctx.GenerateKey(t.session,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_GEN, nil)},
+		append(aesKeyTemplate(label, id), pkcs11.NewAttribute(pkcs11.CKA_VALUE_LEN, aesKeySize)),
+	)
+	if err != nil {
+		return ErrHSM.Wrapf(err, "generating key %s", id)
+	}
+
+	return nil
+}
+
+// SetKeysUsable allows or forbids the use of all versions of the key
+// for encryption and wrapping
+func (t *Token) SetKeysUsable(label string, usable bool) error {
+	handles, err := t.find([]*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, h := range handles {
+		err = t.ctx.SetAttributeValue(t.session, h, usageTemplate(usable))
+		if err != nil {
+			return ErrHSM.Wrapf(err, "changing usage of %s", label)
+		}
+	}
+
+	return nil
+}
+
+// GenerateWrappingKey replaces the RSA wrapping key pair of the key
+// and returns its public key
+func (t *Token) GenerateWrappingKey(label, id string) (*rsa.PublicKey, error) {
+	err := t.DestroyWrappingKey(label)
+	if err != nil {
+		return nil, err
+	}
+
+	public, _, err := t.ctx.GenerateKeyPair(t.session,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_KEY_PAIR_GEN, nil)},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+			pkcs11.NewAttribute(pkcs11.CKA_ID, []byte(id)),
+			pkcs11.NewAttribute(pkcs11.CKA_WRAP, true),
+			pkcs11.NewAttribute(pkcs11.CKA_ENCRYPT, true),
+			pkcs11.NewAttribute(pkcs11.CKA_MODULUS_BITS, wrappingKeyBits),
+			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, rsaPublicExponent),
+		},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+			pkcs11.NewAttribute(pkcs11.CKA_ID, []byte(id)),
+			pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+			pkcs11.NewAttribute(pkcs11.CKA_UNWRAP, true),
+			pkcs11.NewAttribute(pkcs11.CKA_DECRYPT, true),
+		},
+	)
+	if err != nil {
+		return nil, ErrHSM.Wrapf(err, "generating wrapping key of %s", label)
+	}
+
+	attrs, err := t.ctx.GetAttributeValue(t.session, public, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_MODULUS, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, nil),
+	})
+	if err != nil {
+		return nil, ErrHSM.Wrapf(err, "reading wrapping key of %s", label)
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(attrs[0].Value),
+		E: int(new(big.Int).SetBytes(attrs[1].Value).Int64()),
+	}, nil
+}
+
+// UnwrapAESKey unwraps RSA-OAEP SHA-256 wrapped AES-256 key material with
+// the wrapping key of the key into the key version with the id
+func (t *Token) UnwrapAESKey(label, wrappingKeyID, id string, wrapped []byte) error {
+	handles, err := t.find([]*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, []byte(wrappingKeyID)),
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(handles) == 0 {
+		return ErrNotFound
+	}
+
+	oaep := pkcs11.NewOAEPParams(pkcs11.CKM_SHA256, pkcs11.CKG_MGF1_SHA256, pkcs11.CKZ_DATA_SPECIFIED, nil)
+
+	key, err := t.ctx.UnwrapKey(t.session,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_OAEP, oaep)},
+		handles[0], wrapped, aesKeyTemplate(label, id),
+	)
+	if isError(err, pkcs11.CKR_WRAPPED_KEY_INVALID, pkcs11.CKR_WRAPPED_KEY_LEN_RANGE,
+		pkcs11.CKR_ENCRYPTED_DATA_INVALID, pkcs11.CKR_ENCRYPTED_DATA_LEN_RANGE) {
+		return ErrInvalidKeyMaterial
+	}
+
+	if err != nil {
+		return ErrHSM.Wrapf(err, "unwrapping key %s", id)
+	}
+
+	attrs, err := t.ctx.GetAttributeValue(t.session, key, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE_LEN, nil),
+	})
+	if err != nil {
+		return ErrHSM.Wrapf(err, "reading key %s", id)
+	}
+
+	if ulong(attrs[0].Value) != aesKeySize {
+		_ = t.destroy([]pkcs11.ObjectHandle{key})
+		return ErrInvalidKeyMaterial
+	}
+
+	return nil
+}
+
+// DestroyWrappingKey destroys the RSA wrapping key pair of the key
+func (t *Token) DestroyWrappingKey(label string) error {
+	for _, class := range []uint{pkcs11.CKO_PRIVATE_KEY, pkcs11.CKO_PUBLIC_KEY} {
+		handles, err := t.find([]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = t.destroy(handles)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Destroy destroys all objects of the key
+func (t *Token) Destroy(label string) error {
+	handles, err := t.find([]*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	})
+	if err != nil {
+		return err
+	}
+
+	return t.destroy(handles)
+}
+
+func (t *Token) open(tokenLabel, pin string) error {
+	slots, err := t.ctx.GetSlotList(true)
+	if err != nil {
+		return ErrHSM.Wrapf(err, "listing slots")
+	}
+
+	for _, slot := range slots {
+		info, err := t.ctx.GetTokenInfo(slot)
+		if err != nil {
+			return ErrHSM.Wrapf(err, "reading token of slot %d", slot)
+		}
+
+		if strings.TrimSpace(info.Label) != tokenLabel {
+			continue
+		}
+
+		t.session, err = t.ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+		if err != nil {
+			return ErrHSM.Wrapf(err, "opening session on %s", tokenLabel)
+		}
+
+		err = t.ctx.Login(t.session, pkcs11.CKU_USER, pin)
+		if err != nil && !isError(err, pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+			_ = t.ctx.CloseSession(t.session)
+			return ErrHSM.Wrapf(err, "logging in to %s", tokenLabel)
+		}
+
+		return nil
+	}
+
+	return ErrHSM.Wrapf(ErrTokenNotFound, "token %s", tokenLabel)
+}
+
+func (t *Token) createMetadata(label string, value []byte) error {
+	template := append(metadataTemplate(label),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, value),
+	)
+
+	_, err := t.ctx.CreateObject(t.session, template)
+	if err != nil {
+		return ErrHSM.Wrapf(err, "creating metadata of %s", label)
+	}
+
+	return nil
+}
+
+func (t *Token) find(template []*pkcs11.Attribute) ([]pkcs11.ObjectHandle, error) {
+	err := t.ctx.FindObjectsInit(t.session, template)
+	if err != nil {
+		return nil, ErrHSM.Wrapf(err, "finding objects")
+	}
+
+	var handles []pkcs11.ObjectHandle
+
+	for {
+		batch, _, err := t.ctx.FindObjects(t.session, findBatchSize)
+		if err != nil {
+			_ = t.ctx.FindObjectsFinal(t.session)
+			return nil, ErrHSM.Wrapf(err, "finding objects")
+		}
+
+		if len(batch) == 0 {
+			break
+		}
+
+		handles = append(handles, batch...)
+	}
+
+	err = t.ctx.FindObjectsFinal(t.session)
+	if err != nil {
+		return nil, ErrHSM.Wrapf(err, "finding objects")
+	}
+
+	return handles, nil
+}
+
+func (t *Token) destroy(handles []pkcs11.ObjectHandle) error {
+	for _, h := range handles {
+		err := t.ctx.DestroyObject(t.session, h)
+		if err != nil {
+			return ErrHSM.Wrapf(err, "destroying object")
+		}
+	}
+
+	return nil
+}
+
+func metadataTemplate(label string) []*pkcs11.Attribute {
+	return []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_DATA),
+		pkcs11.NewAttribute(pkcs11.CKA_APPLICATION, application),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+}
+
+func aesKeyTemplate(label, id string) []*pkcs11.Attribute {
+	return append([]*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, []byte(id)),
+	}, usageTemplate(true)...)
+}
+
+func usageTemplate(usable bool) []*pkcs11.Attribute {
+	return []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_ENCRYPT, usable),
+		pkcs11.NewAttribute(pkcs11.CKA_DECRYPT, usable),
+		pkcs11.NewAttribute(pkcs11.CKA_WRAP, usable),
+		pkcs11.NewAttribute(pkcs11.CKA_UNWRAP, usable),
+	}
+}
+
+// ulong decodes a CK_ULONG attribute value
+func ulong(b []byte) uint64 {
+	switch len(b) {
+	case 8:
+		return binary.NativeEndian.Uint64(b)
+	case 4:
+		return uint64(binary.NativeEndian.Uint32(b))
+	default:
+		return 0
+	}
+}
+
+func isError(err error, codes ...uint) bool {
+	var p11Err pkcs11.Error
+	if !errors.As(err, &p11Err) {
+		return false
+	}
+
+	for _, code := range codes {
+		if uint(p11Err) == code {
+			return true
+		}
+	}
+
+	return false
+}
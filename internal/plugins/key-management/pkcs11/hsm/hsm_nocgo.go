@@ -0,0 +1,37 @@
+//go:build !cgo
+
+package hsm
+
+import (
+	"crypto/rsa"
+)
+
+// Token is unavailable without cgo, as PKCS#11 modules are C libraries
+type Token struct{}
+
+// Open always fails without cgo
+func Open(_, _, _ string) (*Token, error) {
+	return nil, ErrHSM.Wrapf(ErrCgoDisabled, "opening token")
+}
+
+func (t *Token) Close() error { return ErrCgoDisabled }
+
+func (t *Token) Metadata(string) ([]byte, error) { return nil, ErrCgoDisabled }
+
+func (t *Token) CreateMetadata(string, []byte) error { return ErrCgoDisabled }
+
+func (t *Token) UpdateMetadata(string, []byte) error { return ErrCgoDisabled }
+
+func (t *Token) GenerateAESKey(string, string) error { return ErrCgoDisabled }
+
+func (t *Token) SetKeysUsable(string, bool) error { return ErrCgoDisabled }
+
+func (t *Token) GenerateWrappingKey(string, string) (*rsa.PublicKey, error) {
+	return nil, ErrCgoDisabled
+}
+
+func (t *Token) UnwrapAESKey(string, string, string, []byte) error { return ErrCgoDisabled }
+
+func (t *Token) DestroyWrappingKey(string) error { return ErrCgoDisabled }
+
+func (t *Token) Destroy(string) error { return ErrCgoDisabled }
@@ -0,0 +1,126 @@
+//go:build cgo
+
+package hsm_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/cmk/internal/plugins/key-management/pkcs11/hsm"
+	"github.com/openkcm/cmk/internal/testutils"
+)
+
+func openToken(t *testing.T) *hsm.Token {
+	t.Helper()
+
+	library := testutils.StartSoftHSM(t)
+
+	token, err := hsm.Open(library, testutils.SoftHSMTokenLabel, testutils.SoftHSMPIN)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = token.Close() })
+
+	return token
+}
+
+func TestOpen(t *testing.T) {
+	library := testutils.StartSoftHSM(t)
+
+	t.Run("Should fail on unknown token", func(t *testing.T) {
+		_, err := hsm.Open(library, "unknown", testutils.SoftHSMPIN)
+		assert.ErrorIs(t, err, hsm.ErrTokenNotFound)
+	})
+
+	t.Run("Should fail on wrong pin", func(t *testing.T) {
+		_, err := hsm.Open(library, testutils.SoftHSMTokenLabel, "wrong")
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail on unknown module", func(t *testing.T) {
+		_, err := hsm.Open(t.TempDir()+"/missing.so", testutils.SoftHSMTokenLabel, testutils.SoftHSMPIN)
+		assert.ErrorIs(t, err, hsm.ErrLoadModule)
+	})
+}
+
+func TestMetadata(t *testing.T) {
+	token := openToken(t)
+
+	_, err := token.Metadata("key")
+	assert.ErrorIs(t, err, hsm.ErrNotFound)
+	assert.ErrorIs(t, token.UpdateMetadata("key", []byte("v1")), hsm.ErrNotFound)
+
+	require.NoError(t, token.CreateMetadata("key", []byte("v1")))
+	assert.ErrorIs(t, token.CreateMetadata("key", []byte("v1")), hsm.ErrExists)
+
+	require.NoError(t, token.UpdateMetadata("key", []byte("v2")))
+
+	value, err := token.Metadata("key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("v2"), value)
+
+	require.NoError(t, token.Destroy("key"))
+
+	_, err = token.Metadata("key")
+	assert.ErrorIs(t, err, hsm.ErrNotFound)
+}
+
+func TestKeys(t *testing.T) {
+	token := openToken(t)
+
+	require.NoError(t, token.GenerateAESKey("key", "key/1"))
+	require.NoError(t, token.GenerateAESKey("key", "key/2"))
+	require.NoError(t, token.SetKeysUsable("key", false))
+	require.NoError(t, token.SetKeysUsable("key", true))
+	require.NoError(t, token.Destroy("key"))
+}
+
+func TestUnwrapAESKey(t *testing.T) {
+	token := openToken(t)
+
+	publicKey, err := token.GenerateWrappingKey("key", "key/import")
+	require.NoError(t, err)
+
+	wrap := func(t *testing.T, material []byte) []byte {
+		t.Helper()
+
+		wrapped, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, publicKey, material, nil)
+		require.NoError(t, err)
+
+		return wrapped
+	}
+
+	t.Run("Should reject material of wrong size", func(t *testing.T) {
+		err := token.UnwrapAESKey("key", "key/import", "key/1", wrap(t, make([]byte, 16)))
+		assert.ErrorIs(t, err, hsm.ErrInvalidKeyMaterial)
+	})
+
+	t.Run("Should reject corrupted material", func(t *testing.T) {
+		wrapped := wrap(t, make([]byte, 32))
+		wrapped[0] ^= 0xff
+
+		err := token.UnwrapAESKey("key", "key/import", "key/1", wrapped)
+		assert.ErrorIs(t, err, hsm.ErrInvalidKeyMaterial)
+	})
+
+	t.Run("Should fail on unknown wrapping key", func(t *testing.T) {
+		err := token.UnwrapAESKey("key", "key/other", "key/1", wrap(t, make([]byte, 32)))
+		assert.ErrorIs(t, err, hsm.ErrNotFound)
+	})
+
+	t.Run("Should unwrap material", func(t *testing.T) {
+		material := make([]byte, 32)
+		_, err := rand.Read(material)
+		require.NoError(t, err)
+
+		require.NoError(t, token.UnwrapAESKey("key", "key/import", "key/1", wrap(t, material)))
+		require.NoError(t, token.DestroyWrappingKey("key"))
+
+		err = token.UnwrapAESKey("key", "key/import", "key/2", wrap(t, material))
+		assert.ErrorIs(t, err, hsm.ErrNotFound)
+	})
+}
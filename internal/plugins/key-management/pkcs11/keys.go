@@ -0,0 +1,80 @@
+package pkcs11
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/keymanagement"
+)
+
+// Key states as expected by CMK
+const (
+	keyStatusEnabled         = "ENABLED"
+	keyStatusDisabled        = "DISABLED"
+	keyStatusPendingImport   = "PENDING_IMPORT"
+	keyStatusPendingDeletion = "PENDING_DELETION"
+	keyUsage                 = "ENCRYPT_DECRYPT"
+)
+
+// keyMetadata is the metadata of a key as stored in a data object on the
+// token. The key material of the versions stays in secret key objects.
+type keyMetadata struct {
+	ID        string          `json:"id"`
+	Algorithm int32           `json:"algorithm"`
+	KeyType   int32           `json:"keyType"`
+	Region    string          `json:"region"`
+	Status    string          `json:"status"`
+	Versions  []keyVersion    `json:"versions"`
+	Import    *importMetadata `json:"import,omitempty"`
+	// DeleteAt is set once deletion is scheduled
+	DeleteAt *time.Time `json:"deleteAt,omitempty"`
+}
+
+type keyVersion struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// importMetadata is the wrapping key of a pending import
+type importMetadata struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func (k *keyMetadata) latestVersion() *keyVersion {
+	if len(k.Versions) == 0 {
+		return nil
+	}
+
+	return &k.Versions[len(k.Versions)-1]
+}
+
+func (k *keyMetadata) nextVersionID() string {
+	return strconv.Itoa(len(k.Versions) + 1)
+}
+
+// rotationDue returns true if the latest version of an enabled key is
+// older than the rotation period. BYOK keys are never rotated, as their
+// material is imported by the customer and can't be generated on the HSM.
+func (k *keyMetadata) rotationDue(now time.Time, period time.Duration) bool {
+	latest := k.latestVersion()
+
+	return period > 0 && latest != nil && k.Status == keyStatusEnabled &&
+		keymanagement.KeyType(k.KeyType) != keymanagement.BYOK &&
+		!now.Before(latest.CreatedAt.Add(period))
+}
+
+// deleted returns true once the deletion window has passed
+func (k *keyMetadata) deleted(now time.Time) bool {
+	return k.DeleteAt != nil && !now.Before(*k.DeleteAt)
+}
+
+// versionObjectID is the CKA_ID of the secret key of a key version
+func versionObjectID(keyID, versionID string) string {
+	return keyID + "/" + versionID
+}
+
+// importObjectID is the CKA_ID of the wrapping key of an import
+func importObjectID(keyID, token string) string {
+	return keyID + "/import/" + token
+}
@@ -0,0 +1,654 @@
+// Package pkcs11 is a built-in keystore keeping AES keys on a token of an
+// on premise HSM through its PKCS#11 module. The key material never leaves
+// the HSM; key versions are secret key objects and the key metadata is kept
+// in data objects on the same token. The plugin is tested against SoftHSM2.
+package pkcs11
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-hclog"
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/openkcm/plugin-sdk/pkg/catalog"
+	"github.com/openkcm/plugin-sdk/pkg/hclog2slog"
+	"github.com/samber/oops"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+
+	keymanagementv1 "github.com/openkcm/plugin-sdk/proto/plugin/keystore/operations/v1"
+	configv1 "github.com/openkcm/plugin-sdk/proto/service/common/config/v1"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/keymanagement"
+	"github.com/openkcm/cmk/internal/plugins/key-management/pkcs11/config"
+	"github.com/openkcm/cmk/internal/plugins/key-management/pkcs11/hsm"
+)
+
+const (
+	defaultImportValidity = 24 * time.Hour
+)
+
+var (
+	ErrPKCS11 = oops.In("PKCS#11 keystore plugin")
+
+	ErrNotConfigured      = status.Error(codes.FailedPrecondition, "PKCS#11 keystore is not configured")
+	ErrKeyNotFound        = status.Error(codes.NotFound, "key not found")
+	ErrKeyExists          = status.Error(codes.AlreadyExists, "key already exists")
+	ErrUnsupportedAlg     = status.Error(codes.InvalidArgument, "only AES256 keys are supported")
+	ErrUnsupportedRegion  = status.Error(codes.InvalidArgument, "region is not served by the HSM")
+	ErrKeyPendingImport   = status.Error(codes.FailedPrecondition, "key material has not been imported")
+	ErrKeyNotImportable   = status.Error(codes.FailedPrecondition, "key does not accept key material")
+	ErrImportExpired      = status.Error(codes.FailedPrecondition, "import parameters expired or unknown")
+	ErrInvalidKeyMaterial = status.Error(codes.InvalidArgument, "invalid wrapped key material")
+)
+
+func Register(registry catalog.BuiltInPluginRegistry) {
+	registry.Register(builtin(NewPlugin()))
+}
+
+func builtin(p *Plugin) catalog.BuiltInPlugin {
+	return catalog.MakeBuiltIn("pkcs11",
+		keymanagementv1.KeystoreInstanceKeyOperationPluginServer(p),
+		configv1.ConfigServiceServer(p))
+}
+
+type Plugin struct {
+	keymanagementv1.UnsafeKeystoreInstanceKeyOperationServer
+	configv1.UnsafeConfigServer
+
+	logger    *slog.Logger
+	buildInfo string
+
+	// mu serializes the use of the token session
+	mu             sync.Mutex
+	token          *hsm.Token
+	region         string
+	allowedRegions []string
+	rotationPeriod time.Duration
+	importValidity time.Duration
+}
+
+var (
+	_ keymanagementv1.KeystoreInstanceKeyOperationServer = (*Plugin)(nil)
+	_ configv1.ConfigServer                              = (*Plugin)(nil)
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{
+		buildInfo:      "{}",
+		importValidity: defaultImportValidity,
+	}
+}
+
+func (p *Plugin) SetLogger(logger hclog.Logger) {
+	p.logger = hclog2slog.New(logger)
+}
+
+func (p *Plugin) Configure(
+	_ context.Context,
+	req *configv1.ConfigureRequest,
+) (*configv1.ConfigureResponse, error) {
+	slog.Info("Configuring plugin")
+
+	cfg := &config.Config{}
+
+	err := yaml.Unmarshal([]byte(req.GetYamlConfiguration()), cfg)
+	if err != nil {
+		return nil, ErrPKCS11.Wrapf(err, "Failed to get yaml Configuration")
+	}
+
+	if cfg.Library == "" || cfg.TokenLabel == "" || cfg.Region == "" {
+		return nil, ErrPKCS11.Errorf("library, tokenLabel and region are required")
+	}
+
+	pin, err := commoncfg.LoadValueFromSourceRef(cfg.PIN)
+	if err != nil {
+		return nil, ErrPKCS11.Wrapf(err, "Failed loading pin")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// The module is shared by all sessions of the process, so the old
+	// session is closed before the module is initialized again
+	if p.token != nil {
+		_ = p.token.Close()
+		p.token = nil
+	}
+
+	token, err := hsm.Open(cfg.Library, cfg.TokenLabel, strings.TrimSpace(string(pin)))
+	if err != nil {
+		return nil, ErrPKCS11.Wrapf(err, "Failed opening token")
+	}
+
+	p.token = token
+	p.region = cfg.Region
+	p.allowedRegions = cfg.AllowedRegions
+	p.rotationPeriod = cfg.RotationPeriod
+
+	p.importValidity = cfg.ImportValidity
+	if p.importValidity <= 0 {
+		p.importValidity = defaultImportValidity
+	}
+
+	return &configv1.ConfigureResponse{
+		BuildInfo: &p.buildInfo,
+	}, nil
+}
+
+// Close logs out of the token and unloads the PKCS#11 module
+func (p *Plugin) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token == nil {
+		return nil
+	}
+
+	err := p.token.Close()
+	p.token = nil
+
+	return err
+}
+
+// GetKeyVersions returns the versions of the key, rotating it when due
+func (p *Plugin) GetKeyVersions(
+	_ context.Context,
+	req *keymanagementv1.GetKeyVersionsRequest,
+) (*keymanagementv1.GetKeyVersionsResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key, err := p.getRotatedKey(req.GetParameters().GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]*keymanagementv1.KeyVersion, 0, len(key.Versions))
+	for _, v := range key.Versions {
+		versions = append(versions, &keymanagementv1.KeyVersion{
+			VersionId:    v.ID,
+			CreationTime: timestamppb.New(v.CreatedAt),
+			Status:       key.Status,
+		})
+	}
+
+	return &keymanagementv1.GetKeyVersionsResponse{Versions: versions}, nil
+}
+
+// GetKey returns the key, rotating it when due
+func (p *Plugin) GetKey(
+	_ context.Context,
+	req *keymanagementv1.GetKeyRequest,
+) (*keymanagementv1.GetKeyResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key, err := p.getRotatedKey(req.GetParameters().GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &keymanagementv1.GetKeyResponse{
+		KeyId:     key.ID,
+		Algorithm: keymanagementv1.KeyAlgorithm(key.Algorithm),
+		Status:    key.Status,
+		Usage:     keyUsage,
+	}
+
+	if latest := key.latestVersion(); latest != nil {
+		resp.LatestKeyVersionId = latest.ID
+		resp.LatestRotationTime = timestamppb.New(latest.CreatedAt)
+	}
+
+	return resp, nil
+}
+
+// CreateKey generates a key on the token. BYOK keys are created without
+// material, pending its import.
+func (p *Plugin) CreateKey(
+	_ context.Context,
+	req *keymanagementv1.CreateKeyRequest,
+) (*keymanagementv1.CreateKeyResponse, error) {
+	if keymanagement.KeyAlgorithm(req.GetAlgorithm()) != keymanagement.AES256 {
+		return nil, ErrUnsupportedAlg
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token == nil {
+		return nil, ErrNotConfigured
+	}
+
+	if !p.regionAllowed(req.GetRegion()) {
+		return nil, ErrUnsupportedRegion
+	}
+
+	key := &keyMetadata{
+		ID:        req.GetId(),
+		Algorithm: int32(req.GetAlgorithm()),
+		KeyType:   int32(req.GetKeyType()),
+		Region:    p.region,
+		Status:    keyStatusEnabled,
+	}
+
+	if key.ID == "" {
+		key.ID = uuid.NewString()
+	}
+
+	if keymanagement.KeyType(req.GetKeyType()) == keymanagement.BYOK {
+		key.Status = keyStatusPendingImport
+	}
+
+	value, err := json.Marshal(key)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = p.token.CreateMetadata(key.ID, value)
+	if errors.Is(err, hsm.ErrExists) {
+		return nil, ErrKeyExists
+	}
+
+	if err != nil {
+		return nil, p.hsmError(err)
+	}
+
+	if key.Status == keyStatusEnabled {
+		err = p.addVersion(key)
+		if err != nil {
+			_ = p.token.Destroy(key.ID)
+			return nil, err
+		}
+	}
+
+	return &keymanagementv1.CreateKeyResponse{KeyId: key.ID, Status: key.Status}, nil
+}
+
+// DeleteKey deletes a key after the deletion window in days, or at once
+// without window. Keys pending deletion can't be used.
+func (p *Plugin) DeleteKey(
+	_ context.Context,
+	req *keymanagementv1.DeleteKeyRequest,
+) (*keymanagementv1.DeleteKeyResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key, err := p.getKey(req.GetParameters().GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	window := req.GetWindow()
+	if window <= 0 {
+		err = p.token.Destroy(key.ID)
+		if err != nil {
+			return nil, p.hsmError(err)
+		}
+
+		return &keymanagementv1.DeleteKeyResponse{}, nil
+	}
+
+	err = p.token.SetKeysUsable(key.ID, false)
+	if err != nil {
+		return nil, p.hsmError(err)
+	}
+
+	key.Status = keyStatusPendingDeletion
+	key.DeleteAt = new(time.Now().UTC().AddDate(0, 0, int(window)))
+
+	err = p.updateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keymanagementv1.DeleteKeyResponse{}, nil
+}
+
+func (p *Plugin) EnableKey(
+	_ context.Context,
+	req *keymanagementv1.EnableKeyRequest,
+) (*keymanagementv1.EnableKeyResponse, error) {
+	err := p.setStatus(req.GetParameters().GetKeyId(), keyStatusEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keymanagementv1.EnableKeyResponse{}, nil
+}
+
+func (p *Plugin) DisableKey(
+	_ context.Context,
+	req *keymanagementv1.DisableKeyRequest,
+) (*keymanagementv1.DisableKeyResponse, error) {
+	err := p.setStatus(req.GetParameters().GetKeyId(), keyStatusDisabled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keymanagementv1.DisableKeyResponse{}, nil
+}
+
+// GetImportParameters generates a RSA wrapping key pair on the token for
+// the import of the key material of a BYOK key. The material must be
+// wrapped with RSA-OAEP and SHA-256 under the returned public key.
+func (p *Plugin) GetImportParameters(
+	_ context.Context,
+	req *keymanagementv1.GetImportParametersRequest,
+) (*keymanagementv1.GetImportParametersResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key, err := p.getKey(req.GetParameters().GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Status != keyStatusPendingImport {
+		return nil, ErrKeyNotImportable
+	}
+
+	importToken := uuid.NewString()
+
+	wrappingKey, err := p.token.GenerateWrappingKey(key.ID, importObjectID(key.ID, importToken))
+	if err != nil {
+		return nil, p.hsmError(err)
+	}
+
+	publicKey, err := x509.MarshalPKIXPublicKey(wrappingKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	key.Import = &importMetadata{
+		Token:     importToken,
+		ExpiresAt: time.Now().UTC().Add(p.importValidity),
+	}
+
+	err = p.updateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := structpb.NewStruct(map[string]any{
+		"publicKey":         string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+		"wrappingAlgorithm": string(cmkapi.WrappingAlgorithmNameCKMRSAPKCSOAEP),
+		"hashFunction":      string(cmkapi.WrappingAlgorithmHashFunctionSHA256),
+		"providerParams":    importToken,
+		"validTo":           key.Import.ExpiresAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &keymanagementv1.GetImportParametersResponse{
+		KeyId:            key.ID,
+		ImportParameters: params,
+	}, nil
+}
+
+// ImportKeyMaterial unwraps the base64 encoded key material on the token
+// with the wrapping key of the import parameters and enables the key
+func (p *Plugin) ImportKeyMaterial(
+	_ context.Context,
+	req *keymanagementv1.ImportKeyMaterialRequest,
+) (*keymanagementv1.ImportKeyMaterialResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key, err := p.getKey(req.GetParameters().GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Status != keyStatusPendingImport {
+		return nil, ErrKeyNotImportable
+	}
+
+	importToken, _ := req.GetImportParameters().AsMap()["providerParams"].(string)
+	if key.Import == nil || time.Now().After(key.Import.ExpiresAt) || importToken != key.Import.Token {
+		return nil, ErrImportExpired
+	}
+
+	wrapped, err := base64.StdEncoding.DecodeString(req.GetEncryptedKeyMaterial())
+	if err != nil {
+		return nil, ErrInvalidKeyMaterial
+	}
+
+	version := keyVersion{ID: key.nextVersionID(), CreatedAt: time.Now().UTC()}
+
+	err = p.token.UnwrapAESKey(key.ID, importObjectID(key.ID, importToken),
+		versionObjectID(key.ID, version.ID), wrapped)
+	if errors.Is(err, hsm.ErrInvalidKeyMaterial) {
+		return nil, ErrInvalidKeyMaterial
+	}
+
+	if errors.Is(err, hsm.ErrNotFound) {
+		return nil, ErrImportExpired
+	}
+
+	if err != nil {
+		return nil, p.hsmError(err)
+	}
+
+	key.Versions = append(key.Versions, version)
+	key.Status = keyStatusEnabled
+	key.Import = nil
+
+	err = p.updateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.token.DestroyWrappingKey(key.ID)
+	if err != nil {
+		return nil, p.hsmError(err)
+	}
+
+	return &keymanagementv1.ImportKeyMaterialResponse{}, nil
+}
+
+func (p *Plugin) ValidateKey(
+	_ context.Context,
+	req *keymanagementv1.ValidateKeyRequest,
+) (*keymanagementv1.ValidateKeyResponse, error) {
+	if keymanagement.KeyAlgorithm(req.GetAlgorithm()) != keymanagement.AES256 {
+		return &keymanagementv1.ValidateKeyResponse{
+			IsValid: false,
+			Message: "only AES256 keys are supported",
+		}, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.regionAllowed(req.GetRegion()) {
+		return &keymanagementv1.ValidateKeyResponse{
+			IsValid: false,
+			Message: "region is not served by the HSM",
+		}, nil
+	}
+
+	return &keymanagementv1.ValidateKeyResponse{IsValid: true}, nil
+}
+
+// ValidateKeyAccessData accepts any access data, as the keys are
+// accessed through the token of the plugin
+func (p *Plugin) ValidateKeyAccessData(
+	_ context.Context,
+	_ *keymanagementv1.ValidateKeyAccessDataRequest,
+) (*keymanagementv1.ValidateKeyAccessDataResponse, error) {
+	return &keymanagementv1.ValidateKeyAccessDataResponse{IsValid: true}, nil
+}
+
+func (p *Plugin) TransformCryptoAccessData(
+	_ context.Context,
+	_ *keymanagementv1.TransformCryptoAccessDataRequest,
+) (*keymanagementv1.TransformCryptoAccessDataResponse, error) {
+	return &keymanagementv1.TransformCryptoAccessDataResponse{}, nil
+}
+
+// ExtractKeyRegion returns the configured region of the token,
+// as all keys of the token share it
+func (p *Plugin) ExtractKeyRegion(
+	_ context.Context,
+	_ *keymanagementv1.ExtractKeyRegionRequest,
+) (*keymanagementv1.ExtractKeyRegionResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token == nil {
+		return nil, ErrNotConfigured
+	}
+
+	return &keymanagementv1.ExtractKeyRegionResponse{Region: p.region}, nil
+}
+
+// regionAllowed returns true if the region maps to the region of the
+// token. An empty region defaults to it. The caller must hold the lock.
+func (p *Plugin) regionAllowed(region string) bool {
+	if region == "" || region == p.region {
+		return true
+	}
+
+	return slices.Contains(p.allowedRegions, region)
+}
+
+// getKey returns the key and destroys it once its deletion window passed.
+// The caller must hold the lock.
+func (p *Plugin) getKey(id string) (*keyMetadata, error) {
+	if p.token == nil {
+		return nil, ErrNotConfigured
+	}
+
+	value, err := p.token.Metadata(id)
+	if errors.Is(err, hsm.ErrNotFound) {
+		return nil, ErrKeyNotFound
+	}
+
+	if err != nil {
+		return nil, p.hsmError(err)
+	}
+
+	key := &keyMetadata{}
+
+	err = json.Unmarshal(value, key)
+	if err != nil {
+		return nil, p.hsmError(err)
+	}
+
+	if key.deleted(time.Now()) {
+		err = p.token.Destroy(id)
+		if err != nil {
+			return nil, p.hsmError(err)
+		}
+
+		return nil, ErrKeyNotFound
+	}
+
+	return key, nil
+}
+
+// getRotatedKey returns the key with a new version once the latest
+// version is older than the rotation period. The caller must hold the lock.
+func (p *Plugin) getRotatedKey(id string) (*keyMetadata, error) {
+	key, err := p.getKey(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !key.rotationDue(time.Now(), p.rotationPeriod) {
+		return key, nil
+	}
+
+	err = p.addVersion(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// addVersion generates the material of a new version of the key.
+// The caller must hold the lock.
+func (p *Plugin) addVersion(key *keyMetadata) error {
+	version := keyVersion{ID: key.nextVersionID(), CreatedAt: time.Now().UTC()}
+
+	err := p.token.GenerateAESKey(key.ID, versionObjectID(key.ID, version.ID))
+	if err != nil {
+		return p.hsmError(err)
+	}
+
+	key.Versions = append(key.Versions, version)
+
+	return p.updateKey(key)
+}
+
+// updateKey stores the metadata of the key. The caller must hold the lock.
+func (p *Plugin) updateKey(key *keyMetadata) error {
+	value, err := json.Marshal(key)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	err = p.token.UpdateMetadata(key.ID, value)
+	if errors.Is(err, hsm.ErrNotFound) {
+		return ErrKeyNotFound
+	}
+
+	if err != nil {
+		return p.hsmError(err)
+	}
+
+	return nil
+}
+
+func (p *Plugin) setStatus(id, keyStatus string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key, err := p.getKey(id)
+	if err != nil {
+		return err
+	}
+
+	switch key.Status {
+	case keyStatusPendingImport:
+		return ErrKeyPendingImport
+	case keyStatusPendingDeletion:
+		// Changing the status of a key pending deletion cancels the deletion
+		key.DeleteAt = nil
+	}
+
+	err = p.token.SetKeysUsable(key.ID, keyStatus == keyStatusEnabled)
+	if err != nil {
+		return p.hsmError(err)
+	}
+
+	key.Status = keyStatus
+
+	return p.updateKey(key)
+}
+
+func (p *Plugin) hsmError(err error) error {
+	if p.logger != nil {
+		p.logger.Error("PKCS#11 keystore error", "error", err)
+	}
+
+	return status.Error(codes.Internal, "PKCS#11 keystore error")
+}
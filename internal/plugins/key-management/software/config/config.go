@@ -0,0 +1,37 @@
+package config
+
+import (
+	"time"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+)
+
+type StoreType string
+
+const (
+	StoreTypeFile     StoreType = "file"
+	StoreTypePostgres StoreType = "postgres"
+)
+
+type FileStore struct {
+	Path string `yaml:"path"`
+}
+
+type PostgresStore struct {
+	DSN   commoncfg.SourceRef `yaml:"dsn"`
+	Table string              `yaml:"table"`
+}
+
+type Store struct {
+	Type     StoreType     `yaml:"type"`
+	File     FileStore     `yaml:"file"`
+	Postgres PostgresStore `yaml:"postgres"`
+}
+
+type Config struct {
+	// MasterKey is the base64 encoded AES-256 key encrypting the stored keys
+	MasterKey commoncfg.SourceRef `yaml:"masterKey"`
+	Store     Store               `yaml:"store"`
+	// ImportValidity is how long import parameters can be used
+	ImportValidity time.Duration `yaml:"importValidity"`
+}
@@ -0,0 +1,54 @@
+package software
+
+import (
+	"time"
+)
+
+// Key states as expected by CMK
+const (
+	keyStatusEnabled         = "ENABLED"
+	keyStatusDisabled        = "DISABLED"
+	keyStatusPendingImport   = "PENDING_IMPORT"
+	keyStatusPendingDeletion = "PENDING_DELETION"
+	keyUsage                 = "ENCRYPT_DECRYPT"
+	aesKeySize               = 32
+)
+
+// keyRecord is a key as stored encrypted under the master key
+type keyRecord struct {
+	ID        string        `json:"id"`
+	Algorithm int32         `json:"algorithm"`
+	KeyType   int32         `json:"keyType"`
+	Region    string        `json:"region"`
+	Status    string        `json:"status"`
+	Versions  []keyVersion  `json:"versions"`
+	Import    *importRecord `json:"import,omitempty"`
+	// DeleteAt is set once deletion is scheduled
+	DeleteAt *time.Time `json:"deleteAt,omitempty"`
+}
+
+type keyVersion struct {
+	ID        string    `json:"id"`
+	Material  []byte    `json:"material"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// importRecord is the wrapping key of a pending import
+type importRecord struct {
+	Token      string    `json:"token"`
+	PrivateKey []byte    `json:"privateKey"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+func (k *keyRecord) latestVersion() *keyVersion {
+	if len(k.Versions) == 0 {
+		return nil
+	}
+
+	return &k.Versions[len(k.Versions)-1]
+}
+
+// deleted returns true once the deletion window has passed
+func (k *keyRecord) deleted(now time.Time) bool {
+	return k.DeleteAt != nil && !now.Before(*k.DeleteAt)
+}
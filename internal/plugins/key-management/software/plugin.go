@@ -0,0 +1,646 @@
+// Package software is a built-in keystore keeping AES keys encrypted under
+// a master key in a local directory or a postgres table. It gives
+// developers and air-gapped landscapes real key lifecycles without a
+// cloud KMS, and is not meant to replace a HSM backed keystore.
+package software
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-hclog"
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/openkcm/plugin-sdk/pkg/catalog"
+	"github.com/openkcm/plugin-sdk/pkg/hclog2slog"
+	"github.com/samber/oops"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+
+	keymanagementv1 "github.com/openkcm/plugin-sdk/proto/plugin/keystore/operations/v1"
+	configv1 "github.com/openkcm/plugin-sdk/proto/service/common/config/v1"
+
+	"github.com/openkcm/cmk/internal/api/cmkapi"
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/keymanagement"
+	"github.com/openkcm/cmk/internal/plugins/key-management/software/config"
+	"github.com/openkcm/cmk/internal/plugins/key-management/software/store"
+)
+
+const (
+	defaultImportValidity = 24 * time.Hour
+	wrappingKeyBits       = 3072
+	firstVersionID        = "1"
+)
+
+var (
+	ErrSoftware = oops.In("Software keystore plugin")
+
+	ErrNotConfigured      = status.Error(codes.FailedPrecondition, "software keystore is not configured")
+	ErrKeyNotFound        = status.Error(codes.NotFound, "key not found")
+	ErrKeyExists          = status.Error(codes.AlreadyExists, "key already exists")
+	ErrUnsupportedAlg     = status.Error(codes.InvalidArgument, "only AES256 keys are supported")
+	ErrKeyPendingImport   = status.Error(codes.FailedPrecondition, "key material has not been imported")
+	ErrKeyNotImportable   = status.Error(codes.FailedPrecondition, "key does not accept key material")
+	ErrImportExpired      = status.Error(codes.FailedPrecondition, "import parameters expired or unknown")
+	ErrInvalidKeyMaterial = status.Error(codes.InvalidArgument, "invalid wrapped key material")
+)
+
+func Register(registry catalog.BuiltInPluginRegistry) {
+	registry.Register(builtin(NewPlugin()))
+}
+
+func builtin(p *Plugin) catalog.BuiltInPlugin {
+	return catalog.MakeBuiltIn("software",
+		keymanagementv1.KeystoreInstanceKeyOperationPluginServer(p),
+		configv1.ConfigServiceServer(p))
+}
+
+type Plugin struct {
+	keymanagementv1.UnsafeKeystoreInstanceKeyOperationServer
+	configv1.UnsafeConfigServer
+
+	logger    *slog.Logger
+	buildInfo string
+
+	// mu guards the store, which is replaced on Configure. Concurrent
+	// changes of a key record are serialized by the store backend.
+	mu             sync.RWMutex
+	store          *store.Store
+	importValidity time.Duration
+}
+
+var (
+	_ keymanagementv1.KeystoreInstanceKeyOperationServer = (*Plugin)(nil)
+	_ configv1.ConfigServer                              = (*Plugin)(nil)
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{
+		buildInfo:      "{}",
+		importValidity: defaultImportValidity,
+	}
+}
+
+func (p *Plugin) SetLogger(logger hclog.Logger) {
+	p.logger = hclog2slog.New(logger)
+}
+
+func (p *Plugin) Configure(
+	ctx context.Context,
+	req *configv1.ConfigureRequest,
+) (*configv1.ConfigureResponse, error) {
+	slog.Info("Configuring plugin")
+
+	cfg := &config.Config{}
+
+	err := yaml.Unmarshal([]byte(req.GetYamlConfiguration()), cfg)
+	if err != nil {
+		return nil, ErrSoftware.Wrapf(err, "Failed to get yaml Configuration")
+	}
+
+	s, err := NewStore(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.store != nil {
+		_ = p.store.Close()
+	}
+
+	p.store = s
+
+	p.importValidity = cfg.ImportValidity
+	if p.importValidity <= 0 {
+		p.importValidity = defaultImportValidity
+	}
+
+	return &configv1.ConfigureResponse{
+		BuildInfo: &p.buildInfo,
+	}, nil
+}
+
+// NewStore creates the encrypted key store of the config
+func NewStore(ctx context.Context, cfg *config.Config) (*store.Store, error) {
+	encodedKey, err := commoncfg.LoadValueFromSourceRef(cfg.MasterKey)
+	if err != nil {
+		return nil, ErrSoftware.Wrapf(err, "Failed loading master key")
+	}
+
+	masterKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encodedKey)))
+	if err != nil {
+		return nil, ErrSoftware.Wrapf(err, "Failed decoding master key")
+	}
+
+	var backend store.Backend
+
+	switch cfg.Store.Type {
+	case config.StoreTypeFile:
+		backend, err = store.NewFileBackend(cfg.Store.File.Path)
+	case config.StoreTypePostgres:
+		var dsn []byte
+
+		dsn, err = commoncfg.LoadValueFromSourceRef(cfg.Store.Postgres.DSN)
+		if err != nil {
+			return nil, ErrSoftware.Wrapf(err, "Failed loading postgres dsn")
+		}
+
+		backend, err = store.NewPostgresBackend(ctx, string(dsn), cfg.Store.Postgres.Table)
+	default:
+		return nil, ErrSoftware.Errorf("Unknown store type %q", cfg.Store.Type)
+	}
+
+	if err != nil {
+		return nil, ErrSoftware.Wrapf(err, "Failed creating %s store", cfg.Store.Type)
+	}
+
+	s, err := store.New(backend, masterKey)
+	if err != nil {
+		_ = backend.Close()
+		return nil, ErrSoftware.Wrapf(err, "Failed creating store")
+	}
+
+	return s, nil
+}
+
+func (p *Plugin) GetKeyVersions(
+	ctx context.Context,
+	req *keymanagementv1.GetKeyVersionsRequest,
+) (*keymanagementv1.GetKeyVersionsResponse, error) {
+	key, err := p.getKey(ctx, req.GetParameters().GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]*keymanagementv1.KeyVersion, 0, len(key.Versions))
+	for _, v := range key.Versions {
+		versions = append(versions, &keymanagementv1.KeyVersion{
+			VersionId:    v.ID,
+			CreationTime: timestamppb.New(v.CreatedAt),
+			Status:       key.Status,
+		})
+	}
+
+	return &keymanagementv1.GetKeyVersionsResponse{Versions: versions}, nil
+}
+
+func (p *Plugin) GetKey(
+	ctx context.Context,
+	req *keymanagementv1.GetKeyRequest,
+) (*keymanagementv1.GetKeyResponse, error) {
+	key, err := p.getKey(ctx, req.GetParameters().GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &keymanagementv1.GetKeyResponse{
+		KeyId:     key.ID,
+		Algorithm: keymanagementv1.KeyAlgorithm(key.Algorithm),
+		Status:    key.Status,
+		Usage:     keyUsage,
+	}
+
+	if latest := key.latestVersion(); latest != nil {
+		resp.LatestKeyVersionId = latest.ID
+		resp.LatestRotationTime = timestamppb.New(latest.CreatedAt)
+	}
+
+	return resp, nil
+}
+
+// CreateKey creates a key with random material. BYOK keys are created
+// without material, pending its import.
+func (p *Plugin) CreateKey(
+	ctx context.Context,
+	req *keymanagementv1.CreateKeyRequest,
+) (*keymanagementv1.CreateKeyResponse, error) {
+	if keymanagement.KeyAlgorithm(req.GetAlgorithm()) != keymanagement.AES256 {
+		return nil, ErrUnsupportedAlg
+	}
+
+	s, err := p.getStore()
+	if err != nil {
+		return nil, err
+	}
+
+	key := &keyRecord{
+		ID:        req.GetId(),
+		Algorithm: int32(req.GetAlgorithm()),
+		KeyType:   int32(req.GetKeyType()),
+		Region:    req.GetRegion(),
+		Status:    keyStatusEnabled,
+	}
+
+	if key.ID == "" {
+		key.ID = uuid.NewString()
+	}
+
+	if keymanagement.KeyType(req.GetKeyType()) == keymanagement.BYOK {
+		key.Status = keyStatusPendingImport
+	} else {
+		material := make([]byte, aesKeySize)
+
+		_, err = rand.Read(material)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		key.Versions = []keyVersion{{ID: firstVersionID, Material: material, CreatedAt: time.Now().UTC()}}
+	}
+
+	err = s.Create(ctx, key.ID, key)
+	if errors.Is(err, store.ErrExists) {
+		return nil, ErrKeyExists
+	}
+
+	if err != nil {
+		return nil, p.storeError(err)
+	}
+
+	return &keymanagementv1.CreateKeyResponse{KeyId: key.ID, Status: key.Status}, nil
+}
+
+// DeleteKey deletes a key after the deletion window in days,
+// or at once without window
+func (p *Plugin) DeleteKey(
+	ctx context.Context,
+	req *keymanagementv1.DeleteKeyRequest,
+) (*keymanagementv1.DeleteKeyResponse, error) {
+	window := req.GetWindow()
+	if window <= 0 {
+		key, err := p.getKey(ctx, req.GetParameters().GetKeyId())
+		if err != nil {
+			return nil, err
+		}
+
+		err = p.deleteKey(ctx, key.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &keymanagementv1.DeleteKeyResponse{}, nil
+	}
+
+	_, err := p.modifyKey(ctx, req.GetParameters().GetKeyId(), func(key *keyRecord) error {
+		key.Status = keyStatusPendingDeletion
+		key.DeleteAt = new(time.Now().UTC().AddDate(0, 0, int(window)))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &keymanagementv1.DeleteKeyResponse{}, nil
+}
+
+func (p *Plugin) EnableKey(
+	ctx context.Context,
+	req *keymanagementv1.EnableKeyRequest,
+) (*keymanagementv1.EnableKeyResponse, error) {
+	err := p.setStatus(ctx, req.GetParameters().GetKeyId(), keyStatusEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keymanagementv1.EnableKeyResponse{}, nil
+}
+
+func (p *Plugin) DisableKey(
+	ctx context.Context,
+	req *keymanagementv1.DisableKeyRequest,
+) (*keymanagementv1.DisableKeyResponse, error) {
+	err := p.setStatus(ctx, req.GetParameters().GetKeyId(), keyStatusDisabled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keymanagementv1.DisableKeyResponse{}, nil
+}
+
+// GetImportParameters creates a RSA wrapping key for the import of the
+// key material of a BYOK key. The material must be wrapped with RSA-OAEP
+// and SHA-256 under the returned public key.
+func (p *Plugin) GetImportParameters(
+	ctx context.Context,
+	req *keymanagementv1.GetImportParametersRequest,
+) (*keymanagementv1.GetImportParametersResponse, error) {
+	// Check the key before generating the wrapping key, it is checked
+	// again when storing the import
+	key, err := p.getKey(ctx, req.GetParameters().GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Status != keyStatusPendingImport {
+		return nil, ErrKeyNotImportable
+	}
+
+	wrappingKey, err := rsa.GenerateKey(rand.Reader, wrappingKeyBits)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	privateKey, err := x509.MarshalPKCS8PrivateKey(wrappingKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&wrappingKey.PublicKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	importValidity := p.getImportValidity()
+
+	key, err = p.modifyKey(ctx, key.ID, func(record *keyRecord) error {
+		if record.Status != keyStatusPendingImport {
+			return ErrKeyNotImportable
+		}
+
+		record.Import = &importRecord{
+			Token:      uuid.NewString(),
+			PrivateKey: privateKey,
+			ExpiresAt:  time.Now().UTC().Add(importValidity),
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := structpb.NewStruct(map[string]any{
+		"publicKey":         string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+		"wrappingAlgorithm": string(cmkapi.WrappingAlgorithmNameCKMRSAPKCSOAEP),
+		"hashFunction":      string(cmkapi.WrappingAlgorithmHashFunctionSHA256),
+		"providerParams":    key.Import.Token,
+		"validTo":           key.Import.ExpiresAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &keymanagementv1.GetImportParametersResponse{
+		KeyId:            key.ID,
+		ImportParameters: params,
+	}, nil
+}
+
+// ImportKeyMaterial unwraps the base64 encoded key material with the
+// wrapping key of the import parameters and enables the key
+func (p *Plugin) ImportKeyMaterial(
+	ctx context.Context,
+	req *keymanagementv1.ImportKeyMaterialRequest,
+) (*keymanagementv1.ImportKeyMaterialResponse, error) {
+	token, _ := req.GetImportParameters().AsMap()["providerParams"].(string)
+
+	_, err := p.modifyKey(ctx, req.GetParameters().GetKeyId(), func(key *keyRecord) error {
+		if key.Status != keyStatusPendingImport {
+			return ErrKeyNotImportable
+		}
+
+		if key.Import == nil || time.Now().After(key.Import.ExpiresAt) ||
+			subtle.ConstantTimeCompare([]byte(token), []byte(key.Import.Token)) != 1 {
+			return ErrImportExpired
+		}
+
+		material, err := unwrapKeyMaterial(key.Import.PrivateKey, req.GetEncryptedKeyMaterial())
+		if err != nil {
+			return err
+		}
+
+		key.Versions = append(key.Versions, keyVersion{
+			ID:        firstVersionID,
+			Material:  material,
+			CreatedAt: time.Now().UTC(),
+		})
+		key.Status = keyStatusEnabled
+		key.Import = nil
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &keymanagementv1.ImportKeyMaterialResponse{}, nil
+}
+
+func (p *Plugin) ValidateKey(
+	_ context.Context,
+	req *keymanagementv1.ValidateKeyRequest,
+) (*keymanagementv1.ValidateKeyResponse, error) {
+	if keymanagement.KeyAlgorithm(req.GetAlgorithm()) != keymanagement.AES256 {
+		return &keymanagementv1.ValidateKeyResponse{
+			IsValid: false,
+			Message: "only AES256 keys are supported",
+		}, nil
+	}
+
+	return &keymanagementv1.ValidateKeyResponse{IsValid: true}, nil
+}
+
+// ValidateKeyAccessData accepts any access data, as the keys are
+// local to the plugin
+func (p *Plugin) ValidateKeyAccessData(
+	_ context.Context,
+	_ *keymanagementv1.ValidateKeyAccessDataRequest,
+) (*keymanagementv1.ValidateKeyAccessDataResponse, error) {
+	return &keymanagementv1.ValidateKeyAccessDataResponse{IsValid: true}, nil
+}
+
+func (p *Plugin) TransformCryptoAccessData(
+	_ context.Context,
+	_ *keymanagementv1.TransformCryptoAccessDataRequest,
+) (*keymanagementv1.TransformCryptoAccessDataResponse, error) {
+	return &keymanagementv1.TransformCryptoAccessDataResponse{}, nil
+}
+
+func (p *Plugin) ExtractKeyRegion(
+	ctx context.Context,
+	req *keymanagementv1.ExtractKeyRegionRequest,
+) (*keymanagementv1.ExtractKeyRegionResponse, error) {
+	key, err := p.getKey(ctx, req.GetNativeKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &keymanagementv1.ExtractKeyRegionResponse{Region: key.Region}, nil
+}
+
+func (p *Plugin) getStore() (*store.Store, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.store == nil {
+		return nil, ErrNotConfigured
+	}
+
+	return p.store, nil
+}
+
+func (p *Plugin) getImportValidity() time.Duration {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.importValidity
+}
+
+// getKey returns the key and purges it once its deletion window passed
+func (p *Plugin) getKey(ctx context.Context, id string) (*keyRecord, error) {
+	s, err := p.getStore()
+	if err != nil {
+		return nil, err
+	}
+
+	key := &keyRecord{}
+
+	err = s.Get(ctx, id, key)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, ErrKeyNotFound
+	}
+
+	if err != nil {
+		return nil, p.storeError(err)
+	}
+
+	if key.deleted(time.Now()) {
+		err = p.deleteKey(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, ErrKeyNotFound
+	}
+
+	return key, nil
+}
+
+// modifyKey applies fn to the key and stores it. The store locks the
+// record between the read and the write, so concurrent requests, also
+// of other CMK instances, don't lose updates. An error of fn is returned
+// as is and leaves the key unchanged.
+func (p *Plugin) modifyKey(ctx context.Context, id string, fn func(key *keyRecord) error) (*keyRecord, error) {
+	s, err := p.getStore()
+	if err != nil {
+		return nil, err
+	}
+
+	key := &keyRecord{}
+
+	var (
+		fnErr  error
+		purged bool
+	)
+
+	err = s.Modify(ctx, id, key, func() error {
+		if key.deleted(time.Now()) {
+			purged = true
+			fnErr = ErrKeyNotFound
+		} else {
+			fnErr = fn(key)
+		}
+
+		return fnErr
+	})
+
+	switch {
+	case purged:
+		err = p.deleteKey(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, ErrKeyNotFound
+	case fnErr != nil:
+		return nil, fnErr
+	case errors.Is(err, store.ErrNotFound):
+		return nil, ErrKeyNotFound
+	case err != nil:
+		return nil, p.storeError(err)
+	}
+
+	return key, nil
+}
+
+// deleteKey deletes the key, ignoring a key deleted in the meantime
+func (p *Plugin) deleteKey(ctx context.Context, id string) error {
+	s, err := p.getStore()
+	if err != nil {
+		return err
+	}
+
+	err = s.Delete(ctx, id)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return p.storeError(err)
+	}
+
+	return nil
+}
+
+func (p *Plugin) setStatus(ctx context.Context, id, keyStatus string) error {
+	_, err := p.modifyKey(ctx, id, func(key *keyRecord) error {
+		switch key.Status {
+		case keyStatusPendingImport:
+			return ErrKeyPendingImport
+		case keyStatusPendingDeletion:
+			// Changing the status of a key pending deletion cancels the deletion
+			key.DeleteAt = nil
+		}
+
+		key.Status = keyStatus
+
+		return nil
+	})
+
+	return err
+}
+
+func (p *Plugin) storeError(err error) error {
+	if p.logger != nil {
+		p.logger.Error("software keystore error", "error", err)
+	}
+
+	return status.Error(codes.Internal, "software keystore error")
+}
+
+func unwrapKeyMaterial(privateKeyDER []byte, wrapped string) ([]byte, error) {
+	privateKey, err := x509.ParsePKCS8PrivateKey(privateKeyDER)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rsaKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, status.Error(codes.Internal, "invalid wrapping key")
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, ErrInvalidKeyMaterial
+	}
+
+	material, err := rsa.DecryptOAEP(sha256.New(), nil, rsaKey, ciphertext, nil)
+	if err != nil || len(material) != aesKeySize {
+		return nil, ErrInvalidKeyMaterial
+	}
+
+	return material, nil
+}
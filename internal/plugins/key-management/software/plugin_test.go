@@ -0,0 +1,247 @@
+package software_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	keymanagementv1 "github.com/openkcm/plugin-sdk/proto/plugin/keystore/operations/v1"
+	configv1 "github.com/openkcm/plugin-sdk/proto/service/common/config/v1"
+
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/keymanagement"
+	"github.com/openkcm/cmk/internal/plugins/key-management/software"
+)
+
+const testMasterKey = "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE="
+
+var aes256 = keymanagementv1.KeyAlgorithm(keymanagement.AES256)
+
+func setupPlugin(t *testing.T) *software.Plugin {
+	t.Helper()
+
+	p := software.NewPlugin()
+	_, err := p.Configure(t.Context(), &configv1.ConfigureRequest{
+		YamlConfiguration: `
+masterKey:
+  source: embedded
+  value: ` + testMasterKey + `
+store:
+  type: file
+  file:
+    path: ` + t.TempDir(),
+	})
+	require.NoError(t, err)
+
+	return p
+}
+
+func keyParams(keyID string) *keymanagementv1.RequestParameters {
+	return &keymanagementv1.RequestParameters{KeyId: keyID}
+}
+
+func getKey(t *testing.T, p *software.Plugin, keyID string) *keymanagementv1.GetKeyResponse {
+	t.Helper()
+
+	resp, err := p.GetKey(t.Context(), &keymanagementv1.GetKeyRequest{Parameters: keyParams(keyID)})
+	require.NoError(t, err)
+
+	return resp
+}
+
+func TestConfigure(t *testing.T) {
+	t.Run("Should fail on invalid master key", func(t *testing.T) {
+		_, err := software.NewPlugin().Configure(t.Context(), &configv1.ConfigureRequest{
+			YamlConfiguration: `
+masterKey:
+  source: embedded
+  value: c2hvcnQ=
+store:
+  type: file
+  file:
+    path: ` + t.TempDir(),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail on unknown store", func(t *testing.T) {
+		_, err := software.NewPlugin().Configure(t.Context(), &configv1.ConfigureRequest{
+			YamlConfiguration: `
+masterKey:
+  source: embedded
+  value: ` + testMasterKey + `
+store:
+  type: memory`,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail before configuration", func(t *testing.T) {
+		_, err := software.NewPlugin().GetKey(t.Context(), &keymanagementv1.GetKeyRequest{Parameters: keyParams("key")})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestKeyLifecycle(t *testing.T) {
+	p := setupPlugin(t)
+	keyID := "system-managed-key"
+
+	t.Run("Should create enabled key", func(t *testing.T) {
+		resp, err := p.CreateKey(t.Context(), &keymanagementv1.CreateKeyRequest{
+			Id:        &keyID,
+			Algorithm: aes256,
+			Region:    "local",
+			KeyType:   keymanagementv1.KeyType(keymanagement.SystemManaged),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, keyID, resp.GetKeyId())
+		assert.Equal(t, "ENABLED", resp.GetStatus())
+
+		key := getKey(t, p, keyID)
+		assert.Equal(t, aes256, key.GetAlgorithm())
+		assert.Equal(t, "1", key.GetLatestKeyVersionId())
+		assert.NotNil(t, key.GetLatestRotationTime())
+
+		versions, err := p.GetKeyVersions(t.Context(), &keymanagementv1.GetKeyVersionsRequest{
+			Parameters: keyParams(keyID),
+		})
+		require.NoError(t, err)
+		assert.Len(t, versions.GetVersions(), 1)
+
+		region, err := p.ExtractKeyRegion(t.Context(), &keymanagementv1.ExtractKeyRegionRequest{NativeKeyId: keyID})
+		require.NoError(t, err)
+		assert.Equal(t, "local", region.GetRegion())
+	})
+
+	t.Run("Should fail on existing key", func(t *testing.T) {
+		_, err := p.CreateKey(t.Context(), &keymanagementv1.CreateKeyRequest{Id: &keyID, Algorithm: aes256})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("Should fail on unsupported algorithm", func(t *testing.T) {
+		_, err := p.CreateKey(t.Context(), &keymanagementv1.CreateKeyRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Should disable and enable key", func(t *testing.T) {
+		_, err := p.DisableKey(t.Context(), &keymanagementv1.DisableKeyRequest{Parameters: keyParams(keyID)})
+		require.NoError(t, err)
+		assert.Equal(t, "DISABLED", getKey(t, p, keyID).GetStatus())
+
+		_, err = p.EnableKey(t.Context(), &keymanagementv1.EnableKeyRequest{Parameters: keyParams(keyID)})
+		require.NoError(t, err)
+		assert.Equal(t, "ENABLED", getKey(t, p, keyID).GetStatus())
+	})
+
+	t.Run("Should schedule deletion within window", func(t *testing.T) {
+		_, err := p.DeleteKey(t.Context(), &keymanagementv1.DeleteKeyRequest{
+			Parameters: keyParams(keyID),
+			Window:     new(int32(7)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "PENDING_DELETION", getKey(t, p, keyID).GetStatus())
+	})
+
+	t.Run("Should delete key without window", func(t *testing.T) {
+		_, err := p.DeleteKey(t.Context(), &keymanagementv1.DeleteKeyRequest{Parameters: keyParams(keyID)})
+		require.NoError(t, err)
+
+		_, err = p.GetKey(t.Context(), &keymanagementv1.GetKeyRequest{Parameters: keyParams(keyID)})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestImportKeyMaterial(t *testing.T) {
+	p := setupPlugin(t)
+	keyID := "byok-key"
+
+	_, err := p.CreateKey(t.Context(), &keymanagementv1.CreateKeyRequest{
+		Id:        &keyID,
+		Algorithm: aes256,
+		KeyType:   keymanagementv1.KeyType(keymanagement.BYOK),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING_IMPORT", getKey(t, p, keyID).GetStatus())
+
+	t.Run("Should not enable key without material", func(t *testing.T) {
+		_, err := p.EnableKey(t.Context(), &keymanagementv1.EnableKeyRequest{Parameters: keyParams(keyID)})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	params, err := p.GetImportParameters(t.Context(), &keymanagementv1.GetImportParametersRequest{
+		Parameters: keyParams(keyID),
+		Algorithm:  aes256,
+	})
+	require.NoError(t, err)
+
+	importParams := params.GetImportParameters().AsMap()
+	assert.Equal(t, "CKM_RSA_PKCS_OAEP", importParams["wrappingAlgorithm"])
+	assert.Equal(t, "SHA256", importParams["hashFunction"])
+	assert.NotEmpty(t, importParams["validTo"])
+
+	block, _ := pem.Decode([]byte(importParams["publicKey"].(string)))
+	require.NotNil(t, block)
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	require.NoError(t, err)
+
+	material := make([]byte, 32)
+	_, err = rand.Read(material)
+	require.NoError(t, err)
+
+	wrapped, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, publicKey.(*rsa.PublicKey), material, nil)
+	require.NoError(t, err)
+
+	t.Run("Should reject unknown import parameters", func(t *testing.T) {
+		otherParams := params.GetImportParameters().AsMap()
+		otherParams["providerParams"] = "unknown"
+
+		_, err := p.ImportKeyMaterial(t.Context(), importRequest(t, keyID, otherParams, wrapped))
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("Should reject invalid key material", func(t *testing.T) {
+		_, err := p.ImportKeyMaterial(t.Context(), importRequest(t, keyID, importParams, []byte("not wrapped")))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Should import key material", func(t *testing.T) {
+		_, err := p.ImportKeyMaterial(t.Context(), importRequest(t, keyID, importParams, wrapped))
+		require.NoError(t, err)
+
+		key := getKey(t, p, keyID)
+		assert.Equal(t, "ENABLED", key.GetStatus())
+		assert.Equal(t, "1", key.GetLatestKeyVersionId())
+	})
+
+	t.Run("Should not import twice", func(t *testing.T) {
+		_, err := p.ImportKeyMaterial(t.Context(), importRequest(t, keyID, importParams, wrapped))
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func importRequest(
+	t *testing.T,
+	keyID string,
+	params map[string]any,
+	wrapped []byte,
+) *keymanagementv1.ImportKeyMaterialRequest {
+	t.Helper()
+
+	importParams, err := structpb.NewStruct(params)
+	require.NoError(t, err)
+
+	return &keymanagementv1.ImportKeyMaterialRequest{
+		Parameters:           keyParams(keyID),
+		ImportParameters:     importParams,
+		EncryptedKeyMaterial: base64.StdEncoding.EncodeToString(wrapped),
+	}
+}
@@ -0,0 +1,144 @@
+package store
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	recordFileExt  = ".key"
+	recordFileMode = 0o600
+	storeDirMode   = 0o700
+)
+
+// FileBackend keeps one file per record in a directory.
+// Updates are written to a temporary file and renamed over the record.
+// The directory is local to the process, so writes are serialized in memory.
+type FileBackend struct {
+	dir string
+	mu  sync.Mutex
+}
+
+func NewFileBackend(dir string) (*FileBackend, error) {
+	err := os.MkdirAll(dir, storeDirMode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileBackend{dir: dir}, nil
+}
+
+func (f *FileBackend) Get(_ context.Context, id string) ([]byte, error) {
+	data, err := os.ReadFile(f.path(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+
+	return data, err
+}
+
+func (f *FileBackend) Create(_ context.Context, id string, data []byte) error {
+	file, err := os.OpenFile(f.path(id), os.O_WRONLY|os.O_CREATE|os.O_EXCL, recordFileMode)
+	if errors.Is(err, fs.ErrExist) {
+		return ErrExists
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(f.path(id))
+
+		return err
+	}
+
+	return file.Close()
+}
+
+func (f *FileBackend) Update(_ context.Context, id string, data []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.update(id, data)
+}
+
+func (f *FileBackend) Modify(_ context.Context, id string, fn func(data []byte) ([]byte, error)) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	data, err := os.ReadFile(f.path(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	data, err = fn(data)
+	if err != nil {
+		return err
+	}
+
+	return f.update(id, data)
+}
+
+func (f *FileBackend) Delete(_ context.Context, id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	err := os.Remove(f.path(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotFound
+	}
+
+	return err
+}
+
+func (f *FileBackend) Close() error {
+	return nil
+}
+
+// update replaces the record. The caller must hold the lock.
+func (f *FileBackend) update(id string, data []byte) error {
+	_, err := os.Stat(f.path(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotFound
+	}
+
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+
+	closeErr := tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	if closeErr != nil {
+		return closeErr
+	}
+
+	return os.Rename(tmp.Name(), f.path(id))
+}
+
+// path hex encodes the ID, so IDs never escape the directory
+func (f *FileBackend) path(id string) string {
+	return filepath.Join(f.dir, hex.EncodeToString([]byte(id))+recordFileExt)
+}
@@ -0,0 +1,142 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultTable is the table of the postgres backend if none is configured
+const DefaultTable = "software_keystore_records"
+
+var (
+	ErrInvalidTable = errors.New("invalid table name")
+
+	tableNameRegex = regexp.MustCompile(`^[a-z_][a-z0-9_]{0,62}$`)
+)
+
+// PostgresBackend keeps the records in a table, which is created on start
+type PostgresBackend struct {
+	pool  *pgxpool.Pool
+	table string
+}
+
+func NewPostgresBackend(ctx context.Context, dsn, table string) (*PostgresBackend, error) {
+	if table == "" {
+		table = DefaultTable
+	}
+
+	if !tableNameRegex.MatchString(table) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTable, table)
+	}
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = pool.Exec(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		id VARCHAR(255) PRIMARY KEY,
+		data BYTEA NOT NULL,
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`, table))
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return &PostgresBackend{pool: pool, table: table}, nil
+}
+
+func (p *PostgresBackend) Get(ctx context.Context, id string) ([]byte, error) {
+	var data []byte
+
+	err := p.pool.QueryRow(ctx, fmt.Sprintf(`SELECT data FROM %s WHERE id = $1`, p.table), id).Scan(&data)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	return data, err
+}
+
+func (p *PostgresBackend) Create(ctx context.Context, id string, data []byte) error {
+	tag, err := p.pool.Exec(ctx,
+		fmt.Sprintf(`INSERT INTO %s (id, data) VALUES ($1, $2) ON CONFLICT (id) DO NOTHING`, p.table),
+		id, data)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrExists
+	}
+
+	return nil
+}
+
+func (p *PostgresBackend) Update(ctx context.Context, id string, data []byte) error {
+	tag, err := p.pool.Exec(ctx,
+		fmt.Sprintf(`UPDATE %s SET data = $2, updated_at = NOW() WHERE id = $1`, p.table),
+		id, data)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Modify locks the row with SELECT ... FOR UPDATE, so concurrent
+// modifications, also of other CMK instances, wait for the transaction
+func (p *PostgresBackend) Modify(ctx context.Context, id string, fn func(data []byte) ([]byte, error)) error {
+	return pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		var data []byte
+
+		err := tx.QueryRow(ctx,
+			fmt.Sprintf(`SELECT data FROM %s WHERE id = $1 FOR UPDATE`, p.table), id).Scan(&data)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+
+		if err != nil {
+			return err
+		}
+
+		data, err = fn(data)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx,
+			fmt.Sprintf(`UPDATE %s SET data = $2, updated_at = NOW() WHERE id = $1`, p.table),
+			id, data)
+
+		return err
+	})
+}
+
+func (p *PostgresBackend) Delete(ctx context.Context, id string) error {
+	tag, err := p.pool.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, p.table), id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgresBackend) Close() error {
+	p.pool.Close()
+
+	return nil
+}
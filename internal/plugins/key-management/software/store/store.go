@@ -0,0 +1,159 @@
+// Package store keeps the records of the software keystore encrypted under
+// a master key. Records are sealed with AES-256-GCM, bound to their ID, so
+// a backend never sees key material in clear text and records can't be
+// swapped between keys.
+package store
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+
+	"github.com/openkcm/cmk/internal/errs"
+)
+
+// MasterKeySize is the size of the AES-256 master key
+const MasterKeySize = 32
+
+var (
+	ErrNotFound         = errors.New("record not found")
+	ErrExists           = errors.New("record already exists")
+	ErrInvalidMasterKey = errors.New("master key must be 32 bytes")
+	ErrSeal             = errors.New("failed to seal record")
+	ErrOpen             = errors.New("failed to open record, wrong master key or corrupted record")
+)
+
+// Backend persists sealed records by ID
+type Backend interface {
+	Get(ctx context.Context, id string) ([]byte, error)
+	// Create fails with ErrExists if the record exists
+	Create(ctx context.Context, id string, data []byte) error
+	// Update fails with ErrNotFound if the record does not exist
+	Update(ctx context.Context, id string, data []byte) error
+	// Modify replaces the record with the result of fn, without concurrent
+	// writes between the read and the write. It fails with ErrNotFound if
+	// the record does not exist and returns the error of fn as is.
+	Modify(ctx context.Context, id string, fn func(data []byte) ([]byte, error)) error
+	Delete(ctx context.Context, id string) error
+	Close() error
+}
+
+// Store encrypts records as JSON under the master key
+type Store struct {
+	backend Backend
+	aead    cipher.AEAD
+}
+
+func New(backend Backend, masterKey []byte) (*Store, error) {
+	if len(masterKey) != MasterKeySize {
+		return nil, ErrInvalidMasterKey
+	}
+
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, errs.Wrap(ErrInvalidMasterKey, err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errs.Wrap(ErrInvalidMasterKey, err)
+	}
+
+	return &Store{backend: backend, aead: aead}, nil
+}
+
+// Get decrypts the record into v
+func (s *Store) Get(ctx context.Context, id string, v any) error {
+	data, err := s.backend.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.open(id, data, v)
+}
+
+func (s *Store) Create(ctx context.Context, id string, v any) error {
+	data, err := s.seal(id, v)
+	if err != nil {
+		return err
+	}
+
+	return s.backend.Create(ctx, id, data)
+}
+
+func (s *Store) Update(ctx context.Context, id string, v any) error {
+	data, err := s.seal(id, v)
+	if err != nil {
+		return err
+	}
+
+	return s.backend.Update(ctx, id, data)
+}
+
+// Modify decrypts the record into v, calls fn to change v and stores v
+// again. Concurrent modifications of the record are serialized by the
+// backend. An error of fn aborts the modification and is returned as is.
+func (s *Store) Modify(ctx context.Context, id string, v any, fn func() error) error {
+	return s.backend.Modify(ctx, id, func(data []byte) ([]byte, error) {
+		err := s.open(id, data, v)
+		if err != nil {
+			return nil, err
+		}
+
+		err = fn()
+		if err != nil {
+			return nil, err
+		}
+
+		return s.seal(id, v)
+	})
+}
+
+func (s *Store) Delete(ctx context.Context, id string) error {
+	return s.backend.Delete(ctx, id)
+}
+
+func (s *Store) Close() error {
+	return s.backend.Close()
+}
+
+// seal returns the nonce followed by the encrypted JSON of v,
+// with the ID as additional data
+func (s *Store) seal(id string, v any) ([]byte, error) {
+	plain, err := json.Marshal(v)
+	if err != nil {
+		return nil, errs.Wrap(ErrSeal, err)
+	}
+
+	nonce := make([]byte, s.aead.NonceSize())
+
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, errs.Wrap(ErrSeal, err)
+	}
+
+	return s.aead.Seal(nonce, nonce, plain, []byte(id)), nil
+}
+
+// open decrypts the record data of the ID into v
+func (s *Store) open(id string, data []byte, v any) error {
+	nonceSize := s.aead.NonceSize()
+	if len(data) < nonceSize {
+		return ErrOpen
+	}
+
+	plain, err := s.aead.Open(nil, data[:nonceSize], data[nonceSize:], []byte(id))
+	if err != nil {
+		return errs.Wrap(ErrOpen, err)
+	}
+
+	err = json.Unmarshal(plain, v)
+	if err != nil {
+		return errs.Wrap(ErrOpen, err)
+	}
+
+	return nil
+}
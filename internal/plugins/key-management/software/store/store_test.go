@@ -0,0 +1,184 @@
+package store_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/cmk/internal/plugins/key-management/software/store"
+)
+
+type record struct {
+	Name     string
+	Material []byte
+}
+
+func newStore(t *testing.T, dir string, masterKey []byte) *store.Store {
+	t.Helper()
+
+	backend, err := store.NewFileBackend(dir)
+	require.NoError(t, err)
+
+	s, err := store.New(backend, masterKey)
+	require.NoError(t, err)
+
+	return s
+}
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	masterKey := bytes.Repeat([]byte{1}, store.MasterKeySize)
+	s := newStore(t, dir, masterKey)
+
+	material := bytes.Repeat([]byte{0xAB}, 32)
+
+	t.Run("Should create and get record", func(t *testing.T) {
+		err := s.Create(t.Context(), "key-1", record{Name: "first", Material: material})
+		require.NoError(t, err)
+
+		var got record
+		err = s.Get(t.Context(), "key-1", &got)
+		require.NoError(t, err)
+		assert.Equal(t, "first", got.Name)
+		assert.Equal(t, material, got.Material)
+	})
+
+	t.Run("Should not store material in clear text", func(t *testing.T) {
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+
+		data, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+		require.NoError(t, err)
+		assert.False(t, bytes.Contains(data, material))
+		assert.False(t, bytes.Contains(data, []byte("first")))
+	})
+
+	t.Run("Should fail on existing record", func(t *testing.T) {
+		err := s.Create(t.Context(), "key-1", record{Name: "second"})
+		assert.ErrorIs(t, err, store.ErrExists)
+	})
+
+	t.Run("Should update record", func(t *testing.T) {
+		err := s.Update(t.Context(), "key-1", record{Name: "updated"})
+		require.NoError(t, err)
+
+		var got record
+		err = s.Get(t.Context(), "key-1", &got)
+		require.NoError(t, err)
+		assert.Equal(t, "updated", got.Name)
+
+		err = s.Update(t.Context(), "unknown", record{})
+		assert.ErrorIs(t, err, store.ErrNotFound)
+	})
+
+	t.Run("Should fail to open with another master key", func(t *testing.T) {
+		other := newStore(t, dir, bytes.Repeat([]byte{2}, store.MasterKeySize))
+
+		var got record
+		err := other.Get(t.Context(), "key-1", &got)
+		assert.ErrorIs(t, err, store.ErrOpen)
+	})
+
+	t.Run("Should fail to open record moved to another ID", func(t *testing.T) {
+		err := s.Create(t.Context(), "key-2", record{Name: "other"})
+		require.NoError(t, err)
+
+		backend, err := store.NewFileBackend(dir)
+		require.NoError(t, err)
+
+		data, err := backend.Get(t.Context(), "key-1")
+		require.NoError(t, err)
+		err = backend.Update(t.Context(), "key-2", data)
+		require.NoError(t, err)
+
+		var got record
+		err = s.Get(t.Context(), "key-2", &got)
+		assert.ErrorIs(t, err, store.ErrOpen)
+	})
+
+	t.Run("Should delete record", func(t *testing.T) {
+		err := s.Delete(t.Context(), "key-1")
+		require.NoError(t, err)
+
+		var got record
+		err = s.Get(t.Context(), "key-1", &got)
+		assert.ErrorIs(t, err, store.ErrNotFound)
+
+		err = s.Delete(t.Context(), "key-1")
+		assert.ErrorIs(t, err, store.ErrNotFound)
+	})
+
+	t.Run("Should keep IDs inside the directory", func(t *testing.T) {
+		err := s.Create(t.Context(), "../escape", record{})
+		require.NoError(t, err)
+
+		_, err = os.Stat(filepath.Join(filepath.Dir(dir), "escape"))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestModify(t *testing.T) {
+	s := newStore(t, t.TempDir(), bytes.Repeat([]byte{1}, store.MasterKeySize))
+
+	err := s.Create(t.Context(), "key-1", record{})
+	require.NoError(t, err)
+
+	t.Run("Should not lose concurrent modifications", func(t *testing.T) {
+		const writers = 20
+
+		var wg sync.WaitGroup
+		for range writers {
+			wg.Go(func() {
+				var got record
+				err := s.Modify(t.Context(), "key-1", &got, func() error {
+					got.Material = append(got.Material, 1)
+					return nil
+				})
+				assert.NoError(t, err)
+			})
+		}
+
+		wg.Wait()
+
+		var got record
+		err := s.Get(t.Context(), "key-1", &got)
+		require.NoError(t, err)
+		assert.Len(t, got.Material, writers)
+	})
+
+	t.Run("Should keep record on error", func(t *testing.T) {
+		errAbort := errors.New("abort")
+
+		var got record
+		err := s.Modify(t.Context(), "key-1", &got, func() error {
+			got.Name = "changed"
+			return errAbort
+		})
+		require.ErrorIs(t, err, errAbort)
+
+		err = s.Get(t.Context(), "key-1", &got)
+		require.NoError(t, err)
+		assert.Empty(t, got.Name)
+	})
+
+	t.Run("Should fail on unknown record", func(t *testing.T) {
+		var got record
+		err := s.Modify(t.Context(), "unknown", &got, func() error { return nil })
+		assert.ErrorIs(t, err, store.ErrNotFound)
+	})
+}
+
+func TestNewStore(t *testing.T) {
+	backend, err := store.NewFileBackend(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = store.New(backend, []byte("short"))
+	assert.ErrorIs(t, err, store.ErrInvalidMasterKey)
+}
@@ -0,0 +1,63 @@
+// Package content converts notification bodies for channels without HTML
+// support, like plain text emails and chat messages.
+package content
+
+import (
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+var (
+	htmlTagPattern    = regexp.MustCompile(`(?i)<\s*(html|body|p|div|br|table|h[1-6])\b`)
+	lineBreakPattern  = regexp.MustCompile(`(?i)<\s*(br\s*/?|/p|/div|/tr|/h[1-6]|/li|/table)\s*>`)
+	blankLinesPattern = regexp.MustCompile(`\n{3,}`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+	linkPattern       = regexp.MustCompile(`(?is)<a\s[^>]*href\s*=\s*"([^"]+)"[^>]*>(.*?)</a>`)
+)
+
+// Link is a hyperlink of a HTML body
+type Link struct {
+	Text string
+	URL  string
+}
+
+// IsHTML reports whether the body is a HTML document or fragment
+func IsHTML(body string) bool {
+	return htmlTagPattern.MatchString(body)
+}
+
+// HTMLToText returns the text of the HTML with line breaks after blocks.
+// Whitespace is collapsed as by browsers.
+func HTMLToText(body string) string {
+	text := whitespacePattern.ReplaceAllString(body, " ")
+	text = lineBreakPattern.ReplaceAllString(text, "$0\n")
+	text = html.UnescapeString(bluemonday.StrictPolicy().Sanitize(text))
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+
+	text = blankLinesPattern.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+
+	return strings.TrimSpace(text)
+}
+
+// Links returns the hyperlinks of the HTML, links without URL are left out
+func Links(body string) []Link {
+	var links []Link
+
+	for _, match := range linkPattern.FindAllStringSubmatch(body, -1) {
+		url := strings.TrimSpace(html.UnescapeString(match[1]))
+		if url == "" {
+			continue
+		}
+
+		links = append(links, Link{Text: HTMLToText(match[2]), URL: url})
+	}
+
+	return links
+}
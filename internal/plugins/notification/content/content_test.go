@@ -0,0 +1,34 @@
+package content_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/cmk/internal/plugins/notification/content"
+)
+
+func TestIsHTML(t *testing.T) {
+	assert.True(t, content.IsHTML("<html><body>Hello</body></html>"))
+	assert.True(t, content.IsHTML("<p>Hello</p>"))
+	assert.False(t, content.IsHTML("Hello <world>"))
+}
+
+func TestHTMLToText(t *testing.T) {
+	text := content.HTMLToText(`<html><head><style>p { color: red; }</style></head><body>
+		<h1>Approval</h1>
+		<p>Please   approve &amp; confirm.</p><p>Line<br>break</p>
+	</body></html>`)
+
+	assert.Equal(t, "Approval\nPlease approve & confirm.\nLine\nbreak", text)
+}
+
+func TestLinks(t *testing.T) {
+	links := content.Links(`<p><a style="color: blue" href="https://example.com/a?x=1&amp;y=2">First
+		<b>link</b></a> <a href="">Empty</a> <a href="https://example.com/b">Second</a></p>`)
+
+	assert.Equal(t, []content.Link{
+		{Text: "First link", URL: "https://example.com/a?x=1&y=2"},
+		{Text: "Second", URL: "https://example.com/b"},
+	}, links)
+}
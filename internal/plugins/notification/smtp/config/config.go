@@ -0,0 +1,44 @@
+package config
+
+import (
+	"time"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+)
+
+type TLSMode string
+
+const (
+	// TLSModeStartTLS upgrades the connection with STARTTLS and fails if
+	// the server does not offer it
+	TLSModeStartTLS TLSMode = "starttls"
+	// TLSModeImplicit connects with TLS, usually on port 465
+	TLSModeImplicit TLSMode = "implicit"
+	// TLSModeNone sends in clear text, only for local SMTP servers
+	TLSModeNone TLSMode = "none"
+)
+
+type RateLimit struct {
+	// MessagesPerSecond limits the sent messages, one per recipient.
+	// Messages are not limited without it.
+	MessagesPerSecond float64 `yaml:"messagesPerSecond"`
+	Burst             int     `yaml:"burst"`
+}
+
+type Config struct {
+	Host string  `yaml:"host"`
+	Port int     `yaml:"port"`
+	TLS  TLSMode `yaml:"tls"`
+	// CACertificate is the PEM encoded CA of the server certificate.
+	// The system roots are used without it.
+	CACertificate commoncfg.SourceRef `yaml:"caCertificate"`
+	// Username and Password authenticate with AUTH PLAIN if set
+	Username commoncfg.SourceRef `yaml:"username"`
+	Password commoncfg.SourceRef `yaml:"password"`
+	// From is the sender address of the emails
+	From string `yaml:"from"`
+	// LocalName is sent with EHLO, defaults to localhost
+	LocalName string        `yaml:"localName"`
+	Timeout   time.Duration `yaml:"timeout"`
+	RateLimit RateLimit     `yaml:"rateLimit"`
+}
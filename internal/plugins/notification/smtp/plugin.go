@@ -0,0 +1,404 @@
+// Package smtp is a built-in notification plugin sending email notifications
+// through a SMTP server. Every recipient gets an own message, so failures
+// are reported per recipient.
+package smtp
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/mail"
+	netsmtp "net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/openkcm/plugin-sdk/pkg/catalog"
+	"github.com/openkcm/plugin-sdk/pkg/hclog2slog"
+	"github.com/samber/oops"
+	"golang.org/x/time/rate"
+	"gopkg.in/yaml.v3"
+
+	notificationv1 "github.com/openkcm/plugin-sdk/proto/plugin/notification/v1"
+	configv1 "github.com/openkcm/plugin-sdk/proto/service/common/config/v1"
+
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/notification"
+	"github.com/openkcm/cmk/internal/plugins/notification/smtp/config"
+)
+
+const (
+	defaultTimeout   = 30 * time.Second
+	defaultLocalName = "localhost"
+)
+
+var (
+	ErrSMTP = oops.In("SMTP notification plugin")
+
+	ErrNoStartTLS = errors.New("server does not support STARTTLS")
+
+	errInvalidRecipient = errors.New("invalid recipient address")
+)
+
+func Register(registry catalog.BuiltInPluginRegistry) {
+	registry.Register(builtin(NewPlugin()))
+}
+
+func builtin(p *Plugin) catalog.BuiltInPlugin {
+	return catalog.MakeBuiltIn("smtp",
+		notificationv1.NotificationServicePluginServer(p),
+		configv1.ConfigServiceServer(p))
+}
+
+type Plugin struct {
+	notificationv1.UnsafeNotificationServiceServer
+	configv1.UnsafeConfigServer
+
+	logger    *slog.Logger
+	buildInfo string
+
+	mu     sync.RWMutex
+	server *server
+}
+
+// sessionClient is a SMTP session with its connection, whose deadline
+// is extended for every message
+type sessionClient struct {
+	*netsmtp.Client
+
+	conn net.Conn
+}
+
+// server is the configured SMTP server
+type server struct {
+	addr      string
+	host      string
+	tlsMode   config.TLSMode
+	tlsConfig *tls.Config
+	auth      netsmtp.Auth
+	from      string
+	localName string
+	timeout   time.Duration
+	limiter   *rate.Limiter
+}
+
+var (
+	_ notificationv1.NotificationServiceServer = (*Plugin)(nil)
+	_ configv1.ConfigServer                    = (*Plugin)(nil)
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{
+		buildInfo: "{}",
+	}
+}
+
+func (p *Plugin) SetLogger(logger hclog.Logger) {
+	p.logger = hclog2slog.New(logger)
+}
+
+func (p *Plugin) Configure(
+	_ context.Context,
+	req *configv1.ConfigureRequest,
+) (*configv1.ConfigureResponse, error) {
+	slog.Info("Configuring plugin")
+
+	cfg := &config.Config{}
+
+	err := yaml.Unmarshal([]byte(req.GetYamlConfiguration()), cfg)
+	if err != nil {
+		return nil, ErrSMTP.Wrapf(err, "Failed to get yaml Configuration")
+	}
+
+	s, err := newServer(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	p.server = s
+	p.mu.Unlock()
+
+	return &configv1.ConfigureResponse{
+		BuildInfo: &p.buildInfo,
+	}, nil
+}
+
+// SendNotification sends an email to every recipient. The response is
+// unsuccessful if any recipient failed, the message names them.
+func (p *Plugin) SendNotification(
+	ctx context.Context,
+	req *notificationv1.SendNotificationRequest,
+) (*notificationv1.SendNotificationResponse, error) {
+	p.mu.RLock()
+	s := p.server
+	p.mu.RUnlock()
+
+	if s == nil {
+		return failure("SMTP notification plugin is not configured"), nil
+	}
+
+	if notification.Type(req.GetNotificationType()) != notification.Email {
+		return failure("only email notifications are supported"), nil
+	}
+
+	recipients := req.GetRecipients()
+	failures := make([]string, 0, len(recipients))
+
+	fail := func(recipient string, err error) {
+		failures = append(failures, fmt.Sprintf("%s: %v", recipient, err))
+	}
+
+	c, err := s.dial(ctx)
+	if err != nil {
+		p.logError("Failed to connect to SMTP server", err)
+		return failure(fmt.Sprintf("failed to connect to SMTP server: %v", err)), nil
+	}
+
+	defer func() { _ = c.Close() }()
+
+	for i, recipient := range recipients {
+		err = s.send(ctx, c, recipient, req.GetSubject(), req.GetBody())
+		if err == nil {
+			continue
+		}
+
+		fail(recipient, err)
+
+		var smtpErr *textproto.Error
+		if errors.As(err, &smtpErr) || errors.Is(err, errInvalidRecipient) {
+			// The server rejected the recipient, the session goes on
+			_ = c.Reset()
+			continue
+		}
+
+		// The connection is unusable, the remaining recipients fail too
+		for _, r := range recipients[i+1:] {
+			fail(r, err)
+		}
+
+		break
+	}
+
+	_ = c.Quit()
+
+	if len(failures) > 0 {
+		msg := fmt.Sprintf("failed to send email to %d of %d recipients: %s",
+			len(failures), len(recipients), strings.Join(failures, "; "))
+		p.logWarn(msg)
+
+		return failure(msg), nil
+	}
+
+	return &notificationv1.SendNotificationResponse{Success: true}, nil
+}
+
+func (s *server) dial(ctx context.Context) (*sessionClient, error) {
+	dialer := &net.Dialer{Timeout: s.timeout}
+
+	var (
+		conn net.Conn
+		err  error
+	)
+
+	if s.tlsMode == config.TLSModeImplicit {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: s.tlsConfig}).DialContext(ctx, "tcp", s.addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", s.addr)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	_ = conn.SetDeadline(time.Now().Add(s.timeout))
+
+	c, err := netsmtp.NewClient(conn, s.host)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	err = s.hello(c)
+	if err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+
+	return &sessionClient{Client: c, conn: conn}, nil
+}
+
+func (s *server) hello(c *netsmtp.Client) error {
+	err := c.Hello(s.localName)
+	if err != nil {
+		return err
+	}
+
+	if s.tlsMode == config.TLSModeStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return ErrNoStartTLS
+		}
+
+		err = c.StartTLS(s.tlsConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.auth != nil {
+		return c.Auth(s.auth)
+	}
+
+	return nil
+}
+
+// send sends the email to a single recipient once the rate limit allows it
+func (s *server) send(ctx context.Context, c *sessionClient, recipient, subject, body string) error {
+	to, err := mail.ParseAddress(recipient)
+	if err != nil {
+		return errInvalidRecipient
+	}
+
+	err = s.limiter.Wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	_ = c.conn.SetDeadline(time.Now().Add(s.timeout))
+
+	msg, err := message{
+		From:    s.from,
+		To:      to.Address,
+		Subject: subject,
+		Body:    body,
+		Date:    time.Now(),
+	}.Bytes()
+	if err != nil {
+		return err
+	}
+
+	err = c.Mail(s.from)
+	if err != nil {
+		return err
+	}
+
+	err = c.Rcpt(to.Address)
+	if err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(msg)
+	if err != nil {
+		return err
+	}
+
+	return w.Close()
+}
+
+func (p *Plugin) logError(msg string, err error) {
+	if p.logger != nil {
+		p.logger.Error(msg, "error", err)
+	}
+}
+
+func (p *Plugin) logWarn(msg string) {
+	if p.logger != nil {
+		p.logger.Warn(msg)
+	}
+}
+
+func failure(msg string) *notificationv1.SendNotificationResponse {
+	return &notificationv1.SendNotificationResponse{Success: false, Message: msg}
+}
+
+func newServer(cfg *config.Config) (*server, error) {
+	if cfg.Host == "" || cfg.Port == 0 {
+		return nil, ErrSMTP.Errorf("host and port are required")
+	}
+
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, ErrSMTP.Wrapf(err, "Invalid from address")
+	}
+
+	s := &server{
+		addr:      net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		host:      cfg.Host,
+		tlsMode:   cfg.TLS,
+		tlsConfig: &tls.Config{ServerName: cfg.Host, MinVersion: tls.VersionTLS12},
+		from:      from.Address,
+		localName: cfg.LocalName,
+		timeout:   cfg.Timeout,
+		limiter:   rate.NewLimiter(rate.Inf, 0),
+	}
+
+	switch s.tlsMode {
+	case "":
+		s.tlsMode = config.TLSModeStartTLS
+	case config.TLSModeStartTLS, config.TLSModeImplicit, config.TLSModeNone:
+	default:
+		return nil, ErrSMTP.Errorf("Unknown TLS mode %q", cfg.TLS)
+	}
+
+	if s.localName == "" {
+		s.localName = defaultLocalName
+	}
+
+	if s.timeout <= 0 {
+		s.timeout = defaultTimeout
+	}
+
+	if cfg.RateLimit.MessagesPerSecond > 0 {
+		s.limiter = rate.NewLimiter(rate.Limit(cfg.RateLimit.MessagesPerSecond), max(cfg.RateLimit.Burst, 1))
+	}
+
+	caCert, err := loadOptional(cfg.CACertificate)
+	if err != nil {
+		return nil, ErrSMTP.Wrapf(err, "Failed loading CA certificate")
+	}
+
+	if len(caCert) > 0 {
+		s.tlsConfig.RootCAs = x509.NewCertPool()
+		if !s.tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, ErrSMTP.Errorf("No CA certificate found")
+		}
+	}
+
+	username, err := loadOptional(cfg.Username)
+	if err != nil {
+		return nil, ErrSMTP.Wrapf(err, "Failed loading username")
+	}
+
+	if len(username) > 0 {
+		password, err := loadOptional(cfg.Password)
+		if err != nil {
+			return nil, ErrSMTP.Wrapf(err, "Failed loading password")
+		}
+
+		s.auth = netsmtp.PlainAuth("", strings.TrimSpace(string(username)),
+			strings.TrimSpace(string(password)), cfg.Host)
+	}
+
+	return s, nil
+}
+
+// loadOptional loads the value of a source reference that may be left out
+func loadOptional(ref commoncfg.SourceRef) ([]byte, error) {
+	if ref == (commoncfg.SourceRef{}) {
+		return nil, nil
+	}
+
+	return commoncfg.LoadValueFromSourceRef(ref)
+}
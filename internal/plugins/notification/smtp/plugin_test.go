@@ -0,0 +1,393 @@
+package smtp_test
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	notificationv1 "github.com/openkcm/plugin-sdk/proto/plugin/notification/v1"
+	configv1 "github.com/openkcm/plugin-sdk/proto/service/common/config/v1"
+
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/notification"
+	"github.com/openkcm/cmk/internal/plugins/notification/smtp"
+)
+
+const (
+	testUser     = "cmk"
+	testPassword = "secret"
+)
+
+var emailType = notificationv1.NotificationType(notification.Email)
+
+// standIn is a local SMTP stand-in keeping the received messages. It
+// rejects recipients containing "rejected".
+type standIn struct {
+	listener  net.Listener
+	tlsConfig *tls.Config
+	startTLS  bool
+	caPath    string
+
+	mu       sync.Mutex
+	messages []*mail.Message
+	authed   bool
+	tls      bool
+}
+
+func newStandIn(t *testing.T, implicitTLS, startTLS bool) *standIn {
+	t.Helper()
+
+	s := &standIn{startTLS: startTLS}
+	s.tlsConfig, s.caPath = newServerTLS(t)
+
+	var err error
+	if implicitTLS {
+		s.listener, err = tls.Listen("tcp", "127.0.0.1:0", s.tlsConfig)
+	} else {
+		s.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = s.listener.Close() })
+
+	go s.serve()
+
+	return s
+}
+
+func (s *standIn) port() string {
+	return strconv.Itoa(s.listener.Addr().(*net.TCPAddr).Port)
+}
+
+func (s *standIn) received() []*mail.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.messages
+}
+
+func (s *standIn) state() (bool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tls, s.authed
+}
+
+func (s *standIn) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go s.handle(conn)
+	}
+}
+
+func (s *standIn) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	if _, ok := conn.(*tls.Conn); ok {
+		s.setTLS()
+	}
+
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 localhost SMTP stand-in")
+
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		cmd, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(cmd) {
+		case "EHLO":
+			_ = tp.PrintfLine("250-localhost")
+			if s.startTLS {
+				_ = tp.PrintfLine("250-STARTTLS")
+			}
+
+			_ = tp.PrintfLine("250 AUTH PLAIN")
+		case "STARTTLS":
+			_ = tp.PrintfLine("220 ready")
+
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if tlsConn.Handshake() != nil {
+				return
+			}
+
+			s.setTLS()
+
+			conn = tlsConn
+			tp = textproto.NewConn(conn)
+		case "AUTH":
+			credentials, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(arg, "PLAIN "))
+			if string(credentials) != "\x00"+testUser+"\x00"+testPassword {
+				_ = tp.PrintfLine("535 authentication failed")
+				continue
+			}
+
+			s.mu.Lock()
+			s.authed = true
+			s.mu.Unlock()
+
+			_ = tp.PrintfLine("235 authenticated")
+		case "RCPT":
+			if strings.Contains(arg, "rejected") {
+				_ = tp.PrintfLine("550 mailbox unavailable")
+				continue
+			}
+
+			_ = tp.PrintfLine("250 ok")
+		case "DATA":
+			_ = tp.PrintfLine("354 go ahead")
+
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+
+			msg, err := mail.ReadMessage(bufio.NewReader(strings.NewReader(string(data))))
+			if err != nil {
+				_ = tp.PrintfLine("554 invalid message")
+				continue
+			}
+
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+
+			_ = tp.PrintfLine("250 queued")
+		case "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			return
+		default:
+			_ = tp.PrintfLine("250 ok")
+		}
+	}
+}
+
+func (s *standIn) setTLS() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tls = true
+}
+
+func newServerTLS(t *testing.T) (*tls.Config, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "SMTP stand-in"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	caPath := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+		MinVersion:   tls.VersionTLS12,
+	}, caPath
+}
+
+func setupPlugin(t *testing.T, s *standIn, extraConfig string) *smtp.Plugin {
+	t.Helper()
+
+	p := smtp.NewPlugin()
+	_, err := p.Configure(t.Context(), &configv1.ConfigureRequest{
+		YamlConfiguration: `
+host: 127.0.0.1
+port: ` + s.port() + `
+from: CMK <cmk@example.com>
+caCertificate:
+  source: file
+  file:
+    path: ` + s.caPath + `
+` + extraConfig,
+	})
+	require.NoError(t, err)
+
+	return p
+}
+
+func sendRequest(recipients ...string) *notificationv1.SendNotificationRequest {
+	return &notificationv1.SendNotificationRequest{
+		NotificationType: emailType,
+		Recipients:       recipients,
+		Subject:          "Workflow Approval Required",
+		Body:             "<html><body><h1>Approval</h1><p>Please approve &amp; confirm.</p></body></html>",
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	t.Run("Should fail without host", func(t *testing.T) {
+		_, err := smtp.NewPlugin().Configure(t.Context(), &configv1.ConfigureRequest{
+			YamlConfiguration: `from: cmk@example.com`,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail on unknown TLS mode", func(t *testing.T) {
+		_, err := smtp.NewPlugin().Configure(t.Context(), &configv1.ConfigureRequest{
+			YamlConfiguration: `
+host: localhost
+port: 25
+from: cmk@example.com
+tls: opportunistic`,
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestSendNotification(t *testing.T) {
+	t.Run("Should send HTML and plain text to every recipient", func(t *testing.T) {
+		s := newStandIn(t, false, false)
+		p := setupPlugin(t, s, "tls: none")
+
+		resp, err := p.SendNotification(t.Context(), sendRequest("a@example.com", "b@example.com"))
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess(), resp.GetMessage())
+
+		messages := s.received()
+		require.Len(t, messages, 2)
+		assert.Equal(t, "a@example.com", messages[0].Header.Get("To"))
+		assert.Equal(t, "b@example.com", messages[1].Header.Get("To"))
+		assert.Equal(t, "cmk@example.com", messages[0].Header.Get("From"))
+		assert.Equal(t, "Workflow Approval Required", messages[0].Header.Get("Subject"))
+		assert.Contains(t, messages[0].Header.Get("Content-Type"), "multipart/alternative")
+
+		body, err := readBody(messages[0])
+		require.NoError(t, err)
+		assert.Contains(t, body, "Content-Type: text/plain")
+		assert.Contains(t, body, "Please approve & confirm.")
+		assert.Contains(t, body, "Content-Type: text/html")
+	})
+
+	t.Run("Should report failed recipients", func(t *testing.T) {
+		s := newStandIn(t, false, false)
+		p := setupPlugin(t, s, "tls: none")
+
+		resp, err := p.SendNotification(t.Context(),
+			sendRequest("rejected@example.com", "not an address", "ok@example.com"))
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Contains(t, resp.GetMessage(), "2 of 3 recipients")
+		assert.Contains(t, resp.GetMessage(), "rejected@example.com")
+		assert.Contains(t, resp.GetMessage(), "not an address")
+		assert.NotContains(t, resp.GetMessage(), "ok@example.com")
+		assert.Len(t, s.received(), 1)
+	})
+
+	t.Run("Should authenticate after STARTTLS", func(t *testing.T) {
+		s := newStandIn(t, false, true)
+		p := setupPlugin(t, s, `
+tls: starttls
+username:
+  source: embedded
+  value: `+testUser+`
+password:
+  source: embedded
+  value: `+testPassword)
+
+		resp, err := p.SendNotification(t.Context(), sendRequest("a@example.com"))
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess(), resp.GetMessage())
+		tlsUsed, authed := s.state()
+		assert.True(t, tlsUsed)
+		assert.True(t, authed)
+	})
+
+	t.Run("Should fail without STARTTLS support", func(t *testing.T) {
+		s := newStandIn(t, false, false)
+		p := setupPlugin(t, s, "tls: starttls")
+
+		resp, err := p.SendNotification(t.Context(), sendRequest("a@example.com"))
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Empty(t, s.received())
+	})
+
+	t.Run("Should send with implicit TLS", func(t *testing.T) {
+		s := newStandIn(t, true, false)
+		p := setupPlugin(t, s, "tls: implicit")
+
+		resp, err := p.SendNotification(t.Context(), sendRequest("a@example.com"))
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess(), resp.GetMessage())
+
+		tlsUsed, _ := s.state()
+		assert.True(t, tlsUsed)
+	})
+
+	t.Run("Should limit the sending rate", func(t *testing.T) {
+		s := newStandIn(t, false, false)
+		p := setupPlugin(t, s, `
+tls: none
+rateLimit:
+  messagesPerSecond: 20
+  burst: 1`)
+
+		start := time.Now()
+		resp, err := p.SendNotification(t.Context(),
+			sendRequest("a@example.com", "b@example.com", "c@example.com"))
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess(), resp.GetMessage())
+		assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	})
+
+	t.Run("Should not send other notification types", func(t *testing.T) {
+		s := newStandIn(t, false, false)
+		p := setupPlugin(t, s, "tls: none")
+
+		req := sendRequest("a@example.com")
+		req.NotificationType = notificationv1.NotificationType(notification.Text)
+
+		resp, err := p.SendNotification(t.Context(), req)
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+	})
+}
+
+func readBody(msg *mail.Message) (string, error) {
+	var b strings.Builder
+
+	_, err := bufio.NewReader(msg.Body).WriteTo(&b)
+
+	return b.String(), err
+}
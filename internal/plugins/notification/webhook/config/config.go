@@ -0,0 +1,41 @@
+package config
+
+import (
+	"time"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+)
+
+// Format is the payload format of an endpoint
+type Format string
+
+const (
+	FormatJSON  Format = "json"
+	FormatSlack Format = "slack"
+	FormatTeams Format = "teams"
+)
+
+type Endpoint struct {
+	Name string `yaml:"name"`
+	// URL is a source reference, as chat webhook URLs carry their credentials
+	URL    commoncfg.SourceRef `yaml:"url"`
+	Format Format              `yaml:"format"`
+	// Secret is the HMAC key signing the payloads, they are unsigned without it
+	Secret commoncfg.SourceRef  `yaml:"secret"`
+	Client commoncfg.HTTPClient `yaml:"client"`
+}
+
+type Retry struct {
+	MaxAttempts    int           `yaml:"maxAttempts"`
+	InitialBackoff time.Duration `yaml:"initialBackoff"`
+	MaxBackoff     time.Duration `yaml:"maxBackoff"`
+}
+
+type Config struct {
+	Endpoints []Endpoint `yaml:"endpoints"`
+	// NotificationTypes are the delivered notification types, web by default.
+	// Adding email delivers the email notifications, like the workflow
+	// notifications, to the endpoints as well.
+	NotificationTypes []string `yaml:"notificationTypes"`
+	Retry             Retry    `yaml:"retry"`
+}
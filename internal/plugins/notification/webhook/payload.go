@@ -0,0 +1,175 @@
+package webhook
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/openkcm/cmk/internal/plugins/notification/content"
+	"github.com/openkcm/cmk/internal/plugins/notification/webhook/config"
+)
+
+const (
+	// Limits of the Slack header, section and button texts
+	slackHeaderLimit  = 150
+	slackSectionLimit = 3000
+	slackButtonLimit  = 75
+)
+
+// notificationPayload is the content of a notification for all formats
+type notificationPayload struct {
+	Type       string
+	Subject    string
+	Body       string
+	Text       string
+	Links      []content.Link
+	Recipients []string
+	SentAt     time.Time
+}
+
+func newNotificationPayload(notificationType, subject, body string, recipients []string) notificationPayload {
+	p := notificationPayload{
+		Type:       notificationType,
+		Subject:    subject,
+		Body:       body,
+		Text:       body,
+		Recipients: recipients,
+		SentAt:     time.Now().UTC(),
+	}
+
+	if content.IsHTML(body) {
+		p.Text = content.HTMLToText(body)
+		p.Links = content.Links(body)
+	}
+
+	return p
+}
+
+// marshal returns the payload in the format of an endpoint
+func (p notificationPayload) marshal(format config.Format) ([]byte, error) {
+	switch format {
+	case config.FormatSlack:
+		return json.Marshal(p.slack())
+	case config.FormatTeams:
+		return json.Marshal(p.teams())
+	default:
+		return json.Marshal(p.json())
+	}
+}
+
+type jsonLink struct {
+	Text string `json:"text"`
+	URL  string `json:"url"`
+}
+
+type jsonPayload struct {
+	Type       string     `json:"type"`
+	Subject    string     `json:"subject"`
+	Body       string     `json:"body"`
+	Text       string     `json:"text"`
+	Links      []jsonLink `json:"links"`
+	Recipients []string   `json:"recipients"`
+	SentAt     time.Time  `json:"sentAt"`
+}
+
+func (p notificationPayload) json() jsonPayload {
+	links := make([]jsonLink, 0, len(p.Links))
+	for _, l := range p.Links {
+		links = append(links, jsonLink(l))
+	}
+
+	return jsonPayload{
+		Type:       p.Type,
+		Subject:    p.Subject,
+		Body:       p.Body,
+		Text:       p.Text,
+		Links:      links,
+		Recipients: p.Recipients,
+		SentAt:     p.SentAt,
+	}
+}
+
+// slack returns a message for Slack incoming webhooks using Block Kit
+func (p notificationPayload) slack() map[string]any {
+	text := func(kind, value string) map[string]any {
+		return map[string]any{"type": kind, "text": value}
+	}
+
+	blocks := []map[string]any{
+		{"type": "header", "text": text("plain_text", truncate(p.Subject, slackHeaderLimit))},
+		{"type": "section", "text": text("mrkdwn", truncate(slackEscape(p.Text), slackSectionLimit))},
+	}
+
+	if len(p.Links) > 0 {
+		buttons := make([]map[string]any, 0, len(p.Links))
+		for _, l := range p.Links {
+			buttons = append(buttons, map[string]any{
+				"type": "button",
+				"text": text("plain_text", truncate(l.Text, slackButtonLimit)),
+				"url":  l.URL,
+			})
+		}
+
+		blocks = append(blocks, map[string]any{"type": "actions", "elements": buttons})
+	}
+
+	if len(p.Recipients) > 0 {
+		blocks = append(blocks, map[string]any{
+			"type":     "context",
+			"elements": []map[string]any{text("mrkdwn", "Recipients: "+slackEscape(strings.Join(p.Recipients, ", ")))},
+		})
+	}
+
+	return map[string]any{
+		"text":   p.Subject,
+		"blocks": blocks,
+	}
+}
+
+// teams returns a message with an Adaptive Card for Teams incoming webhooks
+func (p notificationPayload) teams() map[string]any {
+	body := []map[string]any{
+		{"type": "TextBlock", "text": p.Subject, "weight": "Bolder", "size": "Medium", "wrap": true},
+		{"type": "TextBlock", "text": p.Text, "wrap": true},
+	}
+
+	if len(p.Recipients) > 0 {
+		body = append(body, map[string]any{
+			"type":  "FactSet",
+			"facts": []map[string]any{{"title": "Recipients", "value": strings.Join(p.Recipients, ", ")}},
+		})
+	}
+
+	actions := make([]map[string]any, 0, len(p.Links))
+	for _, l := range p.Links {
+		actions = append(actions, map[string]any{"type": "Action.OpenUrl", "title": l.Text, "url": l.URL})
+	}
+
+	return map[string]any{
+		"type": "message",
+		"attachments": []map[string]any{{
+			"contentType": "application/vnd.microsoft.card.adaptive",
+			"content": map[string]any{
+				"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+				"type":    "AdaptiveCard",
+				"version": "1.4",
+				"body":    body,
+				"actions": actions,
+			},
+		}},
+	}
+}
+
+// slackEscape escapes the control characters of Slack mrkdwn
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+func truncate(s string, limit int) string {
+	r := []rune(s)
+	if len(r) <= limit {
+		return s
+	}
+
+	return string(r[:limit-1]) + "…"
+}
@@ -0,0 +1,494 @@
+// Package webhook is a built-in notification plugin posting notifications
+// as JSON to HTTP endpoints like Slack or Teams incoming webhooks.
+//
+// Payloads of endpoints with a secret are signed. The X-CMK-Timestamp
+// header holds the unix time of the delivery and the X-CMK-Signature header
+// "sha256=" followed by the hex encoded HMAC-SHA256 of the timestamp, a dot
+// and the payload. Failed deliveries are retried with exponential backoff.
+//
+// The X-CMK-Delivery header identifies the notification, it is the same
+// for every attempt. After a partial failure the endpoints which got the
+// notification are skipped when it is sent again, so the retry only posts
+// to the failed endpoints. Once every endpoint got it, a notification with
+// the same content is delivered as a new one.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/openkcm/common-sdk/pkg/commonhttp"
+	"github.com/openkcm/plugin-sdk/pkg/catalog"
+	"github.com/openkcm/plugin-sdk/pkg/hclog2slog"
+	"github.com/samber/oops"
+	"gopkg.in/yaml.v3"
+
+	notificationv1 "github.com/openkcm/plugin-sdk/proto/plugin/notification/v1"
+	configv1 "github.com/openkcm/plugin-sdk/proto/service/common/config/v1"
+
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/notification"
+	"github.com/openkcm/cmk/internal/plugins/notification/webhook/config"
+)
+
+const (
+	TimestampHeader = "X-CMK-Timestamp"
+	SignatureHeader = "X-CMK-Signature"
+	DeliveryHeader  = "X-CMK-Delivery"
+
+	defaultTimeout        = 10 * time.Second
+	defaultMaxAttempts    = 3
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = 30 * time.Second
+
+	// deliveredTTL is how long deliveries of a partially failed notification
+	// are remembered to skip the endpoints which got it on its retries
+	deliveredTTL = 24 * time.Hour
+)
+
+var (
+	ErrWebhook = oops.In("Webhook notification plugin")
+
+	errRetryable = errors.New("retryable delivery failure")
+)
+
+// notificationTypes maps the configured notification types
+var notificationTypes = map[string]notification.Type{
+	"email": notification.Email,
+	"text":  notification.Text,
+	"web":   notification.Web,
+}
+
+func Register(registry catalog.BuiltInPluginRegistry) {
+	registry.Register(builtin(NewPlugin()))
+}
+
+func builtin(p *Plugin) catalog.BuiltInPlugin {
+	return catalog.MakeBuiltIn("webhook",
+		notificationv1.NotificationServicePluginServer(p),
+		configv1.ConfigServiceServer(p))
+}
+
+type Plugin struct {
+	notificationv1.UnsafeNotificationServiceServer
+	configv1.UnsafeConfigServer
+
+	logger    *slog.Logger
+	buildInfo string
+
+	mu        sync.RWMutex
+	endpoints []*endpoint
+	types     []notification.Type
+	retry     config.Retry
+
+	// delivered holds the time of the deliveries by notification ID and
+	// endpoint name
+	deliveredMu sync.Mutex
+	delivered   map[string]time.Time
+}
+
+// endpoint is a configured webhook
+type endpoint struct {
+	name   string
+	url    string
+	format config.Format
+	secret []byte
+	client *http.Client
+}
+
+var (
+	_ notificationv1.NotificationServiceServer = (*Plugin)(nil)
+	_ configv1.ConfigServer                    = (*Plugin)(nil)
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{
+		buildInfo: "{}",
+		delivered: make(map[string]time.Time),
+	}
+}
+
+func (p *Plugin) SetLogger(logger hclog.Logger) {
+	p.logger = hclog2slog.New(logger)
+}
+
+func (p *Plugin) Configure(
+	_ context.Context,
+	req *configv1.ConfigureRequest,
+) (*configv1.ConfigureResponse, error) {
+	slog.Info("Configuring plugin")
+
+	cfg := &config.Config{}
+
+	err := yaml.Unmarshal([]byte(req.GetYamlConfiguration()), cfg)
+	if err != nil {
+		return nil, ErrWebhook.Wrapf(err, "Failed to get yaml Configuration")
+	}
+
+	if len(cfg.Endpoints) == 0 {
+		return nil, ErrWebhook.Errorf("No endpoints configured")
+	}
+
+	types, err := parseTypes(cfg.NotificationTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]*endpoint, 0, len(cfg.Endpoints))
+
+	for i := range cfg.Endpoints {
+		e, err := newEndpoint(&cfg.Endpoints[i])
+		if err != nil {
+			return nil, err
+		}
+
+		endpoints = append(endpoints, e)
+	}
+
+	p.mu.Lock()
+	p.endpoints = endpoints
+	p.types = types
+	p.retry = retrySettings(cfg.Retry)
+	p.mu.Unlock()
+
+	return &configv1.ConfigureResponse{
+		BuildInfo: &p.buildInfo,
+	}, nil
+}
+
+// SendNotification posts the notification to every endpoint which didn't
+// get it yet. The response is unsuccessful if any endpoint failed, the
+// message names the failed and the delivered endpoints.
+func (p *Plugin) SendNotification(
+	ctx context.Context,
+	req *notificationv1.SendNotificationRequest,
+) (*notificationv1.SendNotificationResponse, error) {
+	p.mu.RLock()
+	endpoints, types, retry := p.endpoints, p.types, p.retry
+	p.mu.RUnlock()
+
+	if len(endpoints) == 0 {
+		return failure("webhook notification plugin is not configured"), nil
+	}
+
+	notificationType := notification.Type(req.GetNotificationType())
+	if !slices.Contains(types, notificationType) {
+		return failure(fmt.Sprintf("notification type %d is not delivered", notificationType)), nil
+	}
+
+	payload := newNotificationPayload(typeName(notificationType), req.GetSubject(), req.GetBody(),
+		req.GetRecipients())
+	id := notificationID(req)
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		failures  []string
+		delivered []string
+	)
+
+	for _, e := range endpoints {
+		if p.isDelivered(id, e.name) {
+			continue
+		}
+
+		wg.Go(func() {
+			err := p.deliver(ctx, e, id, payload, retry)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %v", e.name, err))
+				return
+			}
+
+			p.setDelivered(id, e.name)
+			delivered = append(delivered, e.name)
+		})
+	}
+
+	wg.Wait()
+
+	if len(failures) > 0 {
+		slices.Sort(failures)
+		slices.Sort(delivered)
+
+		msg := fmt.Sprintf("failed to deliver notification to %d of %d endpoints: %s",
+			len(failures), len(endpoints), strings.Join(failures, "; "))
+		if len(delivered) > 0 {
+			msg += fmt.Sprintf(" (delivered to %s, skipped on retry)", strings.Join(delivered, ", "))
+		}
+
+		p.logWarn(msg)
+
+		return failure(msg), nil
+	}
+
+	p.clearDelivered(id, endpoints)
+
+	return &notificationv1.SendNotificationResponse{Success: true}, nil
+}
+
+// deliver posts the payload to the endpoint, retrying failed deliveries
+// with exponential backoff
+func (p *Plugin) deliver(
+	ctx context.Context,
+	e *endpoint,
+	id string,
+	payload notificationPayload,
+	retry config.Retry,
+) error {
+	body, err := payload.marshal(e.format)
+	if err != nil {
+		return err
+	}
+
+	backoff := retry.InitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		wait, err := e.post(ctx, id, body)
+		if err == nil {
+			return nil
+		}
+
+		if !errors.Is(err, errRetryable) || attempt >= retry.MaxAttempts {
+			return err
+		}
+
+		wait = min(max(wait, backoff), retry.MaxBackoff)
+
+		p.logWarn("Failed to deliver notification, retrying",
+			"endpoint", e.name, "attempt", attempt, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+
+		backoff = min(backoff*2, retry.MaxBackoff)
+	}
+}
+
+// post sends the payload once. Network failures, 429 and 5xx responses are
+// retryable, the wait requested by a Retry-After header is returned.
+func (e *endpoint) post(ctx context.Context, id string, body []byte) (time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, id)
+
+	if len(e.secret) > 0 {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(TimestampHeader, timestamp)
+		req.Header.Set(SignatureHeader, Sign(e.secret, timestamp, body))
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return 0, err
+		}
+
+		return 0, fmt.Errorf("%w: %w", errRetryable, err)
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices:
+		return 0, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+		return retryAfter(resp), fmt.Errorf("%w: endpoint responded with status %d", errRetryable, resp.StatusCode)
+	default:
+		return 0, fmt.Errorf("endpoint responded with status %d", resp.StatusCode)
+	}
+}
+
+// Sign returns the signature header value of a payload
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// notificationID identifies a notification by its content, as a retried
+// notification is sent again with the same content and the request has
+// no ID of its own
+func notificationID(req *notificationv1.SendNotificationRequest) string {
+	hash := sha256.New()
+
+	for _, value := range append([]string{
+		strconv.Itoa(int(req.GetNotificationType())), req.GetSubject(), req.GetBody(),
+	}, req.GetRecipients()...) {
+		hash.Write([]byte(value))
+		hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func (p *Plugin) isDelivered(id, endpointName string) bool {
+	p.deliveredMu.Lock()
+	defer p.deliveredMu.Unlock()
+
+	now := time.Now()
+	for key, at := range p.delivered {
+		if now.Sub(at) > deliveredTTL {
+			delete(p.delivered, key)
+		}
+	}
+
+	_, ok := p.delivered[id+"/"+endpointName]
+
+	return ok
+}
+
+func (p *Plugin) setDelivered(id, endpointName string) {
+	p.deliveredMu.Lock()
+	defer p.deliveredMu.Unlock()
+
+	p.delivered[id+"/"+endpointName] = time.Now()
+}
+
+// clearDelivered forgets the deliveries of a notification every endpoint got,
+// so a later notification with the same content is not skipped
+func (p *Plugin) clearDelivered(id string, endpoints []*endpoint) {
+	p.deliveredMu.Lock()
+	defer p.deliveredMu.Unlock()
+
+	for _, e := range endpoints {
+		delete(p.delivered, id+"/"+e.name)
+	}
+}
+
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+func (p *Plugin) logWarn(msg string, args ...any) {
+	if p.logger != nil {
+		p.logger.Warn(msg, args...)
+	}
+}
+
+func failure(msg string) *notificationv1.SendNotificationResponse {
+	return &notificationv1.SendNotificationResponse{Success: false, Message: msg}
+}
+
+func newEndpoint(cfg *config.Endpoint) (*endpoint, error) {
+	if cfg.Name == "" {
+		return nil, ErrWebhook.Errorf("Endpoint name is required")
+	}
+
+	url, err := commoncfg.LoadValueFromSourceRef(cfg.URL)
+	if err != nil {
+		return nil, ErrWebhook.Wrapf(err, "Failed loading URL of endpoint %s", cfg.Name)
+	}
+
+	e := &endpoint{
+		name:   cfg.Name,
+		url:    strings.TrimSpace(string(url)),
+		format: cfg.Format,
+	}
+
+	switch e.format {
+	case "":
+		e.format = config.FormatJSON
+	case config.FormatJSON, config.FormatSlack, config.FormatTeams:
+	default:
+		return nil, ErrWebhook.Errorf("Unknown format %q of endpoint %s", cfg.Format, cfg.Name)
+	}
+
+	if cfg.Secret != (commoncfg.SourceRef{}) {
+		e.secret, err = commoncfg.LoadValueFromSourceRef(cfg.Secret)
+		if err != nil {
+			return nil, ErrWebhook.Wrapf(err, "Failed loading secret of endpoint %s", cfg.Name)
+		}
+	}
+
+	clientCfg := cfg.Client
+	if clientCfg.Timeout <= 0 {
+		clientCfg.Timeout = defaultTimeout
+	}
+
+	e.client, err = commonhttp.NewHTTPClient(&clientCfg)
+	if err != nil {
+		return nil, ErrWebhook.Wrapf(err, "Failed creating HTTP client of endpoint %s", cfg.Name)
+	}
+
+	return e, nil
+}
+
+func parseTypes(names []string) ([]notification.Type, error) {
+	if len(names) == 0 {
+		return []notification.Type{notification.Web}, nil
+	}
+
+	types := make([]notification.Type, 0, len(names))
+
+	for _, name := range names {
+		t, ok := notificationTypes[strings.ToLower(name)]
+		if !ok {
+			return nil, ErrWebhook.Errorf("Unknown notification type %q", name)
+		}
+
+		types = append(types, t)
+	}
+
+	return types, nil
+}
+
+func typeName(t notification.Type) string {
+	for name, value := range notificationTypes {
+		if value == t {
+			return name
+		}
+	}
+
+	return "unspecified"
+}
+
+func retrySettings(cfg config.Retry) config.Retry {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultMaxAttempts
+	}
+
+	if cfg.InitialBackoff <= 0 {
+		cfg.InitialBackoff = defaultInitialBackoff
+	}
+
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaultMaxBackoff
+	}
+
+	return cfg
+}
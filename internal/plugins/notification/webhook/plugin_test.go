@@ -0,0 +1,298 @@
+package webhook_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	notificationv1 "github.com/openkcm/plugin-sdk/proto/plugin/notification/v1"
+	configv1 "github.com/openkcm/plugin-sdk/proto/service/common/config/v1"
+
+	"github.com/openkcm/cmk/internal/pluginregistry/service/api/notification"
+	"github.com/openkcm/cmk/internal/plugins/notification/webhook"
+)
+
+const (
+	testSecret = "webhook-secret"
+	testBody   = `<html><body><p>A new workflow requires your approval.</p>` +
+		`<a href="https://cmk.example.com/tasks/1">Click here to go to the workflow</a></body></html>`
+)
+
+// receiver is a webhook endpoint keeping the received requests. It
+// responds with the given status codes first, then with 200.
+type receiver struct {
+	server *httptest.Server
+	calls  atomic.Int32
+
+	mu       sync.Mutex
+	statuses []int
+	requests []receivedRequest
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func newReceiver(t *testing.T, statuses ...int) *receiver {
+	t.Helper()
+
+	r := &receiver{statuses: statuses}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.calls.Add(1)
+
+		body, _ := io.ReadAll(req.Body)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if len(r.statuses) > 0 {
+			status := r.statuses[0]
+			r.statuses = r.statuses[1:]
+			w.WriteHeader(status)
+
+			return
+		}
+
+		r.requests = append(r.requests, receivedRequest{header: req.Header.Clone(), body: body})
+	}))
+
+	t.Cleanup(r.server.Close)
+
+	return r
+}
+
+func (r *receiver) received() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.requests
+}
+
+func endpointConfig(name string, r *receiver, format string) string {
+	return `
+  - name: ` + name + `
+    format: ` + format + `
+    url:
+      source: embedded
+      value: ` + r.server.URL + `
+    secret:
+      source: embedded
+      value: ` + testSecret
+}
+
+func setupPlugin(t *testing.T, config string) *webhook.Plugin {
+	t.Helper()
+
+	p := webhook.NewPlugin()
+	_, err := p.Configure(t.Context(), &configv1.ConfigureRequest{
+		YamlConfiguration: `
+retry:
+  maxAttempts: 3
+  initialBackoff: 10ms
+  maxBackoff: 50ms
+` + config,
+	})
+	require.NoError(t, err)
+
+	return p
+}
+
+func sendRequest(t notification.Type) *notificationv1.SendNotificationRequest {
+	return &notificationv1.SendNotificationRequest{
+		NotificationType: notificationv1.NotificationType(t),
+		Recipients:       []string{"approver@example.com"},
+		Subject:          "Workflow Approval Required",
+		Body:             testBody,
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	for name, config := range map[string]string{
+		"Should fail without endpoints": `endpoints: []`,
+		"Should fail on unknown format": `
+endpoints:
+  - name: chat
+    format: irc
+    url:
+      source: embedded
+      value: http://localhost`,
+		"Should fail on unknown notification type": `
+notificationTypes: [pager]
+endpoints:
+  - name: chat
+    url:
+      source: embedded
+      value: http://localhost`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := webhook.NewPlugin().Configure(t.Context(), &configv1.ConfigureRequest{
+				YamlConfiguration: config,
+			})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSendNotification(t *testing.T) {
+	t.Run("Should post signed JSON payload", func(t *testing.T) {
+		r := newReceiver(t)
+		p := setupPlugin(t, "endpoints:"+endpointConfig("generic", r, "json"))
+
+		resp, err := p.SendNotification(t.Context(), sendRequest(notification.Web))
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess(), resp.GetMessage())
+
+		requests := r.received()
+		require.Len(t, requests, 1)
+
+		header := requests[0].header
+		assert.Equal(t, "application/json", header.Get("Content-Type"))
+		assert.Len(t, header.Get(webhook.DeliveryHeader), 64)
+		assert.Equal(t,
+			webhook.Sign([]byte(testSecret), header.Get(webhook.TimestampHeader), requests[0].body),
+			header.Get(webhook.SignatureHeader))
+
+		var payload map[string]any
+		require.NoError(t, json.Unmarshal(requests[0].body, &payload))
+		assert.Equal(t, "web", payload["type"])
+		assert.Equal(t, "Workflow Approval Required", payload["subject"])
+		assert.Equal(t, testBody, payload["body"])
+		assert.Contains(t, payload["text"], "A new workflow requires your approval.")
+		assert.Equal(t, []any{"approver@example.com"}, payload["recipients"])
+		assert.Equal(t, []any{map[string]any{
+			"text": "Click here to go to the workflow",
+			"url":  "https://cmk.example.com/tasks/1",
+		}}, payload["links"])
+	})
+
+	t.Run("Should post chat formats", func(t *testing.T) {
+		slack := newReceiver(t)
+		teams := newReceiver(t)
+		p := setupPlugin(t, "endpoints:"+endpointConfig("slack", slack, "slack")+endpointConfig("teams", teams, "teams"))
+
+		resp, err := p.SendNotification(t.Context(), sendRequest(notification.Web))
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess(), resp.GetMessage())
+
+		require.Len(t, slack.received(), 1)
+		assert.JSONEq(t, `{
+			"text": "Workflow Approval Required",
+			"blocks": [
+				{"type": "header", "text": {"type": "plain_text", "text": "Workflow Approval Required"}},
+				{"type": "section", "text": {"type": "mrkdwn",
+					"text": "A new workflow requires your approval.\nClick here to go to the workflow"}},
+				{"type": "actions", "elements": [{"type": "button",
+					"text": {"type": "plain_text", "text": "Click here to go to the workflow"},
+					"url": "https://cmk.example.com/tasks/1"}]},
+				{"type": "context", "elements": [{"type": "mrkdwn", "text": "Recipients: approver@example.com"}]}
+			]
+		}`, string(slack.received()[0].body))
+
+		require.Len(t, teams.received(), 1)
+
+		var message struct {
+			Type        string `json:"type"`
+			Attachments []struct {
+				ContentType string `json:"contentType"`
+				Content     struct {
+					Type    string           `json:"type"`
+					Body    []map[string]any `json:"body"`
+					Actions []map[string]any `json:"actions"`
+				} `json:"content"`
+			} `json:"attachments"`
+		}
+		require.NoError(t, json.Unmarshal(teams.received()[0].body, &message))
+		assert.Equal(t, "message", message.Type)
+		require.Len(t, message.Attachments, 1)
+		assert.Equal(t, "application/vnd.microsoft.card.adaptive", message.Attachments[0].ContentType)
+		assert.Equal(t, "AdaptiveCard", message.Attachments[0].Content.Type)
+		assert.Equal(t, "Workflow Approval Required", message.Attachments[0].Content.Body[0]["text"])
+		assert.Equal(t, "https://cmk.example.com/tasks/1", message.Attachments[0].Content.Actions[0]["url"])
+	})
+
+	t.Run("Should retry failed deliveries", func(t *testing.T) {
+		r := newReceiver(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+		p := setupPlugin(t, "endpoints:"+endpointConfig("generic", r, "json"))
+
+		resp, err := p.SendNotification(t.Context(), sendRequest(notification.Web))
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess(), resp.GetMessage())
+		assert.Equal(t, int32(3), r.calls.Load())
+		assert.Len(t, r.received(), 1)
+	})
+
+	t.Run("Should report failed endpoints", func(t *testing.T) {
+		rejecting := newReceiver(t, http.StatusBadRequest)
+		failing := newReceiver(t, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway)
+		ok := newReceiver(t)
+		p := setupPlugin(t, "endpoints:"+
+			endpointConfig("rejecting", rejecting, "json")+
+			endpointConfig("failing", failing, "json")+
+			endpointConfig("ok", ok, "json"))
+
+		resp, err := p.SendNotification(t.Context(), sendRequest(notification.Web))
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Contains(t, resp.GetMessage(), "2 of 3 endpoints")
+		assert.Contains(t, resp.GetMessage(), "rejecting: endpoint responded with status 400")
+		assert.Contains(t, resp.GetMessage(), "failing:")
+		assert.NotContains(t, resp.GetMessage(), "ok:")
+		assert.Equal(t, int32(1), rejecting.calls.Load())
+		assert.Equal(t, int32(3), failing.calls.Load())
+		assert.Len(t, ok.received(), 1)
+		assert.Contains(t, resp.GetMessage(), "delivered to ok")
+	})
+
+	t.Run("Should only post to failed endpoints on retry", func(t *testing.T) {
+		failing := newReceiver(t, http.StatusBadRequest)
+		ok := newReceiver(t)
+		p := setupPlugin(t, "endpoints:"+
+			endpointConfig("failing", failing, "json")+
+			endpointConfig("ok", ok, "json"))
+
+		resp, err := p.SendNotification(t.Context(), sendRequest(notification.Web))
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+
+		resp, err = p.SendNotification(t.Context(), sendRequest(notification.Web))
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess(), resp.GetMessage())
+		assert.Len(t, failing.received(), 1)
+		assert.Len(t, ok.received(), 1)
+		assert.Equal(t,
+			ok.received()[0].header.Get(webhook.DeliveryHeader),
+			failing.received()[0].header.Get(webhook.DeliveryHeader))
+
+		// Once delivered to every endpoint, the same notification is new again
+		resp, err = p.SendNotification(t.Context(), sendRequest(notification.Web))
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess(), resp.GetMessage())
+		assert.Len(t, failing.received(), 2)
+		assert.Len(t, ok.received(), 2)
+	})
+
+	t.Run("Should deliver configured notification types only", func(t *testing.T) {
+		r := newReceiver(t)
+		p := setupPlugin(t, "endpoints:"+endpointConfig("generic", r, "json"))
+
+		resp, err := p.SendNotification(t.Context(), sendRequest(notification.Email))
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Empty(t, r.received())
+
+		p = setupPlugin(t, "notificationTypes: [web, email]\nendpoints:"+endpointConfig("generic", r, "json"))
+
+		resp, err = p.SendNotification(t.Context(), sendRequest(notification.Email))
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess(), resp.GetMessage())
+		assert.Len(t, r.received(), 1)
+	})
+}
@@ -0,0 +1,10 @@
+package config
+
+import "time"
+
+type Config struct {
+	// Path is a YAML or JSON file, or a directory of such files
+	Path string `yaml:"path"`
+	// ReloadInterval is how often the files are checked for changes
+	ReloadInterval time.Duration `yaml:"reloadInterval"`
+}
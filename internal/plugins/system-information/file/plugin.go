@@ -0,0 +1,211 @@
+// Package file is a built-in system information plugin serving the metadata
+// of systems from YAML or JSON files, for deployments without a system
+// information service and for tests of the system refresh.
+//
+// The files hold a list of systems with their ID, type and metadata:
+//
+//	systems:
+//	  - id: 0815-4711
+//	    type: system
+//	    metadata:
+//	      name: ERP production
+//
+// A system without type matches systems of any type. The files are checked
+// for changes periodically and reloaded, a file failing to load keeps the
+// systems loaded before.
+package file
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"maps"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/openkcm/plugin-sdk/pkg/catalog"
+	"github.com/openkcm/plugin-sdk/pkg/hclog2slog"
+	"github.com/samber/oops"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+
+	systeminformationv1 "github.com/openkcm/plugin-sdk/proto/plugin/systeminformation/v1"
+	configv1 "github.com/openkcm/plugin-sdk/proto/service/common/config/v1"
+
+	"github.com/openkcm/cmk/internal/plugins/system-information/file/config"
+)
+
+const defaultReloadInterval = 30 * time.Second
+
+var (
+	ErrFile = oops.In("File system information plugin")
+
+	ErrNotConfigured  = status.Error(codes.FailedPrecondition, "file plugin is not configured")
+	ErrSystemNotFound = status.Error(codes.NotFound, "system does not exist")
+	ErrNoID           = status.Error(codes.InvalidArgument, "no id provided")
+)
+
+func Register(registry catalog.BuiltInPluginRegistry) {
+	registry.Register(builtin(NewPlugin()))
+}
+
+func builtin(p *Plugin) catalog.BuiltInPlugin {
+	return catalog.MakeBuiltIn("file",
+		systeminformationv1.SystemInformationServicePluginServer(p),
+		configv1.ConfigServiceServer(p))
+}
+
+type Plugin struct {
+	systeminformationv1.UnsafeSystemInformationServiceServer
+	configv1.UnsafeConfigServer
+
+	logger    *slog.Logger
+	buildInfo string
+
+	systems atomic.Pointer[systems]
+
+	mu         sync.Mutex
+	stopReload context.CancelFunc
+	reloading  sync.WaitGroup
+}
+
+var (
+	_ systeminformationv1.SystemInformationServiceServer = (*Plugin)(nil)
+	_ configv1.ConfigServer                              = (*Plugin)(nil)
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{
+		buildInfo: "{}",
+	}
+}
+
+func (p *Plugin) SetLogger(logger hclog.Logger) {
+	p.logger = hclog2slog.New(logger)
+}
+
+func (p *Plugin) Configure(_ context.Context, req *configv1.ConfigureRequest) (*configv1.ConfigureResponse, error) {
+	slog.Info("Configuring plugin")
+
+	cfg := &config.Config{}
+
+	err := yaml.Unmarshal([]byte(req.GetYamlConfiguration()), cfg)
+	if err != nil {
+		return nil, ErrFile.Wrapf(err, "Failed to get yaml Configuration")
+	}
+
+	if cfg.Path == "" {
+		return nil, ErrFile.Errorf("path is required")
+	}
+
+	src := source{path: cfg.Path}
+
+	version, err := src.version()
+	if err != nil {
+		return nil, ErrFile.Wrapf(err, "Failed to read systems")
+	}
+
+	loaded, err := src.load()
+	if err != nil {
+		return nil, ErrFile.Wrapf(err, "Failed to load systems")
+	}
+
+	_ = p.Close()
+
+	p.systems.Store(&loaded)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	p.mu.Lock()
+	p.stopReload = cancel
+	p.mu.Unlock()
+
+	p.reloading.Go(func() {
+		p.reload(ctx, src, version, cmp.Or(cfg.ReloadInterval, defaultReloadInterval))
+	})
+
+	return &configv1.ConfigureResponse{
+		BuildInfo: &p.buildInfo,
+	}, nil
+}
+
+// Close stops the reload of the files
+func (p *Plugin) Close() error {
+	p.mu.Lock()
+	if p.stopReload != nil {
+		p.stopReload()
+		p.stopReload = nil
+	}
+	p.mu.Unlock()
+
+	p.reloading.Wait()
+
+	return nil
+}
+
+func (p *Plugin) Get(
+	_ context.Context,
+	req *systeminformationv1.GetRequest,
+) (*systeminformationv1.GetResponse, error) {
+	loaded := p.systems.Load()
+	if loaded == nil {
+		return nil, ErrNotConfigured
+	}
+
+	if req.GetId() == "" {
+		return nil, ErrNoID
+	}
+
+	metadata, ok := loaded.lookup(req.GetId(), req.GetType())
+	if !ok {
+		return nil, ErrSystemNotFound
+	}
+
+	return &systeminformationv1.GetResponse{
+		Metadata: maps.Clone(metadata),
+	}, nil
+}
+
+// reload loads the systems whenever the version of the source changes
+func (p *Plugin) reload(ctx context.Context, src source, version string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		current, err := src.version()
+		if err != nil {
+			p.logWarn("Failed to check systems for changes", err)
+			continue
+		}
+
+		if current == version {
+			continue
+		}
+
+		// A failing version is not retried until it changes again
+		version = current
+
+		loaded, err := src.load()
+		if err != nil {
+			p.logWarn("Failed to reload systems, keeping the systems loaded before", err)
+			continue
+		}
+
+		p.systems.Store(&loaded)
+	}
+}
+
+func (p *Plugin) logWarn(msg string, err error) {
+	if p.logger != nil {
+		p.logger.Warn(msg, "error", err)
+	}
+}
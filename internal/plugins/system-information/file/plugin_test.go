@@ -0,0 +1,187 @@
+package file_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	systeminformationv1 "github.com/openkcm/plugin-sdk/proto/plugin/systeminformation/v1"
+	configv1 "github.com/openkcm/plugin-sdk/proto/service/common/config/v1"
+
+	"github.com/openkcm/cmk/internal/plugins/system-information/file"
+)
+
+const systemsYAML = `
+systems:
+  - id: sys-1
+    type: system
+    metadata:
+      name: ERP production
+      region: eu10
+  - id: sys-1
+    metadata:
+      name: ERP any type
+  - id: sys-2
+    type: SYSTEM
+    metadata:
+      name: CRM
+`
+
+const systemsJSON = `{"systems": [{"id": "sys-3", "type": "system", "metadata": {"name": "HR"}}]}`
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func configure(t *testing.T, path, extraConfig string) (*file.Plugin, error) {
+	t.Helper()
+
+	p := file.NewPlugin()
+	t.Cleanup(func() { _ = p.Close() })
+
+	_, err := p.Configure(t.Context(), &configv1.ConfigureRequest{
+		YamlConfiguration: "path: " + path + "\n" + extraConfig,
+	})
+
+	return p, err
+}
+
+func get(t *testing.T, p *file.Plugin, id, typ string) (map[string]string, error) {
+	t.Helper()
+
+	resp, err := p.Get(t.Context(), &systeminformationv1.GetRequest{Id: id, Type: typ})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetMetadata(), nil
+}
+
+func TestConfigure(t *testing.T) {
+	for name, content := range map[string]string{
+		"Should fail on invalid YAML":   "systems: [",
+		"Should fail on missing ID":     "systems:\n  - type: system",
+		"Should fail on duplicate type": "systems:\n  - id: a\n    type: x\n  - id: a\n    type: X",
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "systems.yaml")
+			writeFile(t, path, content)
+
+			_, err := configure(t, path, "")
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("Should fail on missing file", func(t *testing.T) {
+		_, err := configure(t, filepath.Join(t.TempDir(), "missing.yaml"), "")
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail without path", func(t *testing.T) {
+		_, err := configure(t, `""`, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail before configuration", func(t *testing.T) {
+		_, err := file.NewPlugin().Get(t.Context(), &systeminformationv1.GetRequest{Id: "sys-1"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestGet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "systems.yaml")
+	writeFile(t, path, systemsYAML)
+
+	p, err := configure(t, path, "")
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		id       string
+		typ      string
+		expected map[string]string
+	}{
+		"Should return system of the type": {
+			id: "sys-1", typ: "system", expected: map[string]string{"name": "ERP production", "region": "eu10"},
+		},
+		"Should fall back to system without type": {
+			id: "sys-1", typ: "subaccount", expected: map[string]string{"name": "ERP any type"},
+		},
+		"Should match type ignoring case": {
+			id: "sys-2", typ: "system", expected: map[string]string{"name": "CRM"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			metadata, err := get(t, p, tc.id, tc.typ)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, metadata)
+		})
+	}
+
+	t.Run("Should not find unknown system", func(t *testing.T) {
+		_, err := get(t, p, "sys-2", "subaccount")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Should fail without ID", func(t *testing.T) {
+		_, err := get(t, p, "", "system")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestGetFromDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "erp.yaml"), systemsYAML)
+	writeFile(t, filepath.Join(dir, "hr.json"), systemsJSON)
+	writeFile(t, filepath.Join(dir, ".hidden.yaml"), "systems: [")
+	writeFile(t, filepath.Join(dir, "README.md"), "# Systems")
+
+	p, err := configure(t, dir, "")
+	require.NoError(t, err)
+
+	metadata, err := get(t, p, "sys-3", "system")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "HR"}, metadata)
+
+	metadata, err = get(t, p, "sys-2", "system")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "CRM"}, metadata)
+
+	t.Run("Should fail on system defined in two files", func(t *testing.T) {
+		writeFile(t, filepath.Join(dir, "copy.json"), systemsJSON)
+
+		_, err := configure(t, dir, "")
+		assert.ErrorIs(t, err, file.ErrDuplicateSystem)
+	})
+}
+
+func TestReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "systems.json")
+	writeFile(t, path, systemsJSON)
+
+	p, err := configure(t, path, "reloadInterval: 10ms")
+	require.NoError(t, err)
+
+	name := func() string {
+		metadata, err := get(t, p, "sys-3", "system")
+		if err != nil {
+			return ""
+		}
+
+		return metadata["name"]
+	}
+
+	writeFile(t, path, `{"systems": [{"id": "sys-3", "metadata": {"name": "HR reloaded"}}]}`)
+	assert.Eventually(t, func() bool { return name() == "HR reloaded" }, time.Second, 10*time.Millisecond)
+
+	writeFile(t, path, `{"systems": [`)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, "HR reloaded", name(), "invalid file should keep the systems loaded before")
+}
@@ -0,0 +1,158 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrNoSystemID      = errors.New("system without id")
+	ErrDuplicateSystem = errors.New("system defined more than once")
+)
+
+// extensions are the extensions of the files read from a directory
+var extensions = []string{".yaml", ".yml", ".json"}
+
+// document is the content of a file. JSON files are read as YAML.
+type document struct {
+	Systems []system `yaml:"systems"`
+}
+
+type system struct {
+	ID string `yaml:"id"`
+	// Type of the system, empty matches systems of any type
+	Type     string            `yaml:"type"`
+	Metadata map[string]string `yaml:"metadata"`
+}
+
+type systemKey struct {
+	id  string
+	typ string
+}
+
+// systems holds the metadata of the systems by ID and type
+type systems map[systemKey]map[string]string
+
+// lookup returns the metadata of the system with the ID and type, falling
+// back to the system with the ID and no type
+func (s systems) lookup(id, typ string) (map[string]string, bool) {
+	if metadata, ok := s[systemKey{id: id, typ: strings.ToLower(typ)}]; ok {
+		return metadata, true
+	}
+
+	metadata, ok := s[systemKey{id: id}]
+
+	return metadata, ok
+}
+
+// source is the file or the directory of files holding the systems
+type source struct {
+	path string
+}
+
+// files returns the files of the source. Hidden files are skipped, like
+// the data directories of mounted Kubernetes config maps.
+func (s source) files() ([]string, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{s.path}, nil
+	}
+
+	entries, err := os.ReadDir(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), ".") || !slices.Contains(extensions, strings.ToLower(filepath.Ext(e.Name()))) {
+			continue
+		}
+
+		path := filepath.Join(s.path, e.Name())
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			files = append(files, path)
+		}
+	}
+
+	return files, nil
+}
+
+// version returns a value changing whenever a file of the source changes
+func (s source) version() (string, error) {
+	files, err := s.files()
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	for _, f := range files {
+		info, err := os.Stat(f)
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(&b, "%s:%d:%d;", f, info.Size(), info.ModTime().UnixNano())
+	}
+
+	return b.String(), nil
+}
+
+// load reads the systems of all files
+func (s source) load() (systems, error) {
+	files, err := s.files()
+	if err != nil {
+		return nil, err
+	}
+
+	result := systems{}
+	origins := map[systemKey]string{}
+
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+
+		var doc document
+
+		err = yaml.Unmarshal(data, &doc)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f, err)
+		}
+
+		for _, sys := range doc.Systems {
+			if sys.ID == "" {
+				return nil, fmt.Errorf("%s: %w", f, ErrNoSystemID)
+			}
+
+			key := systemKey{id: sys.ID, typ: strings.ToLower(sys.Type)}
+			if origin, ok := origins[key]; ok {
+				return nil, fmt.Errorf("%w: %s in %s and %s", ErrDuplicateSystem, sys.ID, origin, f)
+			}
+
+			origins[key] = f
+			result[key] = sys.Metadata
+		}
+	}
+
+	return result, nil
+}
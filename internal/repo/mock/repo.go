@@ -193,6 +193,16 @@ func (r *InMemoryRepository) Transaction(
 	return nil
 }
 
+// NewTransaction is a Transaction, as the in-memory transactions are not nested.
+func (r *InMemoryRepository) NewTransaction(ctx context.Context, txFunc repo.TransactionFunc) error {
+	return r.Transaction(ctx, txFunc)
+}
+
+// LockXact is a no-op as the in-memory transactions are not concurrent.
+func (r *InMemoryRepository) LockXact(_ context.Context, _ string) error {
+	return nil
+}
+
 func (r *InMemoryRepository) OffboardTenant(_ context.Context, schemaName string) error {
 	delete(r.db.databases, schemaName)
 	return nil
@@ -48,6 +48,9 @@ const (
 	WorkflowField       QueryField = "workflow"
 	WorkflowIDField     QueryField = "workflow_id"
 	GroupIDField        QueryField = "group_id"
+	SystemIDField       QueryField = "system_id"
+	SystemGroupIDField  QueryField = "system_group_id"
+	ValueField          QueryField = "value"
 	ApprovedField       QueryField = "approved"
 	ArtifactTypeField   QueryField = "artifact_type"
 	ArtifactIDField     QueryField = "artifact_id"
@@ -61,12 +64,18 @@ const (
 	ErrorCodeField      QueryField = "error_code"
 	AutoRotateField     QueryField = "auto_rotate"
 	ExpirationDateField QueryField = "expiration_date"
+	ExpiresAtField      QueryField = "expires_at"
+	ExpiredField        QueryField = "expired"
 	CreationDateField   QueryField = "creation_date"
 	CreatedField        QueryField = "created_at"
 	UpdatedField        QueryField = "updated_at"
 	RotatedField        QueryField = "rotated_at"
 	IssuerURLField      QueryField = "issuer_url"
 	IAMIdField          QueryField = "iam_identifier"
+	RoleField           QueryField = "role"
+	ResourceTypeField   QueryField = "resource_type"
+	AccessField         QueryField = "access"
+	TokenHashField      QueryField = "token_hash"
 	UnderWorkflowField  QueryField = "under_workflow"
 	DataField           QueryField = "data"
 	Name                QueryField = "name"
@@ -74,6 +83,16 @@ const (
 	ArtifactNameField      QueryField = "artifact_name"
 	ParamResourceNameField QueryField = "parameters_resource_name"
 
+	EventTypeField      QueryField = "event_type"
+	ObjectIDField       QueryField = "object_id"
+	ActorIDField        QueryField = "actor_id"
+	SequenceNumberField QueryField = "sequence_number"
+
+	// CreatedAfterField and CreatedBeforeField are filter keys for
+	// time range queries on the CreatedField column
+	CreatedAfterField  QueryField = "created_after"
+	CreatedBeforeField QueryField = "created_before"
+
 	// KeyconfigTotalSystems and KeyconfigTotalKeys are used as aliases in JOIN operations,
 	// typically in combination with the tableName to reference aggregated fields.
 	KeyconfigTotalSystems     QueryField = "total_systems"
@@ -26,6 +26,12 @@ type Repo interface {
 	Patch(ctx context.Context, resource Resource, query Query) (bool, error)
 	Set(ctx context.Context, resource Resource, query Query) error
 	Transaction(ctx context.Context, txFunc TransactionFunc) error
+	// NewTransaction runs txFunc in a transaction of its own, which is
+	// committed when txFunc returns, even inside a transaction in context.
+	NewTransaction(ctx context.Context, txFunc TransactionFunc) error
+	// LockXact waits for the lock on key, which is held until the
+	// transaction in context ends. It must be called inside Transaction.
+	LockXact(ctx context.Context, key string) error
 	Count(ctx context.Context, resource Resource, query Query) (int, error)
 	OffboardTenant(ctx context.Context, tenantID string) error
 	GetFilterOptions(ctx context.Context, resource Resource, columns []Filter, query Query) error
@@ -62,6 +68,8 @@ var (
 	ErrDeleteResource      = errors.New("failed to delete resource")
 	ErrGetResource         = errors.New("failed to get resource")
 	ErrTransaction         = errors.New("failed to execute transaction")
+	ErrNoTransaction       = errors.New("no transaction in context")
+	ErrLock                = errors.New("failed to acquire lock")
 	ErrWithTenant          = errors.New("failed to use tenant from context")
 	ErrTenantNotFound      = errors.New("tenant not found")
 	ErrInvalidFieldName    = errors.New("invalid field name")
@@ -15,6 +15,7 @@ import (
 	"github.com/openkcm/cmk/internal/log"
 	"github.com/openkcm/cmk/internal/model"
 	"github.com/openkcm/cmk/internal/multitenancy"
+	"github.com/openkcm/cmk/internal/multitenancy/migrator"
 	"github.com/openkcm/cmk/internal/repo"
 	"github.com/openkcm/cmk/internal/repo/violations"
 	cmkcontext "github.com/openkcm/cmk/utils/context"
@@ -23,6 +24,10 @@ import (
 const (
 	pqUniqueViolationErrCode = "23505" // see https://www.postgresql.org/docs/14/errcodes-appendix.html
 	PublicSchema             = "public"
+
+	// pg_advisory_xact_lock waits for the lock, which is released
+	// at the end of the transaction
+	sqlAdvisoryXactLock = "SELECT pg_advisory_xact_lock(?)"
 )
 
 type ctxKey string
@@ -443,6 +448,13 @@ func (r *ResourceRepository) Transaction(ctx context.Context, txFunc repo.Transa
 	if _, ok := ctx.Value(dbCtxKey).(*multitenancy.DB); ok {
 		return txFunc(ctx)
 	}
+
+	return r.NewTransaction(ctx, txFunc)
+}
+
+// NewTransaction runs txFunc in a transaction on another connection than
+// the transaction in context, if any, so it commits independently of it.
+func (r *ResourceRepository) NewTransaction(ctx context.Context, txFunc repo.TransactionFunc) error {
 	err := r.db.Transaction(
 		func(db *multitenancy.DB) error {
 			ctx := context.WithValue(ctx, dbCtxKey, db)
@@ -456,6 +468,22 @@ func (r *ResourceRepository) Transaction(ctx context.Context, txFunc repo.Transa
 	return nil
 }
 
+// LockXact waits for a transaction-level advisory lock on key.
+// Advisory locks are not bound to a schema, so tenant locks need the tenant in key.
+func (r *ResourceRepository) LockXact(ctx context.Context, key string) error {
+	tx, ok := ctx.Value(dbCtxKey).(*multitenancy.DB)
+	if !ok {
+		return repo.ErrNoTransaction
+	}
+
+	err := tx.WithContext(ctx).Exec(sqlAdvisoryXactLock, migrator.GenerateLockKey(key)).Error
+	if err != nil {
+		return errs.Wrap(repo.ErrLock, err)
+	}
+
+	return nil
+}
+
 func (r *ResourceRepository) getSchemaFromCtx(ctx context.Context) (string, error) {
 	tenant, err := cmkcontext.ExtractTenantID(ctx)
 	if err != nil {
@@ -106,5 +106,6 @@ func NewRepoAuthzLoader(
 		repoInternalPolicies,
 		RepoBusinessPolicies,
 		RepoResourceTypeActions,
+		nil,
 	)
 }
@@ -3,6 +3,7 @@ package testutils
 import (
 	"database/sql"
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -135,6 +136,64 @@ func NewGroup(m func(*model.Group)) *model.Group {
 	return new(mut(m))
 }
 
+func NewSystemGroup(m func(*model.SystemGroup)) *model.SystemGroup {
+	mut := NewMutator(func() model.SystemGroup {
+		return model.SystemGroup{
+			ID:   uuid.New(),
+			Name: uuid.NewString(),
+		}
+	})
+
+	return new(mut(m))
+}
+
+func NewCustomRole(m func(*model.CustomRole)) *model.CustomRole {
+	mut := NewMutator(func() model.CustomRole {
+		return model.CustomRole{
+			ID:       uuid.New(),
+			Name:     constants.BusinessRole("KEY_OPERATOR_" + strings.ToUpper(uuid.NewString()[:8])),
+			BaseRole: constants.KeyAdminRole,
+			Permissions: json.RawMessage(
+				`[{"resourceType":"Key","actions":["read","update"]}]`,
+			),
+		}
+	})
+
+	return new(mut(m))
+}
+
+func NewResourceGrant(m func(*model.ResourceGrant)) *model.ResourceGrant {
+	mut := NewMutator(func() model.ResourceGrant {
+		return model.ResourceGrant{
+			ID:           uuid.New(),
+			GroupID:      uuid.New(),
+			ResourceType: model.ResourceGrantTypeKeyConfiguration,
+			ResourceID:   uuid.New(),
+			Access:       model.ResourceGrantAccessRead,
+		}
+	})
+
+	return new(mut(m))
+}
+
+func NewAPIToken(m func(*model.APIToken)) *model.APIToken {
+	mut := NewMutator(func() model.APIToken {
+		id := uuid.New()
+
+		return model.APIToken{
+			ID:        id,
+			Name:      uuid.NewString()[:8],
+			GroupID:   uuid.New(),
+			Prefix:    "cmk_" + id.String()[:8],
+			TokenHash: strings.ReplaceAll(id.String()+id.String(), "-", ""),
+			CreatedBy: uuid.NewString(),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+	})
+
+	return new(mut(m))
+}
+
 func NewKeystoreConfig(m func(*model.KeystoreConfig)) *model.KeystoreConfig {
 	mut := NewMutator(func() model.KeystoreConfig {
 		return model.KeystoreConfig{
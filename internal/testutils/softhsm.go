@@ -0,0 +1,77 @@
+package testutils
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	SoftHSMTokenLabel = "cmk-test"
+	SoftHSMPIN        = "1234"
+
+	// softHSMLibraryEnv overrides the path of the SoftHSM2 module
+	softHSMLibraryEnv = "SOFTHSM2_LIB"
+)
+
+var softHSMLibraries = []string{
+	"/usr/lib/softhsm/libsofthsm2.so",
+	"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/lib/aarch64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/local/lib/softhsm/libsofthsm2.so",
+	"/opt/homebrew/lib/softhsm/libsofthsm2.so",
+}
+
+// StartSoftHSM initializes a SoftHSM2 token in a temporary directory and
+// returns the path of the SoftHSM2 module. The token has the label
+// SoftHSMTokenLabel and the user PIN SoftHSMPIN. The test is skipped if
+// SoftHSM2 is not installed.
+func StartSoftHSM(tb testing.TB) string {
+	tb.Helper()
+
+	library := findSoftHSMLibrary()
+	if library == "" {
+		tb.Skip("SoftHSM2 module not found, set " + softHSMLibraryEnv)
+	}
+
+	util, err := exec.LookPath("softhsm2-util")
+	if err != nil {
+		tb.Skip("softhsm2-util not found")
+	}
+
+	dir := tb.TempDir()
+	tokenDir := filepath.Join(dir, "tokens")
+	require.NoError(tb, os.Mkdir(tokenDir, 0o700))
+
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(tb, os.WriteFile(conf, []byte(
+		"directories.tokendir = "+tokenDir+"\nobjectstore.backend = file\nlog.level = ERROR\n",
+	), filePermRW))
+
+	tb.Setenv("SOFTHSM2_CONF", conf)
+
+	// #nosec G204 -- test only command with fixed arguments
+	out, err := exec.CommandContext(tb.Context(), util, "--init-token", "--free",
+		"--label", SoftHSMTokenLabel, "--pin", SoftHSMPIN, "--so-pin", SoftHSMPIN).CombinedOutput()
+	require.NoError(tb, err, string(out))
+
+	return library
+}
+
+func findSoftHSMLibrary() string {
+	if library := os.Getenv(softHSMLibraryEnv); library != "" {
+		return library
+	}
+
+	for _, library := range softHSMLibraries {
+		_, err := os.Stat(library)
+		if err == nil {
+			return library
+		}
+	}
+
+	return ""
+}
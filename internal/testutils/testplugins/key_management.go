@@ -96,6 +96,25 @@ func (s *TestKeyManagement) WithValidNativeIDPattern(pattern string) *TestKeyMan
 	return s
 }
 
+// declaredKeyManagement is a KeyManagement declaring capabilities
+type declaredKeyManagement struct {
+	keymanagement.KeyManagement
+
+	capabilities keymanagement.Capabilities
+}
+
+func (d declaredKeyManagement) Capabilities() keymanagement.Capabilities {
+	return d.capabilities
+}
+
+// WithCapabilities returns the KeyManagement service declaring the capabilities
+func WithCapabilities(
+	svc keymanagement.KeyManagement,
+	capabilities keymanagement.Capabilities,
+) keymanagement.KeyManagement {
+	return declaredKeyManagement{KeyManagement: svc, capabilities: capabilities}
+}
+
 func (s *TestKeyManagement) ServiceInfo() api.Info {
 	var tags []string
 	if s.IsHYOK {
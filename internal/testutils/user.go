@@ -49,6 +49,15 @@ func (u *user) GetRoleFromIAM(ctx context.Context, iamIdentifiers []string) (con
 	return constants.KeyAdminRole, nil
 }
 
+func (u *user) ExplainAccess(
+	ctx context.Context,
+	action authz.APIAction,
+	resource authz.APIResourceType,
+	resourceID *uuid.UUID,
+) (manager.AccessDecision, error) {
+	return manager.AccessDecision{}, nil
+}
+
 func (u *user) GetBusinessUserInfo(ctx context.Context) (manager.BusinessUserInfo, error) {
 	return manager.BusinessUserInfo{}, nil
 }
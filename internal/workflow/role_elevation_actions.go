@@ -0,0 +1,25 @@
+package workflow
+
+import (
+	"context"
+
+	"github.com/openkcm/cmk/internal/errs"
+	"github.com/openkcm/cmk/internal/model"
+)
+
+type RoleElevationActions interface {
+	GrantRoleElevation(ctx context.Context, workflow *model.Workflow) (*model.RoleElevation, error)
+}
+
+func (l *Lifecycle) grantRoleElevation(ctx context.Context) error {
+	if l.RoleElevationActions == nil {
+		return errs.Wrapf(ErrWorkflowExecution, "role elevation is not supported")
+	}
+
+	_, err := l.RoleElevationActions.GrantRoleElevation(ctx, l.Workflow)
+	if err != nil {
+		return errs.Wrap(ErrWorkflowExecution, err)
+	}
+
+	return nil
+}
@@ -25,8 +25,9 @@ type Lifecycle struct {
 	KeyConfigurationActions KeyConfigurationActions
 	SystemActions           SystemActions
 	MinimumApproverCount    int
-	EligibleApproverIDs     map[string]bool // Optional: if set, only these approvers count for voting
-	ActorApproverGroupIDs   []uuid.UUID     // Optional: if set, validates actor is still in approver groups
+	EligibleApproverIDs     map[string]bool      // Optional: if set, only these approvers count for voting
+	ActorApproverGroupIDs   []uuid.UUID          // Optional: if set, validates actor is still in approver groups
+	RoleElevationActions    RoleElevationActions // Optional: required to execute role elevation workflows
 }
 
 // convertEvent converts Transition and model.WorkflowState types to string
@@ -634,6 +635,7 @@ func (l *Lifecycle) executeWorkflowAction(ctx context.Context) error {
 		model.WorkflowArtifactTypeKeyConfiguration: {
 			model.WorkflowActionTypeDelete:        l.deleteKeyConfiguration,
 			model.WorkflowActionTypeUpdatePrimary: l.updatePrimaryKey,
+			model.WorkflowActionTypeElevate:       l.grantRoleElevation,
 		},
 		model.WorkflowArtifactTypeSystem: {
 			model.WorkflowActionTypeLink:   l.systemLinkOrSwitch,
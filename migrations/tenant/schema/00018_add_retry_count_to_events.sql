@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE events ADD COLUMN retry_count INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE events DROP COLUMN retry_count;
@@ -0,0 +1,26 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS system_groups (
+	id uuid PRIMARY KEY,
+	name varchar(64) NOT NULL UNIQUE,
+	description text,
+	selector jsonb,
+	created_at timestamptz NOT NULL,
+	updated_at timestamptz NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS system_group_systems (
+	system_group_id uuid NOT NULL,
+	system_id uuid NOT NULL,
+	PRIMARY KEY (system_group_id, system_id),
+	CONSTRAINT fk_system_group_systems_system_group
+		FOREIGN KEY (system_group_id) REFERENCES system_groups(id) ON DELETE CASCADE,
+	CONSTRAINT fk_system_group_systems_system
+		FOREIGN KEY (system_id) REFERENCES systems(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_system_group_systems_system_id ON system_group_systems(system_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS system_group_systems;
+DROP TABLE IF EXISTS system_groups;
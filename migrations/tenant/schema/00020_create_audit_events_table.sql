@@ -0,0 +1,16 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS audit_events (
+	id uuid PRIMARY KEY,
+	event_type varchar(64) NOT NULL,
+	object_id varchar(255) NOT NULL,
+	actor_id varchar(255) NOT NULL,
+	correlation_id varchar(255),
+	attributes jsonb,
+	created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_audit_events_created_at ON audit_events(created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_events;
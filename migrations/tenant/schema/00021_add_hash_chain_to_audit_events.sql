@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE audit_events ADD COLUMN sequence_number BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE audit_events ADD COLUMN previous_hash varchar(64) NOT NULL DEFAULT '';
+ALTER TABLE audit_events ADD COLUMN hash varchar(64) NOT NULL DEFAULT '';
+
+-- Events stored before hash chaining keep sequence number 0 and are not part of the chain
+CREATE UNIQUE INDEX idx_audit_events_sequence_number ON audit_events(sequence_number) WHERE sequence_number > 0;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_audit_events_sequence_number;
+ALTER TABLE audit_events DROP COLUMN hash;
+ALTER TABLE audit_events DROP COLUMN previous_hash;
+ALTER TABLE audit_events DROP COLUMN sequence_number;
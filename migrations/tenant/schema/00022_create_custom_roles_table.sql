@@ -0,0 +1,14 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS custom_roles (
+	id uuid PRIMARY KEY,
+	name varchar(64) NOT NULL UNIQUE,
+	description text,
+	base_role varchar(255) NOT NULL,
+	permissions jsonb NOT NULL,
+	created_at timestamptz NOT NULL,
+	updated_at timestamptz NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS custom_roles;
@@ -0,0 +1,19 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS resource_grants (
+	id uuid PRIMARY KEY,
+	group_id uuid NOT NULL,
+	resource_type varchar(64) NOT NULL,
+	resource_id uuid NOT NULL,
+	access varchar(32) NOT NULL,
+	created_at timestamptz NOT NULL,
+	updated_at timestamptz NOT NULL,
+	CONSTRAINT idx_resource_grant UNIQUE (group_id, resource_type, resource_id),
+	CONSTRAINT fk_resource_grants_group
+		FOREIGN KEY (group_id) REFERENCES groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_resource_grants_resource ON resource_grants(resource_type, resource_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS resource_grants;
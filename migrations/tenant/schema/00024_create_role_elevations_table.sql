@@ -0,0 +1,21 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS role_elevations (
+	id uuid PRIMARY KEY,
+	user_id varchar(255) NOT NULL,
+	key_configuration_id uuid NOT NULL,
+	role varchar(50) NOT NULL,
+	workflow_id uuid NOT NULL,
+	expires_at timestamptz NOT NULL,
+	expired boolean NOT NULL DEFAULT false,
+	created_at timestamptz NOT NULL,
+	updated_at timestamptz NOT NULL,
+	CONSTRAINT fk_role_elevations_key_configuration
+		FOREIGN KEY (key_configuration_id) REFERENCES key_configurations(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_role_elevations_user ON role_elevations(user_id, key_configuration_id);
+CREATE INDEX idx_role_elevations_expiry ON role_elevations(expired, expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS role_elevations;
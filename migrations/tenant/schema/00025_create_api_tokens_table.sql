@@ -0,0 +1,23 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS api_tokens (
+	id uuid PRIMARY KEY,
+	name varchar(64) NOT NULL,
+	group_id uuid NOT NULL,
+	prefix varchar(16) NOT NULL,
+	token_hash varchar(64) NOT NULL,
+	created_by varchar(255) NOT NULL,
+	expires_at timestamptz NOT NULL,
+	revoked_at timestamptz,
+	last_used_at timestamptz,
+	created_at timestamptz NOT NULL,
+	updated_at timestamptz NOT NULL,
+	CONSTRAINT idx_api_tokens_token_hash UNIQUE (token_hash),
+	CONSTRAINT fk_api_tokens_group
+		FOREIGN KEY (group_id) REFERENCES groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_api_tokens_group ON api_tokens(group_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS api_tokens;
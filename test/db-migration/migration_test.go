@@ -137,6 +137,13 @@ func TestMissingSchemaScripts(t *testing.T) {
 		&model.ImportParams{},
 		&model.Keystore{},
 		&model.Event{},
+		&model.SystemGroup{},
+		&model.SystemGroupSystem{},
+		&model.AuditEvent{},
+		&model.CustomRole{},
+		&model.ResourceGrant{},
+		&model.RoleElevation{},
+		&model.APIToken{},
 	)
 	assert.NoError(t, err)
 	assert.NoError(t, gormMigrated.MigrateTenantModels(t.Context(), gormTenant.SchemaName))
@@ -596,6 +603,102 @@ func TestSchemaMigrations(t *testing.T) {
 			target:    db.TenantTarget,
 			version:   17,
 		},
+		{
+			name:      "Should up tenant/00018_add_retry_count_to_events.sql",
+			downgrade: false,
+			target:    db.TenantTarget,
+			version:   18,
+		},
+		{
+			name:      "Should down tenant/00018_add_retry_count_to_events.sql",
+			downgrade: true,
+			target:    db.TenantTarget,
+			version:   18,
+		},
+		{
+			name:      "Should up tenant/00019_create_system_groups_table.sql",
+			downgrade: false,
+			target:    db.TenantTarget,
+			version:   19,
+		},
+		{
+			name:      "Should down tenant/00019_create_system_groups_table.sql",
+			downgrade: true,
+			target:    db.TenantTarget,
+			version:   19,
+		},
+		{
+			name:      "Should up tenant/00020_create_audit_events_table.sql",
+			downgrade: false,
+			target:    db.TenantTarget,
+			version:   20,
+		},
+		{
+			name:      "Should down tenant/00020_create_audit_events_table.sql",
+			downgrade: true,
+			target:    db.TenantTarget,
+			version:   20,
+		},
+		{
+			name:      "Should up tenant/00021_add_hash_chain_to_audit_events.sql",
+			downgrade: false,
+			target:    db.TenantTarget,
+			version:   21,
+		},
+		{
+			name:      "Should down tenant/00021_add_hash_chain_to_audit_events.sql",
+			downgrade: true,
+			target:    db.TenantTarget,
+			version:   21,
+		},
+		{
+			name:      "Should up tenant/00022_create_custom_roles_table.sql",
+			downgrade: false,
+			target:    db.TenantTarget,
+			version:   22,
+		},
+		{
+			name:      "Should down tenant/00022_create_custom_roles_table.sql",
+			downgrade: true,
+			target:    db.TenantTarget,
+			version:   22,
+		},
+		{
+			name:      "Should up tenant/00023_create_resource_grants_table.sql",
+			downgrade: false,
+			target:    db.TenantTarget,
+			version:   23,
+		},
+		{
+			name:      "Should down tenant/00023_create_resource_grants_table.sql",
+			downgrade: true,
+			target:    db.TenantTarget,
+			version:   23,
+		},
+		{
+			name:      "Should up tenant/00024_create_role_elevations_table.sql",
+			downgrade: false,
+			target:    db.TenantTarget,
+			version:   24,
+		},
+		{
+			name:      "Should down tenant/00024_create_role_elevations_table.sql",
+			downgrade: true,
+			target:    db.TenantTarget,
+			version:   24,
+		},
+		{
+			name:      "Should up tenant/00025_create_api_tokens_table.sql",
+			downgrade: false,
+			target:    db.TenantTarget,
+			version:   25,
+		},
+		{
+			name:      "Should down tenant/00025_create_api_tokens_table.sql",
+			downgrade: true,
+			target:    db.TenantTarget,
+			version:   25,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -58,9 +58,9 @@ func (s *DBSuite) SetupSuite() {
 
 	cmkAuditor := auditor.New(ctx, cfg)
 
-	cm := manager.NewCertificateManager(ctx, r, svcRegistry, cfg)
+	cm := manager.NewCertificateManager(ctx, r, svcRegistry, cfg, cmkAuditor)
 	um := manager.NewUserManager(r, cmkAuditor)
-	tagm := manager.NewTagManager(r)
+	tagm := manager.NewTagManager(r, cmkAuditor)
 	kcm := manager.NewKeyConfigManager(r, cm, um, tagm, cmkAuditor, eventFactory, cfg)
 
 	sys := manager.NewSystemManager(
@@ -78,7 +78,7 @@ func (s *DBSuite) SetupSuite() {
 	km := manager.NewKeyManager(
 		r,
 		svcRegistry,
-		manager.NewTenantConfigManager(r, svcRegistry, nil, nil),
+		manager.NewTenantConfigManager(r, svcRegistry, nil, nil, cmkAuditor),
 		kcm,
 		um,
 		cm,
@@ -91,7 +91,7 @@ func (s *DBSuite) SetupSuite() {
 	s.NoError(err)
 
 	s.tm = manager.NewTenantManager(r, sys, km, um, cmkAuditor, migrator)
-	s.gm = manager.NewGroupManager(sql.NewRepository(s.db), svcRegistry, um)
+	s.gm = manager.NewGroupManager(sql.NewRepository(s.db), svcRegistry, um, cmkAuditor)
 }
 
 func (s *DBSuite) TearDownSuite() {
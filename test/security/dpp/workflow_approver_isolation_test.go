@@ -73,17 +73,17 @@ func TestWorkflowApproverIsolation(t *testing.T) {
 	cfg := &config.Config{}
 
 	cmkAuditor := auditor.New(t.Context(), cfg)
-	certManager := manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg)
-	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, cfg, certManager)
+	certManager := manager.NewCertificateManager(t.Context(), r, svcRegistry, cfg, cmkAuditor)
+	tenantConfigManager := manager.NewTenantConfigManager(r, svcRegistry, cfg, certManager, cmkAuditor)
 	userManager := manager.NewUserManager(authzRepoInst, cmkAuditor)
-	tagManager := manager.NewTagManager(r)
+	tagManager := manager.NewTagManager(r, cmkAuditor)
 	keyConfigManager := manager.NewKeyConfigManager(r, certManager, userManager, tagManager, cmkAuditor, nil, cfg)
-	groupManager := manager.NewGroupManager(r, svcRegistry, userManager)
+	groupManager := manager.NewGroupManager(r, svcRegistry, userManager, cmkAuditor)
 	clientsFactory, err := clients.NewFactory(cfg.Services)
 	require.NoError(t, err)
 	systemManager := manager.NewSystemManager(t.Context(), r, nil, clientsFactory, nil, svcRegistry, cfg, keyConfigManager, userManager)
 	keym := manager.NewKeyManager(r, svcRegistry, tenantConfigManager, keyConfigManager, userManager, certManager, nil, cmkAuditor, nil)
-	m := manager.NewWorkflowManager(r, svcRegistry, keym, keyConfigManager, systemManager, groupManager, userManager, nil, tenantConfigManager, cfg)
+	m := manager.NewWorkflowManager(r, svcRegistry, keym, keyConfigManager, systemManager, groupManager, userManager, nil, tenantConfigManager, cfg, cmkAuditor)
 
 	ctx := testutils.CreateCtxWithTenant(tenant)
 	ctxSys, err := cmkcontext.BusinessToInternalContext(ctx, constants.InternalTaskWorkflowApproversRole)
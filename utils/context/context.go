@@ -81,6 +81,25 @@ func GetRequestID(ctx context.Context) (string, error) {
 	return requestID, nil
 }
 
+const keyConfigurationScopeKey = key("keyConfigurationScope")
+
+// InjectKeyConfigurationScope sets the key configuration a request acts on,
+// role elevations only apply within it
+func InjectKeyConfigurationScope(ctx context.Context, keyConfigID uuid.UUID) context.Context {
+	return context.WithValue(ctx, keyConfigurationScopeKey, keyConfigID)
+}
+
+// ExtractKeyConfigurationScope returns the key configuration a request acts on,
+// or false if the request is not scoped to one
+func ExtractKeyConfigurationScope(ctx context.Context) (uuid.UUID, bool) {
+	keyConfigID, ok := ctx.Value(keyConfigurationScopeKey).(uuid.UUID)
+	if !ok || keyConfigID == uuid.Nil {
+		return uuid.Nil, false
+	}
+
+	return keyConfigID, true
+}
+
 // User data
 
 func ExtractUserType(ctx context.Context) (string, error) {
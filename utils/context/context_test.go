@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/openkcm/common-sdk/pkg/auth"
 	"github.com/stretchr/testify/assert"
 
@@ -69,6 +70,26 @@ func TestCreateTenantCtx(t *testing.T) {
 	)
 }
 
+func TestKeyConfigurationScope(t *testing.T) {
+	t.Run("Should return the injected key configuration", func(t *testing.T) {
+		keyConfigID := uuid.New()
+		ctx := cmkcontext.InjectKeyConfigurationScope(t.Context(), keyConfigID)
+
+		got, ok := cmkcontext.ExtractKeyConfigurationScope(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, keyConfigID, got)
+	})
+
+	t.Run("Should not be scoped without key configuration", func(t *testing.T) {
+		_, ok := cmkcontext.ExtractKeyConfigurationScope(t.Context())
+		assert.False(t, ok)
+
+		ctx := cmkcontext.InjectKeyConfigurationScope(t.Context(), uuid.Nil)
+		_, ok = cmkcontext.ExtractKeyConfigurationScope(ctx)
+		assert.False(t, ok)
+	})
+}
+
 func TestExtractBusinessUserData(t *testing.T) {
 	t.Run(
 		"Should return error if no client data in context", func(t *testing.T) {
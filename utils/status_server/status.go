@@ -11,10 +11,12 @@ import (
 	"github.com/openkcm/cmk/internal/constants"
 	"github.com/openkcm/cmk/internal/db/dsn"
 	"github.com/openkcm/cmk/internal/log"
+	cmkpluginregistry "github.com/openkcm/cmk/internal/pluginregistry"
+	servicewrapper "github.com/openkcm/cmk/internal/pluginregistry/service/wrapper"
 )
 
 // StartStatusServer creates a status server and by default waits for the DB to be alive
-// to start erving
+// to start erving. The health state of the plugins is reported in the readiness info.
 func StartStatusServer(ctx context.Context, cfg *config.Config, opts ...health.Option) {
 	dsnFromConfig, err := dsn.FromDBConfig(cfg.Database)
 	if err != nil {
@@ -26,8 +28,13 @@ func StartStatusServer(ctx context.Context, cfg *config.Config, opts ...health.O
 			constants.DBDriver,
 			dsnFromConfig,
 		),
+		health.WithInfoFunc(pluginHealthInfo),
 	}, opts...)
 
+	if cfg.PluginHealth.FailReadiness {
+		healthOptions = append(healthOptions, health.WithCheck(pluginHealthCheck(cfg.PluginHealth)))
+	}
+
 	go func() {
 		err := status.Serve(ctx, &cfg.BaseConfig, healthOptions...)
 		if err != nil {
@@ -37,3 +44,27 @@ func StartStatusServer(ctx context.Context, cfg *config.Config, opts ...health.O
 		}
 	}()
 }
+
+// pluginHealthInfo adds the health state of the plugins watched in this
+// process to the readiness info
+func pluginHealthInfo(info map[string]any) {
+	plugins := cmkpluginregistry.Health().Plugins()
+	if len(plugins) > 0 {
+		info["plugins"] = plugins
+	}
+}
+
+// pluginHealthCheck fails while a plugin of the required types is unhealthy
+func pluginHealthCheck(cfg config.PluginHealth) health.Check {
+	requiredTypes := cfg.RequiredTypes
+	if len(requiredTypes) == 0 {
+		requiredTypes = []string{servicewrapper.KeyManagementType, servicewrapper.IdentityManagementType}
+	}
+
+	return health.Check{
+		Name: "Plugins",
+		Check: func(_ context.Context) error {
+			return cmkpluginregistry.Health().CheckTypes(requiredTypes...)
+		},
+	}
+}